@@ -15,6 +15,8 @@ import (
 
 	"github.com/go-redis/redis/v8"
 	"github.com/jackc/pgx/v4/pgxpool"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 
@@ -26,7 +28,11 @@ import (
 	"homework1/internal/gateway"
 	"homework1/internal/kafka"
 	"homework1/internal/metrics"
+	"homework1/internal/outbox"
 	"homework1/internal/pool"
+	"homework1/internal/publisher"
+	"homework1/internal/repository"
+	"homework1/internal/scheduler"
 	"homework1/internal/server"
 	"homework1/internal/service"
 	"homework1/internal/tracing" // Импортируем пакет для трейсинга
@@ -56,12 +62,19 @@ func main() {
 			log.Fatalf("Ошибка завершения трейсинга: %v", err)
 		}
 	}()
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
 	log.Println("Трейсинг инициализирован:", cfg.TracingURL)
 
 	initDatabase(cfg)
 	defer dao.Closedb()
 
 	dbPool := dao.GetPool()
+	dao.SetRetryPolicy(dao.RetryPolicy{
+		MaxAttempts: cfg.DBTxMaxAttempts,
+		BaseDelay:   cfg.DBTxRetryBaseDelay,
+		MaxDelay:    cfg.DBTxRetryMaxDelay,
+	})
+	metrics.RegisterTxRetryMetrics(dao.GetTxRetryStats)
 
 	redisClient := initRedis(cfg)
 	defer redisClient.Close()
@@ -69,19 +82,39 @@ func main() {
 	go metrics.StartMetricsServer(cfg.MetricsAddr)
 	log.Println("Приложение запущено. Адрес метрик:", cfg.MetricsAddr)
 
-	kafkaProducer, err := kafka.NewProducer(cfg.KafkaBrokers, cfg.KafkaTopic)
+	keyFunc, err := kafka.ParseKeyFunc(cfg.KafkaPartitionKey)
 	if err != nil {
-		log.Fatalf("Ошибка при инициализации Kafka Producer: %v", err)
+		log.Fatalf("Ошибка в настройках Kafka: %v", err)
 	}
-	defer kafkaProducer.Close()
 
-	wp := pool.NewWorkerPool(2)
+	eventPublisher := initEventPublisher(cfg, keyFunc)
+	defer eventPublisher.Close()
+
+	startOutboxRelay(ctx, cfg, dbPool, eventPublisher)
+
+	queuePolicy, err := pool.ParseQueuePolicy(cfg.WorkerPoolQueuePolicy)
+	if err != nil {
+		log.Fatalf("Ошибка в настройках пула воркеров: %v", err)
+	}
+
+	wp := pool.NewWorkerPoolWithConfig(pool.Config{
+		Workers:      cfg.WorkerPoolSize,
+		QueueSize:    cfg.WorkerPoolQueueSize,
+		Policy:       queuePolicy,
+		BlockTimeout: cfg.WorkerPoolBlockTimeout,
+	})
+	defer wp.Close()
+	metrics.RegisterWorkerPoolMetrics(wp.Stats)
+	metrics.RegisterCacheMetrics(cache.GetStats)
 
-	orderService, userService, packagingService, returnService, returnReasonService, statusService := initServices(dbPool, wp, kafkaProducer, redisClient)
+	localStore, coherence := initLocalCache(ctx, cfg, redisClient, dbPool)
 
-	startServers(ctx, cfg, orderService, userService, packagingService, returnService, returnReasonService, statusService, wp)
+	orderService, userService, packagingService, returnService, returnReasonService, statusService, cacheAdmin := initServices(cfg, repository.NewPostgresStore(dbPool), wp, eventPublisher, redisClient, localStore, coherence)
+	warmCache(ctx, cfg, cacheAdmin)
 
-	startBackgroundTask(ctx, orderService, wp)
+	jobScheduler := startScheduler(ctx, cfg, dbPool, orderService)
+
+	startServers(ctx, cfg, orderService, userService, packagingService, returnService, returnReasonService, statusService, wp, jobScheduler, cacheAdmin)
 
 	grpcClients := setupGRPCClients(cfg.GrpcPort)
 	view.RunInteractiveMode(ctx, grpcClients, wp)
@@ -96,29 +129,141 @@ func initRedis(cfg *config.Config) *redis.Client {
 	return rdb
 }
 
-// Функция для инициализации сервисов с Redis-кэшем и Kafka Producer
-func initServices(dbPool *pgxpool.Pool, wp *pool.WorkerPool, kafkaProd *kafka.Producer, redisClient *redis.Client) (
-	*service.OrderService, *service.UserService, *service.PackagingService, *service.ReturnService, *service.ReturnReasonService, *service.StatusService) {
+// initEventPublisher создает публикатор событий выбранной в конфигурации реализации.
+// Публикаторы file и memory позволяют запускать сервис локально без Kafka.
+func initEventPublisher(cfg *config.Config, keyFunc kafka.KeyFunc) publisher.EventPublisher {
+	backend, err := publisher.ParseBackend(cfg.EventPublisher)
+	if err != nil {
+		log.Fatalf("Ошибка в настройках публикатора событий: %v", err)
+	}
 
-	cacheConfig := cache.CacheConfig{
-		DefaultTTL: 10 * time.Minute, // Установите значение по умолчанию для TTL
+	eventPublisher, err := publisher.New(publisher.Config{
+		Backend:  backend,
+		Brokers:  cfg.KafkaBrokers,
+		Topic:    cfg.KafkaTopic,
+		KeyFunc:  keyFunc,
+		FilePath: cfg.EventPublisherFile,
+	})
+	if err != nil {
+		log.Fatalf("Ошибка при инициализации публикатора событий %s: %v", backend, err)
 	}
+	log.Println("Публикатор событий инициализирован:", backend)
+	return eventPublisher
+}
 
-	orderCache := cache.NewRedisCache[string, model.Order](redisClient, cacheConfig)
-	userCache := cache.NewRedisCache[string, model.User](redisClient, cacheConfig)
-	packagingCache := cache.NewRedisCache[string, model.PackagingOption](redisClient, cacheConfig)
-	returnCache := cache.NewRedisCache[string, model.Return](redisClient, cacheConfig)
-	returnReasonCache := cache.NewRedisCache[string, model.ReturnReason](redisClient, cacheConfig)
-	statusCache := cache.NewRedisCache[string, model.Status](redisClient, cacheConfig)
-
-	orderService := service.NewOrderService(dbPool, wp, kafkaProd, orderCache)
-	userService := service.NewUserService(dbPool, wp, userCache)
-	packagingService := service.NewPackagingService(dbPool, wp, packagingCache)
-	returnService := service.NewReturnService(dbPool, wp, kafkaProd, returnCache)
-	returnReasonService := service.NewReturnReasonService(dbPool, wp, returnReasonCache)
-	statusService := service.NewStatusService(dbPool, wp, statusCache)
-
-	return orderService, userService, packagingService, returnService, returnReasonService, statusService
+// Функция для инициализации сервисов с кэшем и публикатором событий.
+// Справочники (статусы, упаковка, причины возврата) читаются при каждом создании заказа,
+// поэтому для них используется отдельная реализация кэша, по умолчанию двухуровневая.
+// Все кэши в памяти процесса используют одно хранилище localStore, а сброс записей рассылается другим репликам через coherence.
+// Кэши именованы по сущностям: имя используется в метриках и административных операциях.
+func initServices(cfg *config.Config, store repository.Store, wp *pool.WorkerPool, eventPublisher publisher.EventPublisher, redisClient *redis.Client,
+	localStore *cache.LRUStore, coherence *cache.Coherence) (
+	*service.OrderService, *service.UserService, *service.PackagingService, *service.ReturnService, *service.ReturnReasonService, *service.StatusService,
+	*service.CacheAdminService) {
+
+	cacheOptions := newCacheOptions(cfg, cfg.CacheBackend, localStore)
+	dictionaryCacheOptions := newCacheOptions(cfg, cfg.CacheDictionaryBackend, localStore)
+	log.Printf("Кэш: backend=%s, dictionary_backend=%s", cacheOptions.Backend, dictionaryCacheOptions.Backend)
+
+	loadOptions := cache.LoadOptions{
+		TTL:              cfg.CacheTTL,
+		NegativeTTL:      cfg.CacheNegativeTTL,
+		NotFound:         repository.ErrNotFound,
+		EarlyRefreshBeta: cfg.CacheEarlyRefreshBeta,
+	}
+
+	orderCache := newLoadingCache[model.Order]("orders", redisClient, cacheOptions, loadOptions, coherence)
+	userCache := newLoadingCache[model.User]("users", redisClient, cacheOptions, loadOptions, coherence)
+	packagingCache := newLoadingCache[model.PackagingOption]("packaging", redisClient, dictionaryCacheOptions, loadOptions, coherence)
+	returnCache := newLoadingCache[model.Return]("returns", redisClient, cacheOptions, loadOptions, coherence)
+	returnReasonCache := newLoadingCache[model.ReturnReason]("return_reasons", redisClient, dictionaryCacheOptions, loadOptions, coherence)
+	statusCache := newLoadingCache[model.Status]("statuses", redisClient, dictionaryCacheOptions, loadOptions, coherence)
+
+	orderService := service.NewOrderService(store, wp, eventPublisher, orderCache)
+	userService := service.NewUserService(store, wp, userCache)
+	packagingService := service.NewPackagingService(store, wp, packagingCache)
+	returnService := service.NewReturnService(store, wp, eventPublisher, returnCache)
+	returnReasonService := service.NewReturnReasonService(store, wp, returnReasonCache)
+	statusService := service.NewStatusService(store, wp, statusCache)
+
+	cacheAdmin := service.NewCacheAdminService(
+		[]service.AdminCache{orderCache, userCache, packagingCache, returnCache, returnReasonCache, statusCache},
+		statusService, packagingService, returnReasonService,
+	)
+
+	return orderService, userService, packagingService, returnService, returnReasonService, statusService, cacheAdmin
+}
+
+// newLoadingCache создает кэш сервиса с именем name, который объединяет одновременные загрузки одного ключа из базы данных
+// и рассылает сброс записей другим репликам
+func newLoadingCache[V any](name string, redisClient *redis.Client, options cache.Options, loadOptions cache.LoadOptions, coherence *cache.Coherence) *cache.LoadingCache[string, V] {
+	options.Name = name
+	loadOptions.Name = name
+	return cache.NewLoadingCache(cache.WithCoherence(cache.New[string, V](redisClient, options), coherence), loadOptions)
+}
+
+// warmCache загружает в кэш справочники до запуска серверов, чтобы первые заказы не ждали загрузки из базы данных.
+// Ошибка прогрева не останавливает запуск: справочники загрузятся при первом обращении.
+func warmCache(ctx context.Context, cfg *config.Config, cacheAdmin *service.CacheAdminService) {
+	if cfg.CacheWarmTimeout <= 0 {
+		return
+	}
+
+	warmCtx, cancel := context.WithTimeout(ctx, cfg.CacheWarmTimeout)
+	defer cancel()
+
+	if err := cacheAdmin.WarmCaches(warmCtx); err != nil {
+		log.Printf("Кэш справочников не прогрет: %v", err)
+	}
+}
+
+// initLocalCache создает общее хранилище кэшей в памяти процесса и запускает его согласование с другими репликами.
+// Сервисы сбрасывают ключи друг друга (например, возврат сбрасывает кэш заказа), поэтому хранилище общее, как при общем Redis.
+// Без шины сброса coherence равен nil: изменения других реплик становятся видны после истечения записей.
+func initLocalCache(ctx context.Context, cfg *config.Config, redisClient *redis.Client, dbPool *pgxpool.Pool) (*cache.LRUStore, *cache.Coherence) {
+	localStore := cache.NewLRUStore(cache.LRUConfig{
+		DefaultTTL: cfg.CacheTTL,
+		MaxEntries: cfg.CacheMaxEntries,
+		MaxBytes:   cfg.CacheMaxBytes,
+	})
+
+	busBackend, err := cache.ParseBusBackend(cfg.CacheInvalidationBus)
+	if err != nil {
+		log.Fatalf("Ошибка в настройках кэша: %v", err)
+	}
+
+	var bus cache.InvalidationBus
+	switch busBackend {
+	case cache.BusRedis:
+		bus = cache.NewRedisBus(redisClient, cfg.CacheInvalidationChannel)
+	case cache.BusPostgres:
+		bus = cache.NewPostgresBus(dbPool, cfg.CacheInvalidationChannel, cfg.CacheInvalidationRetry)
+	default:
+		log.Println("Шина сброса кэша отключена")
+		return localStore, nil
+	}
+
+	coherence := cache.NewCoherence(bus, localStore)
+	go coherence.Run(ctx)
+	log.Printf("Шина сброса кэша: %s, канал %s", busBackend, cfg.CacheInvalidationChannel)
+	return localStore, coherence
+}
+
+// newCacheOptions возвращает параметры кэша с реализацией backendName и общим хранилищем в памяти процесса localStore
+func newCacheOptions(cfg *config.Config, backendName string, localStore *cache.LRUStore) cache.Options {
+	backend, err := cache.ParseBackend(backendName)
+	if err != nil {
+		log.Fatalf("Ошибка в настройках кэша: %v", err)
+	}
+
+	return cache.Options{
+		Backend:    backend,
+		DefaultTTL: cfg.CacheTTL,
+		LocalTTL:   cfg.CacheLocalTTL,
+		MaxEntries: cfg.CacheMaxEntries,
+		MaxBytes:   cfg.CacheMaxBytes,
+		Local:      localStore,
+	}
 }
 
 // Функция для добавления секции servers в OpenAPI YAML
@@ -183,10 +328,10 @@ func startServers(
 	orderService *service.OrderService, userService *service.UserService,
 	packagingService *service.PackagingService, returnService *service.ReturnService,
 	returnReasonService *service.ReturnReasonService, statusService *service.StatusService,
-	wp *pool.WorkerPool) {
+	wp *pool.WorkerPool, jobScheduler *scheduler.Scheduler, cacheAdmin *service.CacheAdminService) {
 
 	go func() {
-		if err := startGRPCServer(cfg.GrpcPort, orderService, userService, packagingService, returnService, returnReasonService, statusService, wp); err != nil {
+		if err := startGRPCServer(cfg.GrpcPort, orderService, userService, packagingService, returnService, returnReasonService, statusService, wp, jobScheduler, cacheAdmin, cfg.WorkerPoolRetryAfter); err != nil {
 			log.Fatalf("Ошибка при запуске gRPC сервера: %v", err)
 		}
 		log.Println("gRPC сервер завершил работу")
@@ -200,33 +345,52 @@ func startServers(
 	}()
 }
 
-// startBackgroundTask запускает фоновую задачу для проверки просроченных заказов
-func startBackgroundTask(ctx context.Context, orderService *service.OrderService, wp *pool.WorkerPool) {
-	go func() {
-		ticker := time.NewTicker(10 * time.Minute)
-		defer ticker.Stop()
-
-		for {
-			select {
-			case <-ticker.C:
-				if ctx.Err() != nil {
-					log.Println("Контекст уже отменен:", ctx.Err())
-					return
-				}
-				wp.SubmitTask(func() {
-					err := orderService.CheckExpiredOrders(ctx)
-					if err != nil {
-						log.Printf("Ошибка при проверке просроченных заказов: %v", err)
-					} else {
-						log.Println("Проверка просроченных заказов завершена успешно.")
-					}
-				})
-			case <-ctx.Done():
-				log.Println("Завершение фоновой задачи проверки просроченных заказов.")
-				return
-			}
+// startOutboxRelay запускает реле, публикующее события из outbox через публикатор событий
+func startOutboxRelay(ctx context.Context, cfg *config.Config, dbPool *pgxpool.Pool, eventPublisher publisher.EventPublisher) {
+	relay := outbox.NewRelay(dbPool, eventPublisher, outbox.RelayConfig{
+		PollInterval: cfg.OutboxPollInterval,
+		BatchSize:    cfg.OutboxBatchSize,
+		BaseBackoff:  cfg.OutboxPollInterval,
+		MaxBackoff:   cfg.OutboxMaxBackoff,
+	})
+	go relay.Run(ctx)
+	log.Println("Реле outbox запущено, топик:", cfg.KafkaTopic)
+}
+
+// startScheduler регистрирует фоновые задания и запускает планировщик.
+// Задания выполняются только на реплике-лидере, выбранной через advisory-блокировку PostgreSQL.
+// Задания обрабатывают заказы через пул задачами с низким приоритетом, поэтому сами выполняются вне пула:
+// ожидание вложенных задач из воркера того же пула могло бы занять все воркеры.
+func startScheduler(ctx context.Context, cfg *config.Config, dbPool *pgxpool.Pool, orderService *service.OrderService) *scheduler.Scheduler {
+	elector := scheduler.NewPostgresElector(dbPool, cfg.SchedulerLeaderLock, cfg.SchedulerLeaderCheckInterval)
+	go elector.Run(ctx)
+
+	jobScheduler := scheduler.NewScheduler(elector)
+
+	jobs := []struct {
+		name string
+		spec string
+		run  scheduler.JobFunc
+	}{
+		{"expired-orders", cfg.JobExpiredOrdersSchedule, func(ctx context.Context) error {
+			summary, err := orderService.CheckExpiredOrders(ctx, cfg.ExpiredOrdersBatchSize)
+			metrics.RecordExpiredOrders(summary)
+			scheduler.RecordSummary(ctx, summary.String())
+			return err
+		}},
+		{"expiry-reminders", cfg.JobExpiryRemindersSchedule, func(ctx context.Context) error {
+			return orderService.NotifyExpiringOrders(ctx, cfg.OrderExpiryNotice)
+		}},
+	}
+	for _, job := range jobs {
+		if err := jobScheduler.Register(job.name, job.spec, job.run); err != nil {
+			log.Fatalf("Ошибка в настройках планировщика: %v", err)
 		}
-	}()
+	}
+
+	go jobScheduler.Run(ctx)
+	log.Println("Планировщик заданий запущен")
+	return jobScheduler
 }
 
 func setupGRPCClients(grpcPort string) *client.APIServiceClientWrapper {
@@ -252,7 +416,7 @@ func setupGRPCClients(grpcPort string) *client.APIServiceClientWrapper {
 }
 
 // startGRPCServer запускает gRPC сервер
-func startGRPCServer(grpcPort string, orderService *service.OrderService, userService *service.UserService, packagingService *service.PackagingService, returnService *service.ReturnService, returnReasonService *service.ReturnReasonService, statusService *service.StatusService, workerPool *pool.WorkerPool) error {
+func startGRPCServer(grpcPort string, orderService *service.OrderService, userService *service.UserService, packagingService *service.PackagingService, returnService *service.ReturnService, returnReasonService *service.ReturnReasonService, statusService *service.StatusService, workerPool *pool.WorkerPool, jobScheduler *scheduler.Scheduler, cacheAdmin *service.CacheAdminService, retryAfter time.Duration) error {
 	lis, err := net.Listen("tcp", ":"+grpcPort)
 	if err != nil {
 		return fmt.Errorf("не удалось начать слушать порт %s: %w", grpcPort, err)
@@ -261,9 +425,10 @@ func startGRPCServer(grpcPort string, orderService *service.OrderService, userSe
 	grpcServer := grpc.NewServer(
 		grpc.MaxRecvMsgSize(50*1024*1024),
 		grpc.MaxSendMsgSize(50*1024*1024),
+		grpc.ChainUnaryInterceptor(server.ActorInterceptor, server.OverloadInterceptor(retryAfter)),
 	)
 
-	v1.RegisterAPIServiceServer(grpcServer, server.NewAPIServiceServer(userService, orderService, packagingService, returnService, returnReasonService, statusService, workerPool))
+	v1.RegisterAPIServiceServer(grpcServer, server.NewAPIServiceServer(userService, orderService, packagingService, returnService, returnReasonService, statusService, workerPool, jobScheduler, cacheAdmin))
 
 	log.Printf("gRPC сервер запущен на порту %s", grpcPort)
 	return grpcServer.Serve(lis)
@@ -1,36 +1,170 @@
 package main
 
 import (
+	"context"
 	"homework1/internal/config"
+	"homework1/internal/dao"
 	"homework1/internal/kafka"
+	"homework1/internal/notification"
+	"io"
 	"log"
 	"os"
 	"os/signal"
+	"strconv"
 	"syscall"
+	"time"
+
+	"github.com/jackc/pgx/v4/pgxpool"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
 )
 
 func main() {
 	// Загрузка конфигурации
 	cfg := config.LoadConfig()
 
+	// Контекст трассировки передается в заголовках событий в формате W3C Trace Context
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	// Отмена контекста по сигналу завершения запускает корректную остановку
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	// Подкоманда redrive переотправляет сообщения из DLQ в основной топик и завершает работу
+	if len(os.Args) > 1 && os.Args[1] == "redrive" {
+		runRedrive(ctx, cfg)
+		return
+	}
+
 	consumerGroup, err := kafka.NewConsumerGroup(cfg.KafkaBrokers, cfg.KafkaGroupID)
 	if err != nil {
 		log.Fatalf("Ошибка создания consumer группы: %v", err)
 	}
 
-	// Обработка системных сигналов для корректного завершения работы
+	dlq, err := kafka.NewDeadLetterQueue(cfg.KafkaBrokers, cfg.KafkaDLQTopic)
+	if err != nil {
+		log.Fatalf("Ошибка создания продюсера DLQ: %v", err)
+	}
+
+	retry := kafka.RetryPolicy{
+		MaxRetries:     cfg.NotifierMaxRetries,
+		InitialBackoff: cfg.NotifierRetryBackoff,
+		MaxBackoff:     cfg.NotifierRetryMaxBackoff,
+	}
+
+	dao.Initdb(cfg.DBUser, cfg.DBPassword, cfg.DBName, cfg.DBHost, dbPort(cfg))
+	defer dao.Closedb()
+
+	dispatcher, err := newDispatcher(cfg, dao.GetPool())
+	if err != nil {
+		log.Fatalf("Ошибка настройки каналов уведомлений: %v", err)
+	}
+
+	processed := kafka.NewPostgresProcessedEventStore(dao.GetPool())
+	go cleanupProcessedEvents(ctx, processed, cfg.NotifierDedupRetention)
+
+	handler := kafka.NewNotifierHandler(retry, dlq, dispatcher, processed, cfg.NotifierShutdownTimeout)
+
+	// Запуск Kafka Consumer в отдельной горутине
+	done := make(chan error, 1)
 	go func() {
-		signals := make(chan os.Signal, 1)
-		signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM)
-		<-signals
-		log.Println("Получен сигнал завершения. Завершаем работу...")
-		os.Exit(0)
+		done <- kafka.RunConsumer(ctx, consumerGroup, []string{cfg.KafkaTopic}, handler, retry)
 	}()
+	log.Println("Notifier запущен, топик:", cfg.KafkaTopic)
 
-	handler := kafka.NotifierHandler{}
+	<-ctx.Done()
+	log.Println("Получен сигнал завершения. Дообрабатываем текущие сообщения...")
 
-	// Запуск Kafka Consumer в отдельной горутине
-	kafka.StartConsumer(consumerGroup, []string{cfg.KafkaTopic}, handler)
+	shutdown(done, consumerGroup, dlq, cfg.NotifierShutdownTimeout)
+}
+
+// shutdown дожидается завершения текущей сессии консьюмера и закрывает группу и продюсера DLQ.
+// Если остановка не укладывается в timeout, процесс завершается без ожидания.
+func shutdown(done <-chan error, consumerGroup io.Closer, dlq io.Closer, timeout time.Duration) {
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+
+		if err := <-done; err != nil {
+			log.Printf("Консьюмер завершился с ошибкой: %v", err)
+		}
+		if err := consumerGroup.Close(); err != nil {
+			log.Printf("Ошибка закрытия consumer группы: %v", err)
+		}
+		if err := dlq.Close(); err != nil {
+			log.Printf("Ошибка закрытия продюсера DLQ: %v", err)
+		}
+	}()
+
+	select {
+	case <-stopped:
+		log.Println("Notifier корректно остановлен")
+	case <-time.After(timeout):
+		log.Printf("Notifier не остановился за %s, завершаем работу принудительно", timeout)
+		os.Exit(1)
+	}
+}
+
+// cleanupProcessedEvents периодически удаляет отметки об обработанных событиях старше retention
+func cleanupProcessedEvents(ctx context.Context, store *kafka.PostgresProcessedEventStore, retention time.Duration) {
+	ticker := time.NewTicker(time.Hour)
+	defer ticker.Stop()
+
+	for {
+		deleted, err := store.Cleanup(ctx, retention)
+		if err != nil {
+			log.Printf("Ошибка удаления отметок об обработанных событиях: %v", err)
+		} else if deleted > 0 {
+			log.Printf("Удалено отметок об обработанных событиях: %d", deleted)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// newDispatcher настраивает каналы уведомлений, шаблоны и правила маршрутизации.
+// Каналы без заданного адреса не создаются.
+func newDispatcher(cfg *config.Config, dbPool *pgxpool.Pool) (*notification.Dispatcher, error) {
+	routes, err := notification.ParseRoutes(cfg.NotifierRoutes)
+	if err != nil {
+		return nil, err
+	}
+
+	templates, err := notification.LoadTemplates(cfg.NotifierTemplatesDir)
+	if err != nil {
+		return nil, err
+	}
+
+	var channels []notification.Channel
+	if cfg.NotifierSMTPAddr != "" {
+		channels = append(channels, notification.NewSMTPChannel(cfg.NotifierSMTPAddr, cfg.NotifierSMTPFrom, cfg.NotifierSMTPRecipient, nil))
+	}
+	if cfg.NotifierWebhookURL != "" {
+		channels = append(channels, notification.NewWebhookChannel(cfg.NotifierWebhookURL, cfg.NotifierWebhookTimeout))
+	}
+
+	return notification.NewDispatcher(routes, templates, notification.NewPostgresDeliveryLog(dbPool), channels...)
+}
 
-	select {}
+// dbPort возвращает порт базы данных из конфигурации
+func dbPort(cfg *config.Config) int {
+	port, err := strconv.Atoi(cfg.DBPort)
+	if err != nil {
+		log.Fatalf("Некорректный формат порта: %v", err)
+	}
+	return port
+}
+
+// runRedrive переотправляет накопившиеся сообщения DLQ в основной топик
+func runRedrive(ctx context.Context, cfg *config.Config) {
+	log.Printf("Переотправка сообщений из %s в %s", cfg.KafkaDLQTopic, cfg.KafkaTopic)
+	redriven, err := kafka.Redrive(ctx, cfg.KafkaBrokers, cfg.KafkaGroupID+"-redrive", cfg.KafkaDLQTopic, cfg.KafkaTopic)
+	if err != nil {
+		log.Fatalf("Ошибка переотправки сообщений DLQ: %v", err)
+	}
+	log.Printf("Переотправлено сообщений из DLQ: %d", redriven)
 }
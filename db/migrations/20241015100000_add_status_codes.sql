@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+
+ALTER TABLE statuses ADD COLUMN IF NOT EXISTS code TEXT UNIQUE;
+
+UPDATE statuses SET code = 'accepted' WHERE status_name = 'Создан';
+UPDATE statuses SET code = 'issued' WHERE status_name = 'Выдан';
+UPDATE statuses SET code = 'returned' WHERE status_name = 'Возврат';
+UPDATE statuses SET code = 'handed_to_courier' WHERE status_name = 'Передан курьеру';
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+ALTER TABLE statuses DROP COLUMN IF EXISTS code;
+
+-- +goose StatementEnd
@@ -0,0 +1,38 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE TABLE IF NOT EXISTS order_status_history (
+                                                    change_id BIGSERIAL PRIMARY KEY,
+                                                    order_id INT NOT NULL,
+                                                    old_status_id INT,
+                                                    new_status_id INT,
+                                                    actor TEXT NOT NULL,
+                                                    reason TEXT NOT NULL DEFAULT '',
+                                                    changed_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Индекс для выборки истории конкретного заказа в хронологическом порядке
+CREATE INDEX idx_order_status_history_order_id ON order_status_history (order_id, changed_at, change_id);
+
+-- История только дополняется: изменение и удаление записей запрещены
+CREATE OR REPLACE FUNCTION order_status_history_append_only() RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'order_status_history is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_order_status_history_append_only
+    BEFORE UPDATE OR DELETE ON order_status_history
+    FOR EACH ROW EXECUTE FUNCTION order_status_history_append_only();
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TRIGGER IF EXISTS trg_order_status_history_append_only ON order_status_history;
+DROP FUNCTION IF EXISTS order_status_history_append_only();
+DROP INDEX IF EXISTS idx_order_status_history_order_id;
+DROP TABLE IF EXISTS order_status_history CASCADE;
+
+-- +goose StatementEnd
@@ -0,0 +1,31 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE TABLE IF NOT EXISTS outbox_events (
+                                             event_id BIGSERIAL PRIMARY KEY,
+                                             topic TEXT NOT NULL,
+                                             event_key TEXT NOT NULL DEFAULT '',
+                                             aggregate_id INT NOT NULL,
+                                             event_type TEXT NOT NULL,
+                                             payload JSONB NOT NULL,
+                                             attempts INT NOT NULL DEFAULT 0,
+                                             last_error TEXT,
+                                             created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                                             next_attempt_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                                             sent_at TIMESTAMP
+);
+
+-- Частичные индексы для выборки неотправленных событий реле с сохранением порядка по ключу
+CREATE INDEX idx_outbox_events_pending ON outbox_events (event_id) WHERE sent_at IS NULL;
+CREATE INDEX idx_outbox_events_pending_key ON outbox_events (event_key, event_id) WHERE sent_at IS NULL;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP INDEX IF EXISTS idx_outbox_events_pending_key;
+DROP INDEX IF EXISTS idx_outbox_events_pending;
+DROP TABLE IF EXISTS outbox_events CASCADE;
+
+-- +goose StatementEnd
@@ -0,0 +1,31 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Отметка об отправленном уведомлении о скором истечении срока хранения
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS expiry_notified_at TIMESTAMP;
+
+-- Журнал попыток доставки уведомлений
+CREATE TABLE IF NOT EXISTS notification_deliveries (
+                                                       delivery_id BIGSERIAL PRIMARY KEY,
+                                                       event_id TEXT NOT NULL,
+                                                       event_type TEXT NOT NULL,
+                                                       channel TEXT NOT NULL,
+                                                       recipient TEXT NOT NULL DEFAULT '',
+                                                       status TEXT NOT NULL,
+                                                       error TEXT NOT NULL DEFAULT '',
+                                                       duration_ms BIGINT NOT NULL DEFAULT 0,
+                                                       attempted_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_notification_deliveries_event ON notification_deliveries (event_id, channel);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP INDEX IF EXISTS idx_notification_deliveries_event;
+DROP TABLE IF EXISTS notification_deliveries CASCADE;
+ALTER TABLE orders DROP COLUMN IF EXISTS expiry_notified_at;
+
+-- +goose StatementEnd
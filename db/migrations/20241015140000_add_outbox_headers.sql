@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Заголовки сообщения Kafka: тип события, ключ идемпотентности и контекст трассировки
+ALTER TABLE outbox_events ADD COLUMN IF NOT EXISTS headers JSONB NOT NULL DEFAULT '{}';
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+ALTER TABLE outbox_events DROP COLUMN IF EXISTS headers;
+
+-- +goose StatementEnd
@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- События, уже обработанные notifier, для исключения повторной обработки
+CREATE TABLE IF NOT EXISTS processed_events (
+                                                event_id TEXT PRIMARY KEY,
+                                                event_type TEXT NOT NULL,
+                                                processed_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_processed_events_processed_at ON processed_events (processed_at);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP INDEX IF EXISTS idx_processed_events_processed_at;
+DROP TABLE IF EXISTS processed_events CASCADE;
+
+-- +goose StatementEnd
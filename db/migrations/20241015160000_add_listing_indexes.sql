@@ -0,0 +1,35 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Индексы постраничных списков заказов и возвратов: фильтр по полю и курсор по возрастанию ID.
+-- Составной индекс по пользователю заменяет idx_orders_user_id.
+DROP INDEX IF EXISTS idx_orders_user_id;
+CREATE INDEX idx_orders_user_id_order_id ON orders (user_id, order_id);
+CREATE INDEX idx_orders_status_id_order_id ON orders (status_id, order_id);
+CREATE INDEX idx_orders_packaging_id_order_id ON orders (packaging_id, order_id);
+CREATE INDEX idx_orders_acceptance_date ON orders (acceptance_date);
+CREATE INDEX idx_orders_expiration_date ON orders (expiration_date);
+CREATE INDEX idx_orders_not_issued ON orders (order_id) WHERE issue_date IS NULL;
+
+CREATE INDEX idx_returns_user_id_return_id ON returns (user_id, return_id);
+CREATE INDEX idx_returns_status_id_return_id ON returns (status_id, return_id);
+CREATE INDEX idx_returns_return_date ON returns (return_date);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP INDEX IF EXISTS idx_returns_return_date;
+DROP INDEX IF EXISTS idx_returns_status_id_return_id;
+DROP INDEX IF EXISTS idx_returns_user_id_return_id;
+
+DROP INDEX IF EXISTS idx_orders_not_issued;
+DROP INDEX IF EXISTS idx_orders_expiration_date;
+DROP INDEX IF EXISTS idx_orders_acceptance_date;
+DROP INDEX IF EXISTS idx_orders_packaging_id_order_id;
+DROP INDEX IF EXISTS idx_orders_status_id_order_id;
+DROP INDEX IF EXISTS idx_orders_user_id_order_id;
+CREATE INDEX idx_orders_user_id ON orders (user_id);
+
+-- +goose StatementEnd
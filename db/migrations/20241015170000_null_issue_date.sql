@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Невыданные заказы записывались с нулевой датой выдачи вместо NULL, поэтому фильтр по факту выдачи
+-- и частичный индекс idx_orders_not_issued их не видели
+UPDATE orders SET issue_date = NULL WHERE issue_date = '0001-01-01 00:00:00';
+
+-- +goose StatementEnd
+
+-- +goose Down
+
+-- Нулевая дата выдачи не восстанавливается: NULL и есть корректное значение для невыданного заказа
@@ -0,0 +1,757 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: order_events.proto
+
+package v1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on OrderEvent with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *OrderEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OrderEvent with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in OrderEventMultiError, or
+// nil if none found.
+func (m *OrderEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OrderEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for EventId
+
+	// no validation rules for EventType
+
+	// no validation rules for SchemaVersion
+
+	// no validation rules for AggregateId
+
+	if all {
+		switch v := interface{}(m.GetOccurredAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrderEventValidationError{
+					field:  "OccurredAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrderEventValidationError{
+					field:  "OccurredAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOccurredAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrderEventValidationError{
+				field:  "OccurredAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Description
+
+	switch v := m.Payload.(type) {
+	case *OrderEvent_Order:
+		if v == nil {
+			err := OrderEventValidationError{
+				field:  "Payload",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetOrder()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, OrderEventValidationError{
+						field:  "Order",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, OrderEventValidationError{
+						field:  "Order",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetOrder()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return OrderEventValidationError{
+					field:  "Order",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *OrderEvent_OrderReturn:
+		if v == nil {
+			err := OrderEventValidationError{
+				field:  "Payload",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetOrderReturn()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, OrderEventValidationError{
+						field:  "OrderReturn",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, OrderEventValidationError{
+						field:  "OrderReturn",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetOrderReturn()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return OrderEventValidationError{
+					field:  "OrderReturn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *OrderEvent_Error:
+		if v == nil {
+			err := OrderEventValidationError{
+				field:  "Payload",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetError()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, OrderEventValidationError{
+						field:  "Error",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, OrderEventValidationError{
+						field:  "Error",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetError()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return OrderEventValidationError{
+					field:  "Error",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return OrderEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// OrderEventMultiError is an error wrapping multiple validation errors
+// returned by OrderEvent.ValidateAll() if the designated constraints aren't met.
+type OrderEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OrderEventMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OrderEventMultiError) AllErrors() []error { return m }
+
+// OrderEventValidationError is the validation error returned by
+// OrderEvent.Validate if the designated constraints aren't met.
+type OrderEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrderEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrderEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrderEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrderEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrderEventValidationError) ErrorName() string { return "OrderEventValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OrderEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrderEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrderEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrderEventValidationError{}
+
+// Validate checks the field values on OrderSnapshot with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *OrderSnapshot) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OrderSnapshot with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in OrderSnapshotMultiError, or
+// nil if none found.
+func (m *OrderSnapshot) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OrderSnapshot) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for OrderId
+
+	// no validation rules for UserId
+
+	// no validation rules for PackagingId
+
+	// no validation rules for StatusId
+
+	if all {
+		switch v := interface{}(m.GetAcceptanceDate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrderSnapshotValidationError{
+					field:  "AcceptanceDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrderSnapshotValidationError{
+					field:  "AcceptanceDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAcceptanceDate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrderSnapshotValidationError{
+				field:  "AcceptanceDate",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetExpirationDate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrderSnapshotValidationError{
+					field:  "ExpirationDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrderSnapshotValidationError{
+					field:  "ExpirationDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpirationDate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrderSnapshotValidationError{
+				field:  "ExpirationDate",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetIssueDate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrderSnapshotValidationError{
+					field:  "IssueDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrderSnapshotValidationError{
+					field:  "IssueDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetIssueDate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrderSnapshotValidationError{
+				field:  "IssueDate",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Weight
+
+	// no validation rules for BaseCost
+
+	// no validation rules for PackagingCost
+
+	// no validation rules for TotalCost
+
+	// no validation rules for WithFilm
+
+	if len(errors) > 0 {
+		return OrderSnapshotMultiError(errors)
+	}
+
+	return nil
+}
+
+// OrderSnapshotMultiError is an error wrapping multiple validation errors
+// returned by OrderSnapshot.ValidateAll() if the designated constraints
+// aren't met.
+type OrderSnapshotMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OrderSnapshotMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OrderSnapshotMultiError) AllErrors() []error { return m }
+
+// OrderSnapshotValidationError is the validation error returned by
+// OrderSnapshot.Validate if the designated constraints aren't met.
+type OrderSnapshotValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrderSnapshotValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrderSnapshotValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrderSnapshotValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrderSnapshotValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrderSnapshotValidationError) ErrorName() string { return "OrderSnapshotValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OrderSnapshotValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrderSnapshot.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrderSnapshotValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrderSnapshotValidationError{}
+
+// Validate checks the field values on ReturnSnapshot with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ReturnSnapshot) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReturnSnapshot with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ReturnSnapshotMultiError,
+// or nil if none found.
+func (m *ReturnSnapshot) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReturnSnapshot) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ReturnId
+
+	// no validation rules for OrderId
+
+	// no validation rules for UserId
+
+	// no validation rules for ReasonId
+
+	// no validation rules for StatusId
+
+	// no validation rules for PackagingId
+
+	if all {
+		switch v := interface{}(m.GetReturnDate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReturnSnapshotValidationError{
+					field:  "ReturnDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReturnSnapshotValidationError{
+					field:  "ReturnDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReturnDate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReturnSnapshotValidationError{
+				field:  "ReturnDate",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for BaseCost
+
+	// no validation rules for PackagingCost
+
+	// no validation rules for TotalCost
+
+	if len(errors) > 0 {
+		return ReturnSnapshotMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReturnSnapshotMultiError is an error wrapping multiple validation errors
+// returned by ReturnSnapshot.ValidateAll() if the designated constraints
+// aren't met.
+type ReturnSnapshotMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReturnSnapshotMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReturnSnapshotMultiError) AllErrors() []error { return m }
+
+// ReturnSnapshotValidationError is the validation error returned by
+// ReturnSnapshot.Validate if the designated constraints aren't met.
+type ReturnSnapshotValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReturnSnapshotValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReturnSnapshotValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReturnSnapshotValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReturnSnapshotValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReturnSnapshotValidationError) ErrorName() string { return "ReturnSnapshotValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReturnSnapshotValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReturnSnapshot.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReturnSnapshotValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReturnSnapshotValidationError{}
+
+// Validate checks the field values on ErrorDetails with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ErrorDetails) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ErrorDetails with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ErrorDetailsMultiError, or
+// nil if none found.
+func (m *ErrorDetails) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ErrorDetails) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Operation
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return ErrorDetailsMultiError(errors)
+	}
+
+	return nil
+}
+
+// ErrorDetailsMultiError is an error wrapping multiple validation errors
+// returned by ErrorDetails.ValidateAll() if the designated constraints aren't met.
+type ErrorDetailsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ErrorDetailsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ErrorDetailsMultiError) AllErrors() []error { return m }
+
+// ErrorDetailsValidationError is the validation error returned by
+// ErrorDetails.Validate if the designated constraints aren't met.
+type ErrorDetailsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ErrorDetailsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ErrorDetailsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ErrorDetailsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ErrorDetailsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ErrorDetailsValidationError) ErrorName() string { return "ErrorDetailsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ErrorDetailsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sErrorDetails.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ErrorDetailsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ErrorDetailsValidationError{}
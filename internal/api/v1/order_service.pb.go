@@ -24,6 +24,56 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Фильтр по факту выдачи заказа
+type IssueFilter int32
+
+const (
+	IssueFilter_ISSUE_FILTER_UNSPECIFIED IssueFilter = 0 // Все заказы
+	IssueFilter_ISSUE_FILTER_ISSUED      IssueFilter = 1 // Только выданные
+	IssueFilter_ISSUE_FILTER_NOT_ISSUED  IssueFilter = 2 // Только невыданные
+)
+
+// Enum value maps for IssueFilter.
+var (
+	IssueFilter_name = map[int32]string{
+		0: "ISSUE_FILTER_UNSPECIFIED",
+		1: "ISSUE_FILTER_ISSUED",
+		2: "ISSUE_FILTER_NOT_ISSUED",
+	}
+	IssueFilter_value = map[string]int32{
+		"ISSUE_FILTER_UNSPECIFIED": 0,
+		"ISSUE_FILTER_ISSUED":      1,
+		"ISSUE_FILTER_NOT_ISSUED":  2,
+	}
+)
+
+func (x IssueFilter) Enum() *IssueFilter {
+	p := new(IssueFilter)
+	*p = x
+	return p
+}
+
+func (x IssueFilter) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (IssueFilter) Descriptor() protoreflect.EnumDescriptor {
+	return file_order_service_proto_enumTypes[0].Descriptor()
+}
+
+func (IssueFilter) Type() protoreflect.EnumType {
+	return &file_order_service_proto_enumTypes[0]
+}
+
+func (x IssueFilter) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use IssueFilter.Descriptor instead.
+func (IssueFilter) EnumDescriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{0}
+}
+
 // ------------------- Сообщения -------------------
 // User messages
 type CreateUserRequest struct {
@@ -1326,28 +1376,38 @@ func (x *GetOrdersByUserIDResponse) GetOrders() []*GetOrderResponse {
 	return nil
 }
 
-type SeedOrdersRequest struct {
+// Даты фильтров в формате YYYY-MM-DD, обе границы диапазона включительно; пустая дата не ограничивает выборку
+type ListOrdersRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Count int32 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	PageSize     int32       `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken    string      `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	UserId       int32       `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	StatusId     int32       `protobuf:"varint,4,opt,name=status_id,json=statusId,proto3" json:"status_id,omitempty"`
+	PackagingId  int32       `protobuf:"varint,5,opt,name=packaging_id,json=packagingId,proto3" json:"packaging_id,omitempty"`
+	AcceptedFrom string      `protobuf:"bytes,6,opt,name=accepted_from,json=acceptedFrom,proto3" json:"accepted_from,omitempty"`
+	AcceptedTo   string      `protobuf:"bytes,7,opt,name=accepted_to,json=acceptedTo,proto3" json:"accepted_to,omitempty"`
+	ExpiresFrom  string      `protobuf:"bytes,8,opt,name=expires_from,json=expiresFrom,proto3" json:"expires_from,omitempty"`
+	ExpiresTo    string      `protobuf:"bytes,9,opt,name=expires_to,json=expiresTo,proto3" json:"expires_to,omitempty"`
+	Issued       IssueFilter `protobuf:"varint,10,opt,name=issued,proto3,enum=api.v1.IssueFilter" json:"issued,omitempty"`
 }
 
-func (x *SeedOrdersRequest) Reset() {
-	*x = SeedOrdersRequest{}
+func (x *ListOrdersRequest) Reset() {
+	*x = ListOrdersRequest{}
 	mi := &file_order_service_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SeedOrdersRequest) String() string {
+func (x *ListOrdersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SeedOrdersRequest) ProtoMessage() {}
+func (*ListOrdersRequest) ProtoMessage() {}
 
-func (x *SeedOrdersRequest) ProtoReflect() protoreflect.Message {
+func (x *ListOrdersRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_order_service_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1359,40 +1419,104 @@ func (x *SeedOrdersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SeedOrdersRequest.ProtoReflect.Descriptor instead.
-func (*SeedOrdersRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListOrdersRequest.ProtoReflect.Descriptor instead.
+func (*ListOrdersRequest) Descriptor() ([]byte, []int) {
 	return file_order_service_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *SeedOrdersRequest) GetCount() int32 {
+func (x *ListOrdersRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.Count
+		return x.PageSize
 	}
 	return 0
 }
 
-type SeedOrdersResponse struct {
+func (x *ListOrdersRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListOrdersRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ListOrdersRequest) GetStatusId() int32 {
+	if x != nil {
+		return x.StatusId
+	}
+	return 0
+}
+
+func (x *ListOrdersRequest) GetPackagingId() int32 {
+	if x != nil {
+		return x.PackagingId
+	}
+	return 0
+}
+
+func (x *ListOrdersRequest) GetAcceptedFrom() string {
+	if x != nil {
+		return x.AcceptedFrom
+	}
+	return ""
+}
+
+func (x *ListOrdersRequest) GetAcceptedTo() string {
+	if x != nil {
+		return x.AcceptedTo
+	}
+	return ""
+}
+
+func (x *ListOrdersRequest) GetExpiresFrom() string {
+	if x != nil {
+		return x.ExpiresFrom
+	}
+	return ""
+}
+
+func (x *ListOrdersRequest) GetExpiresTo() string {
+	if x != nil {
+		return x.ExpiresTo
+	}
+	return ""
+}
+
+func (x *ListOrdersRequest) GetIssued() IssueFilter {
+	if x != nil {
+		return x.Issued
+	}
+	return IssueFilter_ISSUE_FILTER_UNSPECIFIED
+}
+
+type ListOrdersResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Orders        []*GetOrderResponse `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+	NextPageToken string              `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 }
 
-func (x *SeedOrdersResponse) Reset() {
-	*x = SeedOrdersResponse{}
+func (x *ListOrdersResponse) Reset() {
+	*x = ListOrdersResponse{}
 	mi := &file_order_service_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SeedOrdersResponse) String() string {
+func (x *ListOrdersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SeedOrdersResponse) ProtoMessage() {}
+func (*ListOrdersResponse) ProtoMessage() {}
 
-func (x *SeedOrdersResponse) ProtoReflect() protoreflect.Message {
+func (x *ListOrdersResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_order_service_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1404,40 +1528,47 @@ func (x *SeedOrdersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SeedOrdersResponse.ProtoReflect.Descriptor instead.
-func (*SeedOrdersResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListOrdersResponse.ProtoReflect.Descriptor instead.
+func (*ListOrdersResponse) Descriptor() ([]byte, []int) {
 	return file_order_service_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *SeedOrdersResponse) GetMessage() string {
+func (x *ListOrdersResponse) GetOrders() []*GetOrderResponse {
 	if x != nil {
-		return x.Message
+		return x.Orders
+	}
+	return nil
+}
+
+func (x *ListOrdersResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
 	}
 	return ""
 }
 
-type IssueOrderRequest struct {
+type SeedOrdersRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OrderId int32 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Count int32 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (x *IssueOrderRequest) Reset() {
-	*x = IssueOrderRequest{}
+func (x *SeedOrdersRequest) Reset() {
+	*x = SeedOrdersRequest{}
 	mi := &file_order_service_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *IssueOrderRequest) String() string {
+func (x *SeedOrdersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IssueOrderRequest) ProtoMessage() {}
+func (*SeedOrdersRequest) ProtoMessage() {}
 
-func (x *IssueOrderRequest) ProtoReflect() protoreflect.Message {
+func (x *SeedOrdersRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_order_service_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1449,19 +1580,19 @@ func (x *IssueOrderRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IssueOrderRequest.ProtoReflect.Descriptor instead.
-func (*IssueOrderRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SeedOrdersRequest.ProtoReflect.Descriptor instead.
+func (*SeedOrdersRequest) Descriptor() ([]byte, []int) {
 	return file_order_service_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *IssueOrderRequest) GetOrderId() int32 {
+func (x *SeedOrdersRequest) GetCount() int32 {
 	if x != nil {
-		return x.OrderId
+		return x.Count
 	}
 	return 0
 }
 
-type IssueOrderResponse struct {
+type SeedOrdersResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -1469,20 +1600,20 @@ type IssueOrderResponse struct {
 	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *IssueOrderResponse) Reset() {
-	*x = IssueOrderResponse{}
+func (x *SeedOrdersResponse) Reset() {
+	*x = SeedOrdersResponse{}
 	mi := &file_order_service_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *IssueOrderResponse) String() string {
+func (x *SeedOrdersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IssueOrderResponse) ProtoMessage() {}
+func (*SeedOrdersResponse) ProtoMessage() {}
 
-func (x *IssueOrderResponse) ProtoReflect() protoreflect.Message {
+func (x *SeedOrdersResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_order_service_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1494,43 +1625,40 @@ func (x *IssueOrderResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IssueOrderResponse.ProtoReflect.Descriptor instead.
-func (*IssueOrderResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SeedOrdersResponse.ProtoReflect.Descriptor instead.
+func (*SeedOrdersResponse) Descriptor() ([]byte, []int) {
 	return file_order_service_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *IssueOrderResponse) GetMessage() string {
+func (x *SeedOrdersResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-// Packaging messages
-type CreatePackagingRequest struct {
+type IssueOrderRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PackagingType string  `protobuf:"bytes,1,opt,name=packaging_type,json=packagingType,proto3" json:"packaging_type,omitempty"`
-	Cost          float64 `protobuf:"fixed64,2,opt,name=cost,proto3" json:"cost,omitempty"`
-	MaxWeight     float64 `protobuf:"fixed64,3,opt,name=max_weight,json=maxWeight,proto3" json:"max_weight,omitempty"`
+	OrderId int32 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
 }
 
-func (x *CreatePackagingRequest) Reset() {
-	*x = CreatePackagingRequest{}
+func (x *IssueOrderRequest) Reset() {
+	*x = IssueOrderRequest{}
 	mi := &file_order_service_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreatePackagingRequest) String() string {
+func (x *IssueOrderRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreatePackagingRequest) ProtoMessage() {}
+func (*IssueOrderRequest) ProtoMessage() {}
 
-func (x *CreatePackagingRequest) ProtoReflect() protoreflect.Message {
+func (x *IssueOrderRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_order_service_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1542,54 +1670,40 @@ func (x *CreatePackagingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreatePackagingRequest.ProtoReflect.Descriptor instead.
-func (*CreatePackagingRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use IssueOrderRequest.ProtoReflect.Descriptor instead.
+func (*IssueOrderRequest) Descriptor() ([]byte, []int) {
 	return file_order_service_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *CreatePackagingRequest) GetPackagingType() string {
-	if x != nil {
-		return x.PackagingType
-	}
-	return ""
-}
-
-func (x *CreatePackagingRequest) GetCost() float64 {
-	if x != nil {
-		return x.Cost
-	}
-	return 0
-}
-
-func (x *CreatePackagingRequest) GetMaxWeight() float64 {
+func (x *IssueOrderRequest) GetOrderId() int32 {
 	if x != nil {
-		return x.MaxWeight
+		return x.OrderId
 	}
 	return 0
 }
 
-type CreatePackagingResponse struct {
+type IssueOrderResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PackagingId int32 `protobuf:"varint,1,opt,name=packaging_id,json=packagingId,proto3" json:"packaging_id,omitempty"`
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *CreatePackagingResponse) Reset() {
-	*x = CreatePackagingResponse{}
+func (x *IssueOrderResponse) Reset() {
+	*x = IssueOrderResponse{}
 	mi := &file_order_service_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreatePackagingResponse) String() string {
+func (x *IssueOrderResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreatePackagingResponse) ProtoMessage() {}
+func (*IssueOrderResponse) ProtoMessage() {}
 
-func (x *CreatePackagingResponse) ProtoReflect() protoreflect.Message {
+func (x *IssueOrderResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_order_service_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1601,40 +1715,40 @@ func (x *CreatePackagingResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreatePackagingResponse.ProtoReflect.Descriptor instead.
-func (*CreatePackagingResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use IssueOrderResponse.ProtoReflect.Descriptor instead.
+func (*IssueOrderResponse) Descriptor() ([]byte, []int) {
 	return file_order_service_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *CreatePackagingResponse) GetPackagingId() int32 {
+func (x *IssueOrderResponse) GetMessage() string {
 	if x != nil {
-		return x.PackagingId
+		return x.Message
 	}
-	return 0
+	return ""
 }
 
-type GetPackagingRequest struct {
+type GetOrderHistoryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PackagingId int32 `protobuf:"varint,1,opt,name=packaging_id,json=packagingId,proto3" json:"packaging_id,omitempty"`
+	OrderId int32 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
 }
 
-func (x *GetPackagingRequest) Reset() {
-	*x = GetPackagingRequest{}
+func (x *GetOrderHistoryRequest) Reset() {
+	*x = GetOrderHistoryRequest{}
 	mi := &file_order_service_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPackagingRequest) String() string {
+func (x *GetOrderHistoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPackagingRequest) ProtoMessage() {}
+func (*GetOrderHistoryRequest) ProtoMessage() {}
 
-func (x *GetPackagingRequest) ProtoReflect() protoreflect.Message {
+func (x *GetOrderHistoryRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_order_service_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1646,43 +1760,48 @@ func (x *GetPackagingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPackagingRequest.ProtoReflect.Descriptor instead.
-func (*GetPackagingRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetOrderHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetOrderHistoryRequest) Descriptor() ([]byte, []int) {
 	return file_order_service_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *GetPackagingRequest) GetPackagingId() int32 {
+func (x *GetOrderHistoryRequest) GetOrderId() int32 {
 	if x != nil {
-		return x.PackagingId
+		return x.OrderId
 	}
 	return 0
 }
 
-type GetPackagingResponse struct {
+type OrderStatusChange struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PackagingId   int32   `protobuf:"varint,1,opt,name=packaging_id,json=packagingId,proto3" json:"packaging_id,omitempty"`
-	PackagingType string  `protobuf:"bytes,2,opt,name=packaging_type,json=packagingType,proto3" json:"packaging_type,omitempty"`
-	Cost          float64 `protobuf:"fixed64,3,opt,name=cost,proto3" json:"cost,omitempty"`
-	MaxWeight     float64 `protobuf:"fixed64,4,opt,name=max_weight,json=maxWeight,proto3" json:"max_weight,omitempty"`
+	ChangeId      int32  `protobuf:"varint,1,opt,name=change_id,json=changeId,proto3" json:"change_id,omitempty"`
+	OrderId       int32  `protobuf:"varint,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	OldStatusId   int32  `protobuf:"varint,3,opt,name=old_status_id,json=oldStatusId,proto3" json:"old_status_id,omitempty"`
+	OldStatusName string `protobuf:"bytes,4,opt,name=old_status_name,json=oldStatusName,proto3" json:"old_status_name,omitempty"`
+	NewStatusId   int32  `protobuf:"varint,5,opt,name=new_status_id,json=newStatusId,proto3" json:"new_status_id,omitempty"`
+	NewStatusName string `protobuf:"bytes,6,opt,name=new_status_name,json=newStatusName,proto3" json:"new_status_name,omitempty"`
+	Actor         string `protobuf:"bytes,7,opt,name=actor,proto3" json:"actor,omitempty"`
+	Reason        string `protobuf:"bytes,8,opt,name=reason,proto3" json:"reason,omitempty"`
+	ChangedAt     string `protobuf:"bytes,9,opt,name=changed_at,json=changedAt,proto3" json:"changed_at,omitempty"`
 }
 
-func (x *GetPackagingResponse) Reset() {
-	*x = GetPackagingResponse{}
+func (x *OrderStatusChange) Reset() {
+	*x = OrderStatusChange{}
 	mi := &file_order_service_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPackagingResponse) String() string {
+func (x *OrderStatusChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPackagingResponse) ProtoMessage() {}
+func (*OrderStatusChange) ProtoMessage() {}
 
-func (x *GetPackagingResponse) ProtoReflect() protoreflect.Message {
+func (x *OrderStatusChange) ProtoReflect() protoreflect.Message {
 	mi := &file_order_service_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1694,61 +1813,96 @@ func (x *GetPackagingResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPackagingResponse.ProtoReflect.Descriptor instead.
-func (*GetPackagingResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use OrderStatusChange.ProtoReflect.Descriptor instead.
+func (*OrderStatusChange) Descriptor() ([]byte, []int) {
 	return file_order_service_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *GetPackagingResponse) GetPackagingId() int32 {
+func (x *OrderStatusChange) GetChangeId() int32 {
 	if x != nil {
-		return x.PackagingId
+		return x.ChangeId
 	}
 	return 0
 }
 
-func (x *GetPackagingResponse) GetPackagingType() string {
+func (x *OrderStatusChange) GetOrderId() int32 {
 	if x != nil {
-		return x.PackagingType
+		return x.OrderId
 	}
-	return ""
+	return 0
 }
 
-func (x *GetPackagingResponse) GetCost() float64 {
+func (x *OrderStatusChange) GetOldStatusId() int32 {
 	if x != nil {
-		return x.Cost
+		return x.OldStatusId
 	}
 	return 0
 }
 
-func (x *GetPackagingResponse) GetMaxWeight() float64 {
+func (x *OrderStatusChange) GetOldStatusName() string {
 	if x != nil {
-		return x.MaxWeight
+		return x.OldStatusName
 	}
-	return 0
+	return ""
 }
 
-type GetAllPackagingResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	PackagingOptions []*Packaging `protobuf:"bytes,1,rep,name=packaging_options,json=packagingOptions,proto3" json:"packaging_options,omitempty"`
+func (x *OrderStatusChange) GetNewStatusId() int32 {
+	if x != nil {
+		return x.NewStatusId
+	}
+	return 0
 }
 
-func (x *GetAllPackagingResponse) Reset() {
-	*x = GetAllPackagingResponse{}
-	mi := &file_order_service_proto_msgTypes[31]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *OrderStatusChange) GetNewStatusName() string {
+	if x != nil {
+		return x.NewStatusName
+	}
+	return ""
 }
 
-func (x *GetAllPackagingResponse) String() string {
+func (x *OrderStatusChange) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *OrderStatusChange) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *OrderStatusChange) GetChangedAt() string {
+	if x != nil {
+		return x.ChangedAt
+	}
+	return ""
+}
+
+type GetOrderHistoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Changes []*OrderStatusChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+}
+
+func (x *GetOrderHistoryResponse) Reset() {
+	*x = GetOrderHistoryResponse{}
+	mi := &file_order_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrderHistoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAllPackagingResponse) ProtoMessage() {}
+func (*GetOrderHistoryResponse) ProtoMessage() {}
 
-func (x *GetAllPackagingResponse) ProtoReflect() protoreflect.Message {
+func (x *GetOrderHistoryResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_order_service_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1760,43 +1914,43 @@ func (x *GetAllPackagingResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAllPackagingResponse.ProtoReflect.Descriptor instead.
-func (*GetAllPackagingResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetOrderHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetOrderHistoryResponse) Descriptor() ([]byte, []int) {
 	return file_order_service_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *GetAllPackagingResponse) GetPackagingOptions() []*Packaging {
+func (x *GetOrderHistoryResponse) GetChanges() []*OrderStatusChange {
 	if x != nil {
-		return x.PackagingOptions
+		return x.Changes
 	}
 	return nil
 }
 
-type Packaging struct {
+// Packaging messages
+type CreatePackagingRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PackagingId   int32   `protobuf:"varint,1,opt,name=packaging_id,json=packagingId,proto3" json:"packaging_id,omitempty"`
-	PackagingType string  `protobuf:"bytes,2,opt,name=packaging_type,json=packagingType,proto3" json:"packaging_type,omitempty"`
-	Cost          float64 `protobuf:"fixed64,3,opt,name=cost,proto3" json:"cost,omitempty"`
-	MaxWeight     float64 `protobuf:"fixed64,4,opt,name=max_weight,json=maxWeight,proto3" json:"max_weight,omitempty"`
+	PackagingType string  `protobuf:"bytes,1,opt,name=packaging_type,json=packagingType,proto3" json:"packaging_type,omitempty"`
+	Cost          float64 `protobuf:"fixed64,2,opt,name=cost,proto3" json:"cost,omitempty"`
+	MaxWeight     float64 `protobuf:"fixed64,3,opt,name=max_weight,json=maxWeight,proto3" json:"max_weight,omitempty"`
 }
 
-func (x *Packaging) Reset() {
-	*x = Packaging{}
+func (x *CreatePackagingRequest) Reset() {
+	*x = CreatePackagingRequest{}
 	mi := &file_order_service_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Packaging) String() string {
+func (x *CreatePackagingRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Packaging) ProtoMessage() {}
+func (*CreatePackagingRequest) ProtoMessage() {}
 
-func (x *Packaging) ProtoReflect() protoreflect.Message {
+func (x *CreatePackagingRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_order_service_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1808,64 +1962,54 @@ func (x *Packaging) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Packaging.ProtoReflect.Descriptor instead.
-func (*Packaging) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreatePackagingRequest.ProtoReflect.Descriptor instead.
+func (*CreatePackagingRequest) Descriptor() ([]byte, []int) {
 	return file_order_service_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *Packaging) GetPackagingId() int32 {
-	if x != nil {
-		return x.PackagingId
-	}
-	return 0
-}
-
-func (x *Packaging) GetPackagingType() string {
+func (x *CreatePackagingRequest) GetPackagingType() string {
 	if x != nil {
 		return x.PackagingType
 	}
 	return ""
 }
 
-func (x *Packaging) GetCost() float64 {
+func (x *CreatePackagingRequest) GetCost() float64 {
 	if x != nil {
 		return x.Cost
 	}
 	return 0
 }
 
-func (x *Packaging) GetMaxWeight() float64 {
+func (x *CreatePackagingRequest) GetMaxWeight() float64 {
 	if x != nil {
 		return x.MaxWeight
 	}
 	return 0
 }
 
-type UpdatePackagingRequest struct {
+type CreatePackagingResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PackagingId   int32   `protobuf:"varint,1,opt,name=packaging_id,json=packagingId,proto3" json:"packaging_id,omitempty"`
-	PackagingType string  `protobuf:"bytes,2,opt,name=packaging_type,json=packagingType,proto3" json:"packaging_type,omitempty"`
-	Cost          float64 `protobuf:"fixed64,3,opt,name=cost,proto3" json:"cost,omitempty"`
-	MaxWeight     float64 `protobuf:"fixed64,4,opt,name=max_weight,json=maxWeight,proto3" json:"max_weight,omitempty"`
+	PackagingId int32 `protobuf:"varint,1,opt,name=packaging_id,json=packagingId,proto3" json:"packaging_id,omitempty"`
 }
 
-func (x *UpdatePackagingRequest) Reset() {
-	*x = UpdatePackagingRequest{}
+func (x *CreatePackagingResponse) Reset() {
+	*x = CreatePackagingResponse{}
 	mi := &file_order_service_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdatePackagingRequest) String() string {
+func (x *CreatePackagingResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdatePackagingRequest) ProtoMessage() {}
+func (*CreatePackagingResponse) ProtoMessage() {}
 
-func (x *UpdatePackagingRequest) ProtoReflect() protoreflect.Message {
+func (x *CreatePackagingResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_order_service_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1877,61 +2021,40 @@ func (x *UpdatePackagingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdatePackagingRequest.ProtoReflect.Descriptor instead.
-func (*UpdatePackagingRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreatePackagingResponse.ProtoReflect.Descriptor instead.
+func (*CreatePackagingResponse) Descriptor() ([]byte, []int) {
 	return file_order_service_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *UpdatePackagingRequest) GetPackagingId() int32 {
+func (x *CreatePackagingResponse) GetPackagingId() int32 {
 	if x != nil {
 		return x.PackagingId
 	}
 	return 0
 }
 
-func (x *UpdatePackagingRequest) GetPackagingType() string {
-	if x != nil {
-		return x.PackagingType
-	}
-	return ""
-}
-
-func (x *UpdatePackagingRequest) GetCost() float64 {
-	if x != nil {
-		return x.Cost
-	}
-	return 0
-}
-
-func (x *UpdatePackagingRequest) GetMaxWeight() float64 {
-	if x != nil {
-		return x.MaxWeight
-	}
-	return 0
-}
-
-type UpdatePackagingResponse struct {
+type GetPackagingRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	PackagingId int32 `protobuf:"varint,1,opt,name=packaging_id,json=packagingId,proto3" json:"packaging_id,omitempty"`
 }
 
-func (x *UpdatePackagingResponse) Reset() {
-	*x = UpdatePackagingResponse{}
+func (x *GetPackagingRequest) Reset() {
+	*x = GetPackagingRequest{}
 	mi := &file_order_service_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdatePackagingResponse) String() string {
+func (x *GetPackagingRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdatePackagingResponse) ProtoMessage() {}
+func (*GetPackagingRequest) ProtoMessage() {}
 
-func (x *UpdatePackagingResponse) ProtoReflect() protoreflect.Message {
+func (x *GetPackagingRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_order_service_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1943,40 +2066,43 @@ func (x *UpdatePackagingResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdatePackagingResponse.ProtoReflect.Descriptor instead.
-func (*UpdatePackagingResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetPackagingRequest.ProtoReflect.Descriptor instead.
+func (*GetPackagingRequest) Descriptor() ([]byte, []int) {
 	return file_order_service_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *UpdatePackagingResponse) GetMessage() string {
+func (x *GetPackagingRequest) GetPackagingId() int32 {
 	if x != nil {
-		return x.Message
+		return x.PackagingId
 	}
-	return ""
+	return 0
 }
 
-type DeletePackagingRequest struct {
+type GetPackagingResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PackagingId int32 `protobuf:"varint,1,opt,name=packaging_id,json=packagingId,proto3" json:"packaging_id,omitempty"`
+	PackagingId   int32   `protobuf:"varint,1,opt,name=packaging_id,json=packagingId,proto3" json:"packaging_id,omitempty"`
+	PackagingType string  `protobuf:"bytes,2,opt,name=packaging_type,json=packagingType,proto3" json:"packaging_type,omitempty"`
+	Cost          float64 `protobuf:"fixed64,3,opt,name=cost,proto3" json:"cost,omitempty"`
+	MaxWeight     float64 `protobuf:"fixed64,4,opt,name=max_weight,json=maxWeight,proto3" json:"max_weight,omitempty"`
 }
 
-func (x *DeletePackagingRequest) Reset() {
-	*x = DeletePackagingRequest{}
+func (x *GetPackagingResponse) Reset() {
+	*x = GetPackagingResponse{}
 	mi := &file_order_service_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeletePackagingRequest) String() string {
+func (x *GetPackagingResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeletePackagingRequest) ProtoMessage() {}
+func (*GetPackagingResponse) ProtoMessage() {}
 
-func (x *DeletePackagingRequest) ProtoReflect() protoreflect.Message {
+func (x *GetPackagingResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_order_service_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1988,40 +2114,61 @@ func (x *DeletePackagingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeletePackagingRequest.ProtoReflect.Descriptor instead.
-func (*DeletePackagingRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetPackagingResponse.ProtoReflect.Descriptor instead.
+func (*GetPackagingResponse) Descriptor() ([]byte, []int) {
 	return file_order_service_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *DeletePackagingRequest) GetPackagingId() int32 {
+func (x *GetPackagingResponse) GetPackagingId() int32 {
 	if x != nil {
 		return x.PackagingId
 	}
 	return 0
 }
 
-type DeletePackagingResponse struct {
+func (x *GetPackagingResponse) GetPackagingType() string {
+	if x != nil {
+		return x.PackagingType
+	}
+	return ""
+}
+
+func (x *GetPackagingResponse) GetCost() float64 {
+	if x != nil {
+		return x.Cost
+	}
+	return 0
+}
+
+func (x *GetPackagingResponse) GetMaxWeight() float64 {
+	if x != nil {
+		return x.MaxWeight
+	}
+	return 0
+}
+
+type GetAllPackagingResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	PackagingOptions []*Packaging `protobuf:"bytes,1,rep,name=packaging_options,json=packagingOptions,proto3" json:"packaging_options,omitempty"`
 }
 
-func (x *DeletePackagingResponse) Reset() {
-	*x = DeletePackagingResponse{}
+func (x *GetAllPackagingResponse) Reset() {
+	*x = GetAllPackagingResponse{}
 	mi := &file_order_service_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeletePackagingResponse) String() string {
+func (x *GetAllPackagingResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeletePackagingResponse) ProtoMessage() {}
+func (*GetAllPackagingResponse) ProtoMessage() {}
 
-func (x *DeletePackagingResponse) ProtoReflect() protoreflect.Message {
+func (x *GetAllPackagingResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_order_service_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2033,41 +2180,43 @@ func (x *DeletePackagingResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeletePackagingResponse.ProtoReflect.Descriptor instead.
-func (*DeletePackagingResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetAllPackagingResponse.ProtoReflect.Descriptor instead.
+func (*GetAllPackagingResponse) Descriptor() ([]byte, []int) {
 	return file_order_service_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *DeletePackagingResponse) GetMessage() string {
+func (x *GetAllPackagingResponse) GetPackagingOptions() []*Packaging {
 	if x != nil {
-		return x.Message
+		return x.PackagingOptions
 	}
-	return ""
+	return nil
 }
 
-// Return messages
-type CreateReturnRequest struct {
+type Packaging struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OrderId int32 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	PackagingId   int32   `protobuf:"varint,1,opt,name=packaging_id,json=packagingId,proto3" json:"packaging_id,omitempty"`
+	PackagingType string  `protobuf:"bytes,2,opt,name=packaging_type,json=packagingType,proto3" json:"packaging_type,omitempty"`
+	Cost          float64 `protobuf:"fixed64,3,opt,name=cost,proto3" json:"cost,omitempty"`
+	MaxWeight     float64 `protobuf:"fixed64,4,opt,name=max_weight,json=maxWeight,proto3" json:"max_weight,omitempty"`
 }
 
-func (x *CreateReturnRequest) Reset() {
-	*x = CreateReturnRequest{}
+func (x *Packaging) Reset() {
+	*x = Packaging{}
 	mi := &file_order_service_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateReturnRequest) String() string {
+func (x *Packaging) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateReturnRequest) ProtoMessage() {}
+func (*Packaging) ProtoMessage() {}
 
-func (x *CreateReturnRequest) ProtoReflect() protoreflect.Message {
+func (x *Packaging) ProtoReflect() protoreflect.Message {
 	mi := &file_order_service_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2079,40 +2228,64 @@ func (x *CreateReturnRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateReturnRequest.ProtoReflect.Descriptor instead.
-func (*CreateReturnRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use Packaging.ProtoReflect.Descriptor instead.
+func (*Packaging) Descriptor() ([]byte, []int) {
 	return file_order_service_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *CreateReturnRequest) GetOrderId() int32 {
+func (x *Packaging) GetPackagingId() int32 {
 	if x != nil {
-		return x.OrderId
+		return x.PackagingId
 	}
 	return 0
 }
 
-type CreateReturnResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+func (x *Packaging) GetPackagingType() string {
+	if x != nil {
+		return x.PackagingType
+	}
+	return ""
 }
 
-func (x *CreateReturnResponse) Reset() {
-	*x = CreateReturnResponse{}
-	mi := &file_order_service_proto_msgTypes[38]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *Packaging) GetCost() float64 {
+	if x != nil {
+		return x.Cost
+	}
+	return 0
 }
 
-func (x *CreateReturnResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
+func (x *Packaging) GetMaxWeight() float64 {
+	if x != nil {
+		return x.MaxWeight
+	}
+	return 0
+}
 
-func (*CreateReturnResponse) ProtoMessage() {}
+type UpdatePackagingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *CreateReturnResponse) ProtoReflect() protoreflect.Message {
+	PackagingId   int32   `protobuf:"varint,1,opt,name=packaging_id,json=packagingId,proto3" json:"packaging_id,omitempty"`
+	PackagingType string  `protobuf:"bytes,2,opt,name=packaging_type,json=packagingType,proto3" json:"packaging_type,omitempty"`
+	Cost          float64 `protobuf:"fixed64,3,opt,name=cost,proto3" json:"cost,omitempty"`
+	MaxWeight     float64 `protobuf:"fixed64,4,opt,name=max_weight,json=maxWeight,proto3" json:"max_weight,omitempty"`
+}
+
+func (x *UpdatePackagingRequest) Reset() {
+	*x = UpdatePackagingRequest{}
+	mi := &file_order_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePackagingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePackagingRequest) ProtoMessage() {}
+
+func (x *UpdatePackagingRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_order_service_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2124,40 +2297,61 @@ func (x *CreateReturnResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateReturnResponse.ProtoReflect.Descriptor instead.
-func (*CreateReturnResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdatePackagingRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePackagingRequest) Descriptor() ([]byte, []int) {
 	return file_order_service_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *CreateReturnResponse) GetMessage() string {
+func (x *UpdatePackagingRequest) GetPackagingId() int32 {
 	if x != nil {
-		return x.Message
+		return x.PackagingId
+	}
+	return 0
+}
+
+func (x *UpdatePackagingRequest) GetPackagingType() string {
+	if x != nil {
+		return x.PackagingType
 	}
 	return ""
 }
 
-type GetReturnsResponse struct {
+func (x *UpdatePackagingRequest) GetCost() float64 {
+	if x != nil {
+		return x.Cost
+	}
+	return 0
+}
+
+func (x *UpdatePackagingRequest) GetMaxWeight() float64 {
+	if x != nil {
+		return x.MaxWeight
+	}
+	return 0
+}
+
+type UpdatePackagingResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Returns []*ReturnResponse `protobuf:"bytes,1,rep,name=returns,proto3" json:"returns,omitempty"`
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *GetReturnsResponse) Reset() {
-	*x = GetReturnsResponse{}
+func (x *UpdatePackagingResponse) Reset() {
+	*x = UpdatePackagingResponse{}
 	mi := &file_order_service_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetReturnsResponse) String() string {
+func (x *UpdatePackagingResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetReturnsResponse) ProtoMessage() {}
+func (*UpdatePackagingResponse) ProtoMessage() {}
 
-func (x *GetReturnsResponse) ProtoReflect() protoreflect.Message {
+func (x *UpdatePackagingResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_order_service_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2169,40 +2363,40 @@ func (x *GetReturnsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetReturnsResponse.ProtoReflect.Descriptor instead.
-func (*GetReturnsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdatePackagingResponse.ProtoReflect.Descriptor instead.
+func (*UpdatePackagingResponse) Descriptor() ([]byte, []int) {
 	return file_order_service_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *GetReturnsResponse) GetReturns() []*ReturnResponse {
+func (x *UpdatePackagingResponse) GetMessage() string {
 	if x != nil {
-		return x.Returns
+		return x.Message
 	}
-	return nil
+	return ""
 }
 
-type GetReturnByOrderIDRequest struct {
+type DeletePackagingRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OrderId int32 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	PackagingId int32 `protobuf:"varint,1,opt,name=packaging_id,json=packagingId,proto3" json:"packaging_id,omitempty"`
 }
 
-func (x *GetReturnByOrderIDRequest) Reset() {
-	*x = GetReturnByOrderIDRequest{}
+func (x *DeletePackagingRequest) Reset() {
+	*x = DeletePackagingRequest{}
 	mi := &file_order_service_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetReturnByOrderIDRequest) String() string {
+func (x *DeletePackagingRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetReturnByOrderIDRequest) ProtoMessage() {}
+func (*DeletePackagingRequest) ProtoMessage() {}
 
-func (x *GetReturnByOrderIDRequest) ProtoReflect() protoreflect.Message {
+func (x *DeletePackagingRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_order_service_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2214,40 +2408,40 @@ func (x *GetReturnByOrderIDRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetReturnByOrderIDRequest.ProtoReflect.Descriptor instead.
-func (*GetReturnByOrderIDRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeletePackagingRequest.ProtoReflect.Descriptor instead.
+func (*DeletePackagingRequest) Descriptor() ([]byte, []int) {
 	return file_order_service_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *GetReturnByOrderIDRequest) GetOrderId() int32 {
+func (x *DeletePackagingRequest) GetPackagingId() int32 {
 	if x != nil {
-		return x.OrderId
+		return x.PackagingId
 	}
 	return 0
 }
 
-type GetReturnByOrderIDResponse struct {
+type DeletePackagingResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ReturnInfo *ReturnResponse `protobuf:"bytes,1,opt,name=return_info,json=returnInfo,proto3" json:"return_info,omitempty"`
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *GetReturnByOrderIDResponse) Reset() {
-	*x = GetReturnByOrderIDResponse{}
+func (x *DeletePackagingResponse) Reset() {
+	*x = DeletePackagingResponse{}
 	mi := &file_order_service_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetReturnByOrderIDResponse) String() string {
+func (x *DeletePackagingResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetReturnByOrderIDResponse) ProtoMessage() {}
+func (*DeletePackagingResponse) ProtoMessage() {}
 
-func (x *GetReturnByOrderIDResponse) ProtoReflect() protoreflect.Message {
+func (x *DeletePackagingResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_order_service_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2259,40 +2453,41 @@ func (x *GetReturnByOrderIDResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetReturnByOrderIDResponse.ProtoReflect.Descriptor instead.
-func (*GetReturnByOrderIDResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeletePackagingResponse.ProtoReflect.Descriptor instead.
+func (*DeletePackagingResponse) Descriptor() ([]byte, []int) {
 	return file_order_service_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *GetReturnByOrderIDResponse) GetReturnInfo() *ReturnResponse {
+func (x *DeletePackagingResponse) GetMessage() string {
 	if x != nil {
-		return x.ReturnInfo
+		return x.Message
 	}
-	return nil
+	return ""
 }
 
-type GetReturnsByUserIDRequest struct {
+// Return messages
+type CreateReturnRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId int32 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	OrderId int32 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
 }
 
-func (x *GetReturnsByUserIDRequest) Reset() {
-	*x = GetReturnsByUserIDRequest{}
+func (x *CreateReturnRequest) Reset() {
+	*x = CreateReturnRequest{}
 	mi := &file_order_service_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetReturnsByUserIDRequest) String() string {
+func (x *CreateReturnRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetReturnsByUserIDRequest) ProtoMessage() {}
+func (*CreateReturnRequest) ProtoMessage() {}
 
-func (x *GetReturnsByUserIDRequest) ProtoReflect() protoreflect.Message {
+func (x *CreateReturnRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_order_service_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2304,40 +2499,40 @@ func (x *GetReturnsByUserIDRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetReturnsByUserIDRequest.ProtoReflect.Descriptor instead.
-func (*GetReturnsByUserIDRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateReturnRequest.ProtoReflect.Descriptor instead.
+func (*CreateReturnRequest) Descriptor() ([]byte, []int) {
 	return file_order_service_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *GetReturnsByUserIDRequest) GetUserId() int32 {
+func (x *CreateReturnRequest) GetOrderId() int32 {
 	if x != nil {
-		return x.UserId
+		return x.OrderId
 	}
 	return 0
 }
 
-type GetReturnsByUserIDResponse struct {
+type CreateReturnResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Returns []*ReturnResponse `protobuf:"bytes,1,rep,name=returns,proto3" json:"returns,omitempty"`
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *GetReturnsByUserIDResponse) Reset() {
-	*x = GetReturnsByUserIDResponse{}
+func (x *CreateReturnResponse) Reset() {
+	*x = CreateReturnResponse{}
 	mi := &file_order_service_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetReturnsByUserIDResponse) String() string {
+func (x *CreateReturnResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetReturnsByUserIDResponse) ProtoMessage() {}
+func (*CreateReturnResponse) ProtoMessage() {}
 
-func (x *GetReturnsByUserIDResponse) ProtoReflect() protoreflect.Message {
+func (x *CreateReturnResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_order_service_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2349,48 +2544,40 @@ func (x *GetReturnsByUserIDResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetReturnsByUserIDResponse.ProtoReflect.Descriptor instead.
-func (*GetReturnsByUserIDResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateReturnResponse.ProtoReflect.Descriptor instead.
+func (*CreateReturnResponse) Descriptor() ([]byte, []int) {
 	return file_order_service_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *GetReturnsByUserIDResponse) GetReturns() []*ReturnResponse {
+func (x *CreateReturnResponse) GetMessage() string {
 	if x != nil {
-		return x.Returns
+		return x.Message
 	}
-	return nil
+	return ""
 }
 
-type UpdateReturnRequest struct {
+type GetReturnsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ReturnId      int32   `protobuf:"varint,1,opt,name=return_id,json=returnId,proto3" json:"return_id,omitempty"`
-	OrderId       int32   `protobuf:"varint,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
-	UserId        int32   `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	ReasonId      int32   `protobuf:"varint,4,opt,name=reason_id,json=reasonId,proto3" json:"reason_id,omitempty"`
-	BaseCost      float32 `protobuf:"fixed32,5,opt,name=base_cost,json=baseCost,proto3" json:"base_cost,omitempty"`
-	PackagingCost float32 `protobuf:"fixed32,6,opt,name=packaging_cost,json=packagingCost,proto3" json:"packaging_cost,omitempty"`
-	TotalCost     float32 `protobuf:"fixed32,7,opt,name=total_cost,json=totalCost,proto3" json:"total_cost,omitempty"`
-	PackagingId   int32   `protobuf:"varint,8,opt,name=packaging_id,json=packagingId,proto3" json:"packaging_id,omitempty"`
-	StatusId      int32   `protobuf:"varint,9,opt,name=status_id,json=statusId,proto3" json:"status_id,omitempty"`
+	Returns []*ReturnResponse `protobuf:"bytes,1,rep,name=returns,proto3" json:"returns,omitempty"`
 }
 
-func (x *UpdateReturnRequest) Reset() {
-	*x = UpdateReturnRequest{}
+func (x *GetReturnsResponse) Reset() {
+	*x = GetReturnsResponse{}
 	mi := &file_order_service_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateReturnRequest) String() string {
+func (x *GetReturnsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateReturnRequest) ProtoMessage() {}
+func (*GetReturnsResponse) ProtoMessage() {}
 
-func (x *UpdateReturnRequest) ProtoReflect() protoreflect.Message {
+func (x *GetReturnsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_order_service_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2402,97 +2589,86 @@ func (x *UpdateReturnRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateReturnRequest.ProtoReflect.Descriptor instead.
-func (*UpdateReturnRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetReturnsResponse.ProtoReflect.Descriptor instead.
+func (*GetReturnsResponse) Descriptor() ([]byte, []int) {
 	return file_order_service_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *UpdateReturnRequest) GetReturnId() int32 {
+func (x *GetReturnsResponse) GetReturns() []*ReturnResponse {
 	if x != nil {
-		return x.ReturnId
+		return x.Returns
 	}
-	return 0
+	return nil
 }
 
-func (x *UpdateReturnRequest) GetOrderId() int32 {
-	if x != nil {
-		return x.OrderId
-	}
-	return 0
-}
+type GetReturnByOrderIDRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *UpdateReturnRequest) GetUserId() int32 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
+	OrderId int32 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
 }
 
-func (x *UpdateReturnRequest) GetReasonId() int32 {
-	if x != nil {
-		return x.ReasonId
-	}
-	return 0
+func (x *GetReturnByOrderIDRequest) Reset() {
+	*x = GetReturnByOrderIDRequest{}
+	mi := &file_order_service_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateReturnRequest) GetBaseCost() float32 {
-	if x != nil {
-		return x.BaseCost
-	}
-	return 0
+func (x *GetReturnByOrderIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *UpdateReturnRequest) GetPackagingCost() float32 {
+func (*GetReturnByOrderIDRequest) ProtoMessage() {}
+
+func (x *GetReturnByOrderIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[45]
 	if x != nil {
-		return x.PackagingCost
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *UpdateReturnRequest) GetTotalCost() float32 {
-	if x != nil {
-		return x.TotalCost
-	}
-	return 0
-}
-
-func (x *UpdateReturnRequest) GetPackagingId() int32 {
-	if x != nil {
-		return x.PackagingId
-	}
-	return 0
+// Deprecated: Use GetReturnByOrderIDRequest.ProtoReflect.Descriptor instead.
+func (*GetReturnByOrderIDRequest) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *UpdateReturnRequest) GetStatusId() int32 {
+func (x *GetReturnByOrderIDRequest) GetOrderId() int32 {
 	if x != nil {
-		return x.StatusId
+		return x.OrderId
 	}
 	return 0
 }
 
-type UpdateReturnResponse struct {
+type GetReturnByOrderIDResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	ReturnInfo *ReturnResponse `protobuf:"bytes,1,opt,name=return_info,json=returnInfo,proto3" json:"return_info,omitempty"`
 }
 
-func (x *UpdateReturnResponse) Reset() {
-	*x = UpdateReturnResponse{}
-	mi := &file_order_service_proto_msgTypes[45]
+func (x *GetReturnByOrderIDResponse) Reset() {
+	*x = GetReturnByOrderIDResponse{}
+	mi := &file_order_service_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateReturnResponse) String() string {
+func (x *GetReturnByOrderIDResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateReturnResponse) ProtoMessage() {}
+func (*GetReturnByOrderIDResponse) ProtoMessage() {}
 
-func (x *UpdateReturnResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_order_service_proto_msgTypes[45]
+func (x *GetReturnByOrderIDResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2503,41 +2679,41 @@ func (x *UpdateReturnResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateReturnResponse.ProtoReflect.Descriptor instead.
-func (*UpdateReturnResponse) Descriptor() ([]byte, []int) {
-	return file_order_service_proto_rawDescGZIP(), []int{45}
+// Deprecated: Use GetReturnByOrderIDResponse.ProtoReflect.Descriptor instead.
+func (*GetReturnByOrderIDResponse) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *UpdateReturnResponse) GetMessage() string {
+func (x *GetReturnByOrderIDResponse) GetReturnInfo() *ReturnResponse {
 	if x != nil {
-		return x.Message
+		return x.ReturnInfo
 	}
-	return ""
+	return nil
 }
 
-type DeleteReturnRequest struct {
+type GetReturnsByUserIDRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ReturnId int32 `protobuf:"varint,1,opt,name=return_id,json=returnId,proto3" json:"return_id,omitempty"`
+	UserId int32 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 }
 
-func (x *DeleteReturnRequest) Reset() {
-	*x = DeleteReturnRequest{}
-	mi := &file_order_service_proto_msgTypes[46]
+func (x *GetReturnsByUserIDRequest) Reset() {
+	*x = GetReturnsByUserIDRequest{}
+	mi := &file_order_service_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteReturnRequest) String() string {
+func (x *GetReturnsByUserIDRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteReturnRequest) ProtoMessage() {}
+func (*GetReturnsByUserIDRequest) ProtoMessage() {}
 
-func (x *DeleteReturnRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_order_service_proto_msgTypes[46]
+func (x *GetReturnsByUserIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2548,41 +2724,41 @@ func (x *DeleteReturnRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteReturnRequest.ProtoReflect.Descriptor instead.
-func (*DeleteReturnRequest) Descriptor() ([]byte, []int) {
-	return file_order_service_proto_rawDescGZIP(), []int{46}
+// Deprecated: Use GetReturnsByUserIDRequest.ProtoReflect.Descriptor instead.
+func (*GetReturnsByUserIDRequest) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *DeleteReturnRequest) GetReturnId() int32 {
+func (x *GetReturnsByUserIDRequest) GetUserId() int32 {
 	if x != nil {
-		return x.ReturnId
+		return x.UserId
 	}
 	return 0
 }
 
-type DeleteReturnResponse struct {
+type GetReturnsByUserIDResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Returns []*ReturnResponse `protobuf:"bytes,1,rep,name=returns,proto3" json:"returns,omitempty"`
 }
 
-func (x *DeleteReturnResponse) Reset() {
-	*x = DeleteReturnResponse{}
-	mi := &file_order_service_proto_msgTypes[47]
+func (x *GetReturnsByUserIDResponse) Reset() {
+	*x = GetReturnsByUserIDResponse{}
+	mi := &file_order_service_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteReturnResponse) String() string {
+func (x *GetReturnsByUserIDResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteReturnResponse) ProtoMessage() {}
+func (*GetReturnsByUserIDResponse) ProtoMessage() {}
 
-func (x *DeleteReturnResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_order_service_proto_msgTypes[47]
+func (x *GetReturnsByUserIDResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2593,50 +2769,49 @@ func (x *DeleteReturnResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteReturnResponse.ProtoReflect.Descriptor instead.
-func (*DeleteReturnResponse) Descriptor() ([]byte, []int) {
-	return file_order_service_proto_rawDescGZIP(), []int{47}
+// Deprecated: Use GetReturnsByUserIDResponse.ProtoReflect.Descriptor instead.
+func (*GetReturnsByUserIDResponse) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *DeleteReturnResponse) GetMessage() string {
+func (x *GetReturnsByUserIDResponse) GetReturns() []*ReturnResponse {
 	if x != nil {
-		return x.Message
+		return x.Returns
 	}
-	return ""
+	return nil
 }
 
-type ReturnResponse struct {
+// Даты фильтров в формате YYYY-MM-DD, обе границы диапазона включительно; пустая дата не ограничивает выборку
+type ListReturnsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ReturnId      int32   `protobuf:"varint,1,opt,name=return_id,json=returnId,proto3" json:"return_id,omitempty"`
-	OrderId       int32   `protobuf:"varint,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
-	UserId        int32   `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	ReasonId      int32   `protobuf:"varint,4,opt,name=reason_id,json=reasonId,proto3" json:"reason_id,omitempty"`
-	BaseCost      float32 `protobuf:"fixed32,5,opt,name=base_cost,json=baseCost,proto3" json:"base_cost,omitempty"`
-	PackagingCost float32 `protobuf:"fixed32,6,opt,name=packaging_cost,json=packagingCost,proto3" json:"packaging_cost,omitempty"`
-	TotalCost     float32 `protobuf:"fixed32,7,opt,name=total_cost,json=totalCost,proto3" json:"total_cost,omitempty"`
-	PackagingId   int32   `protobuf:"varint,8,opt,name=packaging_id,json=packagingId,proto3" json:"packaging_id,omitempty"`
-	StatusId      int32   `protobuf:"varint,9,opt,name=status_id,json=statusId,proto3" json:"status_id,omitempty"`
-	ReturnDate    string  `protobuf:"bytes,10,opt,name=return_date,json=returnDate,proto3" json:"return_date,omitempty"`
+	PageSize     int32  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken    string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	UserId       int32  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	StatusId     int32  `protobuf:"varint,4,opt,name=status_id,json=statusId,proto3" json:"status_id,omitempty"`
+	PackagingId  int32  `protobuf:"varint,5,opt,name=packaging_id,json=packagingId,proto3" json:"packaging_id,omitempty"`
+	ReasonId     int32  `protobuf:"varint,6,opt,name=reason_id,json=reasonId,proto3" json:"reason_id,omitempty"`
+	ReturnedFrom string `protobuf:"bytes,7,opt,name=returned_from,json=returnedFrom,proto3" json:"returned_from,omitempty"`
+	ReturnedTo   string `protobuf:"bytes,8,opt,name=returned_to,json=returnedTo,proto3" json:"returned_to,omitempty"`
 }
 
-func (x *ReturnResponse) Reset() {
-	*x = ReturnResponse{}
-	mi := &file_order_service_proto_msgTypes[48]
+func (x *ListReturnsRequest) Reset() {
+	*x = ListReturnsRequest{}
+	mi := &file_order_service_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReturnResponse) String() string {
+func (x *ListReturnsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReturnResponse) ProtoMessage() {}
+func (*ListReturnsRequest) ProtoMessage() {}
 
-func (x *ReturnResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_order_service_proto_msgTypes[48]
+func (x *ListReturnsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2647,104 +2822,91 @@ func (x *ReturnResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReturnResponse.ProtoReflect.Descriptor instead.
-func (*ReturnResponse) Descriptor() ([]byte, []int) {
-	return file_order_service_proto_rawDescGZIP(), []int{48}
+// Deprecated: Use ListReturnsRequest.ProtoReflect.Descriptor instead.
+func (*ListReturnsRequest) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *ReturnResponse) GetReturnId() int32 {
+func (x *ListReturnsRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.ReturnId
+		return x.PageSize
 	}
 	return 0
 }
 
-func (x *ReturnResponse) GetOrderId() int32 {
+func (x *ListReturnsRequest) GetPageToken() string {
 	if x != nil {
-		return x.OrderId
+		return x.PageToken
 	}
-	return 0
+	return ""
 }
 
-func (x *ReturnResponse) GetUserId() int32 {
+func (x *ListReturnsRequest) GetUserId() int32 {
 	if x != nil {
 		return x.UserId
 	}
 	return 0
 }
 
-func (x *ReturnResponse) GetReasonId() int32 {
-	if x != nil {
-		return x.ReasonId
-	}
-	return 0
-}
-
-func (x *ReturnResponse) GetBaseCost() float32 {
-	if x != nil {
-		return x.BaseCost
-	}
-	return 0
-}
-
-func (x *ReturnResponse) GetPackagingCost() float32 {
+func (x *ListReturnsRequest) GetStatusId() int32 {
 	if x != nil {
-		return x.PackagingCost
+		return x.StatusId
 	}
 	return 0
 }
 
-func (x *ReturnResponse) GetTotalCost() float32 {
+func (x *ListReturnsRequest) GetPackagingId() int32 {
 	if x != nil {
-		return x.TotalCost
+		return x.PackagingId
 	}
 	return 0
 }
 
-func (x *ReturnResponse) GetPackagingId() int32 {
+func (x *ListReturnsRequest) GetReasonId() int32 {
 	if x != nil {
-		return x.PackagingId
+		return x.ReasonId
 	}
 	return 0
 }
 
-func (x *ReturnResponse) GetStatusId() int32 {
+func (x *ListReturnsRequest) GetReturnedFrom() string {
 	if x != nil {
-		return x.StatusId
+		return x.ReturnedFrom
 	}
-	return 0
+	return ""
 }
 
-func (x *ReturnResponse) GetReturnDate() string {
+func (x *ListReturnsRequest) GetReturnedTo() string {
 	if x != nil {
-		return x.ReturnDate
+		return x.ReturnedTo
 	}
 	return ""
 }
 
-type ProcessReturnRequest struct {
+type ListReturnsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OrderId int32 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Returns       []*ReturnResponse `protobuf:"bytes,1,rep,name=returns,proto3" json:"returns,omitempty"`
+	NextPageToken string            `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 }
 
-func (x *ProcessReturnRequest) Reset() {
-	*x = ProcessReturnRequest{}
-	mi := &file_order_service_proto_msgTypes[49]
+func (x *ListReturnsResponse) Reset() {
+	*x = ListReturnsResponse{}
+	mi := &file_order_service_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ProcessReturnRequest) String() string {
+func (x *ListReturnsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProcessReturnRequest) ProtoMessage() {}
+func (*ListReturnsResponse) ProtoMessage() {}
 
-func (x *ProcessReturnRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_order_service_proto_msgTypes[49]
+func (x *ListReturnsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2755,41 +2917,56 @@ func (x *ProcessReturnRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProcessReturnRequest.ProtoReflect.Descriptor instead.
-func (*ProcessReturnRequest) Descriptor() ([]byte, []int) {
-	return file_order_service_proto_rawDescGZIP(), []int{49}
+// Deprecated: Use ListReturnsResponse.ProtoReflect.Descriptor instead.
+func (*ListReturnsResponse) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *ProcessReturnRequest) GetOrderId() int32 {
+func (x *ListReturnsResponse) GetReturns() []*ReturnResponse {
 	if x != nil {
-		return x.OrderId
+		return x.Returns
 	}
-	return 0
+	return nil
 }
 
-type ProcessReturnResponse struct {
+func (x *ListReturnsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type UpdateReturnRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	ReturnId      int32   `protobuf:"varint,1,opt,name=return_id,json=returnId,proto3" json:"return_id,omitempty"`
+	OrderId       int32   `protobuf:"varint,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	UserId        int32   `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ReasonId      int32   `protobuf:"varint,4,opt,name=reason_id,json=reasonId,proto3" json:"reason_id,omitempty"`
+	BaseCost      float32 `protobuf:"fixed32,5,opt,name=base_cost,json=baseCost,proto3" json:"base_cost,omitempty"`
+	PackagingCost float32 `protobuf:"fixed32,6,opt,name=packaging_cost,json=packagingCost,proto3" json:"packaging_cost,omitempty"`
+	TotalCost     float32 `protobuf:"fixed32,7,opt,name=total_cost,json=totalCost,proto3" json:"total_cost,omitempty"`
+	PackagingId   int32   `protobuf:"varint,8,opt,name=packaging_id,json=packagingId,proto3" json:"packaging_id,omitempty"`
+	StatusId      int32   `protobuf:"varint,9,opt,name=status_id,json=statusId,proto3" json:"status_id,omitempty"`
 }
 
-func (x *ProcessReturnResponse) Reset() {
-	*x = ProcessReturnResponse{}
-	mi := &file_order_service_proto_msgTypes[50]
+func (x *UpdateReturnRequest) Reset() {
+	*x = UpdateReturnRequest{}
+	mi := &file_order_service_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ProcessReturnResponse) String() string {
+func (x *UpdateReturnRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProcessReturnResponse) ProtoMessage() {}
+func (*UpdateReturnRequest) ProtoMessage() {}
 
-func (x *ProcessReturnResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_order_service_proto_msgTypes[50]
+func (x *UpdateReturnRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2800,42 +2977,1192 @@ func (x *ProcessReturnResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProcessReturnResponse.ProtoReflect.Descriptor instead.
-func (*ProcessReturnResponse) Descriptor() ([]byte, []int) {
-	return file_order_service_proto_rawDescGZIP(), []int{50}
+// Deprecated: Use UpdateReturnRequest.ProtoReflect.Descriptor instead.
+func (*UpdateReturnRequest) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *ProcessReturnResponse) GetMessage() string {
+func (x *UpdateReturnRequest) GetReturnId() int32 {
 	if x != nil {
-		return x.Message
+		return x.ReturnId
 	}
-	return ""
-}
-
-// Return Reason messages
-type CreateReturnReasonRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Reason string `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
+	return 0
 }
 
-func (x *CreateReturnReasonRequest) Reset() {
-	*x = CreateReturnReasonRequest{}
-	mi := &file_order_service_proto_msgTypes[51]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *UpdateReturnRequest) GetOrderId() int32 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
 }
 
-func (x *CreateReturnReasonRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *UpdateReturnRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UpdateReturnRequest) GetReasonId() int32 {
+	if x != nil {
+		return x.ReasonId
+	}
+	return 0
+}
+
+func (x *UpdateReturnRequest) GetBaseCost() float32 {
+	if x != nil {
+		return x.BaseCost
+	}
+	return 0
+}
+
+func (x *UpdateReturnRequest) GetPackagingCost() float32 {
+	if x != nil {
+		return x.PackagingCost
+	}
+	return 0
+}
+
+func (x *UpdateReturnRequest) GetTotalCost() float32 {
+	if x != nil {
+		return x.TotalCost
+	}
+	return 0
+}
+
+func (x *UpdateReturnRequest) GetPackagingId() int32 {
+	if x != nil {
+		return x.PackagingId
+	}
+	return 0
+}
+
+func (x *UpdateReturnRequest) GetStatusId() int32 {
+	if x != nil {
+		return x.StatusId
+	}
+	return 0
+}
+
+type UpdateReturnResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *UpdateReturnResponse) Reset() {
+	*x = UpdateReturnResponse{}
+	mi := &file_order_service_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateReturnResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateReturnResponse) ProtoMessage() {}
+
+func (x *UpdateReturnResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateReturnResponse.ProtoReflect.Descriptor instead.
+func (*UpdateReturnResponse) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *UpdateReturnResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type DeleteReturnRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReturnId int32 `protobuf:"varint,1,opt,name=return_id,json=returnId,proto3" json:"return_id,omitempty"`
+}
+
+func (x *DeleteReturnRequest) Reset() {
+	*x = DeleteReturnRequest{}
+	mi := &file_order_service_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteReturnRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteReturnRequest) ProtoMessage() {}
+
+func (x *DeleteReturnRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteReturnRequest.ProtoReflect.Descriptor instead.
+func (*DeleteReturnRequest) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *DeleteReturnRequest) GetReturnId() int32 {
+	if x != nil {
+		return x.ReturnId
+	}
+	return 0
+}
+
+type DeleteReturnResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *DeleteReturnResponse) Reset() {
+	*x = DeleteReturnResponse{}
+	mi := &file_order_service_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteReturnResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteReturnResponse) ProtoMessage() {}
+
+func (x *DeleteReturnResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteReturnResponse.ProtoReflect.Descriptor instead.
+func (*DeleteReturnResponse) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *DeleteReturnResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ReturnResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReturnId      int32   `protobuf:"varint,1,opt,name=return_id,json=returnId,proto3" json:"return_id,omitempty"`
+	OrderId       int32   `protobuf:"varint,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	UserId        int32   `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ReasonId      int32   `protobuf:"varint,4,opt,name=reason_id,json=reasonId,proto3" json:"reason_id,omitempty"`
+	BaseCost      float32 `protobuf:"fixed32,5,opt,name=base_cost,json=baseCost,proto3" json:"base_cost,omitempty"`
+	PackagingCost float32 `protobuf:"fixed32,6,opt,name=packaging_cost,json=packagingCost,proto3" json:"packaging_cost,omitempty"`
+	TotalCost     float32 `protobuf:"fixed32,7,opt,name=total_cost,json=totalCost,proto3" json:"total_cost,omitempty"`
+	PackagingId   int32   `protobuf:"varint,8,opt,name=packaging_id,json=packagingId,proto3" json:"packaging_id,omitempty"`
+	StatusId      int32   `protobuf:"varint,9,opt,name=status_id,json=statusId,proto3" json:"status_id,omitempty"`
+	ReturnDate    string  `protobuf:"bytes,10,opt,name=return_date,json=returnDate,proto3" json:"return_date,omitempty"`
+}
+
+func (x *ReturnResponse) Reset() {
+	*x = ReturnResponse{}
+	mi := &file_order_service_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReturnResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReturnResponse) ProtoMessage() {}
+
+func (x *ReturnResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReturnResponse.ProtoReflect.Descriptor instead.
+func (*ReturnResponse) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ReturnResponse) GetReturnId() int32 {
+	if x != nil {
+		return x.ReturnId
+	}
+	return 0
+}
+
+func (x *ReturnResponse) GetOrderId() int32 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *ReturnResponse) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ReturnResponse) GetReasonId() int32 {
+	if x != nil {
+		return x.ReasonId
+	}
+	return 0
+}
+
+func (x *ReturnResponse) GetBaseCost() float32 {
+	if x != nil {
+		return x.BaseCost
+	}
+	return 0
+}
+
+func (x *ReturnResponse) GetPackagingCost() float32 {
+	if x != nil {
+		return x.PackagingCost
+	}
+	return 0
+}
+
+func (x *ReturnResponse) GetTotalCost() float32 {
+	if x != nil {
+		return x.TotalCost
+	}
+	return 0
+}
+
+func (x *ReturnResponse) GetPackagingId() int32 {
+	if x != nil {
+		return x.PackagingId
+	}
+	return 0
+}
+
+func (x *ReturnResponse) GetStatusId() int32 {
+	if x != nil {
+		return x.StatusId
+	}
+	return 0
+}
+
+func (x *ReturnResponse) GetReturnDate() string {
+	if x != nil {
+		return x.ReturnDate
+	}
+	return ""
+}
+
+type ProcessReturnRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderId int32 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+}
+
+func (x *ProcessReturnRequest) Reset() {
+	*x = ProcessReturnRequest{}
+	mi := &file_order_service_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProcessReturnRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessReturnRequest) ProtoMessage() {}
+
+func (x *ProcessReturnRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessReturnRequest.ProtoReflect.Descriptor instead.
+func (*ProcessReturnRequest) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ProcessReturnRequest) GetOrderId() int32 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+type ProcessReturnResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *ProcessReturnResponse) Reset() {
+	*x = ProcessReturnResponse{}
+	mi := &file_order_service_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProcessReturnResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessReturnResponse) ProtoMessage() {}
+
+func (x *ProcessReturnResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessReturnResponse.ProtoReflect.Descriptor instead.
+func (*ProcessReturnResponse) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ProcessReturnResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Return Reason messages
+type CreateReturnReasonRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reason string `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *CreateReturnReasonRequest) Reset() {
+	*x = CreateReturnReasonRequest{}
+	mi := &file_order_service_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateReturnReasonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
 func (*CreateReturnReasonRequest) ProtoMessage() {}
 
-func (x *CreateReturnReasonRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_order_service_proto_msgTypes[51]
+func (x *CreateReturnReasonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateReturnReasonRequest.ProtoReflect.Descriptor instead.
+func (*CreateReturnReasonRequest) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *CreateReturnReasonRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type CreateReturnReasonResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReasonId int32  `protobuf:"varint,1,opt,name=reason_id,json=reasonId,proto3" json:"reason_id,omitempty"`
+	Message  string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *CreateReturnReasonResponse) Reset() {
+	*x = CreateReturnReasonResponse{}
+	mi := &file_order_service_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateReturnReasonResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateReturnReasonResponse) ProtoMessage() {}
+
+func (x *CreateReturnReasonResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateReturnReasonResponse.ProtoReflect.Descriptor instead.
+func (*CreateReturnReasonResponse) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *CreateReturnReasonResponse) GetReasonId() int32 {
+	if x != nil {
+		return x.ReasonId
+	}
+	return 0
+}
+
+func (x *CreateReturnReasonResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type GetReturnReasonRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReasonId int32 `protobuf:"varint,1,opt,name=reason_id,json=reasonId,proto3" json:"reason_id,omitempty"`
+}
+
+func (x *GetReturnReasonRequest) Reset() {
+	*x = GetReturnReasonRequest{}
+	mi := &file_order_service_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReturnReasonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReturnReasonRequest) ProtoMessage() {}
+
+func (x *GetReturnReasonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReturnReasonRequest.ProtoReflect.Descriptor instead.
+func (*GetReturnReasonRequest) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *GetReturnReasonRequest) GetReasonId() int32 {
+	if x != nil {
+		return x.ReasonId
+	}
+	return 0
+}
+
+type GetReturnReasonResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReasonId int32  `protobuf:"varint,1,opt,name=reason_id,json=reasonId,proto3" json:"reason_id,omitempty"`
+	Reason   string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *GetReturnReasonResponse) Reset() {
+	*x = GetReturnReasonResponse{}
+	mi := &file_order_service_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReturnReasonResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReturnReasonResponse) ProtoMessage() {}
+
+func (x *GetReturnReasonResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReturnReasonResponse.ProtoReflect.Descriptor instead.
+func (*GetReturnReasonResponse) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *GetReturnReasonResponse) GetReasonId() int32 {
+	if x != nil {
+		return x.ReasonId
+	}
+	return 0
+}
+
+func (x *GetReturnReasonResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type GetAllReturnReasonsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reasons []*GetReturnReasonResponse `protobuf:"bytes,1,rep,name=reasons,proto3" json:"reasons,omitempty"`
+}
+
+func (x *GetAllReturnReasonsResponse) Reset() {
+	*x = GetAllReturnReasonsResponse{}
+	mi := &file_order_service_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAllReturnReasonsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllReturnReasonsResponse) ProtoMessage() {}
+
+func (x *GetAllReturnReasonsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllReturnReasonsResponse.ProtoReflect.Descriptor instead.
+func (*GetAllReturnReasonsResponse) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *GetAllReturnReasonsResponse) GetReasons() []*GetReturnReasonResponse {
+	if x != nil {
+		return x.Reasons
+	}
+	return nil
+}
+
+type UpdateReturnReasonRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReasonId int32  `protobuf:"varint,1,opt,name=reason_id,json=reasonId,proto3" json:"reason_id,omitempty"`
+	Reason   string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *UpdateReturnReasonRequest) Reset() {
+	*x = UpdateReturnReasonRequest{}
+	mi := &file_order_service_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateReturnReasonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateReturnReasonRequest) ProtoMessage() {}
+
+func (x *UpdateReturnReasonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateReturnReasonRequest.ProtoReflect.Descriptor instead.
+func (*UpdateReturnReasonRequest) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *UpdateReturnReasonRequest) GetReasonId() int32 {
+	if x != nil {
+		return x.ReasonId
+	}
+	return 0
+}
+
+func (x *UpdateReturnReasonRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type UpdateReturnReasonResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *UpdateReturnReasonResponse) Reset() {
+	*x = UpdateReturnReasonResponse{}
+	mi := &file_order_service_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateReturnReasonResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateReturnReasonResponse) ProtoMessage() {}
+
+func (x *UpdateReturnReasonResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateReturnReasonResponse.ProtoReflect.Descriptor instead.
+func (*UpdateReturnReasonResponse) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *UpdateReturnReasonResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type DeleteReturnReasonRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReasonId int32 `protobuf:"varint,1,opt,name=reason_id,json=reasonId,proto3" json:"reason_id,omitempty"`
+}
+
+func (x *DeleteReturnReasonRequest) Reset() {
+	*x = DeleteReturnReasonRequest{}
+	mi := &file_order_service_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteReturnReasonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteReturnReasonRequest) ProtoMessage() {}
+
+func (x *DeleteReturnReasonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteReturnReasonRequest.ProtoReflect.Descriptor instead.
+func (*DeleteReturnReasonRequest) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *DeleteReturnReasonRequest) GetReasonId() int32 {
+	if x != nil {
+		return x.ReasonId
+	}
+	return 0
+}
+
+type DeleteReturnReasonResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *DeleteReturnReasonResponse) Reset() {
+	*x = DeleteReturnReasonResponse{}
+	mi := &file_order_service_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteReturnReasonResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteReturnReasonResponse) ProtoMessage() {}
+
+func (x *DeleteReturnReasonResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteReturnReasonResponse.ProtoReflect.Descriptor instead.
+func (*DeleteReturnReasonResponse) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *DeleteReturnReasonResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type CheckReturnReasonExistsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReasonId int32 `protobuf:"varint,1,opt,name=reason_id,json=reasonId,proto3" json:"reason_id,omitempty"`
+}
+
+func (x *CheckReturnReasonExistsRequest) Reset() {
+	*x = CheckReturnReasonExistsRequest{}
+	mi := &file_order_service_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckReturnReasonExistsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckReturnReasonExistsRequest) ProtoMessage() {}
+
+func (x *CheckReturnReasonExistsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckReturnReasonExistsRequest.ProtoReflect.Descriptor instead.
+func (*CheckReturnReasonExistsRequest) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *CheckReturnReasonExistsRequest) GetReasonId() int32 {
+	if x != nil {
+		return x.ReasonId
+	}
+	return 0
+}
+
+type CheckReturnReasonExistsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Exists bool `protobuf:"varint,1,opt,name=exists,proto3" json:"exists,omitempty"`
+}
+
+func (x *CheckReturnReasonExistsResponse) Reset() {
+	*x = CheckReturnReasonExistsResponse{}
+	mi := &file_order_service_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckReturnReasonExistsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckReturnReasonExistsResponse) ProtoMessage() {}
+
+func (x *CheckReturnReasonExistsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckReturnReasonExistsResponse.ProtoReflect.Descriptor instead.
+func (*CheckReturnReasonExistsResponse) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *CheckReturnReasonExistsResponse) GetExists() bool {
+	if x != nil {
+		return x.Exists
+	}
+	return false
+}
+
+// Status messages
+type CreateStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StatusName string `protobuf:"bytes,1,opt,name=status_name,json=statusName,proto3" json:"status_name,omitempty"`
+}
+
+func (x *CreateStatusRequest) Reset() {
+	*x = CreateStatusRequest{}
+	mi := &file_order_service_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateStatusRequest) ProtoMessage() {}
+
+func (x *CreateStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateStatusRequest.ProtoReflect.Descriptor instead.
+func (*CreateStatusRequest) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *CreateStatusRequest) GetStatusName() string {
+	if x != nil {
+		return x.StatusName
+	}
+	return ""
+}
+
+type CreateStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StatusId int32  `protobuf:"varint,1,opt,name=status_id,json=statusId,proto3" json:"status_id,omitempty"`
+	Message  string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *CreateStatusResponse) Reset() {
+	*x = CreateStatusResponse{}
+	mi := &file_order_service_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateStatusResponse) ProtoMessage() {}
+
+func (x *CreateStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateStatusResponse.ProtoReflect.Descriptor instead.
+func (*CreateStatusResponse) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *CreateStatusResponse) GetStatusId() int32 {
+	if x != nil {
+		return x.StatusId
+	}
+	return 0
+}
+
+func (x *CreateStatusResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type GetStatusByIDRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StatusId int32 `protobuf:"varint,1,opt,name=status_id,json=statusId,proto3" json:"status_id,omitempty"`
+}
+
+func (x *GetStatusByIDRequest) Reset() {
+	*x = GetStatusByIDRequest{}
+	mi := &file_order_service_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStatusByIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatusByIDRequest) ProtoMessage() {}
+
+func (x *GetStatusByIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatusByIDRequest.ProtoReflect.Descriptor instead.
+func (*GetStatusByIDRequest) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *GetStatusByIDRequest) GetStatusId() int32 {
+	if x != nil {
+		return x.StatusId
+	}
+	return 0
+}
+
+type GetStatusByIDResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StatusId   int32  `protobuf:"varint,1,opt,name=status_id,json=statusId,proto3" json:"status_id,omitempty"`
+	StatusName string `protobuf:"bytes,2,opt,name=status_name,json=statusName,proto3" json:"status_name,omitempty"`
+}
+
+func (x *GetStatusByIDResponse) Reset() {
+	*x = GetStatusByIDResponse{}
+	mi := &file_order_service_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStatusByIDResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatusByIDResponse) ProtoMessage() {}
+
+func (x *GetStatusByIDResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatusByIDResponse.ProtoReflect.Descriptor instead.
+func (*GetStatusByIDResponse) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *GetStatusByIDResponse) GetStatusId() int32 {
+	if x != nil {
+		return x.StatusId
+	}
+	return 0
+}
+
+func (x *GetStatusByIDResponse) GetStatusName() string {
+	if x != nil {
+		return x.StatusName
+	}
+	return ""
+}
+
+type GetAllStatusesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetAllStatusesRequest) Reset() {
+	*x = GetAllStatusesRequest{}
+	mi := &file_order_service_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAllStatusesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllStatusesRequest) ProtoMessage() {}
+
+func (x *GetAllStatusesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllStatusesRequest.ProtoReflect.Descriptor instead.
+func (*GetAllStatusesRequest) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{73}
+}
+
+type GetAllStatusesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Statuses []*GetStatusByIDResponse `protobuf:"bytes,1,rep,name=statuses,proto3" json:"statuses,omitempty"`
+}
+
+func (x *GetAllStatusesResponse) Reset() {
+	*x = GetAllStatusesResponse{}
+	mi := &file_order_service_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAllStatusesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllStatusesResponse) ProtoMessage() {}
+
+func (x *GetAllStatusesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2846,42 +4173,42 @@ func (x *CreateReturnReasonRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateReturnReasonRequest.ProtoReflect.Descriptor instead.
-func (*CreateReturnReasonRequest) Descriptor() ([]byte, []int) {
-	return file_order_service_proto_rawDescGZIP(), []int{51}
+// Deprecated: Use GetAllStatusesResponse.ProtoReflect.Descriptor instead.
+func (*GetAllStatusesResponse) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{74}
 }
 
-func (x *CreateReturnReasonRequest) GetReason() string {
+func (x *GetAllStatusesResponse) GetStatuses() []*GetStatusByIDResponse {
 	if x != nil {
-		return x.Reason
+		return x.Statuses
 	}
-	return ""
+	return nil
 }
 
-type CreateReturnReasonResponse struct {
+type UpdateStatusRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ReasonId int32  `protobuf:"varint,1,opt,name=reason_id,json=reasonId,proto3" json:"reason_id,omitempty"`
-	Message  string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	StatusId   int32  `protobuf:"varint,1,opt,name=status_id,json=statusId,proto3" json:"status_id,omitempty"`
+	StatusName string `protobuf:"bytes,2,opt,name=status_name,json=statusName,proto3" json:"status_name,omitempty"`
 }
 
-func (x *CreateReturnReasonResponse) Reset() {
-	*x = CreateReturnReasonResponse{}
-	mi := &file_order_service_proto_msgTypes[52]
+func (x *UpdateStatusRequest) Reset() {
+	*x = UpdateStatusRequest{}
+	mi := &file_order_service_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateReturnReasonResponse) String() string {
+func (x *UpdateStatusRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateReturnReasonResponse) ProtoMessage() {}
+func (*UpdateStatusRequest) ProtoMessage() {}
 
-func (x *CreateReturnReasonResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_order_service_proto_msgTypes[52]
+func (x *UpdateStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2892,48 +4219,48 @@ func (x *CreateReturnReasonResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateReturnReasonResponse.ProtoReflect.Descriptor instead.
-func (*CreateReturnReasonResponse) Descriptor() ([]byte, []int) {
-	return file_order_service_proto_rawDescGZIP(), []int{52}
+// Deprecated: Use UpdateStatusRequest.ProtoReflect.Descriptor instead.
+func (*UpdateStatusRequest) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{75}
 }
 
-func (x *CreateReturnReasonResponse) GetReasonId() int32 {
+func (x *UpdateStatusRequest) GetStatusId() int32 {
 	if x != nil {
-		return x.ReasonId
+		return x.StatusId
 	}
 	return 0
 }
 
-func (x *CreateReturnReasonResponse) GetMessage() string {
+func (x *UpdateStatusRequest) GetStatusName() string {
 	if x != nil {
-		return x.Message
+		return x.StatusName
 	}
 	return ""
 }
 
-type GetReturnReasonRequest struct {
+type UpdateStatusResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ReasonId int32 `protobuf:"varint,1,opt,name=reason_id,json=reasonId,proto3" json:"reason_id,omitempty"`
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *GetReturnReasonRequest) Reset() {
-	*x = GetReturnReasonRequest{}
-	mi := &file_order_service_proto_msgTypes[53]
+func (x *UpdateStatusResponse) Reset() {
+	*x = UpdateStatusResponse{}
+	mi := &file_order_service_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetReturnReasonRequest) String() string {
+func (x *UpdateStatusResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetReturnReasonRequest) ProtoMessage() {}
+func (*UpdateStatusResponse) ProtoMessage() {}
 
-func (x *GetReturnReasonRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_order_service_proto_msgTypes[53]
+func (x *UpdateStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2944,42 +4271,41 @@ func (x *GetReturnReasonRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetReturnReasonRequest.ProtoReflect.Descriptor instead.
-func (*GetReturnReasonRequest) Descriptor() ([]byte, []int) {
-	return file_order_service_proto_rawDescGZIP(), []int{53}
+// Deprecated: Use UpdateStatusResponse.ProtoReflect.Descriptor instead.
+func (*UpdateStatusResponse) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{76}
 }
 
-func (x *GetReturnReasonRequest) GetReasonId() int32 {
+func (x *UpdateStatusResponse) GetMessage() string {
 	if x != nil {
-		return x.ReasonId
+		return x.Message
 	}
-	return 0
+	return ""
 }
 
-type GetReturnReasonResponse struct {
+type DeleteStatusRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ReasonId int32  `protobuf:"varint,1,opt,name=reason_id,json=reasonId,proto3" json:"reason_id,omitempty"`
-	Reason   string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	StatusId int32 `protobuf:"varint,1,opt,name=status_id,json=statusId,proto3" json:"status_id,omitempty"`
 }
 
-func (x *GetReturnReasonResponse) Reset() {
-	*x = GetReturnReasonResponse{}
-	mi := &file_order_service_proto_msgTypes[54]
+func (x *DeleteStatusRequest) Reset() {
+	*x = DeleteStatusRequest{}
+	mi := &file_order_service_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetReturnReasonResponse) String() string {
+func (x *DeleteStatusRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetReturnReasonResponse) ProtoMessage() {}
+func (*DeleteStatusRequest) ProtoMessage() {}
 
-func (x *GetReturnReasonResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_order_service_proto_msgTypes[54]
+func (x *DeleteStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2990,48 +4316,41 @@ func (x *GetReturnReasonResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetReturnReasonResponse.ProtoReflect.Descriptor instead.
-func (*GetReturnReasonResponse) Descriptor() ([]byte, []int) {
-	return file_order_service_proto_rawDescGZIP(), []int{54}
+// Deprecated: Use DeleteStatusRequest.ProtoReflect.Descriptor instead.
+func (*DeleteStatusRequest) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{77}
 }
 
-func (x *GetReturnReasonResponse) GetReasonId() int32 {
+func (x *DeleteStatusRequest) GetStatusId() int32 {
 	if x != nil {
-		return x.ReasonId
+		return x.StatusId
 	}
 	return 0
 }
 
-func (x *GetReturnReasonResponse) GetReason() string {
-	if x != nil {
-		return x.Reason
-	}
-	return ""
-}
-
-type GetAllReturnReasonsResponse struct {
+type DeleteStatusResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Reasons []*GetReturnReasonResponse `protobuf:"bytes,1,rep,name=reasons,proto3" json:"reasons,omitempty"`
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *GetAllReturnReasonsResponse) Reset() {
-	*x = GetAllReturnReasonsResponse{}
-	mi := &file_order_service_proto_msgTypes[55]
+func (x *DeleteStatusResponse) Reset() {
+	*x = DeleteStatusResponse{}
+	mi := &file_order_service_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAllReturnReasonsResponse) String() string {
+func (x *DeleteStatusResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAllReturnReasonsResponse) ProtoMessage() {}
+func (*DeleteStatusResponse) ProtoMessage() {}
 
-func (x *GetAllReturnReasonsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_order_service_proto_msgTypes[55]
+func (x *DeleteStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3042,42 +4361,41 @@ func (x *GetAllReturnReasonsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAllReturnReasonsResponse.ProtoReflect.Descriptor instead.
-func (*GetAllReturnReasonsResponse) Descriptor() ([]byte, []int) {
-	return file_order_service_proto_rawDescGZIP(), []int{55}
+// Deprecated: Use DeleteStatusResponse.ProtoReflect.Descriptor instead.
+func (*DeleteStatusResponse) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{78}
 }
 
-func (x *GetAllReturnReasonsResponse) GetReasons() []*GetReturnReasonResponse {
+func (x *DeleteStatusResponse) GetMessage() string {
 	if x != nil {
-		return x.Reasons
+		return x.Message
 	}
-	return nil
+	return ""
 }
 
-type UpdateReturnReasonRequest struct {
+type GetStatusByNameRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ReasonId int32  `protobuf:"varint,1,opt,name=reason_id,json=reasonId,proto3" json:"reason_id,omitempty"`
-	Reason   string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	StatusName string `protobuf:"bytes,1,opt,name=status_name,json=statusName,proto3" json:"status_name,omitempty"`
 }
 
-func (x *UpdateReturnReasonRequest) Reset() {
-	*x = UpdateReturnReasonRequest{}
-	mi := &file_order_service_proto_msgTypes[56]
+func (x *GetStatusByNameRequest) Reset() {
+	*x = GetStatusByNameRequest{}
+	mi := &file_order_service_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateReturnReasonRequest) String() string {
+func (x *GetStatusByNameRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateReturnReasonRequest) ProtoMessage() {}
+func (*GetStatusByNameRequest) ProtoMessage() {}
 
-func (x *UpdateReturnReasonRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_order_service_proto_msgTypes[56]
+func (x *GetStatusByNameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3088,48 +4406,42 @@ func (x *UpdateReturnReasonRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateReturnReasonRequest.ProtoReflect.Descriptor instead.
-func (*UpdateReturnReasonRequest) Descriptor() ([]byte, []int) {
-	return file_order_service_proto_rawDescGZIP(), []int{56}
-}
-
-func (x *UpdateReturnReasonRequest) GetReasonId() int32 {
-	if x != nil {
-		return x.ReasonId
-	}
-	return 0
+// Deprecated: Use GetStatusByNameRequest.ProtoReflect.Descriptor instead.
+func (*GetStatusByNameRequest) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{79}
 }
 
-func (x *UpdateReturnReasonRequest) GetReason() string {
+func (x *GetStatusByNameRequest) GetStatusName() string {
 	if x != nil {
-		return x.Reason
+		return x.StatusName
 	}
 	return ""
 }
 
-type UpdateReturnReasonResponse struct {
+type GetStatusByNameResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	StatusId   int32  `protobuf:"varint,1,opt,name=status_id,json=statusId,proto3" json:"status_id,omitempty"`
+	StatusName string `protobuf:"bytes,2,opt,name=status_name,json=statusName,proto3" json:"status_name,omitempty"`
 }
 
-func (x *UpdateReturnReasonResponse) Reset() {
-	*x = UpdateReturnReasonResponse{}
-	mi := &file_order_service_proto_msgTypes[57]
+func (x *GetStatusByNameResponse) Reset() {
+	*x = GetStatusByNameResponse{}
+	mi := &file_order_service_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateReturnReasonResponse) String() string {
+func (x *GetStatusByNameResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateReturnReasonResponse) ProtoMessage() {}
+func (*GetStatusByNameResponse) ProtoMessage() {}
 
-func (x *UpdateReturnReasonResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_order_service_proto_msgTypes[57]
+func (x *GetStatusByNameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3140,41 +4452,48 @@ func (x *UpdateReturnReasonResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateReturnReasonResponse.ProtoReflect.Descriptor instead.
-func (*UpdateReturnReasonResponse) Descriptor() ([]byte, []int) {
-	return file_order_service_proto_rawDescGZIP(), []int{57}
+// Deprecated: Use GetStatusByNameResponse.ProtoReflect.Descriptor instead.
+func (*GetStatusByNameResponse) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{80}
 }
 
-func (x *UpdateReturnReasonResponse) GetMessage() string {
+func (x *GetStatusByNameResponse) GetStatusId() int32 {
 	if x != nil {
-		return x.Message
+		return x.StatusId
+	}
+	return 0
+}
+
+func (x *GetStatusByNameResponse) GetStatusName() string {
+	if x != nil {
+		return x.StatusName
 	}
 	return ""
 }
 
-type DeleteReturnReasonRequest struct {
+type CheckStatusExistsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ReasonId int32 `protobuf:"varint,1,opt,name=reason_id,json=reasonId,proto3" json:"reason_id,omitempty"`
+	StatusId int32 `protobuf:"varint,1,opt,name=status_id,json=statusId,proto3" json:"status_id,omitempty"`
 }
 
-func (x *DeleteReturnReasonRequest) Reset() {
-	*x = DeleteReturnReasonRequest{}
-	mi := &file_order_service_proto_msgTypes[58]
+func (x *CheckStatusExistsRequest) Reset() {
+	*x = CheckStatusExistsRequest{}
+	mi := &file_order_service_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteReturnReasonRequest) String() string {
+func (x *CheckStatusExistsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteReturnReasonRequest) ProtoMessage() {}
+func (*CheckStatusExistsRequest) ProtoMessage() {}
 
-func (x *DeleteReturnReasonRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_order_service_proto_msgTypes[58]
+func (x *CheckStatusExistsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3185,41 +4504,41 @@ func (x *DeleteReturnReasonRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteReturnReasonRequest.ProtoReflect.Descriptor instead.
-func (*DeleteReturnReasonRequest) Descriptor() ([]byte, []int) {
-	return file_order_service_proto_rawDescGZIP(), []int{58}
+// Deprecated: Use CheckStatusExistsRequest.ProtoReflect.Descriptor instead.
+func (*CheckStatusExistsRequest) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{81}
 }
 
-func (x *DeleteReturnReasonRequest) GetReasonId() int32 {
+func (x *CheckStatusExistsRequest) GetStatusId() int32 {
 	if x != nil {
-		return x.ReasonId
+		return x.StatusId
 	}
 	return 0
 }
 
-type DeleteReturnReasonResponse struct {
+type CheckStatusExistsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Exists bool `protobuf:"varint,1,opt,name=exists,proto3" json:"exists,omitempty"`
 }
 
-func (x *DeleteReturnReasonResponse) Reset() {
-	*x = DeleteReturnReasonResponse{}
-	mi := &file_order_service_proto_msgTypes[59]
+func (x *CheckStatusExistsResponse) Reset() {
+	*x = CheckStatusExistsResponse{}
+	mi := &file_order_service_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteReturnReasonResponse) String() string {
+func (x *CheckStatusExistsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteReturnReasonResponse) ProtoMessage() {}
+func (*CheckStatusExistsResponse) ProtoMessage() {}
 
-func (x *DeleteReturnReasonResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_order_service_proto_msgTypes[59]
+func (x *CheckStatusExistsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3230,41 +4549,42 @@ func (x *DeleteReturnReasonResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteReturnReasonResponse.ProtoReflect.Descriptor instead.
-func (*DeleteReturnReasonResponse) Descriptor() ([]byte, []int) {
-	return file_order_service_proto_rawDescGZIP(), []int{59}
+// Deprecated: Use CheckStatusExistsResponse.ProtoReflect.Descriptor instead.
+func (*CheckStatusExistsResponse) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{82}
 }
 
-func (x *DeleteReturnReasonResponse) GetMessage() string {
+func (x *CheckStatusExistsResponse) GetExists() bool {
 	if x != nil {
-		return x.Message
+		return x.Exists
 	}
-	return ""
+	return false
 }
 
-type CheckReturnReasonExistsRequest struct {
+// Worker messages
+type SetWorkerCountRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ReasonId int32 `protobuf:"varint,1,opt,name=reason_id,json=reasonId,proto3" json:"reason_id,omitempty"`
+	Count int32 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (x *CheckReturnReasonExistsRequest) Reset() {
-	*x = CheckReturnReasonExistsRequest{}
-	mi := &file_order_service_proto_msgTypes[60]
+func (x *SetWorkerCountRequest) Reset() {
+	*x = SetWorkerCountRequest{}
+	mi := &file_order_service_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CheckReturnReasonExistsRequest) String() string {
+func (x *SetWorkerCountRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckReturnReasonExistsRequest) ProtoMessage() {}
+func (*SetWorkerCountRequest) ProtoMessage() {}
 
-func (x *CheckReturnReasonExistsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_order_service_proto_msgTypes[60]
+func (x *SetWorkerCountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3275,41 +4595,41 @@ func (x *CheckReturnReasonExistsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckReturnReasonExistsRequest.ProtoReflect.Descriptor instead.
-func (*CheckReturnReasonExistsRequest) Descriptor() ([]byte, []int) {
-	return file_order_service_proto_rawDescGZIP(), []int{60}
+// Deprecated: Use SetWorkerCountRequest.ProtoReflect.Descriptor instead.
+func (*SetWorkerCountRequest) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{83}
 }
 
-func (x *CheckReturnReasonExistsRequest) GetReasonId() int32 {
+func (x *SetWorkerCountRequest) GetCount() int32 {
 	if x != nil {
-		return x.ReasonId
+		return x.Count
 	}
 	return 0
 }
 
-type CheckReturnReasonExistsResponse struct {
+type SetWorkerCountResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Exists bool `protobuf:"varint,1,opt,name=exists,proto3" json:"exists,omitempty"`
+	NewCount int32 `protobuf:"varint,1,opt,name=new_count,json=newCount,proto3" json:"new_count,omitempty"`
 }
 
-func (x *CheckReturnReasonExistsResponse) Reset() {
-	*x = CheckReturnReasonExistsResponse{}
-	mi := &file_order_service_proto_msgTypes[61]
+func (x *SetWorkerCountResponse) Reset() {
+	*x = SetWorkerCountResponse{}
+	mi := &file_order_service_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CheckReturnReasonExistsResponse) String() string {
+func (x *SetWorkerCountResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckReturnReasonExistsResponse) ProtoMessage() {}
+func (*SetWorkerCountResponse) ProtoMessage() {}
 
-func (x *CheckReturnReasonExistsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_order_service_proto_msgTypes[61]
+func (x *SetWorkerCountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3320,42 +4640,49 @@ func (x *CheckReturnReasonExistsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckReturnReasonExistsResponse.ProtoReflect.Descriptor instead.
-func (*CheckReturnReasonExistsResponse) Descriptor() ([]byte, []int) {
-	return file_order_service_proto_rawDescGZIP(), []int{61}
+// Deprecated: Use SetWorkerCountResponse.ProtoReflect.Descriptor instead.
+func (*SetWorkerCountResponse) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{84}
 }
 
-func (x *CheckReturnReasonExistsResponse) GetExists() bool {
+func (x *SetWorkerCountResponse) GetNewCount() int32 {
 	if x != nil {
-		return x.Exists
+		return x.NewCount
 	}
-	return false
+	return 0
 }
 
-// Status messages
-type CreateStatusRequest struct {
+type GetWorkerPoolStatsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	StatusName string `protobuf:"bytes,1,opt,name=status_name,json=statusName,proto3" json:"status_name,omitempty"`
+	Workers        int32  `protobuf:"varint,1,opt,name=workers,proto3" json:"workers,omitempty"`
+	ActiveWorkers  int32  `protobuf:"varint,2,opt,name=active_workers,json=activeWorkers,proto3" json:"active_workers,omitempty"`
+	QueueDepth     int32  `protobuf:"varint,3,opt,name=queue_depth,json=queueDepth,proto3" json:"queue_depth,omitempty"`
+	QueueCapacity  int32  `protobuf:"varint,4,opt,name=queue_capacity,json=queueCapacity,proto3" json:"queue_capacity,omitempty"`
+	CompletedTasks uint64 `protobuf:"varint,5,opt,name=completed_tasks,json=completedTasks,proto3" json:"completed_tasks,omitempty"`
+	FailedTasks    uint64 `protobuf:"varint,6,opt,name=failed_tasks,json=failedTasks,proto3" json:"failed_tasks,omitempty"`
+	PanickedTasks  uint64 `protobuf:"varint,7,opt,name=panicked_tasks,json=panickedTasks,proto3" json:"panicked_tasks,omitempty"`
+	AvgWaitTimeMs  int64  `protobuf:"varint,8,opt,name=avg_wait_time_ms,json=avgWaitTimeMs,proto3" json:"avg_wait_time_ms,omitempty"`
+	RejectedTasks  uint64 `protobuf:"varint,9,opt,name=rejected_tasks,json=rejectedTasks,proto3" json:"rejected_tasks,omitempty"`
 }
 
-func (x *CreateStatusRequest) Reset() {
-	*x = CreateStatusRequest{}
-	mi := &file_order_service_proto_msgTypes[62]
+func (x *GetWorkerPoolStatsResponse) Reset() {
+	*x = GetWorkerPoolStatsResponse{}
+	mi := &file_order_service_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateStatusRequest) String() string {
+func (x *GetWorkerPoolStatsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateStatusRequest) ProtoMessage() {}
+func (*GetWorkerPoolStatsResponse) ProtoMessage() {}
 
-func (x *CreateStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_order_service_proto_msgTypes[62]
+func (x *GetWorkerPoolStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3366,94 +4693,108 @@ func (x *CreateStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateStatusRequest.ProtoReflect.Descriptor instead.
-func (*CreateStatusRequest) Descriptor() ([]byte, []int) {
-	return file_order_service_proto_rawDescGZIP(), []int{62}
+// Deprecated: Use GetWorkerPoolStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetWorkerPoolStatsResponse) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{85}
 }
 
-func (x *CreateStatusRequest) GetStatusName() string {
+func (x *GetWorkerPoolStatsResponse) GetWorkers() int32 {
 	if x != nil {
-		return x.StatusName
+		return x.Workers
 	}
-	return ""
+	return 0
 }
 
-type CreateStatusResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	StatusId int32  `protobuf:"varint,1,opt,name=status_id,json=statusId,proto3" json:"status_id,omitempty"`
-	Message  string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+func (x *GetWorkerPoolStatsResponse) GetActiveWorkers() int32 {
+	if x != nil {
+		return x.ActiveWorkers
+	}
+	return 0
 }
 
-func (x *CreateStatusResponse) Reset() {
-	*x = CreateStatusResponse{}
-	mi := &file_order_service_proto_msgTypes[63]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *GetWorkerPoolStatsResponse) GetQueueDepth() int32 {
+	if x != nil {
+		return x.QueueDepth
+	}
+	return 0
 }
 
-func (x *CreateStatusResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *GetWorkerPoolStatsResponse) GetQueueCapacity() int32 {
+	if x != nil {
+		return x.QueueCapacity
+	}
+	return 0
 }
 
-func (*CreateStatusResponse) ProtoMessage() {}
+func (x *GetWorkerPoolStatsResponse) GetCompletedTasks() uint64 {
+	if x != nil {
+		return x.CompletedTasks
+	}
+	return 0
+}
 
-func (x *CreateStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_order_service_proto_msgTypes[63]
+func (x *GetWorkerPoolStatsResponse) GetFailedTasks() uint64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.FailedTasks
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use CreateStatusResponse.ProtoReflect.Descriptor instead.
-func (*CreateStatusResponse) Descriptor() ([]byte, []int) {
-	return file_order_service_proto_rawDescGZIP(), []int{63}
+func (x *GetWorkerPoolStatsResponse) GetPanickedTasks() uint64 {
+	if x != nil {
+		return x.PanickedTasks
+	}
+	return 0
 }
 
-func (x *CreateStatusResponse) GetStatusId() int32 {
+func (x *GetWorkerPoolStatsResponse) GetAvgWaitTimeMs() int64 {
 	if x != nil {
-		return x.StatusId
+		return x.AvgWaitTimeMs
 	}
 	return 0
 }
 
-func (x *CreateStatusResponse) GetMessage() string {
+func (x *GetWorkerPoolStatsResponse) GetRejectedTasks() uint64 {
 	if x != nil {
-		return x.Message
+		return x.RejectedTasks
 	}
-	return ""
+	return 0
 }
 
-type GetStatusByIDRequest struct {
+// Job messages
+type Job struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	StatusId int32 `protobuf:"varint,1,opt,name=status_id,json=statusId,proto3" json:"status_id,omitempty"`
-}
-
-func (x *GetStatusByIDRequest) Reset() {
-	*x = GetStatusByIDRequest{}
-	mi := &file_order_service_proto_msgTypes[64]
+	Name           string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Schedule       string `protobuf:"bytes,2,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	Running        bool   `protobuf:"varint,3,opt,name=running,proto3" json:"running,omitempty"`
+	LastRunAt      string `protobuf:"bytes,4,opt,name=last_run_at,json=lastRunAt,proto3" json:"last_run_at,omitempty"`
+	LastDurationMs int64  `protobuf:"varint,5,opt,name=last_duration_ms,json=lastDurationMs,proto3" json:"last_duration_ms,omitempty"`
+	LastOutcome    string `protobuf:"bytes,6,opt,name=last_outcome,json=lastOutcome,proto3" json:"last_outcome,omitempty"`
+	LastError      string `protobuf:"bytes,7,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	NextRunAt      string `protobuf:"bytes,8,opt,name=next_run_at,json=nextRunAt,proto3" json:"next_run_at,omitempty"`
+	Runs           uint64 `protobuf:"varint,9,opt,name=runs,proto3" json:"runs,omitempty"`
+	Failures       uint64 `protobuf:"varint,10,opt,name=failures,proto3" json:"failures,omitempty"`
+	LastSummary    string `protobuf:"bytes,11,opt,name=last_summary,json=lastSummary,proto3" json:"last_summary,omitempty"`
+}
+
+func (x *Job) Reset() {
+	*x = Job{}
+	mi := &file_order_service_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetStatusByIDRequest) String() string {
+func (x *Job) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetStatusByIDRequest) ProtoMessage() {}
+func (*Job) ProtoMessage() {}
 
-func (x *GetStatusByIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_order_service_proto_msgTypes[64]
+func (x *Job) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3464,92 +4805,112 @@ func (x *GetStatusByIDRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetStatusByIDRequest.ProtoReflect.Descriptor instead.
-func (*GetStatusByIDRequest) Descriptor() ([]byte, []int) {
-	return file_order_service_proto_rawDescGZIP(), []int{64}
+// Deprecated: Use Job.ProtoReflect.Descriptor instead.
+func (*Job) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{86}
 }
 
-func (x *GetStatusByIDRequest) GetStatusId() int32 {
+func (x *Job) GetName() string {
 	if x != nil {
-		return x.StatusId
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-type GetStatusByIDResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *Job) GetSchedule() string {
+	if x != nil {
+		return x.Schedule
+	}
+	return ""
+}
 
-	StatusId   int32  `protobuf:"varint,1,opt,name=status_id,json=statusId,proto3" json:"status_id,omitempty"`
-	StatusName string `protobuf:"bytes,2,opt,name=status_name,json=statusName,proto3" json:"status_name,omitempty"`
+func (x *Job) GetRunning() bool {
+	if x != nil {
+		return x.Running
+	}
+	return false
 }
 
-func (x *GetStatusByIDResponse) Reset() {
-	*x = GetStatusByIDResponse{}
-	mi := &file_order_service_proto_msgTypes[65]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *Job) GetLastRunAt() string {
+	if x != nil {
+		return x.LastRunAt
+	}
+	return ""
 }
 
-func (x *GetStatusByIDResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Job) GetLastDurationMs() int64 {
+	if x != nil {
+		return x.LastDurationMs
+	}
+	return 0
 }
 
-func (*GetStatusByIDResponse) ProtoMessage() {}
+func (x *Job) GetLastOutcome() string {
+	if x != nil {
+		return x.LastOutcome
+	}
+	return ""
+}
 
-func (x *GetStatusByIDResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_order_service_proto_msgTypes[65]
+func (x *Job) GetLastError() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.LastError
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GetStatusByIDResponse.ProtoReflect.Descriptor instead.
-func (*GetStatusByIDResponse) Descriptor() ([]byte, []int) {
-	return file_order_service_proto_rawDescGZIP(), []int{65}
+func (x *Job) GetNextRunAt() string {
+	if x != nil {
+		return x.NextRunAt
+	}
+	return ""
 }
 
-func (x *GetStatusByIDResponse) GetStatusId() int32 {
+func (x *Job) GetRuns() uint64 {
 	if x != nil {
-		return x.StatusId
+		return x.Runs
 	}
 	return 0
 }
 
-func (x *GetStatusByIDResponse) GetStatusName() string {
+func (x *Job) GetFailures() uint64 {
 	if x != nil {
-		return x.StatusName
+		return x.Failures
+	}
+	return 0
+}
+
+func (x *Job) GetLastSummary() string {
+	if x != nil {
+		return x.LastSummary
 	}
 	return ""
 }
 
-type GetAllStatusesRequest struct {
+type ListJobsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Jobs   []*Job `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
+	Leader bool   `protobuf:"varint,2,opt,name=leader,proto3" json:"leader,omitempty"`
 }
 
-func (x *GetAllStatusesRequest) Reset() {
-	*x = GetAllStatusesRequest{}
-	mi := &file_order_service_proto_msgTypes[66]
+func (x *ListJobsResponse) Reset() {
+	*x = ListJobsResponse{}
+	mi := &file_order_service_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAllStatusesRequest) String() string {
+func (x *ListJobsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAllStatusesRequest) ProtoMessage() {}
+func (*ListJobsResponse) ProtoMessage() {}
 
-func (x *GetAllStatusesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_order_service_proto_msgTypes[66]
+func (x *ListJobsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3560,34 +4921,48 @@ func (x *GetAllStatusesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAllStatusesRequest.ProtoReflect.Descriptor instead.
-func (*GetAllStatusesRequest) Descriptor() ([]byte, []int) {
-	return file_order_service_proto_rawDescGZIP(), []int{66}
+// Deprecated: Use ListJobsResponse.ProtoReflect.Descriptor instead.
+func (*ListJobsResponse) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{87}
 }
 
-type GetAllStatusesResponse struct {
+func (x *ListJobsResponse) GetJobs() []*Job {
+	if x != nil {
+		return x.Jobs
+	}
+	return nil
+}
+
+func (x *ListJobsResponse) GetLeader() bool {
+	if x != nil {
+		return x.Leader
+	}
+	return false
+}
+
+type TriggerJobRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Statuses []*GetStatusByIDResponse `protobuf:"bytes,1,rep,name=statuses,proto3" json:"statuses,omitempty"`
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *GetAllStatusesResponse) Reset() {
-	*x = GetAllStatusesResponse{}
-	mi := &file_order_service_proto_msgTypes[67]
+func (x *TriggerJobRequest) Reset() {
+	*x = TriggerJobRequest{}
+	mi := &file_order_service_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAllStatusesResponse) String() string {
+func (x *TriggerJobRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAllStatusesResponse) ProtoMessage() {}
+func (*TriggerJobRequest) ProtoMessage() {}
 
-func (x *GetAllStatusesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_order_service_proto_msgTypes[67]
+func (x *TriggerJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3598,42 +4973,41 @@ func (x *GetAllStatusesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAllStatusesResponse.ProtoReflect.Descriptor instead.
-func (*GetAllStatusesResponse) Descriptor() ([]byte, []int) {
-	return file_order_service_proto_rawDescGZIP(), []int{67}
+// Deprecated: Use TriggerJobRequest.ProtoReflect.Descriptor instead.
+func (*TriggerJobRequest) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{88}
 }
 
-func (x *GetAllStatusesResponse) GetStatuses() []*GetStatusByIDResponse {
+func (x *TriggerJobRequest) GetName() string {
 	if x != nil {
-		return x.Statuses
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-type UpdateStatusRequest struct {
+type TriggerJobResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	StatusId   int32  `protobuf:"varint,1,opt,name=status_id,json=statusId,proto3" json:"status_id,omitempty"`
-	StatusName string `protobuf:"bytes,2,opt,name=status_name,json=statusName,proto3" json:"status_name,omitempty"`
+	Job *Job `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
 }
 
-func (x *UpdateStatusRequest) Reset() {
-	*x = UpdateStatusRequest{}
-	mi := &file_order_service_proto_msgTypes[68]
+func (x *TriggerJobResponse) Reset() {
+	*x = TriggerJobResponse{}
+	mi := &file_order_service_proto_msgTypes[89]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateStatusRequest) String() string {
+func (x *TriggerJobResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateStatusRequest) ProtoMessage() {}
+func (*TriggerJobResponse) ProtoMessage() {}
 
-func (x *UpdateStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_order_service_proto_msgTypes[68]
+func (x *TriggerJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3644,48 +5018,49 @@ func (x *UpdateStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateStatusRequest.ProtoReflect.Descriptor instead.
-func (*UpdateStatusRequest) Descriptor() ([]byte, []int) {
-	return file_order_service_proto_rawDescGZIP(), []int{68}
-}
-
-func (x *UpdateStatusRequest) GetStatusId() int32 {
-	if x != nil {
-		return x.StatusId
-	}
-	return 0
+// Deprecated: Use TriggerJobResponse.ProtoReflect.Descriptor instead.
+func (*TriggerJobResponse) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{89}
 }
 
-func (x *UpdateStatusRequest) GetStatusName() string {
+func (x *TriggerJobResponse) GetJob() *Job {
 	if x != nil {
-		return x.StatusName
+		return x.Job
 	}
-	return ""
+	return nil
 }
 
-type UpdateStatusResponse struct {
+// Cache messages
+type CacheStats struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Name          string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Hits          uint64  `protobuf:"varint,2,opt,name=hits,proto3" json:"hits,omitempty"`
+	Misses        uint64  `protobuf:"varint,3,opt,name=misses,proto3" json:"misses,omitempty"`
+	Errors        uint64  `protobuf:"varint,4,opt,name=errors,proto3" json:"errors,omitempty"`
+	Loads         uint64  `protobuf:"varint,5,opt,name=loads,proto3" json:"loads,omitempty"`
+	LoadErrors    uint64  `protobuf:"varint,6,opt,name=load_errors,json=loadErrors,proto3" json:"load_errors,omitempty"`
+	AvgLoadTimeMs float64 `protobuf:"fixed64,7,opt,name=avg_load_time_ms,json=avgLoadTimeMs,proto3" json:"avg_load_time_ms,omitempty"`
+	Evictions     uint64  `protobuf:"varint,8,opt,name=evictions,proto3" json:"evictions,omitempty"`
 }
 
-func (x *UpdateStatusResponse) Reset() {
-	*x = UpdateStatusResponse{}
-	mi := &file_order_service_proto_msgTypes[69]
+func (x *CacheStats) Reset() {
+	*x = CacheStats{}
+	mi := &file_order_service_proto_msgTypes[90]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateStatusResponse) String() string {
+func (x *CacheStats) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateStatusResponse) ProtoMessage() {}
+func (*CacheStats) ProtoMessage() {}
 
-func (x *UpdateStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_order_service_proto_msgTypes[69]
+func (x *CacheStats) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[90]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3696,86 +5071,90 @@ func (x *UpdateStatusResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateStatusResponse.ProtoReflect.Descriptor instead.
-func (*UpdateStatusResponse) Descriptor() ([]byte, []int) {
-	return file_order_service_proto_rawDescGZIP(), []int{69}
+// Deprecated: Use CacheStats.ProtoReflect.Descriptor instead.
+func (*CacheStats) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{90}
 }
 
-func (x *UpdateStatusResponse) GetMessage() string {
+func (x *CacheStats) GetName() string {
 	if x != nil {
-		return x.Message
+		return x.Name
 	}
 	return ""
 }
 
-type DeleteStatusRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	StatusId int32 `protobuf:"varint,1,opt,name=status_id,json=statusId,proto3" json:"status_id,omitempty"`
+func (x *CacheStats) GetHits() uint64 {
+	if x != nil {
+		return x.Hits
+	}
+	return 0
 }
 
-func (x *DeleteStatusRequest) Reset() {
-	*x = DeleteStatusRequest{}
-	mi := &file_order_service_proto_msgTypes[70]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *CacheStats) GetMisses() uint64 {
+	if x != nil {
+		return x.Misses
+	}
+	return 0
 }
 
-func (x *DeleteStatusRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *CacheStats) GetErrors() uint64 {
+	if x != nil {
+		return x.Errors
+	}
+	return 0
 }
 
-func (*DeleteStatusRequest) ProtoMessage() {}
+func (x *CacheStats) GetLoads() uint64 {
+	if x != nil {
+		return x.Loads
+	}
+	return 0
+}
 
-func (x *DeleteStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_order_service_proto_msgTypes[70]
+func (x *CacheStats) GetLoadErrors() uint64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.LoadErrors
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use DeleteStatusRequest.ProtoReflect.Descriptor instead.
-func (*DeleteStatusRequest) Descriptor() ([]byte, []int) {
-	return file_order_service_proto_rawDescGZIP(), []int{70}
+func (x *CacheStats) GetAvgLoadTimeMs() float64 {
+	if x != nil {
+		return x.AvgLoadTimeMs
+	}
+	return 0
 }
 
-func (x *DeleteStatusRequest) GetStatusId() int32 {
+func (x *CacheStats) GetEvictions() uint64 {
 	if x != nil {
-		return x.StatusId
+		return x.Evictions
 	}
 	return 0
 }
 
-type DeleteStatusResponse struct {
+type GetCacheStatsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Caches []*CacheStats `protobuf:"bytes,1,rep,name=caches,proto3" json:"caches,omitempty"`
 }
 
-func (x *DeleteStatusResponse) Reset() {
-	*x = DeleteStatusResponse{}
-	mi := &file_order_service_proto_msgTypes[71]
+func (x *GetCacheStatsResponse) Reset() {
+	*x = GetCacheStatsResponse{}
+	mi := &file_order_service_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteStatusResponse) String() string {
+func (x *GetCacheStatsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteStatusResponse) ProtoMessage() {}
+func (*GetCacheStatsResponse) ProtoMessage() {}
 
-func (x *DeleteStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_order_service_proto_msgTypes[71]
+func (x *GetCacheStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3786,41 +5165,42 @@ func (x *DeleteStatusResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteStatusResponse.ProtoReflect.Descriptor instead.
-func (*DeleteStatusResponse) Descriptor() ([]byte, []int) {
-	return file_order_service_proto_rawDescGZIP(), []int{71}
+// Deprecated: Use GetCacheStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetCacheStatsResponse) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{91}
 }
 
-func (x *DeleteStatusResponse) GetMessage() string {
+func (x *GetCacheStatsResponse) GetCaches() []*CacheStats {
 	if x != nil {
-		return x.Message
+		return x.Caches
 	}
-	return ""
+	return nil
 }
 
-type GetStatusByNameRequest struct {
+type InspectCacheKeyRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	StatusName string `protobuf:"bytes,1,opt,name=status_name,json=statusName,proto3" json:"status_name,omitempty"`
+	Cache string `protobuf:"bytes,1,opt,name=cache,proto3" json:"cache,omitempty"`
+	Key   string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
 }
 
-func (x *GetStatusByNameRequest) Reset() {
-	*x = GetStatusByNameRequest{}
-	mi := &file_order_service_proto_msgTypes[72]
+func (x *InspectCacheKeyRequest) Reset() {
+	*x = InspectCacheKeyRequest{}
+	mi := &file_order_service_proto_msgTypes[92]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetStatusByNameRequest) String() string {
+func (x *InspectCacheKeyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetStatusByNameRequest) ProtoMessage() {}
+func (*InspectCacheKeyRequest) ProtoMessage() {}
 
-func (x *GetStatusByNameRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_order_service_proto_msgTypes[72]
+func (x *InspectCacheKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[92]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3831,42 +5211,52 @@ func (x *GetStatusByNameRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetStatusByNameRequest.ProtoReflect.Descriptor instead.
-func (*GetStatusByNameRequest) Descriptor() ([]byte, []int) {
-	return file_order_service_proto_rawDescGZIP(), []int{72}
+// Deprecated: Use InspectCacheKeyRequest.ProtoReflect.Descriptor instead.
+func (*InspectCacheKeyRequest) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{92}
 }
 
-func (x *GetStatusByNameRequest) GetStatusName() string {
+func (x *InspectCacheKeyRequest) GetCache() string {
 	if x != nil {
-		return x.StatusName
+		return x.Cache
 	}
 	return ""
 }
 
-type GetStatusByNameResponse struct {
+func (x *InspectCacheKeyRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type InspectCacheKeyResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	StatusId   int32  `protobuf:"varint,1,opt,name=status_id,json=statusId,proto3" json:"status_id,omitempty"`
-	StatusName string `protobuf:"bytes,2,opt,name=status_name,json=statusName,proto3" json:"status_name,omitempty"`
+	Key            string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value          string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	NotFound       bool   `protobuf:"varint,3,opt,name=not_found,json=notFound,proto3" json:"not_found,omitempty"`
+	ExpiresAt      string `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	LoadDurationMs int64  `protobuf:"varint,5,opt,name=load_duration_ms,json=loadDurationMs,proto3" json:"load_duration_ms,omitempty"`
 }
 
-func (x *GetStatusByNameResponse) Reset() {
-	*x = GetStatusByNameResponse{}
-	mi := &file_order_service_proto_msgTypes[73]
+func (x *InspectCacheKeyResponse) Reset() {
+	*x = InspectCacheKeyResponse{}
+	mi := &file_order_service_proto_msgTypes[93]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetStatusByNameResponse) String() string {
+func (x *InspectCacheKeyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetStatusByNameResponse) ProtoMessage() {}
+func (*InspectCacheKeyResponse) ProtoMessage() {}
 
-func (x *GetStatusByNameResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_order_service_proto_msgTypes[73]
+func (x *InspectCacheKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[93]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3877,48 +5267,71 @@ func (x *GetStatusByNameResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetStatusByNameResponse.ProtoReflect.Descriptor instead.
-func (*GetStatusByNameResponse) Descriptor() ([]byte, []int) {
-	return file_order_service_proto_rawDescGZIP(), []int{73}
+// Deprecated: Use InspectCacheKeyResponse.ProtoReflect.Descriptor instead.
+func (*InspectCacheKeyResponse) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{93}
 }
 
-func (x *GetStatusByNameResponse) GetStatusId() int32 {
+func (x *InspectCacheKeyResponse) GetKey() string {
 	if x != nil {
-		return x.StatusId
+		return x.Key
 	}
-	return 0
+	return ""
 }
 
-func (x *GetStatusByNameResponse) GetStatusName() string {
+func (x *InspectCacheKeyResponse) GetValue() string {
 	if x != nil {
-		return x.StatusName
+		return x.Value
 	}
 	return ""
 }
 
-type CheckStatusExistsRequest struct {
+func (x *InspectCacheKeyResponse) GetNotFound() bool {
+	if x != nil {
+		return x.NotFound
+	}
+	return false
+}
+
+func (x *InspectCacheKeyResponse) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+func (x *InspectCacheKeyResponse) GetLoadDurationMs() int64 {
+	if x != nil {
+		return x.LoadDurationMs
+	}
+	return 0
+}
+
+type InvalidateCacheRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	StatusId int32 `protobuf:"varint,1,opt,name=status_id,json=statusId,proto3" json:"status_id,omitempty"`
+	Cache string   `protobuf:"bytes,1,opt,name=cache,proto3" json:"cache,omitempty"`
+	Keys  []string `protobuf:"bytes,2,rep,name=keys,proto3" json:"keys,omitempty"`
+	Tags  []string `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
 }
 
-func (x *CheckStatusExistsRequest) Reset() {
-	*x = CheckStatusExistsRequest{}
-	mi := &file_order_service_proto_msgTypes[74]
+func (x *InvalidateCacheRequest) Reset() {
+	*x = InvalidateCacheRequest{}
+	mi := &file_order_service_proto_msgTypes[94]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CheckStatusExistsRequest) String() string {
+func (x *InvalidateCacheRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckStatusExistsRequest) ProtoMessage() {}
+func (*InvalidateCacheRequest) ProtoMessage() {}
 
-func (x *CheckStatusExistsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_order_service_proto_msgTypes[74]
+func (x *InvalidateCacheRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[94]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3929,41 +5342,55 @@ func (x *CheckStatusExistsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckStatusExistsRequest.ProtoReflect.Descriptor instead.
-func (*CheckStatusExistsRequest) Descriptor() ([]byte, []int) {
-	return file_order_service_proto_rawDescGZIP(), []int{74}
+// Deprecated: Use InvalidateCacheRequest.ProtoReflect.Descriptor instead.
+func (*InvalidateCacheRequest) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{94}
 }
 
-func (x *CheckStatusExistsRequest) GetStatusId() int32 {
+func (x *InvalidateCacheRequest) GetCache() string {
 	if x != nil {
-		return x.StatusId
+		return x.Cache
 	}
-	return 0
+	return ""
 }
 
-type CheckStatusExistsResponse struct {
+func (x *InvalidateCacheRequest) GetKeys() []string {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+func (x *InvalidateCacheRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type InvalidateCacheResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Exists bool `protobuf:"varint,1,opt,name=exists,proto3" json:"exists,omitempty"`
+	Keys []string `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
 }
 
-func (x *CheckStatusExistsResponse) Reset() {
-	*x = CheckStatusExistsResponse{}
-	mi := &file_order_service_proto_msgTypes[75]
+func (x *InvalidateCacheResponse) Reset() {
+	*x = InvalidateCacheResponse{}
+	mi := &file_order_service_proto_msgTypes[95]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CheckStatusExistsResponse) String() string {
+func (x *InvalidateCacheResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckStatusExistsResponse) ProtoMessage() {}
+func (*InvalidateCacheResponse) ProtoMessage() {}
 
-func (x *CheckStatusExistsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_order_service_proto_msgTypes[75]
+func (x *InvalidateCacheResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[95]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3974,42 +5401,41 @@ func (x *CheckStatusExistsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckStatusExistsResponse.ProtoReflect.Descriptor instead.
-func (*CheckStatusExistsResponse) Descriptor() ([]byte, []int) {
-	return file_order_service_proto_rawDescGZIP(), []int{75}
+// Deprecated: Use InvalidateCacheResponse.ProtoReflect.Descriptor instead.
+func (*InvalidateCacheResponse) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{95}
 }
 
-func (x *CheckStatusExistsResponse) GetExists() bool {
+func (x *InvalidateCacheResponse) GetKeys() []string {
 	if x != nil {
-		return x.Exists
+		return x.Keys
 	}
-	return false
+	return nil
 }
 
-// Worker messages
-type SetWorkerCountRequest struct {
+type FlushCacheRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Count int32 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	Cache string `protobuf:"bytes,1,opt,name=cache,proto3" json:"cache,omitempty"`
 }
 
-func (x *SetWorkerCountRequest) Reset() {
-	*x = SetWorkerCountRequest{}
-	mi := &file_order_service_proto_msgTypes[76]
+func (x *FlushCacheRequest) Reset() {
+	*x = FlushCacheRequest{}
+	mi := &file_order_service_proto_msgTypes[96]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetWorkerCountRequest) String() string {
+func (x *FlushCacheRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetWorkerCountRequest) ProtoMessage() {}
+func (*FlushCacheRequest) ProtoMessage() {}
 
-func (x *SetWorkerCountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_order_service_proto_msgTypes[76]
+func (x *FlushCacheRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[96]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4020,41 +5446,41 @@ func (x *SetWorkerCountRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetWorkerCountRequest.ProtoReflect.Descriptor instead.
-func (*SetWorkerCountRequest) Descriptor() ([]byte, []int) {
-	return file_order_service_proto_rawDescGZIP(), []int{76}
+// Deprecated: Use FlushCacheRequest.ProtoReflect.Descriptor instead.
+func (*FlushCacheRequest) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{96}
 }
 
-func (x *SetWorkerCountRequest) GetCount() int32 {
+func (x *FlushCacheRequest) GetCache() string {
 	if x != nil {
-		return x.Count
+		return x.Cache
 	}
-	return 0
+	return ""
 }
 
-type SetWorkerCountResponse struct {
+type FlushCacheResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	NewCount int32 `protobuf:"varint,1,opt,name=new_count,json=newCount,proto3" json:"new_count,omitempty"`
+	DeletedKeys int32 `protobuf:"varint,1,opt,name=deleted_keys,json=deletedKeys,proto3" json:"deleted_keys,omitempty"`
 }
 
-func (x *SetWorkerCountResponse) Reset() {
-	*x = SetWorkerCountResponse{}
-	mi := &file_order_service_proto_msgTypes[77]
+func (x *FlushCacheResponse) Reset() {
+	*x = FlushCacheResponse{}
+	mi := &file_order_service_proto_msgTypes[97]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetWorkerCountResponse) String() string {
+func (x *FlushCacheResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetWorkerCountResponse) ProtoMessage() {}
+func (*FlushCacheResponse) ProtoMessage() {}
 
-func (x *SetWorkerCountResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_order_service_proto_msgTypes[77]
+func (x *FlushCacheResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[97]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4065,14 +5491,14 @@ func (x *SetWorkerCountResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetWorkerCountResponse.ProtoReflect.Descriptor instead.
-func (*SetWorkerCountResponse) Descriptor() ([]byte, []int) {
-	return file_order_service_proto_rawDescGZIP(), []int{77}
+// Deprecated: Use FlushCacheResponse.ProtoReflect.Descriptor instead.
+func (*FlushCacheResponse) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{97}
 }
 
-func (x *SetWorkerCountResponse) GetNewCount() int32 {
+func (x *FlushCacheResponse) GetDeletedKeys() int32 {
 	if x != nil {
-		return x.NewCount
+		return x.DeletedKeys
 	}
 	return 0
 }
@@ -4250,391 +5676,608 @@ var file_order_service_proto_rawDesc = []byte{
 	0x72, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x06, 0x6f,
 	0x72, 0x64, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x70,
 	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x06, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x22, 0x35, 0x0a,
-	0x11, 0x53, 0x65, 0x65, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x20, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x05, 0x42, 0x0a, 0xe0, 0x41, 0x02, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x52, 0x05, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x22, 0x2e, 0x0a, 0x12, 0x53, 0x65, 0x65, 0x64, 0x4f, 0x72, 0x64, 0x65,
-	0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x22, 0x3a, 0x0a, 0x11, 0x49, 0x73, 0x73, 0x75, 0x65, 0x4f, 0x72, 0x64,
-	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x08, 0x6f, 0x72, 0x64,
-	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0xe0, 0x41, 0x02,
-	0xfa, 0x42, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64,
-	0x22, 0x2e, 0x0a, 0x12, 0x49, 0x73, 0x73, 0x75, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x22, 0xa4, 0x01, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61,
-	0x67, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x0e, 0x70,
-	0x61, 0x63, 0x6b, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x0a, 0xe0, 0x41, 0x02, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52,
-	0x0d, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x54, 0x79, 0x70, 0x65, 0x12, 0x25,
-	0x0a, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x42, 0x11, 0xe0, 0x41,
-	0x02, 0xfa, 0x42, 0x0b, 0x12, 0x09, 0x29, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x52,
-	0x04, 0x63, 0x6f, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x77, 0x65, 0x69,
-	0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x42, 0x11, 0xe0, 0x41, 0x02, 0xfa, 0x42,
-	0x0b, 0x12, 0x09, 0x21, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x52, 0x09, 0x6d, 0x61,
-	0x78, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x3c, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67,
-	0x69, 0x6e, 0x67, 0x49, 0x64, 0x22, 0x44, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x50, 0x61, 0x63, 0x6b,
-	0x61, 0x67, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x0c,
-	0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x05, 0x42, 0x0a, 0xe0, 0x41, 0x02, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x52, 0x0b,
-	0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x22, 0x93, 0x01, 0x0a, 0x14,
-	0x47, 0x65, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x69, 0x6e,
-	0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x70, 0x61, 0x63, 0x6b,
-	0x61, 0x67, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61, 0x63, 0x6b, 0x61,
-	0x67, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0d, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12,
-	0x0a, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x63, 0x6f,
-	0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x57, 0x65, 0x69, 0x67, 0x68,
-	0x74, 0x22, 0x59, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x50, 0x61, 0x63, 0x6b, 0x61,
-	0x67, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x11,
-	0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
-	0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x52, 0x10, 0x70, 0x61, 0x63, 0x6b,
-	0x61, 0x67, 0x69, 0x6e, 0x67, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x88, 0x01, 0x0a,
-	0x09, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x61,
-	0x63, 0x6b, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x0b, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x25, 0x0a,
-	0x0e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x69, 0x6e, 0x67,
-	0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x01, 0x52, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f,
-	0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6d, 0x61,
-	0x78, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0xd3, 0x01, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x2d, 0x0a, 0x0c, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0xe0, 0x41, 0x02, 0xfa, 0x42, 0x04,
-	0x1a, 0x02, 0x20, 0x00, 0x52, 0x0b, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x49,
-	0x64, 0x12, 0x31, 0x0a, 0x0e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x5f, 0x74,
-	0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xe0, 0x41, 0x02, 0xfa, 0x42,
-	0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x0d, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x69, 0x6e, 0x67,
-	0x54, 0x79, 0x70, 0x65, 0x12, 0x25, 0x0a, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x01, 0x42, 0x11, 0xe0, 0x41, 0x02, 0xfa, 0x42, 0x0b, 0x12, 0x09, 0x29, 0x00, 0x00, 0x00,
-	0x00, 0x00, 0x00, 0x00, 0x00, 0x52, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x0a, 0x6d,
-	0x61, 0x78, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x42,
-	0x11, 0xe0, 0x41, 0x02, 0xfa, 0x42, 0x0b, 0x12, 0x09, 0x21, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
-	0x00, 0x00, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x33, 0x0a,
-	0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x69, 0x6e, 0x67,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x22, 0x47, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x61, 0x63, 0x6b,
-	0x61, 0x67, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x0c,
-	0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x05, 0x42, 0x0a, 0xe0, 0x41, 0x02, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x52, 0x0b,
-	0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x22, 0x33, 0x0a, 0x17, 0x44,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x06, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x22, 0x8e, 0x03,
+	0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x1a, 0x05, 0x18, 0xf4, 0x03,
+	0x28, 0x00, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
+	0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x20, 0x0a, 0x07, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xfa, 0x42,
+	0x04, 0x1a, 0x02, 0x28, 0x00, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x24, 0x0a,
+	0x09, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x42, 0x07, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x28, 0x00, 0x52, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x0c, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x69, 0x6e, 0x67,
+	0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x1a, 0x02,
+	0x28, 0x00, 0x52, 0x0b, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12,
+	0x23, 0x0a, 0x0d, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64,
+	0x46, 0x72, 0x6f, 0x6d, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64,
+	0x5f, 0x74, 0x6f, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x63, 0x63, 0x65, 0x70,
+	0x74, 0x65, 0x64, 0x54, 0x6f, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73,
+	0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x73, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x73, 0x5f, 0x74, 0x6f, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x73, 0x54, 0x6f, 0x12, 0x35, 0x0a, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65,
+	0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x49, 0x73, 0x73, 0x75, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x42, 0x08, 0xfa, 0x42,
+	0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x64, 0x22, 0x6e,
+	0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x06, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x06,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x35,
+	0x0a, 0x11, 0x53, 0x65, 0x65, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x42, 0x0a, 0xe0, 0x41, 0x02, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x52, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x2e, 0x0a, 0x12, 0x53, 0x65, 0x65, 0x64, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x3a, 0x0a, 0x11, 0x49, 0x73, 0x73, 0x75, 0x65, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x08, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0xe0, 0x41,
+	0x02, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49,
+	0x64, 0x22, 0x2e, 0x0a, 0x12, 0x49, 0x73, 0x73, 0x75, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x22, 0x3f, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x48, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x08, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0xe0,
+	0x41, 0x02, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x49, 0x64, 0x22, 0xc4, 0x02, 0x0a, 0x11, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x27, 0x0a, 0x0d, 0x6f, 0x6c, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x03, 0xe0, 0x41, 0x01, 0x52, 0x0b, 0x6f, 0x6c,
+	0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x0f, 0x6f, 0x6c, 0x64,
+	0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x01, 0x52, 0x0d, 0x6f, 0x6c, 0x64, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0d, 0x6e, 0x65, 0x77, 0x5f, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x42, 0x03, 0xe0,
+	0x41, 0x01, 0x52, 0x0b, 0x6e, 0x65, 0x77, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x49, 0x64, 0x12,
+	0x2b, 0x0a, 0x0f, 0x6e, 0x65, 0x77, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x01, 0x52, 0x0d, 0x6e,
+	0x65, 0x77, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x63, 0x74,
+	0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x41, 0x74, 0x22, 0x4e, 0x0a, 0x17, 0x47, 0x65, 0x74,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x22, 0xa4, 0x01, 0x0a, 0x16, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x0e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x69, 0x6e,
+	0x67, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xe0, 0x41,
+	0x02, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x0d, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67,
+	0x69, 0x6e, 0x67, 0x54, 0x79, 0x70, 0x65, 0x12, 0x25, 0x0a, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x01, 0x42, 0x11, 0xe0, 0x41, 0x02, 0xfa, 0x42, 0x0b, 0x12, 0x09, 0x29,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x52, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x12, 0x30,
+	0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x01, 0x42, 0x11, 0xe0, 0x41, 0x02, 0xfa, 0x42, 0x0b, 0x12, 0x09, 0x21, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x22, 0x3c, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67,
+	0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x70,
+	0x61, 0x63, 0x6b, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0b, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x22, 0x44,
+	0x0a, 0x13, 0x47, 0x65, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x0c, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x69,
+	0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0xe0, 0x41, 0x02,
+	0xfa, 0x42, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x52, 0x0b, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x69,
+	0x6e, 0x67, 0x49, 0x64, 0x22, 0x93, 0x01, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x50, 0x61, 0x63, 0x6b,
+	0x61, 0x67, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a,
+	0x0c, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0b, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x49, 0x64,
+	0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67,
+	0x69, 0x6e, 0x67, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6d,
+	0x61, 0x78, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x09, 0x6d, 0x61, 0x78, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x59, 0x0a, 0x17, 0x47, 0x65,
+	0x74, 0x41, 0x6c, 0x6c, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x11, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x69,
+	0x6e, 0x67, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67,
+	0x69, 0x6e, 0x67, 0x52, 0x10, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x88, 0x01, 0x0a, 0x09, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67,
+	0x69, 0x6e, 0x67, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x69, 0x6e, 0x67,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x70, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67,
+	0x69, 0x6e, 0x67, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x63, 0x6f, 0x73,
+	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x22, 0xd3, 0x01, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x0c, 0x70,
+	0x61, 0x63, 0x6b, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x42, 0x0a, 0xe0, 0x41, 0x02, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x52, 0x0b, 0x70,
+	0x61, 0x63, 0x6b, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x0e, 0x70, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x0a, 0xe0, 0x41, 0x02, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x0d,
+	0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x54, 0x79, 0x70, 0x65, 0x12, 0x25, 0x0a,
+	0x04, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x42, 0x11, 0xe0, 0x41, 0x02,
+	0xfa, 0x42, 0x0b, 0x12, 0x09, 0x29, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x52, 0x04,
+	0x63, 0x6f, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x77, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x42, 0x11, 0xe0, 0x41, 0x02, 0xfa, 0x42, 0x0b,
+	0x12, 0x09, 0x21, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x52, 0x09, 0x6d, 0x61, 0x78,
+	0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x33, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x47, 0x0a, 0x16, 0x44,
 	0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x22, 0x3c, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x0c, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x69,
+	0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0xe0, 0x41, 0x02,
+	0xfa, 0x42, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x52, 0x0b, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x69,
+	0x6e, 0x67, 0x49, 0x64, 0x22, 0x33, 0x0a, 0x17, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x3c, 0x0a, 0x13, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x25, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x42, 0x0a, 0xe0, 0x41, 0x02, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x52, 0x07,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x22, 0x30, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x46, 0x0a, 0x12, 0x47, 0x65, 0x74,
+	0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x30, 0x0a, 0x07, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x07, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e,
+	0x73, 0x22, 0x42, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x42, 0x79,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25,
+	0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x42, 0x0a, 0xe0, 0x41, 0x02, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x52, 0x07, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x49, 0x64, 0x22, 0x55, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x52, 0x65, 0x74, 0x75,
+	0x72, 0x6e, 0x42, 0x79, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x0b, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x69, 0x6e,
+	0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x52, 0x0a, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x40, 0x0a, 0x19,
+	0x47, 0x65, 0x74, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x07, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0xe0, 0x41, 0x02, 0xfa,
+	0x42, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x4e,
+	0x0a, 0x1a, 0x47, 0x65, 0x74, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x42, 0x79, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x07,
+	0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x07, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x22, 0xbc,
+	0x02, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69,
+	0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x1a, 0x05, 0x18,
+	0xf4, 0x03, 0x28, 0x00, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d,
+	0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x20, 0x0a,
+	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07,
+	0xfa, 0x42, 0x04, 0x1a, 0x02, 0x28, 0x00, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x24, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x28, 0x00, 0x52, 0x08, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x0c, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x69,
+	0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xfa, 0x42, 0x04,
+	0x1a, 0x02, 0x28, 0x00, 0x52, 0x0b, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x49,
+	0x64, 0x12, 0x24, 0x0a, 0x09, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x28, 0x00, 0x52, 0x08, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x74, 0x75, 0x72,
+	0x6e, 0x65, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x1f, 0x0a, 0x0b,
+	0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x54, 0x6f, 0x22, 0x6f, 0x0a,
+	0x13, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x07, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x74, 0x75, 0x72, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x07, 0x72,
+	0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x9b,
+	0x03, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e,
 	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0xe0, 0x41, 0x02, 0xfa, 0x42,
-	0x04, 0x1a, 0x02, 0x20, 0x00, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x22, 0x30,
-	0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x52, 0x65,
+	0x04, 0x1a, 0x02, 0x20, 0x00, 0x52, 0x08, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x49, 0x64, 0x12,
+	0x25, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x42, 0x0a, 0xe0, 0x41, 0x02, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x52, 0x07, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0xe0, 0x41, 0x02, 0xfa, 0x42, 0x04, 0x1a,
+	0x02, 0x20, 0x00, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x09, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a,
+	0xe0, 0x41, 0x02, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x52, 0x08, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x09, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x63, 0x6f, 0x73,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x42, 0x0d, 0xe0, 0x41, 0x02, 0xfa, 0x42, 0x07, 0x0a,
+	0x05, 0x2d, 0x00, 0x00, 0x00, 0x00, 0x52, 0x08, 0x62, 0x61, 0x73, 0x65, 0x43, 0x6f, 0x73, 0x74,
+	0x12, 0x34, 0x0a, 0x0e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f,
+	0x73, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x02, 0x42, 0x0d, 0xe0, 0x41, 0x02, 0xfa, 0x42, 0x07,
+	0x0a, 0x05, 0x2d, 0x00, 0x00, 0x00, 0x00, 0x52, 0x0d, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x69,
+	0x6e, 0x67, 0x43, 0x6f, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f,
+	0x63, 0x6f, 0x73, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x02, 0x42, 0x0d, 0xe0, 0x41, 0x02, 0xfa,
+	0x42, 0x07, 0x0a, 0x05, 0x2d, 0x00, 0x00, 0x00, 0x00, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x43, 0x6f, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x0c, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x69, 0x6e,
+	0x67, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0xe0, 0x41, 0x01, 0xfa,
+	0x42, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x52, 0x0b, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x69, 0x6e,
+	0x67, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x69, 0x64,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0xe0, 0x41, 0x02, 0xfa, 0x42, 0x04, 0x1a, 0x02,
+	0x20, 0x00, 0x52, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x49, 0x64, 0x22, 0x30, 0x0a, 0x14,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x3e,
+	0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0xe0, 0x41, 0x02, 0xfa, 0x42, 0x04,
+	0x1a, 0x02, 0x20, 0x00, 0x52, 0x08, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x49, 0x64, 0x22, 0x30,
+	0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x52, 0x65,
 	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
 	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x22, 0x46, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x07, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
-	0x2e, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52,
-	0x07, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x22, 0x42, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x52,
-	0x65, 0x74, 0x75, 0x72, 0x6e, 0x42, 0x79, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0xe0, 0x41, 0x02, 0xfa, 0x42, 0x04, 0x1a,
-	0x02, 0x20, 0x00, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x22, 0x55, 0x0a, 0x1a,
-	0x47, 0x65, 0x74, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x42, 0x79, 0x4f, 0x72, 0x64, 0x65, 0x72,
-	0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x0b, 0x72, 0x65,
-	0x74, 0x75, 0x72, 0x6e, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x49,
-	0x6e, 0x66, 0x6f, 0x22, 0x40, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e,
-	0x73, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x23, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x05, 0x42, 0x0a, 0xe0, 0x41, 0x02, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x52, 0x06, 0x75,
-	0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x4e, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x52, 0x65, 0x74, 0x75,
-	0x72, 0x6e, 0x73, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x07, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
-	0x74, 0x75, 0x72, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x07, 0x72, 0x65,
-	0x74, 0x75, 0x72, 0x6e, 0x73, 0x22, 0x9b, 0x03, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a,
-	0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
-	0x42, 0x0a, 0xe0, 0x41, 0x02, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x52, 0x08, 0x72, 0x65,
-	0x74, 0x75, 0x72, 0x6e, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f,
-	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0xe0, 0x41, 0x02, 0xfa, 0x42, 0x04,
-	0x1a, 0x02, 0x20, 0x00, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x23, 0x0a,
-	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a,
-	0xe0, 0x41, 0x02, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
-	0x49, 0x64, 0x12, 0x27, 0x0a, 0x09, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0xe0, 0x41, 0x02, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x20,
-	0x00, 0x52, 0x08, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x09, 0x62,
-	0x61, 0x73, 0x65, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x42, 0x0d,
-	0xe0, 0x41, 0x02, 0xfa, 0x42, 0x07, 0x0a, 0x05, 0x2d, 0x00, 0x00, 0x00, 0x00, 0x52, 0x08, 0x62,
-	0x61, 0x73, 0x65, 0x43, 0x6f, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x0e, 0x70, 0x61, 0x63, 0x6b, 0x61,
-	0x67, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x02, 0x42,
-	0x0d, 0xe0, 0x41, 0x02, 0xfa, 0x42, 0x07, 0x0a, 0x05, 0x2d, 0x00, 0x00, 0x00, 0x00, 0x52, 0x0d,
-	0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x73, 0x74, 0x12, 0x2c, 0x0a,
-	0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x02, 0x42, 0x0d, 0xe0, 0x41, 0x02, 0xfa, 0x42, 0x07, 0x0a, 0x05, 0x2d, 0x00, 0x00, 0x00, 0x00,
-	0x52, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x0c, 0x70,
-	0x61, 0x63, 0x6b, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28,
-	0x05, 0x42, 0x0a, 0xe0, 0x41, 0x01, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x52, 0x0b, 0x70,
-	0x61, 0x63, 0x6b, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x09, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0xe0,
-	0x41, 0x02, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x52, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x49, 0x64, 0x22, 0x30, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x74,
-	0x75, 0x72, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x3e, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52,
-	0x65, 0x74, 0x75, 0x72, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x09,
-	0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42,
-	0x0a, 0xe0, 0x41, 0x02, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x52, 0x08, 0x72, 0x65, 0x74,
-	0x75, 0x72, 0x6e, 0x49, 0x64, 0x22, 0x30, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52,
+	0x22, 0xc2, 0x02, 0x0a, 0x0e, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x49, 0x64,
+	0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x49,
+	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x62, 0x61, 0x73, 0x65, 0x43, 0x6f, 0x73, 0x74, 0x12, 0x25,
+	0x0a, 0x0e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x73, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0d, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x69, 0x6e,
+	0x67, 0x43, 0x6f, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63,
+	0x6f, 0x73, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x02, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x43, 0x6f, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x69, 0x6e,
+	0x67, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x70, 0x61, 0x63, 0x6b,
+	0x61, 0x67, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x64,
+	0x61, 0x74, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x74, 0x75, 0x72,
+	0x6e, 0x44, 0x61, 0x74, 0x65, 0x22, 0x3d, 0x0a, 0x14, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
+	0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a,
+	0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42,
+	0x0a, 0xe0, 0x41, 0x02, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x52, 0x07, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x49, 0x64, 0x22, 0x31, 0x0a, 0x15, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x52,
 	0x65, 0x74, 0x75, 0x72, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a,
 	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xc2, 0x02, 0x0a, 0x0e, 0x52, 0x65, 0x74, 0x75,
-	0x72, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65,
-	0x74, 0x75, 0x72, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x72,
-	0x65, 0x74, 0x75, 0x72, 0x6e, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72,
-	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72,
-	0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x72,
-	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
-	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x61, 0x73, 0x65,
-	0x5f, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x62, 0x61, 0x73,
-	0x65, 0x43, 0x6f, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x69,
-	0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0d, 0x70,
-	0x61, 0x63, 0x6b, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
-	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x02,
-	0x52, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x70,
-	0x61, 0x63, 0x6b, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x0b, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x1b,
-	0x0a, 0x09, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x72,
-	0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x22, 0x3d, 0x0a, 0x14,
-	0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0xe0, 0x41, 0x02, 0xfa, 0x42, 0x04, 0x1a, 0x02,
-	0x20, 0x00, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x22, 0x31, 0x0a, 0x15, 0x50,
-	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x3f,
-	0x0a, 0x19, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x52, 0x65,
-	0x61, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x06, 0x72,
-	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xe0, 0x41, 0x02,
-	0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22,
-	0x53, 0x0a, 0x1a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x52,
-	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a,
-	0x09, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x08, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x22, 0x41, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x52, 0x65, 0x74, 0x75, 0x72,
-	0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27,
-	0x0a, 0x09, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x05, 0x42, 0x0a, 0xe0, 0x41, 0x02, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x52, 0x08, 0x72,
-	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x4e, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x52, 0x65,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x3f, 0x0a, 0x19, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xe0, 0x41, 0x02, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01,
+	0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x53, 0x0a, 0x1a, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x41, 0x0a,
+	0x16, 0x47, 0x65, 0x74, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x09, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0xe0, 0x41, 0x02, 0xfa,
+	0x42, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x52, 0x08, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x49, 0x64,
+	0x22, 0x4e, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x52, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x22, 0x58, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e,
+	0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x39, 0x0a, 0x07, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x74,
+	0x75, 0x72, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x52, 0x07, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x22, 0x68, 0x0a, 0x19, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x09, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0xe0, 0x41, 0x02, 0xfa,
+	0x42, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x52, 0x08, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x49, 0x64,
+	0x12, 0x22, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x0a, 0xe0, 0x41, 0x02, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x22, 0x36, 0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65,
 	0x74, 0x75, 0x72, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x49, 0x64, 0x12,
-	0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x58, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x41, 0x6c,
-	0x6c, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x07, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
-	0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x07, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
-	0x73, 0x22, 0x68, 0x0a, 0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x74, 0x75, 0x72,
-	0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27,
-	0x0a, 0x09, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x05, 0x42, 0x0a, 0xe0, 0x41, 0x02, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x52, 0x08, 0x72,
-	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
-	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xe0, 0x41, 0x02, 0xfa, 0x42, 0x04, 0x72,
-	0x02, 0x10, 0x01, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x36, 0x0a, 0x1a, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f,
-	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x22, 0x44, 0x0a, 0x19, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x74,
-	0x75, 0x72, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x27, 0x0a, 0x09, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x44, 0x0a, 0x19,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x52, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x09, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0xe0, 0x41,
+	0x02, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x52, 0x08, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x49, 0x64, 0x22, 0x36, 0x0a, 0x1a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x74, 0x75,
+	0x72, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x49, 0x0a, 0x1e, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x45,
+	0x78, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x09,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42,
+	0x0a, 0xe0, 0x41, 0x02, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x52, 0x08, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x39, 0x0a, 0x1f, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65,
+	0x74, 0x75, 0x72, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x69, 0x73,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73,
+	0x22, 0x42, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xe0, 0x41,
+	0x02, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x4e, 0x61, 0x6d, 0x65, 0x22, 0x4d, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x22, 0x3f, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x09, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a,
+	0xe0, 0x41, 0x02, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x52, 0x08, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x49, 0x64, 0x22, 0x55, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a,
+	0x09, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x17, 0x0a, 0x15, 0x47,
+	0x65, 0x74, 0x41, 0x6c, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0x53, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39,
+	0x0a, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52,
+	0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x22, 0x6b, 0x0a, 0x13, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x27, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x05, 0x42, 0x0a, 0xe0, 0x41, 0x02, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x52,
-	0x08, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x36, 0x0a, 0x1a, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x22, 0x49, 0x0a, 0x1e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e,
-	0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x09, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0xe0, 0x41, 0x02, 0xfa, 0x42, 0x04, 0x1a, 0x02,
-	0x20, 0x00, 0x52, 0x08, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x39, 0x0a, 0x1f,
-	0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f,
-	0x6e, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x16, 0x0a, 0x06, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x06, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x22, 0x42, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b,
-	0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x0a, 0xe0, 0x41, 0x02, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52,
-	0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x4d, 0x0a, 0x14, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x49, 0x64,
-	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x3f, 0x0a, 0x14, 0x47, 0x65,
-	0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x27, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0xe0, 0x41, 0x02, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x20,
-	0x00, 0x52, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x49, 0x64, 0x22, 0x55, 0x0a, 0x15, 0x47,
-	0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x49,
-	0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4e, 0x61,
-	0x6d, 0x65, 0x22, 0x17, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x53, 0x0a, 0x16, 0x47,
-	0x65, 0x74, 0x41, 0x6c, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
-	0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73,
-	0x22, 0x6b, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0xe0, 0x41, 0x02, 0xfa,
-	0x42, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x52, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x49, 0x64,
-	0x12, 0x2b, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xe0, 0x41, 0x02, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10,
-	0x01, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x30, 0x0a,
-	0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22,
-	0x3e, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x0b, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a,
+	0xe0, 0x41, 0x02, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x30, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x3e, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x27, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x42, 0x0a, 0xe0, 0x41, 0x02, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x52, 0x08,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x49, 0x64, 0x22, 0x30, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x45, 0x0a, 0x16, 0x47, 0x65,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xe0, 0x41, 0x02, 0xfa, 0x42,
+	0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4e, 0x61, 0x6d,
+	0x65, 0x22, 0x57, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x79,
+	0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x43, 0x0a, 0x18, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x52,
 	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
 	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0xe0, 0x41, 0x02, 0xfa, 0x42,
 	0x04, 0x1a, 0x02, 0x20, 0x00, 0x52, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x49, 0x64, 0x22,
-	0x30, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x22, 0x45, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x79,
-	0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x0b, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x0a, 0xe0, 0x41, 0x02, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x0a, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x57, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x49, 0x64,
-	0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4e, 0x61, 0x6d,
-	0x65, 0x22, 0x43, 0x0a, 0x18, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a,
-	0x09, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
-	0x42, 0x0a, 0xe0, 0x41, 0x02, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x52, 0x08, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x49, 0x64, 0x22, 0x33, 0x0a, 0x19, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x06, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x22, 0x39, 0x0a, 0x15, 0x53,
-	0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x05, 0x42, 0x0a, 0xe0, 0x41, 0x02, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x52,
-	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x35, 0x0a, 0x16, 0x53, 0x65, 0x74, 0x57, 0x6f, 0x72,
-	0x6b, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x65, 0x77, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x08, 0x6e, 0x65, 0x77, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x32, 0x8c, 0x22,
-	0x0a, 0x0a, 0x41, 0x50, 0x49, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x56, 0x0a, 0x0a,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x19, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x11, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0b, 0x3a, 0x01, 0x2a, 0x22, 0x06, 0x2f, 0x75,
-	0x73, 0x65, 0x72, 0x73, 0x12, 0x54, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x12,
-	0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
-	0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x18, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x12, 0x10, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x73,
-	0x2f, 0x7b, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x52, 0x0a, 0x0b, 0x47, 0x65,
-	0x74, 0x41, 0x6c, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x1a, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c,
-	0x6c, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0e,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x08, 0x12, 0x06, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x12, 0x60,
-	0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x19, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
-	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x3a, 0x01, 0x2a, 0x1a, 0x10,
-	0x2f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x7d,
-	0x12, 0x59, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x19,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73,
-	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x22, 0x18, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x2a, 0x10, 0x2f, 0x75, 0x73, 0x65, 0x72,
-	0x73, 0x2f, 0x7b, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x65, 0x0a, 0x0b, 0x47,
-	0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
-	0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x12, 0x15, 0x2f, 0x75, 0x73,
-	0x65, 0x72, 0x73, 0x2f, 0x7b, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x73, 0x0a, 0x0f, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x45,
-	0x78, 0x69, 0x73, 0x74, 0x73, 0x12, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43,
-	0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43,
-	0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x12, 0x17,
-	0x2f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x7d,
-	0x2f, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x12, 0x5a, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x12, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0c, 0x3a, 0x01, 0x2a, 0x22, 0x07, 0x2f, 0x6f, 0x72, 0x64,
-	0x65, 0x72, 0x73, 0x12, 0x59, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12,
-	0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65,
-	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
-	0x31, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12, 0x12, 0x2f, 0x6f, 0x72, 0x64,
-	0x65, 0x72, 0x73, 0x2f, 0x7b, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x55,
-	0x0a, 0x0c, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x12, 0x16,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
-	0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x09, 0x12, 0x07, 0x2f, 0x6f,
-	0x72, 0x64, 0x65, 0x72, 0x73, 0x12, 0x65, 0x0a, 0x0b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f,
-	0x72, 0x64, 0x65, 0x72, 0x12, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1d, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x17, 0x3a, 0x01, 0x2a, 0x1a, 0x12, 0x2f, 0x6f, 0x72, 0x64, 0x65, 0x72,
-	0x73, 0x2f, 0x7b, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x5d, 0x0a, 0x0b,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x1a, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x33, 0x0a, 0x19, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x45, 0x78,
+	0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x65, 0x78,
+	0x69, 0x73, 0x74, 0x73, 0x22, 0x39, 0x0a, 0x15, 0x53, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x65,
+	0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a,
+	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0xe0, 0x41,
+	0x02, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22,
+	0x35, 0x0a, 0x16, 0x53, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x65, 0x77,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6e, 0x65,
+	0x77, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xe8, 0x02, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x57, 0x6f,
+	0x72, 0x6b, 0x65, 0x72, 0x50, 0x6f, 0x6f, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x12,
+	0x25, 0x0a, 0x0e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x57,
+	0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f,
+	0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x71, 0x75, 0x65,
+	0x75, 0x65, 0x44, 0x65, 0x70, 0x74, 0x68, 0x12, 0x25, 0x0a, 0x0e, 0x71, 0x75, 0x65, 0x75, 0x65,
+	0x5f, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0d, 0x71, 0x75, 0x65, 0x75, 0x65, 0x43, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x12, 0x27,
+	0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x61, 0x73, 0x6b,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74,
+	0x65, 0x64, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x61, 0x69, 0x6c, 0x65,
+	0x64, 0x5f, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x66,
+	0x61, 0x69, 0x6c, 0x65, 0x64, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61,
+	0x6e, 0x69, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0d, 0x70, 0x61, 0x6e, 0x69, 0x63, 0x6b, 0x65, 0x64, 0x54, 0x61, 0x73, 0x6b,
+	0x73, 0x12, 0x27, 0x0a, 0x10, 0x61, 0x76, 0x67, 0x5f, 0x77, 0x61, 0x69, 0x74, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x61, 0x76, 0x67,
+	0x57, 0x61, 0x69, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65,
+	0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0d, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x54, 0x61, 0x73, 0x6b,
+	0x73, 0x22, 0xe7, 0x02, 0x0a, 0x03, 0x4a, 0x6f, 0x62, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x75, 0x6e,
+	0x6e, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x75, 0x6e, 0x6e,
+	0x69, 0x6e, 0x67, 0x12, 0x23, 0x0a, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x72, 0x75, 0x6e, 0x5f,
+	0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x01, 0x52, 0x09, 0x6c,
+	0x61, 0x73, 0x74, 0x52, 0x75, 0x6e, 0x41, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x6c, 0x61, 0x73, 0x74,
+	0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x4d, 0x73, 0x12, 0x26, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6f, 0x75, 0x74, 0x63, 0x6f,
+	0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x01, 0x52, 0x0b, 0x6c,
+	0x61, 0x73, 0x74, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0a, 0x6c, 0x61,
+	0x73, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03,
+	0xe0, 0x41, 0x01, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x23,
+	0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x72, 0x75, 0x6e, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x01, 0x52, 0x09, 0x6e, 0x65, 0x78, 0x74, 0x52, 0x75,
+	0x6e, 0x41, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x75, 0x6e, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x04, 0x72, 0x75, 0x6e, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x61, 0x69, 0x6c, 0x75,
+	0x72, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x66, 0x61, 0x69, 0x6c, 0x75,
+	0x72, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x75, 0x6d, 0x6d,
+	0x61, 0x72, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x01, 0x52, 0x0b,
+	0x6c, 0x61, 0x73, 0x74, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x22, 0x4b, 0x0a, 0x10, 0x4c,
+	0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x1f, 0x0a, 0x04, 0x6a, 0x6f, 0x62, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x6f, 0x62, 0x52, 0x04, 0x6a, 0x6f, 0x62, 0x73,
+	0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x22, 0x33, 0x0a, 0x11, 0x54, 0x72, 0x69, 0x67,
+	0x67, 0x65, 0x72, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xe0, 0x41, 0x02,
+	0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x33, 0x0a,
+	0x12, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x03, 0x6a, 0x6f, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x6f, 0x62, 0x52, 0x03, 0x6a,
+	0x6f, 0x62, 0x22, 0xe2, 0x01, 0x0a, 0x0a, 0x43, 0x61, 0x63, 0x68, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x69, 0x74, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x04, 0x68, 0x69, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x69, 0x73,
+	0x73, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6d, 0x69, 0x73, 0x73, 0x65,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x61,
+	0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x12,
+	0x1f, 0x0a, 0x0b, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6c, 0x6f, 0x61, 0x64, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73,
+	0x12, 0x27, 0x0a, 0x10, 0x61, 0x76, 0x67, 0x5f, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x5f, 0x6d, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x61, 0x76, 0x67, 0x4c,
+	0x6f, 0x61, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x76, 0x69,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x65, 0x76,
+	0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x43, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x43, 0x61,
+	0x63, 0x68, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x2a, 0x0a, 0x06, 0x63, 0x61, 0x63, 0x68, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x63, 0x68, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x52, 0x06, 0x63, 0x61, 0x63, 0x68, 0x65, 0x73, 0x22, 0x58, 0x0a, 0x16,
+	0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x43, 0x61, 0x63, 0x68, 0x65, 0x4b, 0x65, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x05, 0x63, 0x61, 0x63, 0x68, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xe0, 0x41, 0x02, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10,
+	0x01, 0x52, 0x05, 0x63, 0x61, 0x63, 0x68, 0x65, 0x12, 0x1c, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xe0, 0x41, 0x02, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10,
+	0x01, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0xb1, 0x01, 0x0a, 0x17, 0x49, 0x6e, 0x73, 0x70, 0x65,
+	0x63, 0x74, 0x43, 0x61, 0x63, 0x68, 0x65, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x19, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12,
+	0x1b, 0x0a, 0x09, 0x6e, 0x6f, 0x74, 0x5f, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x08, 0x6e, 0x6f, 0x74, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x22, 0x0a, 0x0a,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x03, 0xe0, 0x41, 0x01, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74,
+	0x12, 0x28, 0x0a, 0x10, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x6c, 0x6f, 0x61, 0x64,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x22, 0x6c, 0x0a, 0x16, 0x49, 0x6e,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x05, 0x63, 0x61, 0x63, 0x68, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x0a, 0xe0, 0x41, 0x02, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52,
+	0x05, 0x63, 0x61, 0x63, 0x68, 0x65, 0x12, 0x17, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x01, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x12,
+	0x17, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x42, 0x03, 0xe0,
+	0x41, 0x01, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x22, 0x2d, 0x0a, 0x17, 0x49, 0x6e, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x22, 0x35, 0x0a, 0x11, 0x46, 0x6c, 0x75, 0x73, 0x68,
+	0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x05,
+	0x63, 0x61, 0x63, 0x68, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xe0, 0x41, 0x02,
+	0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x05, 0x63, 0x61, 0x63, 0x68, 0x65, 0x22, 0x37,
+	0x0a, 0x12, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f,
+	0x6b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x64, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x64, 0x4b, 0x65, 0x79, 0x73, 0x2a, 0x61, 0x0a, 0x0b, 0x49, 0x73, 0x73, 0x75, 0x65,
+	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x18, 0x49, 0x53, 0x53, 0x55, 0x45, 0x5f,
+	0x46, 0x49, 0x4c, 0x54, 0x45, 0x52, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x49, 0x53, 0x53, 0x55, 0x45, 0x5f, 0x46, 0x49,
+	0x4c, 0x54, 0x45, 0x52, 0x5f, 0x49, 0x53, 0x53, 0x55, 0x45, 0x44, 0x10, 0x01, 0x12, 0x1b, 0x0a,
+	0x17, 0x49, 0x53, 0x53, 0x55, 0x45, 0x5f, 0x46, 0x49, 0x4c, 0x54, 0x45, 0x52, 0x5f, 0x4e, 0x4f,
+	0x54, 0x5f, 0x49, 0x53, 0x53, 0x55, 0x45, 0x44, 0x10, 0x02, 0x32, 0xe5, 0x2a, 0x0a, 0x0a, 0x41,
+	0x50, 0x49, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x56, 0x0a, 0x0a, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x11,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0b, 0x3a, 0x01, 0x2a, 0x22, 0x06, 0x2f, 0x75, 0x73, 0x65, 0x72,
+	0x73, 0x12, 0x54, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x12, 0x16, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x18, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x12, 0x12, 0x10, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x52, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x41, 0x6c,
+	0x6c, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1b,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x55, 0x73,
+	0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0e, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x08, 0x12, 0x06, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x12, 0x60, 0x0a, 0x0a, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x3a, 0x01, 0x2a, 0x1a, 0x10, 0x2f, 0x75, 0x73,
+	0x65, 0x72, 0x73, 0x2f, 0x7b, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x59, 0x0a,
+	0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x19, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x18,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x2a, 0x10, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x7b,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x65, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x12, 0x15, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x73,
+	0x2f, 0x7b, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x73, 0x0a, 0x0f, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x45, 0x78, 0x69, 0x73,
+	0x74, 0x73, 0x12, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x63,
+	0x6b, 0x55, 0x73, 0x65, 0x72, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x63,
+	0x6b, 0x55, 0x73, 0x65, 0x72, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x12, 0x17, 0x2f, 0x75, 0x73,
+	0x65, 0x72, 0x73, 0x2f, 0x7b, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x65, 0x78,
+	0x69, 0x73, 0x74, 0x73, 0x12, 0x5a, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x12, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x12, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x0c, 0x3a, 0x01, 0x2a, 0x22, 0x07, 0x2f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73,
+	0x12, 0x59, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x17, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12, 0x12, 0x2f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73,
+	0x2f, 0x7b, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x55, 0x0a, 0x0c, 0x47,
+	0x65, 0x74, 0x41, 0x6c, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x1a, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x41, 0x6c, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x0f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x09, 0x12, 0x07, 0x2f, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x73, 0x12, 0x65, 0x0a, 0x0b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x12, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x17, 0x3a, 0x01, 0x2a, 0x1a, 0x12, 0x2f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x2f, 0x7b,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x5d, 0x0a, 0x0b, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x1a, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x14, 0x2a, 0x12, 0x2f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x79, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x73, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x20, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73,
+	0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x73, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x12, 0x17, 0x2f, 0x75, 0x73, 0x65,
+	0x72, 0x73, 0x2f, 0x7b, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x73, 0x12, 0x59, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x73, 0x12, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x14, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0e,
+	0x12, 0x0c, 0x2f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x3a, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x64,
+	0x0a, 0x0a, 0x49, 0x73, 0x73, 0x75, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x19, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x73, 0x73, 0x75, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72,
 	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
-	0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x2a, 0x12, 0x2f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73,
-	0x2f, 0x7b, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x79, 0x0a, 0x11, 0x47,
-	0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44,
-	0x12, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x64,
-	0x65, 0x72, 0x73, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4f,
-	0x72, 0x64, 0x65, 0x72, 0x73, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x12, 0x17, 0x2f,
-	0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x2f,
-	0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x12, 0x64, 0x0a, 0x0a, 0x49, 0x73, 0x73, 0x75, 0x65, 0x4f,
-	0x72, 0x64, 0x65, 0x72, 0x12, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x73,
-	0x73, 0x75, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x3a,
-	0x01, 0x2a, 0x22, 0x18, 0x2f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x6f, 0x72, 0x64,
-	0x65, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x69, 0x73, 0x73, 0x75, 0x65, 0x12, 0x58, 0x0a, 0x0a,
-	0x53, 0x65, 0x65, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x12, 0x19, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x65, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x17, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x11, 0x3a, 0x01, 0x2a, 0x22, 0x0c, 0x2f, 0x6f, 0x72, 0x64, 0x65, 0x72,
-	0x73, 0x2f, 0x73, 0x65, 0x65, 0x64, 0x12, 0x69, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x3a, 0x01, 0x2a, 0x22, 0x18, 0x2f, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x73, 0x2f, 0x7b, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x69,
+	0x73, 0x73, 0x75, 0x65, 0x12, 0x58, 0x0a, 0x0a, 0x53, 0x65, 0x65, 0x64, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x73, 0x12, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x65, 0x64,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x3a, 0x01, 0x2a,
+	0x22, 0x0c, 0x2f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x2f, 0x73, 0x65, 0x65, 0x64, 0x12, 0x76,
+	0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x12, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x12, 0x1a, 0x2f, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x73, 0x2f, 0x7b, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x68,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x69, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
 	0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x12, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e,
 	0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x69,
 	0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e,
@@ -4695,150 +6338,207 @@ var file_order_service_proto_rawDesc = []byte{
 	0x65, 0x74, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x49,
 	0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02,
 	0x1a, 0x12, 0x18, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x75, 0x73, 0x65, 0x72, 0x5f,
-	0x69, 0x64, 0x7d, 0x2f, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x12, 0x6a, 0x0a, 0x0c, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x12, 0x1b, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x74, 0x75, 0x72,
-	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
-	0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x3a, 0x01,
-	0x2a, 0x1a, 0x14, 0x2f, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x2f, 0x7b, 0x72, 0x65, 0x74,
-	0x75, 0x72, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x61, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x12, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
-	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x1c, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x16, 0x2a, 0x14, 0x2f, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x2f, 0x7b,
-	0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x62, 0x0a, 0x0d, 0x50, 0x72,
-	0x6f, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x12, 0x1c, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x74, 0x75,
-	0x72, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x3a, 0x01, 0x2a, 0x22, 0x10, 0x2f, 0x72,
-	0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x2f, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x12, 0x77,
-	0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x52, 0x65,
-	0x61, 0x73, 0x6f, 0x6e, 0x12, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
-	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x52, 0x65, 0x61,
-	0x73, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1a, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x14, 0x3a, 0x01, 0x2a, 0x22, 0x0f, 0x2f, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x2d,
-	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x12, 0x77, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x52, 0x65,
-	0x74, 0x75, 0x72, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1e, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x52, 0x65, 0x61,
-	0x73, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x52, 0x65, 0x61,
-	0x73, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x2d, 0x72, 0x65, 0x61,
-	0x73, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d,
-	0x12, 0x6b, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e,
-	0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
-	0x23, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x52,
-	0x65, 0x74, 0x75, 0x72, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x12, 0x0f, 0x2f, 0x72,
-	0x65, 0x74, 0x75, 0x72, 0x6e, 0x2d, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x12, 0x83, 0x01,
-	0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x52, 0x65,
-	0x61, 0x73, 0x6f, 0x6e, 0x12, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
-	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x52, 0x65, 0x61,
-	0x73, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x20, 0x3a, 0x01, 0x2a, 0x1a, 0x1b, 0x2f, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x2d,
-	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x5f,
-	0x69, 0x64, 0x7d, 0x12, 0x74, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x74,
-	0x75, 0x72, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x52,
-	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x2a, 0x1b, 0x2f, 0x72,
-	0x65, 0x74, 0x75, 0x72, 0x6e, 0x2d, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x72,
-	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x96, 0x01, 0x0a, 0x17, 0x43, 0x68,
+	0x69, 0x64, 0x7d, 0x2f, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x12, 0x5d, 0x0a, 0x0b, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x12, 0x1a, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x15, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0f, 0x12, 0x0d, 0x2f, 0x72, 0x65,
+	0x74, 0x75, 0x72, 0x6e, 0x73, 0x3a, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x6a, 0x0a, 0x0c, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x12, 0x1b, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x3a, 0x01, 0x2a,
+	0x1a, 0x14, 0x2f, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x2f, 0x7b, 0x72, 0x65, 0x74, 0x75,
+	0x72, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x61, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x12, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x1c, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x16, 0x2a, 0x14, 0x2f, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x2f, 0x7b, 0x72,
+	0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x62, 0x0a, 0x0d, 0x50, 0x72, 0x6f,
+	0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x12, 0x1c, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x74, 0x75, 0x72,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x3a, 0x01, 0x2a, 0x22, 0x10, 0x2f, 0x72, 0x65,
+	0x74, 0x75, 0x72, 0x6e, 0x73, 0x2f, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x12, 0x77, 0x0a,
+	0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x52, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x12, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x52, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x14, 0x3a, 0x01, 0x2a, 0x22, 0x0f, 0x2f, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x2d, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x12, 0x77, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x52, 0x65, 0x74,
+	0x75, 0x72, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x52, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x52, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x2d, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x12,
+	0x6b, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x52,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x23,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x52, 0x65,
+	0x74, 0x75, 0x72, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x12, 0x0f, 0x2f, 0x72, 0x65,
+	0x74, 0x75, 0x72, 0x6e, 0x2d, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x12, 0x83, 0x01, 0x0a,
+	0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x52, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x12, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x52, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x20, 0x3a, 0x01, 0x2a, 0x1a, 0x1b, 0x2f, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x2d, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x7d, 0x12, 0x74, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x74, 0x75,
+	0x72, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x52, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x2a, 0x1b, 0x2f, 0x72, 0x65,
+	0x74, 0x75, 0x72, 0x6e, 0x2d, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x96, 0x01, 0x0a, 0x17, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x45, 0x78,
+	0x69, 0x73, 0x74, 0x73, 0x12, 0x26, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68,
 	0x65, 0x63, 0x6b, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x45,
-	0x78, 0x69, 0x73, 0x74, 0x73, 0x12, 0x26, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43,
-	0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e,
-	0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x74, 0x75,
-	0x72, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x12, 0x22,
-	0x2f, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x2d, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x2f,
-	0x7b, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x65, 0x78, 0x69, 0x73,
-	0x74, 0x73, 0x12, 0x5f, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x12, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x14, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x0e, 0x3a, 0x01, 0x2a, 0x22, 0x09, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x65, 0x73, 0x12, 0x6b, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x42, 0x79, 0x49, 0x44, 0x12, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
-	0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x12, 0x15, 0x2f, 0x73, 0x74, 0x61, 0x74,
+	0x78, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x74, 0x75, 0x72,
+	0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x12, 0x22, 0x2f,
+	0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x2d, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x2f, 0x7b,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x65, 0x78, 0x69, 0x73, 0x74,
+	0x73, 0x12, 0x5f, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x14, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x0e, 0x3a, 0x01, 0x2a, 0x22, 0x09, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x65, 0x73, 0x12, 0x6b, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42,
+	0x79, 0x49, 0x44, 0x12, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x12, 0x15, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x65, 0x73, 0x2f, 0x7b, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x69, 0x64, 0x7d, 0x12,
+	0x5b, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65,
+	0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x11, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x0b, 0x12, 0x09, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x12, 0x6b, 0x0a, 0x0c,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1b, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x3a,
+	0x01, 0x2a, 0x1a, 0x15, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x2f, 0x7b, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x62, 0x0a, 0x0c, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x1d,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x2a, 0x15, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65,
+	0x73, 0x2f, 0x7b, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x78, 0x0a,
+	0x0f, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x12, 0x1c, 0x2f, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x65, 0x73, 0x2f, 0x6e, 0x61, 0x6d, 0x65, 0x2f, 0x7b, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x12, 0x7e, 0x0a, 0x11, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x12, 0x20, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x12, 0x1c, 0x2f, 0x73, 0x74, 0x61, 0x74,
 	0x75, 0x73, 0x65, 0x73, 0x2f, 0x7b, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x69, 0x64, 0x7d,
-	0x12, 0x5b, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x65, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1e, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x11, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x0b, 0x12, 0x09, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x12, 0x6b, 0x0a,
-	0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1b, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a,
-	0x3a, 0x01, 0x2a, 0x1a, 0x15, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x2f, 0x7b,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x62, 0x0a, 0x0c, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1b, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
-	0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x2a, 0x15, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x65, 0x73, 0x2f, 0x7b, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x78,
-	0x0a, 0x0f, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x79, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x12, 0x1c, 0x2f, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x65, 0x73, 0x2f, 0x6e, 0x61, 0x6d, 0x65, 0x2f, 0x7b, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x12, 0x7e, 0x0a, 0x11, 0x43, 0x68, 0x65, 0x63,
-	0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x12, 0x20, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x12, 0x1c, 0x2f, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x65, 0x73, 0x2f, 0x7b, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x69, 0x64,
-	0x7d, 0x2f, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x12, 0x62, 0x0a, 0x0e, 0x53, 0x65, 0x74, 0x57,
-	0x6f, 0x72, 0x6b, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x43, 0x6f, 0x75,
-	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x2f, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x12, 0x62, 0x0a, 0x0e, 0x53, 0x65, 0x74, 0x57, 0x6f,
+	0x72, 0x6b, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x3a, 0x01, 0x2a, 0x22, 0x0e, 0x2f, 0x77, 0x6f,
+	0x72, 0x6b, 0x65, 0x72, 0x73, 0x2f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x68, 0x0a, 0x12, 0x47,
+	0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x50, 0x6f, 0x6f, 0x6c, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x50, 0x6f, 0x6f, 0x6c,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x16, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x10, 0x12, 0x0e, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x2f,
+	0x73, 0x74, 0x61, 0x74, 0x73, 0x12, 0x4b, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62,
+	0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x0d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x07, 0x12, 0x05, 0x2f, 0x6a, 0x6f,
+	0x62, 0x73, 0x12, 0x64, 0x0a, 0x0a, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x4a, 0x6f, 0x62,
+	0x12, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65,
+	0x72, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x4a, 0x6f, 0x62, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x3a,
+	0x01, 0x2a, 0x22, 0x14, 0x2f, 0x6a, 0x6f, 0x62, 0x73, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d,
+	0x2f, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x12, 0x5c, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x43,
+	0x61, 0x63, 0x68, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
 	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x3a, 0x01, 0x2a, 0x22, 0x0e, 0x2f, 0x77,
-	0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x2f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0xe9, 0x02, 0x92,
-	0x41, 0xd4, 0x02, 0x12, 0xcd, 0x01, 0x0a, 0x13, 0x55, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x20,
-	0x41, 0x50, 0x49, 0x20, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0xae, 0x01, 0xd0, 0x9e,
-	0xd0, 0xb1, 0xd1, 0x8a, 0xd0, 0xb5, 0xd0, 0xb4, 0xd0, 0xb8, 0xd0, 0xbd, 0xd0, 0xb5, 0xd0, 0xbd,
-	0xd0, 0xbd, 0xd1, 0x8b, 0xd0, 0xb9, 0x20, 0xd1, 0x81, 0xd0, 0xb5, 0xd1, 0x80, 0xd0, 0xb2, 0xd0,
-	0xb8, 0xd1, 0x81, 0x20, 0xd0, 0xb4, 0xd0, 0xbb, 0xd1, 0x8f, 0x20, 0xd1, 0x80, 0xd0, 0xb0, 0xd0,
-	0xb1, 0xd0, 0xbe, 0xd1, 0x82, 0xd1, 0x8b, 0x20, 0xd1, 0x81, 0x20, 0xd0, 0xbf, 0xd0, 0xbe, 0xd0,
-	0xbb, 0xd1, 0x8c, 0xd0, 0xb7, 0xd0, 0xbe, 0xd0, 0xb2, 0xd0, 0xb0, 0xd1, 0x82, 0xd0, 0xb5, 0xd0,
-	0xbb, 0xd1, 0x8f, 0xd0, 0xbc, 0xd0, 0xb8, 0x2c, 0x20, 0xd0, 0xb7, 0xd0, 0xb0, 0xd0, 0xba, 0xd0,
-	0xb0, 0xd0, 0xb7, 0xd0, 0xb0, 0xd0, 0xbc, 0xd0, 0xb8, 0x2c, 0x20, 0xd0, 0xb2, 0xd0, 0xbe, 0xd0,
-	0xb7, 0xd0, 0xb2, 0xd1, 0x80, 0xd0, 0xb0, 0xd1, 0x82, 0xd0, 0xb0, 0xd0, 0xbc, 0xd0, 0xb8, 0x2c,
-	0x20, 0xd1, 0x83, 0xd0, 0xbf, 0xd0, 0xb0, 0xd0, 0xba, 0xd0, 0xbe, 0xd0, 0xb2, 0xd0, 0xba, 0xd0,
-	0xb0, 0xd0, 0xbc, 0xd0, 0xb8, 0x20, 0xd0, 0xb8, 0x20, 0xd0, 0xb2, 0xd0, 0xbe, 0xd1, 0x80, 0xd0,
-	0xba, 0xd0, 0xb5, 0xd1, 0x80, 0xd0, 0xb0, 0xd0, 0xbc, 0xd0, 0xb8, 0x2e, 0x32, 0x05, 0x31, 0x2e,
-	0x30, 0x2e, 0x30, 0x1a, 0x0e, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x68, 0x6f, 0x73, 0x74, 0x3a, 0x37,
-	0x30, 0x30, 0x30, 0x2a, 0x01, 0x01, 0x32, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x5a, 0x4b, 0x0a, 0x49, 0x0a, 0x09,
-	0x61, 0x70, 0x69, 0x2d, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x3c, 0x08, 0x02, 0x12, 0x29, 0xd0,
-	0x90, 0xd0, 0xb2, 0xd1, 0x82, 0xd0, 0xbe, 0xd1, 0x80, 0xd0, 0xb8, 0xd0, 0xb7, 0xd0, 0xb0, 0xd1,
-	0x86, 0xd0, 0xb8, 0xd0, 0xbe, 0xd0, 0xbd, 0xd0, 0xbd, 0xd1, 0x8b, 0xd0, 0xb9, 0x20, 0xd1, 0x82,
-	0xd0, 0xbe, 0xd0, 0xba, 0xd0, 0xb5, 0xd0, 0xbd, 0x1a, 0x0b, 0x78, 0x2d, 0x61, 0x70, 0x69, 0x2d,
-	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x20, 0x02, 0x5a, 0x0f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
-	0x6c, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x79, 0x1a, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61,
+	0x63, 0x68, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x14, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0e, 0x12, 0x0c, 0x2f, 0x63, 0x61, 0x63, 0x68, 0x65,
+	0x2f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x12, 0x75, 0x0a, 0x0f, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63,
+	0x74, 0x43, 0x61, 0x63, 0x68, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x43, 0x61, 0x63, 0x68, 0x65, 0x4b,
+	0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x43, 0x61, 0x63, 0x68, 0x65, 0x4b,
+	0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x1b, 0x12, 0x19, 0x2f, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2f, 0x7b, 0x63, 0x61, 0x63, 0x68,
+	0x65, 0x7d, 0x2f, 0x6b, 0x65, 0x79, 0x73, 0x2f, 0x7b, 0x6b, 0x65, 0x79, 0x7d, 0x12, 0x78, 0x0a,
+	0x0f, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x43, 0x61, 0x63, 0x68, 0x65,
+	0x12, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x65, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x65, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x3a, 0x01, 0x2a, 0x22, 0x19, 0x2f, 0x63,
+	0x61, 0x63, 0x68, 0x65, 0x2f, 0x7b, 0x63, 0x61, 0x63, 0x68, 0x65, 0x7d, 0x2f, 0x69, 0x6e, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x12, 0x64, 0x0a, 0x0a, 0x46, 0x6c, 0x75, 0x73, 0x68,
+	0x43, 0x61, 0x63, 0x68, 0x65, 0x12, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x46,
+	0x6c, 0x75, 0x73, 0x68, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x43,
+	0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x19, 0x3a, 0x01, 0x2a, 0x22, 0x14, 0x2f, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2f,
+	0x7b, 0x63, 0x61, 0x63, 0x68, 0x65, 0x7d, 0x2f, 0x66, 0x6c, 0x75, 0x73, 0x68, 0x12, 0x53, 0x0a,
+	0x09, 0x57, 0x61, 0x72, 0x6d, 0x43, 0x61, 0x63, 0x68, 0x65, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x16, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x10, 0x3a, 0x01, 0x2a, 0x22, 0x0b, 0x2f, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2f, 0x77, 0x61,
+	0x72, 0x6d, 0x42, 0xe9, 0x02, 0x92, 0x41, 0xd4, 0x02, 0x12, 0xcd, 0x01, 0x0a, 0x13, 0x55, 0x6e,
+	0x69, 0x66, 0x69, 0x65, 0x64, 0x20, 0x41, 0x50, 0x49, 0x20, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0xae, 0x01, 0xd0, 0x9e, 0xd0, 0xb1, 0xd1, 0x8a, 0xd0, 0xb5, 0xd0, 0xb4, 0xd0, 0xb8,
+	0xd0, 0xbd, 0xd0, 0xb5, 0xd0, 0xbd, 0xd0, 0xbd, 0xd1, 0x8b, 0xd0, 0xb9, 0x20, 0xd1, 0x81, 0xd0,
+	0xb5, 0xd1, 0x80, 0xd0, 0xb2, 0xd0, 0xb8, 0xd1, 0x81, 0x20, 0xd0, 0xb4, 0xd0, 0xbb, 0xd1, 0x8f,
+	0x20, 0xd1, 0x80, 0xd0, 0xb0, 0xd0, 0xb1, 0xd0, 0xbe, 0xd1, 0x82, 0xd1, 0x8b, 0x20, 0xd1, 0x81,
+	0x20, 0xd0, 0xbf, 0xd0, 0xbe, 0xd0, 0xbb, 0xd1, 0x8c, 0xd0, 0xb7, 0xd0, 0xbe, 0xd0, 0xb2, 0xd0,
+	0xb0, 0xd1, 0x82, 0xd0, 0xb5, 0xd0, 0xbb, 0xd1, 0x8f, 0xd0, 0xbc, 0xd0, 0xb8, 0x2c, 0x20, 0xd0,
+	0xb7, 0xd0, 0xb0, 0xd0, 0xba, 0xd0, 0xb0, 0xd0, 0xb7, 0xd0, 0xb0, 0xd0, 0xbc, 0xd0, 0xb8, 0x2c,
+	0x20, 0xd0, 0xb2, 0xd0, 0xbe, 0xd0, 0xb7, 0xd0, 0xb2, 0xd1, 0x80, 0xd0, 0xb0, 0xd1, 0x82, 0xd0,
+	0xb0, 0xd0, 0xbc, 0xd0, 0xb8, 0x2c, 0x20, 0xd1, 0x83, 0xd0, 0xbf, 0xd0, 0xb0, 0xd0, 0xba, 0xd0,
+	0xbe, 0xd0, 0xb2, 0xd0, 0xba, 0xd0, 0xb0, 0xd0, 0xbc, 0xd0, 0xb8, 0x20, 0xd0, 0xb8, 0x20, 0xd0,
+	0xb2, 0xd0, 0xbe, 0xd1, 0x80, 0xd0, 0xba, 0xd0, 0xb5, 0xd1, 0x80, 0xd0, 0xb0, 0xd0, 0xbc, 0xd0,
+	0xb8, 0x2e, 0x32, 0x05, 0x31, 0x2e, 0x30, 0x2e, 0x30, 0x1a, 0x0e, 0x6c, 0x6f, 0x63, 0x61, 0x6c,
+	0x68, 0x6f, 0x73, 0x74, 0x3a, 0x37, 0x30, 0x30, 0x30, 0x2a, 0x01, 0x01, 0x32, 0x10, 0x61, 0x70,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x10,
+	0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e,
+	0x5a, 0x4b, 0x0a, 0x49, 0x0a, 0x09, 0x61, 0x70, 0x69, 0x2d, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x3c, 0x08, 0x02, 0x12, 0x29, 0xd0, 0x90, 0xd0, 0xb2, 0xd1, 0x82, 0xd0, 0xbe, 0xd1, 0x80, 0xd0,
+	0xb8, 0xd0, 0xb7, 0xd0, 0xb0, 0xd1, 0x86, 0xd0, 0xb8, 0xd0, 0xbe, 0xd0, 0xbd, 0xd0, 0xbd, 0xd1,
+	0x8b, 0xd0, 0xb9, 0x20, 0xd1, 0x82, 0xd0, 0xbe, 0xd0, 0xba, 0xd0, 0xb5, 0xd0, 0xbd, 0x1a, 0x0b,
+	0x78, 0x2d, 0x61, 0x70, 0x69, 0x2d, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x20, 0x02, 0x5a, 0x0f, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -4853,185 +6553,236 @@ func file_order_service_proto_rawDescGZIP() []byte {
 	return file_order_service_proto_rawDescData
 }
 
-var file_order_service_proto_msgTypes = make([]protoimpl.MessageInfo, 78)
+var file_order_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_order_service_proto_msgTypes = make([]protoimpl.MessageInfo, 98)
 var file_order_service_proto_goTypes = []any{
-	(*CreateUserRequest)(nil),               // 0: api.v1.CreateUserRequest
-	(*CreateUserResponse)(nil),              // 1: api.v1.CreateUserResponse
-	(*GetUserRequest)(nil),                  // 2: api.v1.GetUserRequest
-	(*GetUserResponse)(nil),                 // 3: api.v1.GetUserResponse
-	(*GetAllUsersResponse)(nil),             // 4: api.v1.GetAllUsersResponse
-	(*User)(nil),                            // 5: api.v1.User
-	(*UpdateUserRequest)(nil),               // 6: api.v1.UpdateUserRequest
-	(*UpdateUserResponse)(nil),              // 7: api.v1.UpdateUserResponse
-	(*DeleteUserRequest)(nil),               // 8: api.v1.DeleteUserRequest
-	(*GetUserNameRequest)(nil),              // 9: api.v1.GetUserNameRequest
-	(*GetUserNameResponse)(nil),             // 10: api.v1.GetUserNameResponse
-	(*CheckUserExistsRequest)(nil),          // 11: api.v1.CheckUserExistsRequest
-	(*CheckUserExistsResponse)(nil),         // 12: api.v1.CheckUserExistsResponse
-	(*CreateOrderRequest)(nil),              // 13: api.v1.CreateOrderRequest
-	(*CreateOrderResponse)(nil),             // 14: api.v1.CreateOrderResponse
-	(*GetOrderRequest)(nil),                 // 15: api.v1.GetOrderRequest
-	(*GetOrderResponse)(nil),                // 16: api.v1.GetOrderResponse
-	(*GetAllOrdersResponse)(nil),            // 17: api.v1.GetAllOrdersResponse
-	(*UpdateOrderRequest)(nil),              // 18: api.v1.UpdateOrderRequest
-	(*UpdateOrderResponse)(nil),             // 19: api.v1.UpdateOrderResponse
-	(*DeleteOrderRequest)(nil),              // 20: api.v1.DeleteOrderRequest
-	(*GetOrdersByUserIDRequest)(nil),        // 21: api.v1.GetOrdersByUserIDRequest
-	(*GetOrdersByUserIDResponse)(nil),       // 22: api.v1.GetOrdersByUserIDResponse
-	(*SeedOrdersRequest)(nil),               // 23: api.v1.SeedOrdersRequest
-	(*SeedOrdersResponse)(nil),              // 24: api.v1.SeedOrdersResponse
-	(*IssueOrderRequest)(nil),               // 25: api.v1.IssueOrderRequest
-	(*IssueOrderResponse)(nil),              // 26: api.v1.IssueOrderResponse
-	(*CreatePackagingRequest)(nil),          // 27: api.v1.CreatePackagingRequest
-	(*CreatePackagingResponse)(nil),         // 28: api.v1.CreatePackagingResponse
-	(*GetPackagingRequest)(nil),             // 29: api.v1.GetPackagingRequest
-	(*GetPackagingResponse)(nil),            // 30: api.v1.GetPackagingResponse
-	(*GetAllPackagingResponse)(nil),         // 31: api.v1.GetAllPackagingResponse
-	(*Packaging)(nil),                       // 32: api.v1.Packaging
-	(*UpdatePackagingRequest)(nil),          // 33: api.v1.UpdatePackagingRequest
-	(*UpdatePackagingResponse)(nil),         // 34: api.v1.UpdatePackagingResponse
-	(*DeletePackagingRequest)(nil),          // 35: api.v1.DeletePackagingRequest
-	(*DeletePackagingResponse)(nil),         // 36: api.v1.DeletePackagingResponse
-	(*CreateReturnRequest)(nil),             // 37: api.v1.CreateReturnRequest
-	(*CreateReturnResponse)(nil),            // 38: api.v1.CreateReturnResponse
-	(*GetReturnsResponse)(nil),              // 39: api.v1.GetReturnsResponse
-	(*GetReturnByOrderIDRequest)(nil),       // 40: api.v1.GetReturnByOrderIDRequest
-	(*GetReturnByOrderIDResponse)(nil),      // 41: api.v1.GetReturnByOrderIDResponse
-	(*GetReturnsByUserIDRequest)(nil),       // 42: api.v1.GetReturnsByUserIDRequest
-	(*GetReturnsByUserIDResponse)(nil),      // 43: api.v1.GetReturnsByUserIDResponse
-	(*UpdateReturnRequest)(nil),             // 44: api.v1.UpdateReturnRequest
-	(*UpdateReturnResponse)(nil),            // 45: api.v1.UpdateReturnResponse
-	(*DeleteReturnRequest)(nil),             // 46: api.v1.DeleteReturnRequest
-	(*DeleteReturnResponse)(nil),            // 47: api.v1.DeleteReturnResponse
-	(*ReturnResponse)(nil),                  // 48: api.v1.ReturnResponse
-	(*ProcessReturnRequest)(nil),            // 49: api.v1.ProcessReturnRequest
-	(*ProcessReturnResponse)(nil),           // 50: api.v1.ProcessReturnResponse
-	(*CreateReturnReasonRequest)(nil),       // 51: api.v1.CreateReturnReasonRequest
-	(*CreateReturnReasonResponse)(nil),      // 52: api.v1.CreateReturnReasonResponse
-	(*GetReturnReasonRequest)(nil),          // 53: api.v1.GetReturnReasonRequest
-	(*GetReturnReasonResponse)(nil),         // 54: api.v1.GetReturnReasonResponse
-	(*GetAllReturnReasonsResponse)(nil),     // 55: api.v1.GetAllReturnReasonsResponse
-	(*UpdateReturnReasonRequest)(nil),       // 56: api.v1.UpdateReturnReasonRequest
-	(*UpdateReturnReasonResponse)(nil),      // 57: api.v1.UpdateReturnReasonResponse
-	(*DeleteReturnReasonRequest)(nil),       // 58: api.v1.DeleteReturnReasonRequest
-	(*DeleteReturnReasonResponse)(nil),      // 59: api.v1.DeleteReturnReasonResponse
-	(*CheckReturnReasonExistsRequest)(nil),  // 60: api.v1.CheckReturnReasonExistsRequest
-	(*CheckReturnReasonExistsResponse)(nil), // 61: api.v1.CheckReturnReasonExistsResponse
-	(*CreateStatusRequest)(nil),             // 62: api.v1.CreateStatusRequest
-	(*CreateStatusResponse)(nil),            // 63: api.v1.CreateStatusResponse
-	(*GetStatusByIDRequest)(nil),            // 64: api.v1.GetStatusByIDRequest
-	(*GetStatusByIDResponse)(nil),           // 65: api.v1.GetStatusByIDResponse
-	(*GetAllStatusesRequest)(nil),           // 66: api.v1.GetAllStatusesRequest
-	(*GetAllStatusesResponse)(nil),          // 67: api.v1.GetAllStatusesResponse
-	(*UpdateStatusRequest)(nil),             // 68: api.v1.UpdateStatusRequest
-	(*UpdateStatusResponse)(nil),            // 69: api.v1.UpdateStatusResponse
-	(*DeleteStatusRequest)(nil),             // 70: api.v1.DeleteStatusRequest
-	(*DeleteStatusResponse)(nil),            // 71: api.v1.DeleteStatusResponse
-	(*GetStatusByNameRequest)(nil),          // 72: api.v1.GetStatusByNameRequest
-	(*GetStatusByNameResponse)(nil),         // 73: api.v1.GetStatusByNameResponse
-	(*CheckStatusExistsRequest)(nil),        // 74: api.v1.CheckStatusExistsRequest
-	(*CheckStatusExistsResponse)(nil),       // 75: api.v1.CheckStatusExistsResponse
-	(*SetWorkerCountRequest)(nil),           // 76: api.v1.SetWorkerCountRequest
-	(*SetWorkerCountResponse)(nil),          // 77: api.v1.SetWorkerCountResponse
-	(*emptypb.Empty)(nil),                   // 78: google.protobuf.Empty
+	(IssueFilter)(0),                        // 0: api.v1.IssueFilter
+	(*CreateUserRequest)(nil),               // 1: api.v1.CreateUserRequest
+	(*CreateUserResponse)(nil),              // 2: api.v1.CreateUserResponse
+	(*GetUserRequest)(nil),                  // 3: api.v1.GetUserRequest
+	(*GetUserResponse)(nil),                 // 4: api.v1.GetUserResponse
+	(*GetAllUsersResponse)(nil),             // 5: api.v1.GetAllUsersResponse
+	(*User)(nil),                            // 6: api.v1.User
+	(*UpdateUserRequest)(nil),               // 7: api.v1.UpdateUserRequest
+	(*UpdateUserResponse)(nil),              // 8: api.v1.UpdateUserResponse
+	(*DeleteUserRequest)(nil),               // 9: api.v1.DeleteUserRequest
+	(*GetUserNameRequest)(nil),              // 10: api.v1.GetUserNameRequest
+	(*GetUserNameResponse)(nil),             // 11: api.v1.GetUserNameResponse
+	(*CheckUserExistsRequest)(nil),          // 12: api.v1.CheckUserExistsRequest
+	(*CheckUserExistsResponse)(nil),         // 13: api.v1.CheckUserExistsResponse
+	(*CreateOrderRequest)(nil),              // 14: api.v1.CreateOrderRequest
+	(*CreateOrderResponse)(nil),             // 15: api.v1.CreateOrderResponse
+	(*GetOrderRequest)(nil),                 // 16: api.v1.GetOrderRequest
+	(*GetOrderResponse)(nil),                // 17: api.v1.GetOrderResponse
+	(*GetAllOrdersResponse)(nil),            // 18: api.v1.GetAllOrdersResponse
+	(*UpdateOrderRequest)(nil),              // 19: api.v1.UpdateOrderRequest
+	(*UpdateOrderResponse)(nil),             // 20: api.v1.UpdateOrderResponse
+	(*DeleteOrderRequest)(nil),              // 21: api.v1.DeleteOrderRequest
+	(*GetOrdersByUserIDRequest)(nil),        // 22: api.v1.GetOrdersByUserIDRequest
+	(*GetOrdersByUserIDResponse)(nil),       // 23: api.v1.GetOrdersByUserIDResponse
+	(*ListOrdersRequest)(nil),               // 24: api.v1.ListOrdersRequest
+	(*ListOrdersResponse)(nil),              // 25: api.v1.ListOrdersResponse
+	(*SeedOrdersRequest)(nil),               // 26: api.v1.SeedOrdersRequest
+	(*SeedOrdersResponse)(nil),              // 27: api.v1.SeedOrdersResponse
+	(*IssueOrderRequest)(nil),               // 28: api.v1.IssueOrderRequest
+	(*IssueOrderResponse)(nil),              // 29: api.v1.IssueOrderResponse
+	(*GetOrderHistoryRequest)(nil),          // 30: api.v1.GetOrderHistoryRequest
+	(*OrderStatusChange)(nil),               // 31: api.v1.OrderStatusChange
+	(*GetOrderHistoryResponse)(nil),         // 32: api.v1.GetOrderHistoryResponse
+	(*CreatePackagingRequest)(nil),          // 33: api.v1.CreatePackagingRequest
+	(*CreatePackagingResponse)(nil),         // 34: api.v1.CreatePackagingResponse
+	(*GetPackagingRequest)(nil),             // 35: api.v1.GetPackagingRequest
+	(*GetPackagingResponse)(nil),            // 36: api.v1.GetPackagingResponse
+	(*GetAllPackagingResponse)(nil),         // 37: api.v1.GetAllPackagingResponse
+	(*Packaging)(nil),                       // 38: api.v1.Packaging
+	(*UpdatePackagingRequest)(nil),          // 39: api.v1.UpdatePackagingRequest
+	(*UpdatePackagingResponse)(nil),         // 40: api.v1.UpdatePackagingResponse
+	(*DeletePackagingRequest)(nil),          // 41: api.v1.DeletePackagingRequest
+	(*DeletePackagingResponse)(nil),         // 42: api.v1.DeletePackagingResponse
+	(*CreateReturnRequest)(nil),             // 43: api.v1.CreateReturnRequest
+	(*CreateReturnResponse)(nil),            // 44: api.v1.CreateReturnResponse
+	(*GetReturnsResponse)(nil),              // 45: api.v1.GetReturnsResponse
+	(*GetReturnByOrderIDRequest)(nil),       // 46: api.v1.GetReturnByOrderIDRequest
+	(*GetReturnByOrderIDResponse)(nil),      // 47: api.v1.GetReturnByOrderIDResponse
+	(*GetReturnsByUserIDRequest)(nil),       // 48: api.v1.GetReturnsByUserIDRequest
+	(*GetReturnsByUserIDResponse)(nil),      // 49: api.v1.GetReturnsByUserIDResponse
+	(*ListReturnsRequest)(nil),              // 50: api.v1.ListReturnsRequest
+	(*ListReturnsResponse)(nil),             // 51: api.v1.ListReturnsResponse
+	(*UpdateReturnRequest)(nil),             // 52: api.v1.UpdateReturnRequest
+	(*UpdateReturnResponse)(nil),            // 53: api.v1.UpdateReturnResponse
+	(*DeleteReturnRequest)(nil),             // 54: api.v1.DeleteReturnRequest
+	(*DeleteReturnResponse)(nil),            // 55: api.v1.DeleteReturnResponse
+	(*ReturnResponse)(nil),                  // 56: api.v1.ReturnResponse
+	(*ProcessReturnRequest)(nil),            // 57: api.v1.ProcessReturnRequest
+	(*ProcessReturnResponse)(nil),           // 58: api.v1.ProcessReturnResponse
+	(*CreateReturnReasonRequest)(nil),       // 59: api.v1.CreateReturnReasonRequest
+	(*CreateReturnReasonResponse)(nil),      // 60: api.v1.CreateReturnReasonResponse
+	(*GetReturnReasonRequest)(nil),          // 61: api.v1.GetReturnReasonRequest
+	(*GetReturnReasonResponse)(nil),         // 62: api.v1.GetReturnReasonResponse
+	(*GetAllReturnReasonsResponse)(nil),     // 63: api.v1.GetAllReturnReasonsResponse
+	(*UpdateReturnReasonRequest)(nil),       // 64: api.v1.UpdateReturnReasonRequest
+	(*UpdateReturnReasonResponse)(nil),      // 65: api.v1.UpdateReturnReasonResponse
+	(*DeleteReturnReasonRequest)(nil),       // 66: api.v1.DeleteReturnReasonRequest
+	(*DeleteReturnReasonResponse)(nil),      // 67: api.v1.DeleteReturnReasonResponse
+	(*CheckReturnReasonExistsRequest)(nil),  // 68: api.v1.CheckReturnReasonExistsRequest
+	(*CheckReturnReasonExistsResponse)(nil), // 69: api.v1.CheckReturnReasonExistsResponse
+	(*CreateStatusRequest)(nil),             // 70: api.v1.CreateStatusRequest
+	(*CreateStatusResponse)(nil),            // 71: api.v1.CreateStatusResponse
+	(*GetStatusByIDRequest)(nil),            // 72: api.v1.GetStatusByIDRequest
+	(*GetStatusByIDResponse)(nil),           // 73: api.v1.GetStatusByIDResponse
+	(*GetAllStatusesRequest)(nil),           // 74: api.v1.GetAllStatusesRequest
+	(*GetAllStatusesResponse)(nil),          // 75: api.v1.GetAllStatusesResponse
+	(*UpdateStatusRequest)(nil),             // 76: api.v1.UpdateStatusRequest
+	(*UpdateStatusResponse)(nil),            // 77: api.v1.UpdateStatusResponse
+	(*DeleteStatusRequest)(nil),             // 78: api.v1.DeleteStatusRequest
+	(*DeleteStatusResponse)(nil),            // 79: api.v1.DeleteStatusResponse
+	(*GetStatusByNameRequest)(nil),          // 80: api.v1.GetStatusByNameRequest
+	(*GetStatusByNameResponse)(nil),         // 81: api.v1.GetStatusByNameResponse
+	(*CheckStatusExistsRequest)(nil),        // 82: api.v1.CheckStatusExistsRequest
+	(*CheckStatusExistsResponse)(nil),       // 83: api.v1.CheckStatusExistsResponse
+	(*SetWorkerCountRequest)(nil),           // 84: api.v1.SetWorkerCountRequest
+	(*SetWorkerCountResponse)(nil),          // 85: api.v1.SetWorkerCountResponse
+	(*GetWorkerPoolStatsResponse)(nil),      // 86: api.v1.GetWorkerPoolStatsResponse
+	(*Job)(nil),                             // 87: api.v1.Job
+	(*ListJobsResponse)(nil),                // 88: api.v1.ListJobsResponse
+	(*TriggerJobRequest)(nil),               // 89: api.v1.TriggerJobRequest
+	(*TriggerJobResponse)(nil),              // 90: api.v1.TriggerJobResponse
+	(*CacheStats)(nil),                      // 91: api.v1.CacheStats
+	(*GetCacheStatsResponse)(nil),           // 92: api.v1.GetCacheStatsResponse
+	(*InspectCacheKeyRequest)(nil),          // 93: api.v1.InspectCacheKeyRequest
+	(*InspectCacheKeyResponse)(nil),         // 94: api.v1.InspectCacheKeyResponse
+	(*InvalidateCacheRequest)(nil),          // 95: api.v1.InvalidateCacheRequest
+	(*InvalidateCacheResponse)(nil),         // 96: api.v1.InvalidateCacheResponse
+	(*FlushCacheRequest)(nil),               // 97: api.v1.FlushCacheRequest
+	(*FlushCacheResponse)(nil),              // 98: api.v1.FlushCacheResponse
+	(*emptypb.Empty)(nil),                   // 99: google.protobuf.Empty
 }
 var file_order_service_proto_depIdxs = []int32{
-	5,  // 0: api.v1.GetAllUsersResponse.users:type_name -> api.v1.User
-	16, // 1: api.v1.GetAllOrdersResponse.orders:type_name -> api.v1.GetOrderResponse
-	16, // 2: api.v1.GetOrdersByUserIDResponse.orders:type_name -> api.v1.GetOrderResponse
-	32, // 3: api.v1.GetAllPackagingResponse.packaging_options:type_name -> api.v1.Packaging
-	48, // 4: api.v1.GetReturnsResponse.returns:type_name -> api.v1.ReturnResponse
-	48, // 5: api.v1.GetReturnByOrderIDResponse.return_info:type_name -> api.v1.ReturnResponse
-	48, // 6: api.v1.GetReturnsByUserIDResponse.returns:type_name -> api.v1.ReturnResponse
-	54, // 7: api.v1.GetAllReturnReasonsResponse.reasons:type_name -> api.v1.GetReturnReasonResponse
-	65, // 8: api.v1.GetAllStatusesResponse.statuses:type_name -> api.v1.GetStatusByIDResponse
-	0,  // 9: api.v1.APIService.CreateUser:input_type -> api.v1.CreateUserRequest
-	2,  // 10: api.v1.APIService.GetUser:input_type -> api.v1.GetUserRequest
-	78, // 11: api.v1.APIService.GetAllUsers:input_type -> google.protobuf.Empty
-	6,  // 12: api.v1.APIService.UpdateUser:input_type -> api.v1.UpdateUserRequest
-	8,  // 13: api.v1.APIService.DeleteUser:input_type -> api.v1.DeleteUserRequest
-	9,  // 14: api.v1.APIService.GetUserName:input_type -> api.v1.GetUserNameRequest
-	11, // 15: api.v1.APIService.CheckUserExists:input_type -> api.v1.CheckUserExistsRequest
-	13, // 16: api.v1.APIService.CreateOrder:input_type -> api.v1.CreateOrderRequest
-	15, // 17: api.v1.APIService.GetOrder:input_type -> api.v1.GetOrderRequest
-	78, // 18: api.v1.APIService.GetAllOrders:input_type -> google.protobuf.Empty
-	18, // 19: api.v1.APIService.UpdateOrder:input_type -> api.v1.UpdateOrderRequest
-	20, // 20: api.v1.APIService.DeleteOrder:input_type -> api.v1.DeleteOrderRequest
-	21, // 21: api.v1.APIService.GetOrdersByUserID:input_type -> api.v1.GetOrdersByUserIDRequest
-	25, // 22: api.v1.APIService.IssueOrder:input_type -> api.v1.IssueOrderRequest
-	23, // 23: api.v1.APIService.SeedOrders:input_type -> api.v1.SeedOrdersRequest
-	27, // 24: api.v1.APIService.CreatePackaging:input_type -> api.v1.CreatePackagingRequest
-	29, // 25: api.v1.APIService.GetPackaging:input_type -> api.v1.GetPackagingRequest
-	78, // 26: api.v1.APIService.GetAllPackaging:input_type -> google.protobuf.Empty
-	33, // 27: api.v1.APIService.UpdatePackaging:input_type -> api.v1.UpdatePackagingRequest
-	35, // 28: api.v1.APIService.DeletePackaging:input_type -> api.v1.DeletePackagingRequest
-	37, // 29: api.v1.APIService.CreateReturn:input_type -> api.v1.CreateReturnRequest
-	78, // 30: api.v1.APIService.GetReturns:input_type -> google.protobuf.Empty
-	40, // 31: api.v1.APIService.GetReturnByOrderID:input_type -> api.v1.GetReturnByOrderIDRequest
-	42, // 32: api.v1.APIService.GetReturnsByUserID:input_type -> api.v1.GetReturnsByUserIDRequest
-	44, // 33: api.v1.APIService.UpdateReturn:input_type -> api.v1.UpdateReturnRequest
-	46, // 34: api.v1.APIService.DeleteReturn:input_type -> api.v1.DeleteReturnRequest
-	49, // 35: api.v1.APIService.ProcessReturn:input_type -> api.v1.ProcessReturnRequest
-	51, // 36: api.v1.APIService.CreateReturnReason:input_type -> api.v1.CreateReturnReasonRequest
-	53, // 37: api.v1.APIService.GetReturnReason:input_type -> api.v1.GetReturnReasonRequest
-	78, // 38: api.v1.APIService.GetAllReturnReasons:input_type -> google.protobuf.Empty
-	56, // 39: api.v1.APIService.UpdateReturnReason:input_type -> api.v1.UpdateReturnReasonRequest
-	58, // 40: api.v1.APIService.DeleteReturnReason:input_type -> api.v1.DeleteReturnReasonRequest
-	60, // 41: api.v1.APIService.CheckReturnReasonExists:input_type -> api.v1.CheckReturnReasonExistsRequest
-	62, // 42: api.v1.APIService.CreateStatus:input_type -> api.v1.CreateStatusRequest
-	64, // 43: api.v1.APIService.GetStatusByID:input_type -> api.v1.GetStatusByIDRequest
-	78, // 44: api.v1.APIService.GetAllStatuses:input_type -> google.protobuf.Empty
-	68, // 45: api.v1.APIService.UpdateStatus:input_type -> api.v1.UpdateStatusRequest
-	70, // 46: api.v1.APIService.DeleteStatus:input_type -> api.v1.DeleteStatusRequest
-	72, // 47: api.v1.APIService.GetStatusByName:input_type -> api.v1.GetStatusByNameRequest
-	74, // 48: api.v1.APIService.CheckStatusExists:input_type -> api.v1.CheckStatusExistsRequest
-	76, // 49: api.v1.APIService.SetWorkerCount:input_type -> api.v1.SetWorkerCountRequest
-	1,  // 50: api.v1.APIService.CreateUser:output_type -> api.v1.CreateUserResponse
-	3,  // 51: api.v1.APIService.GetUser:output_type -> api.v1.GetUserResponse
-	4,  // 52: api.v1.APIService.GetAllUsers:output_type -> api.v1.GetAllUsersResponse
-	7,  // 53: api.v1.APIService.UpdateUser:output_type -> api.v1.UpdateUserResponse
-	78, // 54: api.v1.APIService.DeleteUser:output_type -> google.protobuf.Empty
-	10, // 55: api.v1.APIService.GetUserName:output_type -> api.v1.GetUserNameResponse
-	12, // 56: api.v1.APIService.CheckUserExists:output_type -> api.v1.CheckUserExistsResponse
-	14, // 57: api.v1.APIService.CreateOrder:output_type -> api.v1.CreateOrderResponse
-	16, // 58: api.v1.APIService.GetOrder:output_type -> api.v1.GetOrderResponse
-	17, // 59: api.v1.APIService.GetAllOrders:output_type -> api.v1.GetAllOrdersResponse
-	19, // 60: api.v1.APIService.UpdateOrder:output_type -> api.v1.UpdateOrderResponse
-	78, // 61: api.v1.APIService.DeleteOrder:output_type -> google.protobuf.Empty
-	22, // 62: api.v1.APIService.GetOrdersByUserID:output_type -> api.v1.GetOrdersByUserIDResponse
-	78, // 63: api.v1.APIService.IssueOrder:output_type -> google.protobuf.Empty
-	78, // 64: api.v1.APIService.SeedOrders:output_type -> google.protobuf.Empty
-	28, // 65: api.v1.APIService.CreatePackaging:output_type -> api.v1.CreatePackagingResponse
-	30, // 66: api.v1.APIService.GetPackaging:output_type -> api.v1.GetPackagingResponse
-	31, // 67: api.v1.APIService.GetAllPackaging:output_type -> api.v1.GetAllPackagingResponse
-	34, // 68: api.v1.APIService.UpdatePackaging:output_type -> api.v1.UpdatePackagingResponse
-	78, // 69: api.v1.APIService.DeletePackaging:output_type -> google.protobuf.Empty
-	38, // 70: api.v1.APIService.CreateReturn:output_type -> api.v1.CreateReturnResponse
-	39, // 71: api.v1.APIService.GetReturns:output_type -> api.v1.GetReturnsResponse
-	41, // 72: api.v1.APIService.GetReturnByOrderID:output_type -> api.v1.GetReturnByOrderIDResponse
-	43, // 73: api.v1.APIService.GetReturnsByUserID:output_type -> api.v1.GetReturnsByUserIDResponse
-	45, // 74: api.v1.APIService.UpdateReturn:output_type -> api.v1.UpdateReturnResponse
-	78, // 75: api.v1.APIService.DeleteReturn:output_type -> google.protobuf.Empty
-	78, // 76: api.v1.APIService.ProcessReturn:output_type -> google.protobuf.Empty
-	52, // 77: api.v1.APIService.CreateReturnReason:output_type -> api.v1.CreateReturnReasonResponse
-	54, // 78: api.v1.APIService.GetReturnReason:output_type -> api.v1.GetReturnReasonResponse
-	55, // 79: api.v1.APIService.GetAllReturnReasons:output_type -> api.v1.GetAllReturnReasonsResponse
-	57, // 80: api.v1.APIService.UpdateReturnReason:output_type -> api.v1.UpdateReturnReasonResponse
-	78, // 81: api.v1.APIService.DeleteReturnReason:output_type -> google.protobuf.Empty
-	61, // 82: api.v1.APIService.CheckReturnReasonExists:output_type -> api.v1.CheckReturnReasonExistsResponse
-	63, // 83: api.v1.APIService.CreateStatus:output_type -> api.v1.CreateStatusResponse
-	65, // 84: api.v1.APIService.GetStatusByID:output_type -> api.v1.GetStatusByIDResponse
-	67, // 85: api.v1.APIService.GetAllStatuses:output_type -> api.v1.GetAllStatusesResponse
-	69, // 86: api.v1.APIService.UpdateStatus:output_type -> api.v1.UpdateStatusResponse
-	78, // 87: api.v1.APIService.DeleteStatus:output_type -> google.protobuf.Empty
-	73, // 88: api.v1.APIService.GetStatusByName:output_type -> api.v1.GetStatusByNameResponse
-	75, // 89: api.v1.APIService.CheckStatusExists:output_type -> api.v1.CheckStatusExistsResponse
-	78, // 90: api.v1.APIService.SetWorkerCount:output_type -> google.protobuf.Empty
-	50, // [50:91] is the sub-list for method output_type
-	9,  // [9:50] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+	6,  // 0: api.v1.GetAllUsersResponse.users:type_name -> api.v1.User
+	17, // 1: api.v1.GetAllOrdersResponse.orders:type_name -> api.v1.GetOrderResponse
+	17, // 2: api.v1.GetOrdersByUserIDResponse.orders:type_name -> api.v1.GetOrderResponse
+	0,  // 3: api.v1.ListOrdersRequest.issued:type_name -> api.v1.IssueFilter
+	17, // 4: api.v1.ListOrdersResponse.orders:type_name -> api.v1.GetOrderResponse
+	31, // 5: api.v1.GetOrderHistoryResponse.changes:type_name -> api.v1.OrderStatusChange
+	38, // 6: api.v1.GetAllPackagingResponse.packaging_options:type_name -> api.v1.Packaging
+	56, // 7: api.v1.GetReturnsResponse.returns:type_name -> api.v1.ReturnResponse
+	56, // 8: api.v1.GetReturnByOrderIDResponse.return_info:type_name -> api.v1.ReturnResponse
+	56, // 9: api.v1.GetReturnsByUserIDResponse.returns:type_name -> api.v1.ReturnResponse
+	56, // 10: api.v1.ListReturnsResponse.returns:type_name -> api.v1.ReturnResponse
+	62, // 11: api.v1.GetAllReturnReasonsResponse.reasons:type_name -> api.v1.GetReturnReasonResponse
+	73, // 12: api.v1.GetAllStatusesResponse.statuses:type_name -> api.v1.GetStatusByIDResponse
+	87, // 13: api.v1.ListJobsResponse.jobs:type_name -> api.v1.Job
+	87, // 14: api.v1.TriggerJobResponse.job:type_name -> api.v1.Job
+	91, // 15: api.v1.GetCacheStatsResponse.caches:type_name -> api.v1.CacheStats
+	1,  // 16: api.v1.APIService.CreateUser:input_type -> api.v1.CreateUserRequest
+	3,  // 17: api.v1.APIService.GetUser:input_type -> api.v1.GetUserRequest
+	99, // 18: api.v1.APIService.GetAllUsers:input_type -> google.protobuf.Empty
+	7,  // 19: api.v1.APIService.UpdateUser:input_type -> api.v1.UpdateUserRequest
+	9,  // 20: api.v1.APIService.DeleteUser:input_type -> api.v1.DeleteUserRequest
+	10, // 21: api.v1.APIService.GetUserName:input_type -> api.v1.GetUserNameRequest
+	12, // 22: api.v1.APIService.CheckUserExists:input_type -> api.v1.CheckUserExistsRequest
+	14, // 23: api.v1.APIService.CreateOrder:input_type -> api.v1.CreateOrderRequest
+	16, // 24: api.v1.APIService.GetOrder:input_type -> api.v1.GetOrderRequest
+	99, // 25: api.v1.APIService.GetAllOrders:input_type -> google.protobuf.Empty
+	19, // 26: api.v1.APIService.UpdateOrder:input_type -> api.v1.UpdateOrderRequest
+	21, // 27: api.v1.APIService.DeleteOrder:input_type -> api.v1.DeleteOrderRequest
+	22, // 28: api.v1.APIService.GetOrdersByUserID:input_type -> api.v1.GetOrdersByUserIDRequest
+	24, // 29: api.v1.APIService.ListOrders:input_type -> api.v1.ListOrdersRequest
+	28, // 30: api.v1.APIService.IssueOrder:input_type -> api.v1.IssueOrderRequest
+	26, // 31: api.v1.APIService.SeedOrders:input_type -> api.v1.SeedOrdersRequest
+	30, // 32: api.v1.APIService.GetOrderHistory:input_type -> api.v1.GetOrderHistoryRequest
+	33, // 33: api.v1.APIService.CreatePackaging:input_type -> api.v1.CreatePackagingRequest
+	35, // 34: api.v1.APIService.GetPackaging:input_type -> api.v1.GetPackagingRequest
+	99, // 35: api.v1.APIService.GetAllPackaging:input_type -> google.protobuf.Empty
+	39, // 36: api.v1.APIService.UpdatePackaging:input_type -> api.v1.UpdatePackagingRequest
+	41, // 37: api.v1.APIService.DeletePackaging:input_type -> api.v1.DeletePackagingRequest
+	43, // 38: api.v1.APIService.CreateReturn:input_type -> api.v1.CreateReturnRequest
+	99, // 39: api.v1.APIService.GetReturns:input_type -> google.protobuf.Empty
+	46, // 40: api.v1.APIService.GetReturnByOrderID:input_type -> api.v1.GetReturnByOrderIDRequest
+	48, // 41: api.v1.APIService.GetReturnsByUserID:input_type -> api.v1.GetReturnsByUserIDRequest
+	50, // 42: api.v1.APIService.ListReturns:input_type -> api.v1.ListReturnsRequest
+	52, // 43: api.v1.APIService.UpdateReturn:input_type -> api.v1.UpdateReturnRequest
+	54, // 44: api.v1.APIService.DeleteReturn:input_type -> api.v1.DeleteReturnRequest
+	57, // 45: api.v1.APIService.ProcessReturn:input_type -> api.v1.ProcessReturnRequest
+	59, // 46: api.v1.APIService.CreateReturnReason:input_type -> api.v1.CreateReturnReasonRequest
+	61, // 47: api.v1.APIService.GetReturnReason:input_type -> api.v1.GetReturnReasonRequest
+	99, // 48: api.v1.APIService.GetAllReturnReasons:input_type -> google.protobuf.Empty
+	64, // 49: api.v1.APIService.UpdateReturnReason:input_type -> api.v1.UpdateReturnReasonRequest
+	66, // 50: api.v1.APIService.DeleteReturnReason:input_type -> api.v1.DeleteReturnReasonRequest
+	68, // 51: api.v1.APIService.CheckReturnReasonExists:input_type -> api.v1.CheckReturnReasonExistsRequest
+	70, // 52: api.v1.APIService.CreateStatus:input_type -> api.v1.CreateStatusRequest
+	72, // 53: api.v1.APIService.GetStatusByID:input_type -> api.v1.GetStatusByIDRequest
+	99, // 54: api.v1.APIService.GetAllStatuses:input_type -> google.protobuf.Empty
+	76, // 55: api.v1.APIService.UpdateStatus:input_type -> api.v1.UpdateStatusRequest
+	78, // 56: api.v1.APIService.DeleteStatus:input_type -> api.v1.DeleteStatusRequest
+	80, // 57: api.v1.APIService.GetStatusByName:input_type -> api.v1.GetStatusByNameRequest
+	82, // 58: api.v1.APIService.CheckStatusExists:input_type -> api.v1.CheckStatusExistsRequest
+	84, // 59: api.v1.APIService.SetWorkerCount:input_type -> api.v1.SetWorkerCountRequest
+	99, // 60: api.v1.APIService.GetWorkerPoolStats:input_type -> google.protobuf.Empty
+	99, // 61: api.v1.APIService.ListJobs:input_type -> google.protobuf.Empty
+	89, // 62: api.v1.APIService.TriggerJob:input_type -> api.v1.TriggerJobRequest
+	99, // 63: api.v1.APIService.GetCacheStats:input_type -> google.protobuf.Empty
+	93, // 64: api.v1.APIService.InspectCacheKey:input_type -> api.v1.InspectCacheKeyRequest
+	95, // 65: api.v1.APIService.InvalidateCache:input_type -> api.v1.InvalidateCacheRequest
+	97, // 66: api.v1.APIService.FlushCache:input_type -> api.v1.FlushCacheRequest
+	99, // 67: api.v1.APIService.WarmCache:input_type -> google.protobuf.Empty
+	2,  // 68: api.v1.APIService.CreateUser:output_type -> api.v1.CreateUserResponse
+	4,  // 69: api.v1.APIService.GetUser:output_type -> api.v1.GetUserResponse
+	5,  // 70: api.v1.APIService.GetAllUsers:output_type -> api.v1.GetAllUsersResponse
+	8,  // 71: api.v1.APIService.UpdateUser:output_type -> api.v1.UpdateUserResponse
+	99, // 72: api.v1.APIService.DeleteUser:output_type -> google.protobuf.Empty
+	11, // 73: api.v1.APIService.GetUserName:output_type -> api.v1.GetUserNameResponse
+	13, // 74: api.v1.APIService.CheckUserExists:output_type -> api.v1.CheckUserExistsResponse
+	15, // 75: api.v1.APIService.CreateOrder:output_type -> api.v1.CreateOrderResponse
+	17, // 76: api.v1.APIService.GetOrder:output_type -> api.v1.GetOrderResponse
+	18, // 77: api.v1.APIService.GetAllOrders:output_type -> api.v1.GetAllOrdersResponse
+	20, // 78: api.v1.APIService.UpdateOrder:output_type -> api.v1.UpdateOrderResponse
+	99, // 79: api.v1.APIService.DeleteOrder:output_type -> google.protobuf.Empty
+	23, // 80: api.v1.APIService.GetOrdersByUserID:output_type -> api.v1.GetOrdersByUserIDResponse
+	25, // 81: api.v1.APIService.ListOrders:output_type -> api.v1.ListOrdersResponse
+	99, // 82: api.v1.APIService.IssueOrder:output_type -> google.protobuf.Empty
+	99, // 83: api.v1.APIService.SeedOrders:output_type -> google.protobuf.Empty
+	32, // 84: api.v1.APIService.GetOrderHistory:output_type -> api.v1.GetOrderHistoryResponse
+	34, // 85: api.v1.APIService.CreatePackaging:output_type -> api.v1.CreatePackagingResponse
+	36, // 86: api.v1.APIService.GetPackaging:output_type -> api.v1.GetPackagingResponse
+	37, // 87: api.v1.APIService.GetAllPackaging:output_type -> api.v1.GetAllPackagingResponse
+	40, // 88: api.v1.APIService.UpdatePackaging:output_type -> api.v1.UpdatePackagingResponse
+	99, // 89: api.v1.APIService.DeletePackaging:output_type -> google.protobuf.Empty
+	44, // 90: api.v1.APIService.CreateReturn:output_type -> api.v1.CreateReturnResponse
+	45, // 91: api.v1.APIService.GetReturns:output_type -> api.v1.GetReturnsResponse
+	47, // 92: api.v1.APIService.GetReturnByOrderID:output_type -> api.v1.GetReturnByOrderIDResponse
+	49, // 93: api.v1.APIService.GetReturnsByUserID:output_type -> api.v1.GetReturnsByUserIDResponse
+	51, // 94: api.v1.APIService.ListReturns:output_type -> api.v1.ListReturnsResponse
+	53, // 95: api.v1.APIService.UpdateReturn:output_type -> api.v1.UpdateReturnResponse
+	99, // 96: api.v1.APIService.DeleteReturn:output_type -> google.protobuf.Empty
+	99, // 97: api.v1.APIService.ProcessReturn:output_type -> google.protobuf.Empty
+	60, // 98: api.v1.APIService.CreateReturnReason:output_type -> api.v1.CreateReturnReasonResponse
+	62, // 99: api.v1.APIService.GetReturnReason:output_type -> api.v1.GetReturnReasonResponse
+	63, // 100: api.v1.APIService.GetAllReturnReasons:output_type -> api.v1.GetAllReturnReasonsResponse
+	65, // 101: api.v1.APIService.UpdateReturnReason:output_type -> api.v1.UpdateReturnReasonResponse
+	99, // 102: api.v1.APIService.DeleteReturnReason:output_type -> google.protobuf.Empty
+	69, // 103: api.v1.APIService.CheckReturnReasonExists:output_type -> api.v1.CheckReturnReasonExistsResponse
+	71, // 104: api.v1.APIService.CreateStatus:output_type -> api.v1.CreateStatusResponse
+	73, // 105: api.v1.APIService.GetStatusByID:output_type -> api.v1.GetStatusByIDResponse
+	75, // 106: api.v1.APIService.GetAllStatuses:output_type -> api.v1.GetAllStatusesResponse
+	77, // 107: api.v1.APIService.UpdateStatus:output_type -> api.v1.UpdateStatusResponse
+	99, // 108: api.v1.APIService.DeleteStatus:output_type -> google.protobuf.Empty
+	81, // 109: api.v1.APIService.GetStatusByName:output_type -> api.v1.GetStatusByNameResponse
+	83, // 110: api.v1.APIService.CheckStatusExists:output_type -> api.v1.CheckStatusExistsResponse
+	99, // 111: api.v1.APIService.SetWorkerCount:output_type -> google.protobuf.Empty
+	86, // 112: api.v1.APIService.GetWorkerPoolStats:output_type -> api.v1.GetWorkerPoolStatsResponse
+	88, // 113: api.v1.APIService.ListJobs:output_type -> api.v1.ListJobsResponse
+	90, // 114: api.v1.APIService.TriggerJob:output_type -> api.v1.TriggerJobResponse
+	92, // 115: api.v1.APIService.GetCacheStats:output_type -> api.v1.GetCacheStatsResponse
+	94, // 116: api.v1.APIService.InspectCacheKey:output_type -> api.v1.InspectCacheKeyResponse
+	96, // 117: api.v1.APIService.InvalidateCache:output_type -> api.v1.InvalidateCacheResponse
+	98, // 118: api.v1.APIService.FlushCache:output_type -> api.v1.FlushCacheResponse
+	99, // 119: api.v1.APIService.WarmCache:output_type -> google.protobuf.Empty
+	68, // [68:120] is the sub-list for method output_type
+	16, // [16:68] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
 }
 
 func init() { file_order_service_proto_init() }
@@ -5044,13 +6795,14 @@ func file_order_service_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_order_service_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   78,
+			NumEnums:      1,
+			NumMessages:   98,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_order_service_proto_goTypes,
 		DependencyIndexes: file_order_service_proto_depIdxs,
+		EnumInfos:         file_order_service_proto_enumTypes,
 		MessageInfos:      file_order_service_proto_msgTypes,
 	}.Build()
 	File_order_service_proto = out.File
@@ -2793,6 +2793,311 @@ var _ interface {
 	ErrorName() string
 } = GetOrdersByUserIDResponseValidationError{}
 
+// Validate checks the field values on ListOrdersRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListOrdersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListOrdersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListOrdersRequestMultiError, or nil if none found.
+func (m *ListOrdersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListOrdersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if val := m.GetPageSize(); val < 0 || val > 500 {
+		err := ListOrdersRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [0, 500]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	if m.GetUserId() < 0 {
+		err := ListOrdersRequestValidationError{
+			field:  "UserId",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetStatusId() < 0 {
+		err := ListOrdersRequestValidationError{
+			field:  "StatusId",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPackagingId() < 0 {
+		err := ListOrdersRequestValidationError{
+			field:  "PackagingId",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for AcceptedFrom
+
+	// no validation rules for AcceptedTo
+
+	// no validation rules for ExpiresFrom
+
+	// no validation rules for ExpiresTo
+
+	if _, ok := IssueFilter_name[int32(m.GetIssued())]; !ok {
+		err := ListOrdersRequestValidationError{
+			field:  "Issued",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListOrdersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListOrdersRequestMultiError is an error wrapping multiple validation errors
+// returned by ListOrdersRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListOrdersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListOrdersRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListOrdersRequestMultiError) AllErrors() []error { return m }
+
+// ListOrdersRequestValidationError is the validation error returned by
+// ListOrdersRequest.Validate if the designated constraints aren't met.
+type ListOrdersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListOrdersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListOrdersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListOrdersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListOrdersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListOrdersRequestValidationError) ErrorName() string {
+	return "ListOrdersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListOrdersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListOrdersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListOrdersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListOrdersRequestValidationError{}
+
+// Validate checks the field values on ListOrdersResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListOrdersResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListOrdersResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListOrdersResponseMultiError, or nil if none found.
+func (m *ListOrdersResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListOrdersResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetOrders() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListOrdersResponseValidationError{
+						field:  fmt.Sprintf("Orders[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListOrdersResponseValidationError{
+						field:  fmt.Sprintf("Orders[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListOrdersResponseValidationError{
+					field:  fmt.Sprintf("Orders[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListOrdersResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListOrdersResponseMultiError is an error wrapping multiple validation errors
+// returned by ListOrdersResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ListOrdersResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListOrdersResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListOrdersResponseMultiError) AllErrors() []error { return m }
+
+// ListOrdersResponseValidationError is the validation error returned by
+// ListOrdersResponse.Validate if the designated constraints aren't met.
+type ListOrdersResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListOrdersResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListOrdersResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListOrdersResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListOrdersResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListOrdersResponseValidationError) ErrorName() string {
+	return "ListOrdersResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListOrdersResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListOrdersResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListOrdersResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListOrdersResponseValidationError{}
+
 // Validate checks the field values on SeedOrdersRequest with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
@@ -3227,32 +3532,32 @@ var _ interface {
 	ErrorName() string
 } = IssueOrderResponseValidationError{}
 
-// Validate checks the field values on CreatePackagingRequest with the rules
+// Validate checks the field values on GetOrderHistoryRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreatePackagingRequest) Validate() error {
+func (m *GetOrderHistoryRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreatePackagingRequest with the rules
+// ValidateAll checks the field values on GetOrderHistoryRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreatePackagingRequestMultiError, or nil if none found.
-func (m *CreatePackagingRequest) ValidateAll() error {
+// GetOrderHistoryRequestMultiError, or nil if none found.
+func (m *GetOrderHistoryRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreatePackagingRequest) validate(all bool) error {
+func (m *GetOrderHistoryRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetPackagingType()) < 1 {
-		err := CreatePackagingRequestValidationError{
-			field:  "PackagingType",
-			reason: "value length must be at least 1 runes",
+	if m.GetOrderId() <= 0 {
+		err := GetOrderHistoryRequestValidationError{
+			field:  "OrderId",
+			reason: "value must be greater than 0",
 		}
 		if !all {
 			return err
@@ -3260,42 +3565,20 @@ func (m *CreatePackagingRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if m.GetCost() < 0 {
-		err := CreatePackagingRequestValidationError{
-			field:  "Cost",
-			reason: "value must be greater than or equal to 0",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if m.GetMaxWeight() <= 0 {
-		err := CreatePackagingRequestValidationError{
-			field:  "MaxWeight",
-			reason: "value must be greater than 0",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if len(errors) > 0 {
-		return CreatePackagingRequestMultiError(errors)
+	if len(errors) > 0 {
+		return GetOrderHistoryRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreatePackagingRequestMultiError is an error wrapping multiple validation
-// errors returned by CreatePackagingRequest.ValidateAll() if the designated
+// GetOrderHistoryRequestMultiError is an error wrapping multiple validation
+// errors returned by GetOrderHistoryRequest.ValidateAll() if the designated
 // constraints aren't met.
-type CreatePackagingRequestMultiError []error
+type GetOrderHistoryRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreatePackagingRequestMultiError) Error() string {
+func (m GetOrderHistoryRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3304,11 +3587,11 @@ func (m CreatePackagingRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreatePackagingRequestMultiError) AllErrors() []error { return m }
+func (m GetOrderHistoryRequestMultiError) AllErrors() []error { return m }
 
-// CreatePackagingRequestValidationError is the validation error returned by
-// CreatePackagingRequest.Validate if the designated constraints aren't met.
-type CreatePackagingRequestValidationError struct {
+// GetOrderHistoryRequestValidationError is the validation error returned by
+// GetOrderHistoryRequest.Validate if the designated constraints aren't met.
+type GetOrderHistoryRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3316,24 +3599,24 @@ type CreatePackagingRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreatePackagingRequestValidationError) Field() string { return e.field }
+func (e GetOrderHistoryRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreatePackagingRequestValidationError) Reason() string { return e.reason }
+func (e GetOrderHistoryRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreatePackagingRequestValidationError) Cause() error { return e.cause }
+func (e GetOrderHistoryRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreatePackagingRequestValidationError) Key() bool { return e.key }
+func (e GetOrderHistoryRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreatePackagingRequestValidationError) ErrorName() string {
-	return "CreatePackagingRequestValidationError"
+func (e GetOrderHistoryRequestValidationError) ErrorName() string {
+	return "GetOrderHistoryRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreatePackagingRequestValidationError) Error() string {
+func (e GetOrderHistoryRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3345,14 +3628,14 @@ func (e CreatePackagingRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreatePackagingRequest.%s: %s%s",
+		"invalid %sGetOrderHistoryRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreatePackagingRequestValidationError{}
+var _ error = GetOrderHistoryRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3360,46 +3643,62 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreatePackagingRequestValidationError{}
+} = GetOrderHistoryRequestValidationError{}
 
-// Validate checks the field values on CreatePackagingResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreatePackagingResponse) Validate() error {
+// Validate checks the field values on OrderStatusChange with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *OrderStatusChange) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreatePackagingResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on OrderStatusChange with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreatePackagingResponseMultiError, or nil if none found.
-func (m *CreatePackagingResponse) ValidateAll() error {
+// OrderStatusChangeMultiError, or nil if none found.
+func (m *OrderStatusChange) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreatePackagingResponse) validate(all bool) error {
+func (m *OrderStatusChange) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for PackagingId
+	// no validation rules for ChangeId
+
+	// no validation rules for OrderId
+
+	// no validation rules for OldStatusId
+
+	// no validation rules for OldStatusName
+
+	// no validation rules for NewStatusId
+
+	// no validation rules for NewStatusName
+
+	// no validation rules for Actor
+
+	// no validation rules for Reason
+
+	// no validation rules for ChangedAt
 
 	if len(errors) > 0 {
-		return CreatePackagingResponseMultiError(errors)
+		return OrderStatusChangeMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreatePackagingResponseMultiError is an error wrapping multiple validation
-// errors returned by CreatePackagingResponse.ValidateAll() if the designated
-// constraints aren't met.
-type CreatePackagingResponseMultiError []error
+// OrderStatusChangeMultiError is an error wrapping multiple validation errors
+// returned by OrderStatusChange.ValidateAll() if the designated constraints
+// aren't met.
+type OrderStatusChangeMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreatePackagingResponseMultiError) Error() string {
+func (m OrderStatusChangeMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3408,11 +3707,11 @@ func (m CreatePackagingResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreatePackagingResponseMultiError) AllErrors() []error { return m }
+func (m OrderStatusChangeMultiError) AllErrors() []error { return m }
 
-// CreatePackagingResponseValidationError is the validation error returned by
-// CreatePackagingResponse.Validate if the designated constraints aren't met.
-type CreatePackagingResponseValidationError struct {
+// OrderStatusChangeValidationError is the validation error returned by
+// OrderStatusChange.Validate if the designated constraints aren't met.
+type OrderStatusChangeValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3420,24 +3719,24 @@ type CreatePackagingResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreatePackagingResponseValidationError) Field() string { return e.field }
+func (e OrderStatusChangeValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreatePackagingResponseValidationError) Reason() string { return e.reason }
+func (e OrderStatusChangeValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreatePackagingResponseValidationError) Cause() error { return e.cause }
+func (e OrderStatusChangeValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreatePackagingResponseValidationError) Key() bool { return e.key }
+func (e OrderStatusChangeValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreatePackagingResponseValidationError) ErrorName() string {
-	return "CreatePackagingResponseValidationError"
+func (e OrderStatusChangeValidationError) ErrorName() string {
+	return "OrderStatusChangeValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreatePackagingResponseValidationError) Error() string {
+func (e OrderStatusChangeValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3449,14 +3748,14 @@ func (e CreatePackagingResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreatePackagingResponse.%s: %s%s",
+		"invalid %sOrderStatusChange.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreatePackagingResponseValidationError{}
+var _ error = OrderStatusChangeValidationError{}
 
 var _ interface {
 	Field() string
@@ -3464,55 +3763,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreatePackagingResponseValidationError{}
+} = OrderStatusChangeValidationError{}
 
-// Validate checks the field values on GetPackagingRequest with the rules
+// Validate checks the field values on GetOrderHistoryResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetPackagingRequest) Validate() error {
+func (m *GetOrderHistoryResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetPackagingRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetOrderHistoryResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetPackagingRequestMultiError, or nil if none found.
-func (m *GetPackagingRequest) ValidateAll() error {
+// GetOrderHistoryResponseMultiError, or nil if none found.
+func (m *GetOrderHistoryResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetPackagingRequest) validate(all bool) error {
+func (m *GetOrderHistoryResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetPackagingId() <= 0 {
-		err := GetPackagingRequestValidationError{
-			field:  "PackagingId",
-			reason: "value must be greater than 0",
-		}
-		if !all {
-			return err
+	for idx, item := range m.GetChanges() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetOrderHistoryResponseValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetOrderHistoryResponseValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetOrderHistoryResponseValidationError{
+					field:  fmt.Sprintf("Changes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
 		}
-		errors = append(errors, err)
+
 	}
 
 	if len(errors) > 0 {
-		return GetPackagingRequestMultiError(errors)
+		return GetOrderHistoryResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetPackagingRequestMultiError is an error wrapping multiple validation
-// errors returned by GetPackagingRequest.ValidateAll() if the designated
+// GetOrderHistoryResponseMultiError is an error wrapping multiple validation
+// errors returned by GetOrderHistoryResponse.ValidateAll() if the designated
 // constraints aren't met.
-type GetPackagingRequestMultiError []error
+type GetOrderHistoryResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetPackagingRequestMultiError) Error() string {
+func (m GetOrderHistoryResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3521,11 +3843,11 @@ func (m GetPackagingRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetPackagingRequestMultiError) AllErrors() []error { return m }
+func (m GetOrderHistoryResponseMultiError) AllErrors() []error { return m }
 
-// GetPackagingRequestValidationError is the validation error returned by
-// GetPackagingRequest.Validate if the designated constraints aren't met.
-type GetPackagingRequestValidationError struct {
+// GetOrderHistoryResponseValidationError is the validation error returned by
+// GetOrderHistoryResponse.Validate if the designated constraints aren't met.
+type GetOrderHistoryResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3533,24 +3855,24 @@ type GetPackagingRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetPackagingRequestValidationError) Field() string { return e.field }
+func (e GetOrderHistoryResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetPackagingRequestValidationError) Reason() string { return e.reason }
+func (e GetOrderHistoryResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetPackagingRequestValidationError) Cause() error { return e.cause }
+func (e GetOrderHistoryResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetPackagingRequestValidationError) Key() bool { return e.key }
+func (e GetOrderHistoryResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetPackagingRequestValidationError) ErrorName() string {
-	return "GetPackagingRequestValidationError"
+func (e GetOrderHistoryResponseValidationError) ErrorName() string {
+	return "GetOrderHistoryResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetPackagingRequestValidationError) Error() string {
+func (e GetOrderHistoryResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3562,14 +3884,14 @@ func (e GetPackagingRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetPackagingRequest.%s: %s%s",
+		"invalid %sGetOrderHistoryResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetPackagingRequestValidationError{}
+var _ error = GetOrderHistoryResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3577,52 +3899,77 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetPackagingRequestValidationError{}
+} = GetOrderHistoryResponseValidationError{}
 
-// Validate checks the field values on GetPackagingResponse with the rules
+// Validate checks the field values on CreatePackagingRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetPackagingResponse) Validate() error {
+func (m *CreatePackagingRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetPackagingResponse with the rules
+// ValidateAll checks the field values on CreatePackagingRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetPackagingResponseMultiError, or nil if none found.
-func (m *GetPackagingResponse) ValidateAll() error {
+// CreatePackagingRequestMultiError, or nil if none found.
+func (m *CreatePackagingRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetPackagingResponse) validate(all bool) error {
+func (m *CreatePackagingRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for PackagingId
-
-	// no validation rules for PackagingType
+	if utf8.RuneCountInString(m.GetPackagingType()) < 1 {
+		err := CreatePackagingRequestValidationError{
+			field:  "PackagingType",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for Cost
+	if m.GetCost() < 0 {
+		err := CreatePackagingRequestValidationError{
+			field:  "Cost",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for MaxWeight
+	if m.GetMaxWeight() <= 0 {
+		err := CreatePackagingRequestValidationError{
+			field:  "MaxWeight",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return GetPackagingResponseMultiError(errors)
+		return CreatePackagingRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetPackagingResponseMultiError is an error wrapping multiple validation
-// errors returned by GetPackagingResponse.ValidateAll() if the designated
+// CreatePackagingRequestMultiError is an error wrapping multiple validation
+// errors returned by CreatePackagingRequest.ValidateAll() if the designated
 // constraints aren't met.
-type GetPackagingResponseMultiError []error
+type CreatePackagingRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetPackagingResponseMultiError) Error() string {
+func (m CreatePackagingRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3631,11 +3978,11 @@ func (m GetPackagingResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetPackagingResponseMultiError) AllErrors() []error { return m }
+func (m CreatePackagingRequestMultiError) AllErrors() []error { return m }
 
-// GetPackagingResponseValidationError is the validation error returned by
-// GetPackagingResponse.Validate if the designated constraints aren't met.
-type GetPackagingResponseValidationError struct {
+// CreatePackagingRequestValidationError is the validation error returned by
+// CreatePackagingRequest.Validate if the designated constraints aren't met.
+type CreatePackagingRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3643,24 +3990,24 @@ type GetPackagingResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetPackagingResponseValidationError) Field() string { return e.field }
+func (e CreatePackagingRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetPackagingResponseValidationError) Reason() string { return e.reason }
+func (e CreatePackagingRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetPackagingResponseValidationError) Cause() error { return e.cause }
+func (e CreatePackagingRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetPackagingResponseValidationError) Key() bool { return e.key }
+func (e CreatePackagingRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetPackagingResponseValidationError) ErrorName() string {
-	return "GetPackagingResponseValidationError"
+func (e CreatePackagingRequestValidationError) ErrorName() string {
+	return "CreatePackagingRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetPackagingResponseValidationError) Error() string {
+func (e CreatePackagingRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3672,14 +4019,14 @@ func (e GetPackagingResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetPackagingResponse.%s: %s%s",
+		"invalid %sCreatePackagingRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetPackagingResponseValidationError{}
+var _ error = CreatePackagingRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3687,78 +4034,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetPackagingResponseValidationError{}
+} = CreatePackagingRequestValidationError{}
 
-// Validate checks the field values on GetAllPackagingResponse with the rules
+// Validate checks the field values on CreatePackagingResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetAllPackagingResponse) Validate() error {
+func (m *CreatePackagingResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetAllPackagingResponse with the
+// ValidateAll checks the field values on CreatePackagingResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetAllPackagingResponseMultiError, or nil if none found.
-func (m *GetAllPackagingResponse) ValidateAll() error {
+// CreatePackagingResponseMultiError, or nil if none found.
+func (m *CreatePackagingResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetAllPackagingResponse) validate(all bool) error {
+func (m *CreatePackagingResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetPackagingOptions() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetAllPackagingResponseValidationError{
-						field:  fmt.Sprintf("PackagingOptions[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, GetAllPackagingResponseValidationError{
-						field:  fmt.Sprintf("PackagingOptions[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return GetAllPackagingResponseValidationError{
-					field:  fmt.Sprintf("PackagingOptions[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
+	// no validation rules for PackagingId
 
 	if len(errors) > 0 {
-		return GetAllPackagingResponseMultiError(errors)
+		return CreatePackagingResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetAllPackagingResponseMultiError is an error wrapping multiple validation
-// errors returned by GetAllPackagingResponse.ValidateAll() if the designated
+// CreatePackagingResponseMultiError is an error wrapping multiple validation
+// errors returned by CreatePackagingResponse.ValidateAll() if the designated
 // constraints aren't met.
-type GetAllPackagingResponseMultiError []error
+type CreatePackagingResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetAllPackagingResponseMultiError) Error() string {
+func (m CreatePackagingResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3767,11 +4082,11 @@ func (m GetAllPackagingResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetAllPackagingResponseMultiError) AllErrors() []error { return m }
+func (m CreatePackagingResponseMultiError) AllErrors() []error { return m }
 
-// GetAllPackagingResponseValidationError is the validation error returned by
-// GetAllPackagingResponse.Validate if the designated constraints aren't met.
-type GetAllPackagingResponseValidationError struct {
+// CreatePackagingResponseValidationError is the validation error returned by
+// CreatePackagingResponse.Validate if the designated constraints aren't met.
+type CreatePackagingResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3779,24 +4094,24 @@ type GetAllPackagingResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetAllPackagingResponseValidationError) Field() string { return e.field }
+func (e CreatePackagingResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetAllPackagingResponseValidationError) Reason() string { return e.reason }
+func (e CreatePackagingResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetAllPackagingResponseValidationError) Cause() error { return e.cause }
+func (e CreatePackagingResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetAllPackagingResponseValidationError) Key() bool { return e.key }
+func (e CreatePackagingResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetAllPackagingResponseValidationError) ErrorName() string {
-	return "GetAllPackagingResponseValidationError"
+func (e CreatePackagingResponseValidationError) ErrorName() string {
+	return "CreatePackagingResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetAllPackagingResponseValidationError) Error() string {
+func (e CreatePackagingResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3808,14 +4123,14 @@ func (e GetAllPackagingResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetAllPackagingResponse.%s: %s%s",
+		"invalid %sCreatePackagingResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetAllPackagingResponseValidationError{}
+var _ error = CreatePackagingResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3823,51 +4138,55 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetAllPackagingResponseValidationError{}
+} = CreatePackagingResponseValidationError{}
 
-// Validate checks the field values on Packaging with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *Packaging) Validate() error {
+// Validate checks the field values on GetPackagingRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetPackagingRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Packaging with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in PackagingMultiError, or nil
-// if none found.
-func (m *Packaging) ValidateAll() error {
+// ValidateAll checks the field values on GetPackagingRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetPackagingRequestMultiError, or nil if none found.
+func (m *GetPackagingRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Packaging) validate(all bool) error {
+func (m *GetPackagingRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for PackagingId
-
-	// no validation rules for PackagingType
-
-	// no validation rules for Cost
-
-	// no validation rules for MaxWeight
+	if m.GetPackagingId() <= 0 {
+		err := GetPackagingRequestValidationError{
+			field:  "PackagingId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return PackagingMultiError(errors)
+		return GetPackagingRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// PackagingMultiError is an error wrapping multiple validation errors returned
-// by Packaging.ValidateAll() if the designated constraints aren't met.
-type PackagingMultiError []error
+// GetPackagingRequestMultiError is an error wrapping multiple validation
+// errors returned by GetPackagingRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetPackagingRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PackagingMultiError) Error() string {
+func (m GetPackagingRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3876,11 +4195,11 @@ func (m PackagingMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PackagingMultiError) AllErrors() []error { return m }
+func (m GetPackagingRequestMultiError) AllErrors() []error { return m }
 
-// PackagingValidationError is the validation error returned by
-// Packaging.Validate if the designated constraints aren't met.
-type PackagingValidationError struct {
+// GetPackagingRequestValidationError is the validation error returned by
+// GetPackagingRequest.Validate if the designated constraints aren't met.
+type GetPackagingRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3888,22 +4207,24 @@ type PackagingValidationError struct {
 }
 
 // Field function returns field value.
-func (e PackagingValidationError) Field() string { return e.field }
+func (e GetPackagingRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PackagingValidationError) Reason() string { return e.reason }
+func (e GetPackagingRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PackagingValidationError) Cause() error { return e.cause }
+func (e GetPackagingRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PackagingValidationError) Key() bool { return e.key }
+func (e GetPackagingRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PackagingValidationError) ErrorName() string { return "PackagingValidationError" }
+func (e GetPackagingRequestValidationError) ErrorName() string {
+	return "GetPackagingRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e PackagingValidationError) Error() string {
+func (e GetPackagingRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3915,14 +4236,14 @@ func (e PackagingValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPackaging.%s: %s%s",
+		"invalid %sGetPackagingRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PackagingValidationError{}
+var _ error = GetPackagingRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3930,88 +4251,52 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PackagingValidationError{}
+} = GetPackagingRequestValidationError{}
 
-// Validate checks the field values on UpdatePackagingRequest with the rules
+// Validate checks the field values on GetPackagingResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdatePackagingRequest) Validate() error {
+func (m *GetPackagingResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdatePackagingRequest with the rules
+// ValidateAll checks the field values on GetPackagingResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdatePackagingRequestMultiError, or nil if none found.
-func (m *UpdatePackagingRequest) ValidateAll() error {
+// GetPackagingResponseMultiError, or nil if none found.
+func (m *GetPackagingResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdatePackagingRequest) validate(all bool) error {
+func (m *GetPackagingResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetPackagingId() <= 0 {
-		err := UpdatePackagingRequestValidationError{
-			field:  "PackagingId",
-			reason: "value must be greater than 0",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for PackagingId
 
-	if utf8.RuneCountInString(m.GetPackagingType()) < 1 {
-		err := UpdatePackagingRequestValidationError{
-			field:  "PackagingType",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for PackagingType
 
-	if m.GetCost() < 0 {
-		err := UpdatePackagingRequestValidationError{
-			field:  "Cost",
-			reason: "value must be greater than or equal to 0",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Cost
 
-	if m.GetMaxWeight() <= 0 {
-		err := UpdatePackagingRequestValidationError{
-			field:  "MaxWeight",
-			reason: "value must be greater than 0",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for MaxWeight
 
 	if len(errors) > 0 {
-		return UpdatePackagingRequestMultiError(errors)
+		return GetPackagingResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdatePackagingRequestMultiError is an error wrapping multiple validation
-// errors returned by UpdatePackagingRequest.ValidateAll() if the designated
+// GetPackagingResponseMultiError is an error wrapping multiple validation
+// errors returned by GetPackagingResponse.ValidateAll() if the designated
 // constraints aren't met.
-type UpdatePackagingRequestMultiError []error
+type GetPackagingResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdatePackagingRequestMultiError) Error() string {
+func (m GetPackagingResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4020,11 +4305,11 @@ func (m UpdatePackagingRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdatePackagingRequestMultiError) AllErrors() []error { return m }
+func (m GetPackagingResponseMultiError) AllErrors() []error { return m }
 
-// UpdatePackagingRequestValidationError is the validation error returned by
-// UpdatePackagingRequest.Validate if the designated constraints aren't met.
-type UpdatePackagingRequestValidationError struct {
+// GetPackagingResponseValidationError is the validation error returned by
+// GetPackagingResponse.Validate if the designated constraints aren't met.
+type GetPackagingResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4032,24 +4317,24 @@ type UpdatePackagingRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdatePackagingRequestValidationError) Field() string { return e.field }
+func (e GetPackagingResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdatePackagingRequestValidationError) Reason() string { return e.reason }
+func (e GetPackagingResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdatePackagingRequestValidationError) Cause() error { return e.cause }
+func (e GetPackagingResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdatePackagingRequestValidationError) Key() bool { return e.key }
+func (e GetPackagingResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdatePackagingRequestValidationError) ErrorName() string {
-	return "UpdatePackagingRequestValidationError"
+func (e GetPackagingResponseValidationError) ErrorName() string {
+	return "GetPackagingResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdatePackagingRequestValidationError) Error() string {
+func (e GetPackagingResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4061,14 +4346,14 @@ func (e UpdatePackagingRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdatePackagingRequest.%s: %s%s",
+		"invalid %sGetPackagingResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdatePackagingRequestValidationError{}
+var _ error = GetPackagingResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -4076,59 +4361,2230 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdatePackagingRequestValidationError{}
+} = GetPackagingResponseValidationError{}
 
-// Validate checks the field values on UpdatePackagingResponse with the rules
+// Validate checks the field values on GetAllPackagingResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdatePackagingResponse) Validate() error {
+func (m *GetAllPackagingResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdatePackagingResponse with the
+// ValidateAll checks the field values on GetAllPackagingResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdatePackagingResponseMultiError, or nil if none found.
-func (m *UpdatePackagingResponse) ValidateAll() error {
+// GetAllPackagingResponseMultiError, or nil if none found.
+func (m *GetAllPackagingResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdatePackagingResponse) validate(all bool) error {
+func (m *GetAllPackagingResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Message
-
-	if len(errors) > 0 {
-		return UpdatePackagingResponseMultiError(errors)
-	}
-
-	return nil
-}
-
-// UpdatePackagingResponseMultiError is an error wrapping multiple validation
-// errors returned by UpdatePackagingResponse.ValidateAll() if the designated
-// constraints aren't met.
-type UpdatePackagingResponseMultiError []error
-
-// Error returns a concatenation of all the error messages it wraps.
-func (m UpdatePackagingResponseMultiError) Error() string {
-	var msgs []string
-	for _, err := range m {
-		msgs = append(msgs, err.Error())
-	}
-	return strings.Join(msgs, "; ")
-}
+	for idx, item := range m.GetPackagingOptions() {
+		_, _ = idx, item
 
-// AllErrors returns a list of validation violation errors.
-func (m UpdatePackagingResponseMultiError) AllErrors() []error { return m }
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetAllPackagingResponseValidationError{
+						field:  fmt.Sprintf("PackagingOptions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetAllPackagingResponseValidationError{
+						field:  fmt.Sprintf("PackagingOptions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetAllPackagingResponseValidationError{
+					field:  fmt.Sprintf("PackagingOptions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetAllPackagingResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAllPackagingResponseMultiError is an error wrapping multiple validation
+// errors returned by GetAllPackagingResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetAllPackagingResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAllPackagingResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAllPackagingResponseMultiError) AllErrors() []error { return m }
+
+// GetAllPackagingResponseValidationError is the validation error returned by
+// GetAllPackagingResponse.Validate if the designated constraints aren't met.
+type GetAllPackagingResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAllPackagingResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAllPackagingResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAllPackagingResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAllPackagingResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAllPackagingResponseValidationError) ErrorName() string {
+	return "GetAllPackagingResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAllPackagingResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAllPackagingResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAllPackagingResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAllPackagingResponseValidationError{}
+
+// Validate checks the field values on Packaging with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Packaging) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Packaging with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PackagingMultiError, or nil
+// if none found.
+func (m *Packaging) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Packaging) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PackagingId
+
+	// no validation rules for PackagingType
+
+	// no validation rules for Cost
+
+	// no validation rules for MaxWeight
+
+	if len(errors) > 0 {
+		return PackagingMultiError(errors)
+	}
+
+	return nil
+}
+
+// PackagingMultiError is an error wrapping multiple validation errors returned
+// by Packaging.ValidateAll() if the designated constraints aren't met.
+type PackagingMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PackagingMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PackagingMultiError) AllErrors() []error { return m }
+
+// PackagingValidationError is the validation error returned by
+// Packaging.Validate if the designated constraints aren't met.
+type PackagingValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PackagingValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PackagingValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PackagingValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PackagingValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PackagingValidationError) ErrorName() string { return "PackagingValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PackagingValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPackaging.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PackagingValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PackagingValidationError{}
+
+// Validate checks the field values on UpdatePackagingRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdatePackagingRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdatePackagingRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdatePackagingRequestMultiError, or nil if none found.
+func (m *UpdatePackagingRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdatePackagingRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetPackagingId() <= 0 {
+		err := UpdatePackagingRequestValidationError{
+			field:  "PackagingId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetPackagingType()) < 1 {
+		err := UpdatePackagingRequestValidationError{
+			field:  "PackagingType",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetCost() < 0 {
+		err := UpdatePackagingRequestValidationError{
+			field:  "Cost",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMaxWeight() <= 0 {
+		err := UpdatePackagingRequestValidationError{
+			field:  "MaxWeight",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UpdatePackagingRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdatePackagingRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdatePackagingRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdatePackagingRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdatePackagingRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdatePackagingRequestMultiError) AllErrors() []error { return m }
+
+// UpdatePackagingRequestValidationError is the validation error returned by
+// UpdatePackagingRequest.Validate if the designated constraints aren't met.
+type UpdatePackagingRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdatePackagingRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdatePackagingRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdatePackagingRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdatePackagingRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdatePackagingRequestValidationError) ErrorName() string {
+	return "UpdatePackagingRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdatePackagingRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdatePackagingRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdatePackagingRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdatePackagingRequestValidationError{}
+
+// Validate checks the field values on UpdatePackagingResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdatePackagingResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdatePackagingResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdatePackagingResponseMultiError, or nil if none found.
+func (m *UpdatePackagingResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdatePackagingResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return UpdatePackagingResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdatePackagingResponseMultiError is an error wrapping multiple validation
+// errors returned by UpdatePackagingResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UpdatePackagingResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdatePackagingResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdatePackagingResponseMultiError) AllErrors() []error { return m }
+
+// UpdatePackagingResponseValidationError is the validation error returned by
+// UpdatePackagingResponse.Validate if the designated constraints aren't met.
+type UpdatePackagingResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdatePackagingResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdatePackagingResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdatePackagingResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdatePackagingResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdatePackagingResponseValidationError) ErrorName() string {
+	return "UpdatePackagingResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdatePackagingResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdatePackagingResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdatePackagingResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdatePackagingResponseValidationError{}
+
+// Validate checks the field values on DeletePackagingRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeletePackagingRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeletePackagingRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeletePackagingRequestMultiError, or nil if none found.
+func (m *DeletePackagingRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeletePackagingRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetPackagingId() <= 0 {
+		err := DeletePackagingRequestValidationError{
+			field:  "PackagingId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeletePackagingRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeletePackagingRequestMultiError is an error wrapping multiple validation
+// errors returned by DeletePackagingRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeletePackagingRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeletePackagingRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeletePackagingRequestMultiError) AllErrors() []error { return m }
+
+// DeletePackagingRequestValidationError is the validation error returned by
+// DeletePackagingRequest.Validate if the designated constraints aren't met.
+type DeletePackagingRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeletePackagingRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeletePackagingRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeletePackagingRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeletePackagingRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeletePackagingRequestValidationError) ErrorName() string {
+	return "DeletePackagingRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeletePackagingRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeletePackagingRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeletePackagingRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeletePackagingRequestValidationError{}
+
+// Validate checks the field values on DeletePackagingResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeletePackagingResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeletePackagingResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeletePackagingResponseMultiError, or nil if none found.
+func (m *DeletePackagingResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeletePackagingResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return DeletePackagingResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeletePackagingResponseMultiError is an error wrapping multiple validation
+// errors returned by DeletePackagingResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeletePackagingResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeletePackagingResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeletePackagingResponseMultiError) AllErrors() []error { return m }
+
+// DeletePackagingResponseValidationError is the validation error returned by
+// DeletePackagingResponse.Validate if the designated constraints aren't met.
+type DeletePackagingResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeletePackagingResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeletePackagingResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeletePackagingResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeletePackagingResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeletePackagingResponseValidationError) ErrorName() string {
+	return "DeletePackagingResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeletePackagingResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeletePackagingResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeletePackagingResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeletePackagingResponseValidationError{}
+
+// Validate checks the field values on CreateReturnRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateReturnRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateReturnRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateReturnRequestMultiError, or nil if none found.
+func (m *CreateReturnRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateReturnRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetOrderId() <= 0 {
+		err := CreateReturnRequestValidationError{
+			field:  "OrderId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreateReturnRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateReturnRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateReturnRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateReturnRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateReturnRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateReturnRequestMultiError) AllErrors() []error { return m }
+
+// CreateReturnRequestValidationError is the validation error returned by
+// CreateReturnRequest.Validate if the designated constraints aren't met.
+type CreateReturnRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateReturnRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateReturnRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateReturnRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateReturnRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateReturnRequestValidationError) ErrorName() string {
+	return "CreateReturnRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateReturnRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateReturnRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateReturnRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateReturnRequestValidationError{}
+
+// Validate checks the field values on CreateReturnResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateReturnResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateReturnResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateReturnResponseMultiError, or nil if none found.
+func (m *CreateReturnResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateReturnResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return CreateReturnResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateReturnResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateReturnResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateReturnResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateReturnResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateReturnResponseMultiError) AllErrors() []error { return m }
+
+// CreateReturnResponseValidationError is the validation error returned by
+// CreateReturnResponse.Validate if the designated constraints aren't met.
+type CreateReturnResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateReturnResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateReturnResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateReturnResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateReturnResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateReturnResponseValidationError) ErrorName() string {
+	return "CreateReturnResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateReturnResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateReturnResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateReturnResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateReturnResponseValidationError{}
+
+// Validate checks the field values on GetReturnsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetReturnsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetReturnsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetReturnsResponseMultiError, or nil if none found.
+func (m *GetReturnsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetReturnsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetReturns() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetReturnsResponseValidationError{
+						field:  fmt.Sprintf("Returns[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetReturnsResponseValidationError{
+						field:  fmt.Sprintf("Returns[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetReturnsResponseValidationError{
+					field:  fmt.Sprintf("Returns[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetReturnsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetReturnsResponseMultiError is an error wrapping multiple validation errors
+// returned by GetReturnsResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetReturnsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetReturnsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetReturnsResponseMultiError) AllErrors() []error { return m }
+
+// GetReturnsResponseValidationError is the validation error returned by
+// GetReturnsResponse.Validate if the designated constraints aren't met.
+type GetReturnsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetReturnsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetReturnsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetReturnsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetReturnsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetReturnsResponseValidationError) ErrorName() string {
+	return "GetReturnsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetReturnsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetReturnsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetReturnsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetReturnsResponseValidationError{}
+
+// Validate checks the field values on GetReturnByOrderIDRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetReturnByOrderIDRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetReturnByOrderIDRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetReturnByOrderIDRequestMultiError, or nil if none found.
+func (m *GetReturnByOrderIDRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetReturnByOrderIDRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetOrderId() <= 0 {
+		err := GetReturnByOrderIDRequestValidationError{
+			field:  "OrderId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetReturnByOrderIDRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetReturnByOrderIDRequestMultiError is an error wrapping multiple validation
+// errors returned by GetReturnByOrderIDRequest.ValidateAll() if the
+// designated constraints aren't met.
+type GetReturnByOrderIDRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetReturnByOrderIDRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetReturnByOrderIDRequestMultiError) AllErrors() []error { return m }
+
+// GetReturnByOrderIDRequestValidationError is the validation error returned by
+// GetReturnByOrderIDRequest.Validate if the designated constraints aren't met.
+type GetReturnByOrderIDRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetReturnByOrderIDRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetReturnByOrderIDRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetReturnByOrderIDRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetReturnByOrderIDRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetReturnByOrderIDRequestValidationError) ErrorName() string {
+	return "GetReturnByOrderIDRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetReturnByOrderIDRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetReturnByOrderIDRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetReturnByOrderIDRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetReturnByOrderIDRequestValidationError{}
+
+// Validate checks the field values on GetReturnByOrderIDResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetReturnByOrderIDResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetReturnByOrderIDResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetReturnByOrderIDResponseMultiError, or nil if none found.
+func (m *GetReturnByOrderIDResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetReturnByOrderIDResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetReturnInfo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetReturnByOrderIDResponseValidationError{
+					field:  "ReturnInfo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetReturnByOrderIDResponseValidationError{
+					field:  "ReturnInfo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReturnInfo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetReturnByOrderIDResponseValidationError{
+				field:  "ReturnInfo",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetReturnByOrderIDResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetReturnByOrderIDResponseMultiError is an error wrapping multiple
+// validation errors returned by GetReturnByOrderIDResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetReturnByOrderIDResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetReturnByOrderIDResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetReturnByOrderIDResponseMultiError) AllErrors() []error { return m }
+
+// GetReturnByOrderIDResponseValidationError is the validation error returned
+// by GetReturnByOrderIDResponse.Validate if the designated constraints aren't met.
+type GetReturnByOrderIDResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetReturnByOrderIDResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetReturnByOrderIDResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetReturnByOrderIDResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetReturnByOrderIDResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetReturnByOrderIDResponseValidationError) ErrorName() string {
+	return "GetReturnByOrderIDResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetReturnByOrderIDResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetReturnByOrderIDResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetReturnByOrderIDResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetReturnByOrderIDResponseValidationError{}
+
+// Validate checks the field values on GetReturnsByUserIDRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetReturnsByUserIDRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetReturnsByUserIDRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetReturnsByUserIDRequestMultiError, or nil if none found.
+func (m *GetReturnsByUserIDRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetReturnsByUserIDRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUserId() <= 0 {
+		err := GetReturnsByUserIDRequestValidationError{
+			field:  "UserId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetReturnsByUserIDRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetReturnsByUserIDRequestMultiError is an error wrapping multiple validation
+// errors returned by GetReturnsByUserIDRequest.ValidateAll() if the
+// designated constraints aren't met.
+type GetReturnsByUserIDRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetReturnsByUserIDRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetReturnsByUserIDRequestMultiError) AllErrors() []error { return m }
+
+// GetReturnsByUserIDRequestValidationError is the validation error returned by
+// GetReturnsByUserIDRequest.Validate if the designated constraints aren't met.
+type GetReturnsByUserIDRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetReturnsByUserIDRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetReturnsByUserIDRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetReturnsByUserIDRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetReturnsByUserIDRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetReturnsByUserIDRequestValidationError) ErrorName() string {
+	return "GetReturnsByUserIDRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetReturnsByUserIDRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetReturnsByUserIDRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetReturnsByUserIDRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetReturnsByUserIDRequestValidationError{}
+
+// Validate checks the field values on GetReturnsByUserIDResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetReturnsByUserIDResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetReturnsByUserIDResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetReturnsByUserIDResponseMultiError, or nil if none found.
+func (m *GetReturnsByUserIDResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetReturnsByUserIDResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetReturns() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetReturnsByUserIDResponseValidationError{
+						field:  fmt.Sprintf("Returns[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetReturnsByUserIDResponseValidationError{
+						field:  fmt.Sprintf("Returns[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetReturnsByUserIDResponseValidationError{
+					field:  fmt.Sprintf("Returns[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetReturnsByUserIDResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetReturnsByUserIDResponseMultiError is an error wrapping multiple
+// validation errors returned by GetReturnsByUserIDResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetReturnsByUserIDResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetReturnsByUserIDResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetReturnsByUserIDResponseMultiError) AllErrors() []error { return m }
+
+// GetReturnsByUserIDResponseValidationError is the validation error returned
+// by GetReturnsByUserIDResponse.Validate if the designated constraints aren't met.
+type GetReturnsByUserIDResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetReturnsByUserIDResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetReturnsByUserIDResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetReturnsByUserIDResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetReturnsByUserIDResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetReturnsByUserIDResponseValidationError) ErrorName() string {
+	return "GetReturnsByUserIDResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetReturnsByUserIDResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetReturnsByUserIDResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetReturnsByUserIDResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetReturnsByUserIDResponseValidationError{}
+
+// Validate checks the field values on ListReturnsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListReturnsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListReturnsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListReturnsRequestMultiError, or nil if none found.
+func (m *ListReturnsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListReturnsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if val := m.GetPageSize(); val < 0 || val > 500 {
+		err := ListReturnsRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [0, 500]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	if m.GetUserId() < 0 {
+		err := ListReturnsRequestValidationError{
+			field:  "UserId",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetStatusId() < 0 {
+		err := ListReturnsRequestValidationError{
+			field:  "StatusId",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPackagingId() < 0 {
+		err := ListReturnsRequestValidationError{
+			field:  "PackagingId",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetReasonId() < 0 {
+		err := ListReturnsRequestValidationError{
+			field:  "ReasonId",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for ReturnedFrom
+
+	// no validation rules for ReturnedTo
+
+	if len(errors) > 0 {
+		return ListReturnsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListReturnsRequestMultiError is an error wrapping multiple validation errors
+// returned by ListReturnsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListReturnsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListReturnsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListReturnsRequestMultiError) AllErrors() []error { return m }
+
+// ListReturnsRequestValidationError is the validation error returned by
+// ListReturnsRequest.Validate if the designated constraints aren't met.
+type ListReturnsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListReturnsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListReturnsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListReturnsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListReturnsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListReturnsRequestValidationError) ErrorName() string {
+	return "ListReturnsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListReturnsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListReturnsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListReturnsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListReturnsRequestValidationError{}
+
+// Validate checks the field values on ListReturnsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListReturnsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListReturnsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListReturnsResponseMultiError, or nil if none found.
+func (m *ListReturnsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListReturnsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetReturns() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListReturnsResponseValidationError{
+						field:  fmt.Sprintf("Returns[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListReturnsResponseValidationError{
+						field:  fmt.Sprintf("Returns[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListReturnsResponseValidationError{
+					field:  fmt.Sprintf("Returns[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListReturnsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListReturnsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListReturnsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListReturnsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListReturnsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListReturnsResponseMultiError) AllErrors() []error { return m }
+
+// ListReturnsResponseValidationError is the validation error returned by
+// ListReturnsResponse.Validate if the designated constraints aren't met.
+type ListReturnsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListReturnsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListReturnsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListReturnsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListReturnsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListReturnsResponseValidationError) ErrorName() string {
+	return "ListReturnsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListReturnsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListReturnsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListReturnsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListReturnsResponseValidationError{}
+
+// Validate checks the field values on UpdateReturnRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateReturnRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateReturnRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateReturnRequestMultiError, or nil if none found.
+func (m *UpdateReturnRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateReturnRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetReturnId() <= 0 {
+		err := UpdateReturnRequestValidationError{
+			field:  "ReturnId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetOrderId() <= 0 {
+		err := UpdateReturnRequestValidationError{
+			field:  "OrderId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetUserId() <= 0 {
+		err := UpdateReturnRequestValidationError{
+			field:  "UserId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetReasonId() <= 0 {
+		err := UpdateReturnRequestValidationError{
+			field:  "ReasonId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetBaseCost() < 0 {
+		err := UpdateReturnRequestValidationError{
+			field:  "BaseCost",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPackagingCost() < 0 {
+		err := UpdateReturnRequestValidationError{
+			field:  "PackagingCost",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetTotalCost() < 0 {
+		err := UpdateReturnRequestValidationError{
+			field:  "TotalCost",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPackagingId() <= 0 {
+		err := UpdateReturnRequestValidationError{
+			field:  "PackagingId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetStatusId() <= 0 {
+		err := UpdateReturnRequestValidationError{
+			field:  "StatusId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UpdateReturnRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateReturnRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateReturnRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateReturnRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateReturnRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateReturnRequestMultiError) AllErrors() []error { return m }
+
+// UpdateReturnRequestValidationError is the validation error returned by
+// UpdateReturnRequest.Validate if the designated constraints aren't met.
+type UpdateReturnRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateReturnRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateReturnRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateReturnRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateReturnRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateReturnRequestValidationError) ErrorName() string {
+	return "UpdateReturnRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateReturnRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateReturnRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateReturnRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateReturnRequestValidationError{}
+
+// Validate checks the field values on UpdateReturnResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateReturnResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateReturnResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateReturnResponseMultiError, or nil if none found.
+func (m *UpdateReturnResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateReturnResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return UpdateReturnResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateReturnResponseMultiError is an error wrapping multiple validation
+// errors returned by UpdateReturnResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateReturnResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateReturnResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateReturnResponseMultiError) AllErrors() []error { return m }
+
+// UpdateReturnResponseValidationError is the validation error returned by
+// UpdateReturnResponse.Validate if the designated constraints aren't met.
+type UpdateReturnResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateReturnResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateReturnResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateReturnResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateReturnResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateReturnResponseValidationError) ErrorName() string {
+	return "UpdateReturnResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateReturnResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateReturnResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateReturnResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateReturnResponseValidationError{}
+
+// Validate checks the field values on DeleteReturnRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteReturnRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteReturnRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteReturnRequestMultiError, or nil if none found.
+func (m *DeleteReturnRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteReturnRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetReturnId() <= 0 {
+		err := DeleteReturnRequestValidationError{
+			field:  "ReturnId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-// UpdatePackagingResponseValidationError is the validation error returned by
-// UpdatePackagingResponse.Validate if the designated constraints aren't met.
-type UpdatePackagingResponseValidationError struct {
+	if len(errors) > 0 {
+		return DeleteReturnRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteReturnRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteReturnRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteReturnRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteReturnRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteReturnRequestMultiError) AllErrors() []error { return m }
+
+// DeleteReturnRequestValidationError is the validation error returned by
+// DeleteReturnRequest.Validate if the designated constraints aren't met.
+type DeleteReturnRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4136,24 +6592,24 @@ type UpdatePackagingResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdatePackagingResponseValidationError) Field() string { return e.field }
+func (e DeleteReturnRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdatePackagingResponseValidationError) Reason() string { return e.reason }
+func (e DeleteReturnRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdatePackagingResponseValidationError) Cause() error { return e.cause }
+func (e DeleteReturnRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdatePackagingResponseValidationError) Key() bool { return e.key }
+func (e DeleteReturnRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdatePackagingResponseValidationError) ErrorName() string {
-	return "UpdatePackagingResponseValidationError"
+func (e DeleteReturnRequestValidationError) ErrorName() string {
+	return "DeleteReturnRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdatePackagingResponseValidationError) Error() string {
+func (e DeleteReturnRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4165,14 +6621,14 @@ func (e UpdatePackagingResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdatePackagingResponse.%s: %s%s",
+		"invalid %sDeleteReturnRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdatePackagingResponseValidationError{}
+var _ error = DeleteReturnRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -4180,55 +6636,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdatePackagingResponseValidationError{}
+} = DeleteReturnRequestValidationError{}
 
-// Validate checks the field values on DeletePackagingRequest with the rules
+// Validate checks the field values on DeleteReturnResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeletePackagingRequest) Validate() error {
+func (m *DeleteReturnResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeletePackagingRequest with the rules
+// ValidateAll checks the field values on DeleteReturnResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeletePackagingRequestMultiError, or nil if none found.
-func (m *DeletePackagingRequest) ValidateAll() error {
+// DeleteReturnResponseMultiError, or nil if none found.
+func (m *DeleteReturnResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeletePackagingRequest) validate(all bool) error {
+func (m *DeleteReturnResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetPackagingId() <= 0 {
-		err := DeletePackagingRequestValidationError{
-			field:  "PackagingId",
-			reason: "value must be greater than 0",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Message
 
 	if len(errors) > 0 {
-		return DeletePackagingRequestMultiError(errors)
+		return DeleteReturnResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeletePackagingRequestMultiError is an error wrapping multiple validation
-// errors returned by DeletePackagingRequest.ValidateAll() if the designated
+// DeleteReturnResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteReturnResponse.ValidateAll() if the designated
 // constraints aren't met.
-type DeletePackagingRequestMultiError []error
+type DeleteReturnResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeletePackagingRequestMultiError) Error() string {
+func (m DeleteReturnResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4237,11 +6684,11 @@ func (m DeletePackagingRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeletePackagingRequestMultiError) AllErrors() []error { return m }
+func (m DeleteReturnResponseMultiError) AllErrors() []error { return m }
 
-// DeletePackagingRequestValidationError is the validation error returned by
-// DeletePackagingRequest.Validate if the designated constraints aren't met.
-type DeletePackagingRequestValidationError struct {
+// DeleteReturnResponseValidationError is the validation error returned by
+// DeleteReturnResponse.Validate if the designated constraints aren't met.
+type DeleteReturnResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4249,24 +6696,24 @@ type DeletePackagingRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeletePackagingRequestValidationError) Field() string { return e.field }
+func (e DeleteReturnResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeletePackagingRequestValidationError) Reason() string { return e.reason }
+func (e DeleteReturnResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeletePackagingRequestValidationError) Cause() error { return e.cause }
+func (e DeleteReturnResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeletePackagingRequestValidationError) Key() bool { return e.key }
+func (e DeleteReturnResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeletePackagingRequestValidationError) ErrorName() string {
-	return "DeletePackagingRequestValidationError"
+func (e DeleteReturnResponseValidationError) ErrorName() string {
+	return "DeleteReturnResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeletePackagingRequestValidationError) Error() string {
+func (e DeleteReturnResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4278,14 +6725,14 @@ func (e DeletePackagingRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeletePackagingRequest.%s: %s%s",
+		"invalid %sDeleteReturnResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeletePackagingRequestValidationError{}
+var _ error = DeleteReturnResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -4293,46 +6740,64 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeletePackagingRequestValidationError{}
+} = DeleteReturnResponseValidationError{}
 
-// Validate checks the field values on DeletePackagingResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeletePackagingResponse) Validate() error {
+// Validate checks the field values on ReturnResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ReturnResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeletePackagingResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// DeletePackagingResponseMultiError, or nil if none found.
-func (m *DeletePackagingResponse) ValidateAll() error {
+// ValidateAll checks the field values on ReturnResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ReturnResponseMultiError,
+// or nil if none found.
+func (m *ReturnResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeletePackagingResponse) validate(all bool) error {
+func (m *ReturnResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Message
+	// no validation rules for ReturnId
+
+	// no validation rules for OrderId
+
+	// no validation rules for UserId
+
+	// no validation rules for ReasonId
+
+	// no validation rules for BaseCost
+
+	// no validation rules for PackagingCost
+
+	// no validation rules for TotalCost
+
+	// no validation rules for PackagingId
+
+	// no validation rules for StatusId
+
+	// no validation rules for ReturnDate
 
 	if len(errors) > 0 {
-		return DeletePackagingResponseMultiError(errors)
+		return ReturnResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeletePackagingResponseMultiError is an error wrapping multiple validation
-// errors returned by DeletePackagingResponse.ValidateAll() if the designated
-// constraints aren't met.
-type DeletePackagingResponseMultiError []error
+// ReturnResponseMultiError is an error wrapping multiple validation errors
+// returned by ReturnResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ReturnResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeletePackagingResponseMultiError) Error() string {
+func (m ReturnResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4341,11 +6806,11 @@ func (m DeletePackagingResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeletePackagingResponseMultiError) AllErrors() []error { return m }
+func (m ReturnResponseMultiError) AllErrors() []error { return m }
 
-// DeletePackagingResponseValidationError is the validation error returned by
-// DeletePackagingResponse.Validate if the designated constraints aren't met.
-type DeletePackagingResponseValidationError struct {
+// ReturnResponseValidationError is the validation error returned by
+// ReturnResponse.Validate if the designated constraints aren't met.
+type ReturnResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4353,24 +6818,22 @@ type DeletePackagingResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeletePackagingResponseValidationError) Field() string { return e.field }
+func (e ReturnResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeletePackagingResponseValidationError) Reason() string { return e.reason }
+func (e ReturnResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeletePackagingResponseValidationError) Cause() error { return e.cause }
+func (e ReturnResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeletePackagingResponseValidationError) Key() bool { return e.key }
+func (e ReturnResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeletePackagingResponseValidationError) ErrorName() string {
-	return "DeletePackagingResponseValidationError"
-}
+func (e ReturnResponseValidationError) ErrorName() string { return "ReturnResponseValidationError" }
 
 // Error satisfies the builtin error interface
-func (e DeletePackagingResponseValidationError) Error() string {
+func (e ReturnResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4382,14 +6845,14 @@ func (e DeletePackagingResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeletePackagingResponse.%s: %s%s",
+		"invalid %sReturnResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeletePackagingResponseValidationError{}
+var _ error = ReturnResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -4397,24 +6860,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeletePackagingResponseValidationError{}
+} = ReturnResponseValidationError{}
 
-// Validate checks the field values on CreateReturnRequest with the rules
+// Validate checks the field values on ProcessReturnRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateReturnRequest) Validate() error {
+func (m *ProcessReturnRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateReturnRequest with the rules
+// ValidateAll checks the field values on ProcessReturnRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateReturnRequestMultiError, or nil if none found.
-func (m *CreateReturnRequest) ValidateAll() error {
+// ProcessReturnRequestMultiError, or nil if none found.
+func (m *ProcessReturnRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateReturnRequest) validate(all bool) error {
+func (m *ProcessReturnRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -4422,7 +6885,7 @@ func (m *CreateReturnRequest) validate(all bool) error {
 	var errors []error
 
 	if m.GetOrderId() <= 0 {
-		err := CreateReturnRequestValidationError{
+		err := ProcessReturnRequestValidationError{
 			field:  "OrderId",
 			reason: "value must be greater than 0",
 		}
@@ -4433,19 +6896,19 @@ func (m *CreateReturnRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return CreateReturnRequestMultiError(errors)
+		return ProcessReturnRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateReturnRequestMultiError is an error wrapping multiple validation
-// errors returned by CreateReturnRequest.ValidateAll() if the designated
+// ProcessReturnRequestMultiError is an error wrapping multiple validation
+// errors returned by ProcessReturnRequest.ValidateAll() if the designated
 // constraints aren't met.
-type CreateReturnRequestMultiError []error
+type ProcessReturnRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateReturnRequestMultiError) Error() string {
+func (m ProcessReturnRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4454,11 +6917,11 @@ func (m CreateReturnRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateReturnRequestMultiError) AllErrors() []error { return m }
+func (m ProcessReturnRequestMultiError) AllErrors() []error { return m }
 
-// CreateReturnRequestValidationError is the validation error returned by
-// CreateReturnRequest.Validate if the designated constraints aren't met.
-type CreateReturnRequestValidationError struct {
+// ProcessReturnRequestValidationError is the validation error returned by
+// ProcessReturnRequest.Validate if the designated constraints aren't met.
+type ProcessReturnRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4466,24 +6929,24 @@ type CreateReturnRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateReturnRequestValidationError) Field() string { return e.field }
+func (e ProcessReturnRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateReturnRequestValidationError) Reason() string { return e.reason }
+func (e ProcessReturnRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateReturnRequestValidationError) Cause() error { return e.cause }
+func (e ProcessReturnRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateReturnRequestValidationError) Key() bool { return e.key }
+func (e ProcessReturnRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateReturnRequestValidationError) ErrorName() string {
-	return "CreateReturnRequestValidationError"
+func (e ProcessReturnRequestValidationError) ErrorName() string {
+	return "ProcessReturnRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateReturnRequestValidationError) Error() string {
+func (e ProcessReturnRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4495,14 +6958,14 @@ func (e CreateReturnRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateReturnRequest.%s: %s%s",
+		"invalid %sProcessReturnRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateReturnRequestValidationError{}
+var _ error = ProcessReturnRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -4510,24 +6973,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateReturnRequestValidationError{}
+} = ProcessReturnRequestValidationError{}
 
-// Validate checks the field values on CreateReturnResponse with the rules
+// Validate checks the field values on ProcessReturnResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateReturnResponse) Validate() error {
+func (m *ProcessReturnResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateReturnResponse with the rules
+// ValidateAll checks the field values on ProcessReturnResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateReturnResponseMultiError, or nil if none found.
-func (m *CreateReturnResponse) ValidateAll() error {
+// ProcessReturnResponseMultiError, or nil if none found.
+func (m *ProcessReturnResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateReturnResponse) validate(all bool) error {
+func (m *ProcessReturnResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -4537,19 +7000,19 @@ func (m *CreateReturnResponse) validate(all bool) error {
 	// no validation rules for Message
 
 	if len(errors) > 0 {
-		return CreateReturnResponseMultiError(errors)
+		return ProcessReturnResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateReturnResponseMultiError is an error wrapping multiple validation
-// errors returned by CreateReturnResponse.ValidateAll() if the designated
+// ProcessReturnResponseMultiError is an error wrapping multiple validation
+// errors returned by ProcessReturnResponse.ValidateAll() if the designated
 // constraints aren't met.
-type CreateReturnResponseMultiError []error
+type ProcessReturnResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateReturnResponseMultiError) Error() string {
+func (m ProcessReturnResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4558,11 +7021,11 @@ func (m CreateReturnResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateReturnResponseMultiError) AllErrors() []error { return m }
+func (m ProcessReturnResponseMultiError) AllErrors() []error { return m }
 
-// CreateReturnResponseValidationError is the validation error returned by
-// CreateReturnResponse.Validate if the designated constraints aren't met.
-type CreateReturnResponseValidationError struct {
+// ProcessReturnResponseValidationError is the validation error returned by
+// ProcessReturnResponse.Validate if the designated constraints aren't met.
+type ProcessReturnResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4570,24 +7033,24 @@ type CreateReturnResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateReturnResponseValidationError) Field() string { return e.field }
+func (e ProcessReturnResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateReturnResponseValidationError) Reason() string { return e.reason }
+func (e ProcessReturnResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateReturnResponseValidationError) Cause() error { return e.cause }
+func (e ProcessReturnResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateReturnResponseValidationError) Key() bool { return e.key }
+func (e ProcessReturnResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateReturnResponseValidationError) ErrorName() string {
-	return "CreateReturnResponseValidationError"
+func (e ProcessReturnResponseValidationError) ErrorName() string {
+	return "ProcessReturnResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateReturnResponseValidationError) Error() string {
+func (e ProcessReturnResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4599,14 +7062,14 @@ func (e CreateReturnResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateReturnResponse.%s: %s%s",
+		"invalid %sProcessReturnResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateReturnResponseValidationError{}
+var _ error = ProcessReturnResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -4614,78 +7077,55 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateReturnResponseValidationError{}
+} = ProcessReturnResponseValidationError{}
 
-// Validate checks the field values on GetReturnsResponse with the rules
+// Validate checks the field values on CreateReturnReasonRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetReturnsResponse) Validate() error {
+func (m *CreateReturnReasonRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetReturnsResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on CreateReturnReasonRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetReturnsResponseMultiError, or nil if none found.
-func (m *GetReturnsResponse) ValidateAll() error {
+// CreateReturnReasonRequestMultiError, or nil if none found.
+func (m *CreateReturnReasonRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetReturnsResponse) validate(all bool) error {
+func (m *CreateReturnReasonRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetReturns() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetReturnsResponseValidationError{
-						field:  fmt.Sprintf("Returns[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, GetReturnsResponseValidationError{
-						field:  fmt.Sprintf("Returns[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return GetReturnsResponseValidationError{
-					field:  fmt.Sprintf("Returns[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
+	if utf8.RuneCountInString(m.GetReason()) < 1 {
+		err := CreateReturnReasonRequestValidationError{
+			field:  "Reason",
+			reason: "value length must be at least 1 runes",
 		}
-
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
 	if len(errors) > 0 {
-		return GetReturnsResponseMultiError(errors)
+		return CreateReturnReasonRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetReturnsResponseMultiError is an error wrapping multiple validation errors
-// returned by GetReturnsResponse.ValidateAll() if the designated constraints
-// aren't met.
-type GetReturnsResponseMultiError []error
+// CreateReturnReasonRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateReturnReasonRequest.ValidateAll() if the
+// designated constraints aren't met.
+type CreateReturnReasonRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetReturnsResponseMultiError) Error() string {
+func (m CreateReturnReasonRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4694,11 +7134,11 @@ func (m GetReturnsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetReturnsResponseMultiError) AllErrors() []error { return m }
+func (m CreateReturnReasonRequestMultiError) AllErrors() []error { return m }
 
-// GetReturnsResponseValidationError is the validation error returned by
-// GetReturnsResponse.Validate if the designated constraints aren't met.
-type GetReturnsResponseValidationError struct {
+// CreateReturnReasonRequestValidationError is the validation error returned by
+// CreateReturnReasonRequest.Validate if the designated constraints aren't met.
+type CreateReturnReasonRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4706,24 +7146,24 @@ type GetReturnsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetReturnsResponseValidationError) Field() string { return e.field }
+func (e CreateReturnReasonRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetReturnsResponseValidationError) Reason() string { return e.reason }
+func (e CreateReturnReasonRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetReturnsResponseValidationError) Cause() error { return e.cause }
+func (e CreateReturnReasonRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetReturnsResponseValidationError) Key() bool { return e.key }
+func (e CreateReturnReasonRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetReturnsResponseValidationError) ErrorName() string {
-	return "GetReturnsResponseValidationError"
+func (e CreateReturnReasonRequestValidationError) ErrorName() string {
+	return "CreateReturnReasonRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetReturnsResponseValidationError) Error() string {
+func (e CreateReturnReasonRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4735,14 +7175,14 @@ func (e GetReturnsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetReturnsResponse.%s: %s%s",
+		"invalid %sCreateReturnReasonRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetReturnsResponseValidationError{}
+var _ error = CreateReturnReasonRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -4750,55 +7190,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetReturnsResponseValidationError{}
+} = CreateReturnReasonRequestValidationError{}
 
-// Validate checks the field values on GetReturnByOrderIDRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on CreateReturnReasonResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetReturnByOrderIDRequest) Validate() error {
+func (m *CreateReturnReasonResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetReturnByOrderIDRequest with the
+// ValidateAll checks the field values on CreateReturnReasonResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetReturnByOrderIDRequestMultiError, or nil if none found.
-func (m *GetReturnByOrderIDRequest) ValidateAll() error {
+// CreateReturnReasonResponseMultiError, or nil if none found.
+func (m *CreateReturnReasonResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetReturnByOrderIDRequest) validate(all bool) error {
+func (m *CreateReturnReasonResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetOrderId() <= 0 {
-		err := GetReturnByOrderIDRequestValidationError{
-			field:  "OrderId",
-			reason: "value must be greater than 0",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for ReasonId
+
+	// no validation rules for Message
 
 	if len(errors) > 0 {
-		return GetReturnByOrderIDRequestMultiError(errors)
+		return CreateReturnReasonResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetReturnByOrderIDRequestMultiError is an error wrapping multiple validation
-// errors returned by GetReturnByOrderIDRequest.ValidateAll() if the
-// designated constraints aren't met.
-type GetReturnByOrderIDRequestMultiError []error
+// CreateReturnReasonResponseMultiError is an error wrapping multiple
+// validation errors returned by CreateReturnReasonResponse.ValidateAll() if
+// the designated constraints aren't met.
+type CreateReturnReasonResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetReturnByOrderIDRequestMultiError) Error() string {
+func (m CreateReturnReasonResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4807,11 +7240,11 @@ func (m GetReturnByOrderIDRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetReturnByOrderIDRequestMultiError) AllErrors() []error { return m }
+func (m CreateReturnReasonResponseMultiError) AllErrors() []error { return m }
 
-// GetReturnByOrderIDRequestValidationError is the validation error returned by
-// GetReturnByOrderIDRequest.Validate if the designated constraints aren't met.
-type GetReturnByOrderIDRequestValidationError struct {
+// CreateReturnReasonResponseValidationError is the validation error returned
+// by CreateReturnReasonResponse.Validate if the designated constraints aren't met.
+type CreateReturnReasonResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4819,24 +7252,24 @@ type GetReturnByOrderIDRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetReturnByOrderIDRequestValidationError) Field() string { return e.field }
+func (e CreateReturnReasonResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetReturnByOrderIDRequestValidationError) Reason() string { return e.reason }
+func (e CreateReturnReasonResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetReturnByOrderIDRequestValidationError) Cause() error { return e.cause }
+func (e CreateReturnReasonResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetReturnByOrderIDRequestValidationError) Key() bool { return e.key }
+func (e CreateReturnReasonResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetReturnByOrderIDRequestValidationError) ErrorName() string {
-	return "GetReturnByOrderIDRequestValidationError"
+func (e CreateReturnReasonResponseValidationError) ErrorName() string {
+	return "CreateReturnReasonResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetReturnByOrderIDRequestValidationError) Error() string {
+func (e CreateReturnReasonResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4848,14 +7281,14 @@ func (e GetReturnByOrderIDRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetReturnByOrderIDRequest.%s: %s%s",
+		"invalid %sCreateReturnReasonResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetReturnByOrderIDRequestValidationError{}
+var _ error = CreateReturnReasonResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -4863,73 +7296,55 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetReturnByOrderIDRequestValidationError{}
+} = CreateReturnReasonResponseValidationError{}
 
-// Validate checks the field values on GetReturnByOrderIDResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on GetReturnReasonRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetReturnByOrderIDResponse) Validate() error {
+func (m *GetReturnReasonRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetReturnByOrderIDResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetReturnReasonRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetReturnByOrderIDResponseMultiError, or nil if none found.
-func (m *GetReturnByOrderIDResponse) ValidateAll() error {
+// GetReturnReasonRequestMultiError, or nil if none found.
+func (m *GetReturnReasonRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetReturnByOrderIDResponse) validate(all bool) error {
+func (m *GetReturnReasonRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetReturnInfo()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetReturnByOrderIDResponseValidationError{
-					field:  "ReturnInfo",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, GetReturnByOrderIDResponseValidationError{
-					field:  "ReturnInfo",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
+	if m.GetReasonId() <= 0 {
+		err := GetReturnReasonRequestValidationError{
+			field:  "ReasonId",
+			reason: "value must be greater than 0",
 		}
-	} else if v, ok := interface{}(m.GetReturnInfo()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GetReturnByOrderIDResponseValidationError{
-				field:  "ReturnInfo",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
 	}
 
 	if len(errors) > 0 {
-		return GetReturnByOrderIDResponseMultiError(errors)
+		return GetReturnReasonRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetReturnByOrderIDResponseMultiError is an error wrapping multiple
-// validation errors returned by GetReturnByOrderIDResponse.ValidateAll() if
-// the designated constraints aren't met.
-type GetReturnByOrderIDResponseMultiError []error
-
+// GetReturnReasonRequestMultiError is an error wrapping multiple validation
+// errors returned by GetReturnReasonRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetReturnReasonRequestMultiError []error
+
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetReturnByOrderIDResponseMultiError) Error() string {
+func (m GetReturnReasonRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4938,11 +7353,11 @@ func (m GetReturnByOrderIDResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetReturnByOrderIDResponseMultiError) AllErrors() []error { return m }
+func (m GetReturnReasonRequestMultiError) AllErrors() []error { return m }
 
-// GetReturnByOrderIDResponseValidationError is the validation error returned
-// by GetReturnByOrderIDResponse.Validate if the designated constraints aren't met.
-type GetReturnByOrderIDResponseValidationError struct {
+// GetReturnReasonRequestValidationError is the validation error returned by
+// GetReturnReasonRequest.Validate if the designated constraints aren't met.
+type GetReturnReasonRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4950,24 +7365,24 @@ type GetReturnByOrderIDResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetReturnByOrderIDResponseValidationError) Field() string { return e.field }
+func (e GetReturnReasonRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetReturnByOrderIDResponseValidationError) Reason() string { return e.reason }
+func (e GetReturnReasonRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetReturnByOrderIDResponseValidationError) Cause() error { return e.cause }
+func (e GetReturnReasonRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetReturnByOrderIDResponseValidationError) Key() bool { return e.key }
+func (e GetReturnReasonRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetReturnByOrderIDResponseValidationError) ErrorName() string {
-	return "GetReturnByOrderIDResponseValidationError"
+func (e GetReturnReasonRequestValidationError) ErrorName() string {
+	return "GetReturnReasonRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetReturnByOrderIDResponseValidationError) Error() string {
+func (e GetReturnReasonRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4979,14 +7394,14 @@ func (e GetReturnByOrderIDResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetReturnByOrderIDResponse.%s: %s%s",
+		"invalid %sGetReturnReasonRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetReturnByOrderIDResponseValidationError{}
+var _ error = GetReturnReasonRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -4994,55 +7409,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetReturnByOrderIDResponseValidationError{}
+} = GetReturnReasonRequestValidationError{}
 
-// Validate checks the field values on GetReturnsByUserIDRequest with the rules
+// Validate checks the field values on GetReturnReasonResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetReturnsByUserIDRequest) Validate() error {
+func (m *GetReturnReasonResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetReturnsByUserIDRequest with the
+// ValidateAll checks the field values on GetReturnReasonResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetReturnsByUserIDRequestMultiError, or nil if none found.
-func (m *GetReturnsByUserIDRequest) ValidateAll() error {
+// GetReturnReasonResponseMultiError, or nil if none found.
+func (m *GetReturnReasonResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetReturnsByUserIDRequest) validate(all bool) error {
+func (m *GetReturnReasonResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetUserId() <= 0 {
-		err := GetReturnsByUserIDRequestValidationError{
-			field:  "UserId",
-			reason: "value must be greater than 0",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for ReasonId
+
+	// no validation rules for Reason
 
 	if len(errors) > 0 {
-		return GetReturnsByUserIDRequestMultiError(errors)
+		return GetReturnReasonResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetReturnsByUserIDRequestMultiError is an error wrapping multiple validation
-// errors returned by GetReturnsByUserIDRequest.ValidateAll() if the
-// designated constraints aren't met.
-type GetReturnsByUserIDRequestMultiError []error
+// GetReturnReasonResponseMultiError is an error wrapping multiple validation
+// errors returned by GetReturnReasonResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetReturnReasonResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetReturnsByUserIDRequestMultiError) Error() string {
+func (m GetReturnReasonResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5051,11 +7459,11 @@ func (m GetReturnsByUserIDRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetReturnsByUserIDRequestMultiError) AllErrors() []error { return m }
+func (m GetReturnReasonResponseMultiError) AllErrors() []error { return m }
 
-// GetReturnsByUserIDRequestValidationError is the validation error returned by
-// GetReturnsByUserIDRequest.Validate if the designated constraints aren't met.
-type GetReturnsByUserIDRequestValidationError struct {
+// GetReturnReasonResponseValidationError is the validation error returned by
+// GetReturnReasonResponse.Validate if the designated constraints aren't met.
+type GetReturnReasonResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5063,24 +7471,24 @@ type GetReturnsByUserIDRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetReturnsByUserIDRequestValidationError) Field() string { return e.field }
+func (e GetReturnReasonResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetReturnsByUserIDRequestValidationError) Reason() string { return e.reason }
+func (e GetReturnReasonResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetReturnsByUserIDRequestValidationError) Cause() error { return e.cause }
+func (e GetReturnReasonResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetReturnsByUserIDRequestValidationError) Key() bool { return e.key }
+func (e GetReturnReasonResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetReturnsByUserIDRequestValidationError) ErrorName() string {
-	return "GetReturnsByUserIDRequestValidationError"
+func (e GetReturnReasonResponseValidationError) ErrorName() string {
+	return "GetReturnReasonResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetReturnsByUserIDRequestValidationError) Error() string {
+func (e GetReturnReasonResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5092,14 +7500,14 @@ func (e GetReturnsByUserIDRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetReturnsByUserIDRequest.%s: %s%s",
+		"invalid %sGetReturnReasonResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetReturnsByUserIDRequestValidationError{}
+var _ error = GetReturnReasonResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -5107,47 +7515,47 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetReturnsByUserIDRequestValidationError{}
+} = GetReturnReasonResponseValidationError{}
 
-// Validate checks the field values on GetReturnsByUserIDResponse with the
+// Validate checks the field values on GetAllReturnReasonsResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetReturnsByUserIDResponse) Validate() error {
+func (m *GetAllReturnReasonsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetReturnsByUserIDResponse with the
+// ValidateAll checks the field values on GetAllReturnReasonsResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetReturnsByUserIDResponseMultiError, or nil if none found.
-func (m *GetReturnsByUserIDResponse) ValidateAll() error {
+// GetAllReturnReasonsResponseMultiError, or nil if none found.
+func (m *GetAllReturnReasonsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetReturnsByUserIDResponse) validate(all bool) error {
+func (m *GetAllReturnReasonsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetReturns() {
+	for idx, item := range m.GetReasons() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetReturnsByUserIDResponseValidationError{
-						field:  fmt.Sprintf("Returns[%v]", idx),
+					errors = append(errors, GetAllReturnReasonsResponseValidationError{
+						field:  fmt.Sprintf("Reasons[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, GetReturnsByUserIDResponseValidationError{
-						field:  fmt.Sprintf("Returns[%v]", idx),
+					errors = append(errors, GetAllReturnReasonsResponseValidationError{
+						field:  fmt.Sprintf("Reasons[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -5155,8 +7563,8 @@ func (m *GetReturnsByUserIDResponse) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return GetReturnsByUserIDResponseValidationError{
-					field:  fmt.Sprintf("Returns[%v]", idx),
+				return GetAllReturnReasonsResponseValidationError{
+					field:  fmt.Sprintf("Reasons[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -5166,19 +7574,19 @@ func (m *GetReturnsByUserIDResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return GetReturnsByUserIDResponseMultiError(errors)
+		return GetAllReturnReasonsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetReturnsByUserIDResponseMultiError is an error wrapping multiple
-// validation errors returned by GetReturnsByUserIDResponse.ValidateAll() if
+// GetAllReturnReasonsResponseMultiError is an error wrapping multiple
+// validation errors returned by GetAllReturnReasonsResponse.ValidateAll() if
 // the designated constraints aren't met.
-type GetReturnsByUserIDResponseMultiError []error
+type GetAllReturnReasonsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetReturnsByUserIDResponseMultiError) Error() string {
+func (m GetAllReturnReasonsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5187,11 +7595,12 @@ func (m GetReturnsByUserIDResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetReturnsByUserIDResponseMultiError) AllErrors() []error { return m }
+func (m GetAllReturnReasonsResponseMultiError) AllErrors() []error { return m }
 
-// GetReturnsByUserIDResponseValidationError is the validation error returned
-// by GetReturnsByUserIDResponse.Validate if the designated constraints aren't met.
-type GetReturnsByUserIDResponseValidationError struct {
+// GetAllReturnReasonsResponseValidationError is the validation error returned
+// by GetAllReturnReasonsResponse.Validate if the designated constraints
+// aren't met.
+type GetAllReturnReasonsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5199,24 +7608,24 @@ type GetReturnsByUserIDResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetReturnsByUserIDResponseValidationError) Field() string { return e.field }
+func (e GetAllReturnReasonsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetReturnsByUserIDResponseValidationError) Reason() string { return e.reason }
+func (e GetAllReturnReasonsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetReturnsByUserIDResponseValidationError) Cause() error { return e.cause }
+func (e GetAllReturnReasonsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetReturnsByUserIDResponseValidationError) Key() bool { return e.key }
+func (e GetAllReturnReasonsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetReturnsByUserIDResponseValidationError) ErrorName() string {
-	return "GetReturnsByUserIDResponseValidationError"
+func (e GetAllReturnReasonsResponseValidationError) ErrorName() string {
+	return "GetAllReturnReasonsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetReturnsByUserIDResponseValidationError) Error() string {
+func (e GetAllReturnReasonsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5228,14 +7637,14 @@ func (e GetReturnsByUserIDResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetReturnsByUserIDResponse.%s: %s%s",
+		"invalid %sGetAllReturnReasonsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetReturnsByUserIDResponseValidationError{}
+var _ error = GetAllReturnReasonsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -5243,110 +7652,33 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetReturnsByUserIDResponseValidationError{}
+} = GetAllReturnReasonsResponseValidationError{}
 
-// Validate checks the field values on UpdateReturnRequest with the rules
+// Validate checks the field values on UpdateReturnReasonRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateReturnRequest) Validate() error {
+func (m *UpdateReturnReasonRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateReturnRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on UpdateReturnReasonRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateReturnRequestMultiError, or nil if none found.
-func (m *UpdateReturnRequest) ValidateAll() error {
+// UpdateReturnReasonRequestMultiError, or nil if none found.
+func (m *UpdateReturnReasonRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateReturnRequest) validate(all bool) error {
+func (m *UpdateReturnReasonRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetReturnId() <= 0 {
-		err := UpdateReturnRequestValidationError{
-			field:  "ReturnId",
-			reason: "value must be greater than 0",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if m.GetOrderId() <= 0 {
-		err := UpdateReturnRequestValidationError{
-			field:  "OrderId",
-			reason: "value must be greater than 0",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if m.GetUserId() <= 0 {
-		err := UpdateReturnRequestValidationError{
-			field:  "UserId",
-			reason: "value must be greater than 0",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if m.GetReasonId() <= 0 {
-		err := UpdateReturnRequestValidationError{
-			field:  "ReasonId",
-			reason: "value must be greater than 0",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if m.GetBaseCost() < 0 {
-		err := UpdateReturnRequestValidationError{
-			field:  "BaseCost",
-			reason: "value must be greater than or equal to 0",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if m.GetPackagingCost() < 0 {
-		err := UpdateReturnRequestValidationError{
-			field:  "PackagingCost",
-			reason: "value must be greater than or equal to 0",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if m.GetTotalCost() < 0 {
-		err := UpdateReturnRequestValidationError{
-			field:  "TotalCost",
-			reason: "value must be greater than or equal to 0",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if m.GetPackagingId() <= 0 {
-		err := UpdateReturnRequestValidationError{
-			field:  "PackagingId",
+	if m.GetReasonId() <= 0 {
+		err := UpdateReturnReasonRequestValidationError{
+			field:  "ReasonId",
 			reason: "value must be greater than 0",
 		}
 		if !all {
@@ -5355,10 +7687,10 @@ func (m *UpdateReturnRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if m.GetStatusId() <= 0 {
-		err := UpdateReturnRequestValidationError{
-			field:  "StatusId",
-			reason: "value must be greater than 0",
+	if utf8.RuneCountInString(m.GetReason()) < 1 {
+		err := UpdateReturnReasonRequestValidationError{
+			field:  "Reason",
+			reason: "value length must be at least 1 runes",
 		}
 		if !all {
 			return err
@@ -5367,19 +7699,19 @@ func (m *UpdateReturnRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return UpdateReturnRequestMultiError(errors)
+		return UpdateReturnReasonRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateReturnRequestMultiError is an error wrapping multiple validation
-// errors returned by UpdateReturnRequest.ValidateAll() if the designated
-// constraints aren't met.
-type UpdateReturnRequestMultiError []error
+// UpdateReturnReasonRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateReturnReasonRequest.ValidateAll() if the
+// designated constraints aren't met.
+type UpdateReturnReasonRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateReturnRequestMultiError) Error() string {
+func (m UpdateReturnReasonRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5388,11 +7720,11 @@ func (m UpdateReturnRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateReturnRequestMultiError) AllErrors() []error { return m }
+func (m UpdateReturnReasonRequestMultiError) AllErrors() []error { return m }
 
-// UpdateReturnRequestValidationError is the validation error returned by
-// UpdateReturnRequest.Validate if the designated constraints aren't met.
-type UpdateReturnRequestValidationError struct {
+// UpdateReturnReasonRequestValidationError is the validation error returned by
+// UpdateReturnReasonRequest.Validate if the designated constraints aren't met.
+type UpdateReturnReasonRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5400,24 +7732,24 @@ type UpdateReturnRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateReturnRequestValidationError) Field() string { return e.field }
+func (e UpdateReturnReasonRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateReturnRequestValidationError) Reason() string { return e.reason }
+func (e UpdateReturnReasonRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateReturnRequestValidationError) Cause() error { return e.cause }
+func (e UpdateReturnReasonRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateReturnRequestValidationError) Key() bool { return e.key }
+func (e UpdateReturnReasonRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateReturnRequestValidationError) ErrorName() string {
-	return "UpdateReturnRequestValidationError"
+func (e UpdateReturnReasonRequestValidationError) ErrorName() string {
+	return "UpdateReturnReasonRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateReturnRequestValidationError) Error() string {
+func (e UpdateReturnReasonRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5429,14 +7761,14 @@ func (e UpdateReturnRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateReturnRequest.%s: %s%s",
+		"invalid %sUpdateReturnReasonRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateReturnRequestValidationError{}
+var _ error = UpdateReturnReasonRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -5444,24 +7776,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateReturnRequestValidationError{}
+} = UpdateReturnReasonRequestValidationError{}
 
-// Validate checks the field values on UpdateReturnResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on UpdateReturnReasonResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateReturnResponse) Validate() error {
+func (m *UpdateReturnReasonResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateReturnResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on UpdateReturnReasonResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateReturnResponseMultiError, or nil if none found.
-func (m *UpdateReturnResponse) ValidateAll() error {
+// UpdateReturnReasonResponseMultiError, or nil if none found.
+func (m *UpdateReturnReasonResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateReturnResponse) validate(all bool) error {
+func (m *UpdateReturnReasonResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -5471,19 +7803,19 @@ func (m *UpdateReturnResponse) validate(all bool) error {
 	// no validation rules for Message
 
 	if len(errors) > 0 {
-		return UpdateReturnResponseMultiError(errors)
+		return UpdateReturnReasonResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateReturnResponseMultiError is an error wrapping multiple validation
-// errors returned by UpdateReturnResponse.ValidateAll() if the designated
-// constraints aren't met.
-type UpdateReturnResponseMultiError []error
+// UpdateReturnReasonResponseMultiError is an error wrapping multiple
+// validation errors returned by UpdateReturnReasonResponse.ValidateAll() if
+// the designated constraints aren't met.
+type UpdateReturnReasonResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateReturnResponseMultiError) Error() string {
+func (m UpdateReturnReasonResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5492,11 +7824,11 @@ func (m UpdateReturnResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateReturnResponseMultiError) AllErrors() []error { return m }
+func (m UpdateReturnReasonResponseMultiError) AllErrors() []error { return m }
 
-// UpdateReturnResponseValidationError is the validation error returned by
-// UpdateReturnResponse.Validate if the designated constraints aren't met.
-type UpdateReturnResponseValidationError struct {
+// UpdateReturnReasonResponseValidationError is the validation error returned
+// by UpdateReturnReasonResponse.Validate if the designated constraints aren't met.
+type UpdateReturnReasonResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5504,24 +7836,24 @@ type UpdateReturnResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateReturnResponseValidationError) Field() string { return e.field }
+func (e UpdateReturnReasonResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateReturnResponseValidationError) Reason() string { return e.reason }
+func (e UpdateReturnReasonResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateReturnResponseValidationError) Cause() error { return e.cause }
+func (e UpdateReturnReasonResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateReturnResponseValidationError) Key() bool { return e.key }
+func (e UpdateReturnReasonResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateReturnResponseValidationError) ErrorName() string {
-	return "UpdateReturnResponseValidationError"
+func (e UpdateReturnReasonResponseValidationError) ErrorName() string {
+	return "UpdateReturnReasonResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateReturnResponseValidationError) Error() string {
+func (e UpdateReturnReasonResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5533,14 +7865,14 @@ func (e UpdateReturnResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateReturnResponse.%s: %s%s",
+		"invalid %sUpdateReturnReasonResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateReturnResponseValidationError{}
+var _ error = UpdateReturnReasonResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -5548,33 +7880,33 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateReturnResponseValidationError{}
+} = UpdateReturnReasonResponseValidationError{}
 
-// Validate checks the field values on DeleteReturnRequest with the rules
+// Validate checks the field values on DeleteReturnReasonRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteReturnRequest) Validate() error {
+func (m *DeleteReturnReasonRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteReturnRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on DeleteReturnReasonRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteReturnRequestMultiError, or nil if none found.
-func (m *DeleteReturnRequest) ValidateAll() error {
+// DeleteReturnReasonRequestMultiError, or nil if none found.
+func (m *DeleteReturnReasonRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteReturnRequest) validate(all bool) error {
+func (m *DeleteReturnReasonRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetReturnId() <= 0 {
-		err := DeleteReturnRequestValidationError{
-			field:  "ReturnId",
+	if m.GetReasonId() <= 0 {
+		err := DeleteReturnReasonRequestValidationError{
+			field:  "ReasonId",
 			reason: "value must be greater than 0",
 		}
 		if !all {
@@ -5584,19 +7916,19 @@ func (m *DeleteReturnRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return DeleteReturnRequestMultiError(errors)
+		return DeleteReturnReasonRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteReturnRequestMultiError is an error wrapping multiple validation
-// errors returned by DeleteReturnRequest.ValidateAll() if the designated
-// constraints aren't met.
-type DeleteReturnRequestMultiError []error
+// DeleteReturnReasonRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteReturnReasonRequest.ValidateAll() if the
+// designated constraints aren't met.
+type DeleteReturnReasonRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteReturnRequestMultiError) Error() string {
+func (m DeleteReturnReasonRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5605,11 +7937,11 @@ func (m DeleteReturnRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteReturnRequestMultiError) AllErrors() []error { return m }
+func (m DeleteReturnReasonRequestMultiError) AllErrors() []error { return m }
 
-// DeleteReturnRequestValidationError is the validation error returned by
-// DeleteReturnRequest.Validate if the designated constraints aren't met.
-type DeleteReturnRequestValidationError struct {
+// DeleteReturnReasonRequestValidationError is the validation error returned by
+// DeleteReturnReasonRequest.Validate if the designated constraints aren't met.
+type DeleteReturnReasonRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5617,24 +7949,128 @@ type DeleteReturnRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteReturnRequestValidationError) Field() string { return e.field }
+func (e DeleteReturnReasonRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteReturnReasonRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteReturnReasonRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteReturnReasonRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteReturnReasonRequestValidationError) ErrorName() string {
+	return "DeleteReturnReasonRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteReturnReasonRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteReturnReasonRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteReturnReasonRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteReturnReasonRequestValidationError{}
+
+// Validate checks the field values on DeleteReturnReasonResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteReturnReasonResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteReturnReasonResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteReturnReasonResponseMultiError, or nil if none found.
+func (m *DeleteReturnReasonResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteReturnReasonResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return DeleteReturnReasonResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteReturnReasonResponseMultiError is an error wrapping multiple
+// validation errors returned by DeleteReturnReasonResponse.ValidateAll() if
+// the designated constraints aren't met.
+type DeleteReturnReasonResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteReturnReasonResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteReturnReasonResponseMultiError) AllErrors() []error { return m }
+
+// DeleteReturnReasonResponseValidationError is the validation error returned
+// by DeleteReturnReasonResponse.Validate if the designated constraints aren't met.
+type DeleteReturnReasonResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteReturnReasonResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteReturnRequestValidationError) Reason() string { return e.reason }
+func (e DeleteReturnReasonResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteReturnRequestValidationError) Cause() error { return e.cause }
+func (e DeleteReturnReasonResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteReturnRequestValidationError) Key() bool { return e.key }
+func (e DeleteReturnReasonResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteReturnRequestValidationError) ErrorName() string {
-	return "DeleteReturnRequestValidationError"
+func (e DeleteReturnReasonResponseValidationError) ErrorName() string {
+	return "DeleteReturnReasonResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteReturnRequestValidationError) Error() string {
+func (e DeleteReturnReasonResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5646,14 +8082,14 @@ func (e DeleteReturnRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteReturnRequest.%s: %s%s",
+		"invalid %sDeleteReturnReasonResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteReturnRequestValidationError{}
+var _ error = DeleteReturnReasonResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -5661,46 +8097,55 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteReturnRequestValidationError{}
+} = DeleteReturnReasonResponseValidationError{}
 
-// Validate checks the field values on DeleteReturnResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on CheckReturnReasonExistsRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteReturnResponse) Validate() error {
+func (m *CheckReturnReasonExistsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteReturnResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// DeleteReturnResponseMultiError, or nil if none found.
-func (m *DeleteReturnResponse) ValidateAll() error {
+// ValidateAll checks the field values on CheckReturnReasonExistsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// CheckReturnReasonExistsRequestMultiError, or nil if none found.
+func (m *CheckReturnReasonExistsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteReturnResponse) validate(all bool) error {
+func (m *CheckReturnReasonExistsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Message
+	if m.GetReasonId() <= 0 {
+		err := CheckReturnReasonExistsRequestValidationError{
+			field:  "ReasonId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return DeleteReturnResponseMultiError(errors)
+		return CheckReturnReasonExistsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteReturnResponseMultiError is an error wrapping multiple validation
-// errors returned by DeleteReturnResponse.ValidateAll() if the designated
-// constraints aren't met.
-type DeleteReturnResponseMultiError []error
+// CheckReturnReasonExistsRequestMultiError is an error wrapping multiple
+// validation errors returned by CheckReturnReasonExistsRequest.ValidateAll()
+// if the designated constraints aren't met.
+type CheckReturnReasonExistsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteReturnResponseMultiError) Error() string {
+func (m CheckReturnReasonExistsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5709,11 +8154,12 @@ func (m DeleteReturnResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteReturnResponseMultiError) AllErrors() []error { return m }
+func (m CheckReturnReasonExistsRequestMultiError) AllErrors() []error { return m }
 
-// DeleteReturnResponseValidationError is the validation error returned by
-// DeleteReturnResponse.Validate if the designated constraints aren't met.
-type DeleteReturnResponseValidationError struct {
+// CheckReturnReasonExistsRequestValidationError is the validation error
+// returned by CheckReturnReasonExistsRequest.Validate if the designated
+// constraints aren't met.
+type CheckReturnReasonExistsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5721,24 +8167,24 @@ type DeleteReturnResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteReturnResponseValidationError) Field() string { return e.field }
+func (e CheckReturnReasonExistsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteReturnResponseValidationError) Reason() string { return e.reason }
+func (e CheckReturnReasonExistsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteReturnResponseValidationError) Cause() error { return e.cause }
+func (e CheckReturnReasonExistsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteReturnResponseValidationError) Key() bool { return e.key }
+func (e CheckReturnReasonExistsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteReturnResponseValidationError) ErrorName() string {
-	return "DeleteReturnResponseValidationError"
+func (e CheckReturnReasonExistsRequestValidationError) ErrorName() string {
+	return "CheckReturnReasonExistsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteReturnResponseValidationError) Error() string {
+func (e CheckReturnReasonExistsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5750,14 +8196,14 @@ func (e DeleteReturnResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteReturnResponse.%s: %s%s",
+		"invalid %sCheckReturnReasonExistsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteReturnResponseValidationError{}
+var _ error = CheckReturnReasonExistsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -5765,64 +8211,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteReturnResponseValidationError{}
+} = CheckReturnReasonExistsRequestValidationError{}
 
-// Validate checks the field values on ReturnResponse with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *ReturnResponse) Validate() error {
+// Validate checks the field values on CheckReturnReasonExistsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CheckReturnReasonExistsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ReturnResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in ReturnResponseMultiError,
-// or nil if none found.
-func (m *ReturnResponse) ValidateAll() error {
+// ValidateAll checks the field values on CheckReturnReasonExistsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// CheckReturnReasonExistsResponseMultiError, or nil if none found.
+func (m *CheckReturnReasonExistsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ReturnResponse) validate(all bool) error {
+func (m *CheckReturnReasonExistsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for ReturnId
-
-	// no validation rules for OrderId
-
-	// no validation rules for UserId
-
-	// no validation rules for ReasonId
-
-	// no validation rules for BaseCost
-
-	// no validation rules for PackagingCost
-
-	// no validation rules for TotalCost
-
-	// no validation rules for PackagingId
-
-	// no validation rules for StatusId
-
-	// no validation rules for ReturnDate
+	// no validation rules for Exists
 
 	if len(errors) > 0 {
-		return ReturnResponseMultiError(errors)
+		return CheckReturnReasonExistsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ReturnResponseMultiError is an error wrapping multiple validation errors
-// returned by ReturnResponse.ValidateAll() if the designated constraints
-// aren't met.
-type ReturnResponseMultiError []error
+// CheckReturnReasonExistsResponseMultiError is an error wrapping multiple
+// validation errors returned by CheckReturnReasonExistsResponse.ValidateAll()
+// if the designated constraints aren't met.
+type CheckReturnReasonExistsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ReturnResponseMultiError) Error() string {
+func (m CheckReturnReasonExistsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5831,11 +8259,12 @@ func (m ReturnResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ReturnResponseMultiError) AllErrors() []error { return m }
+func (m CheckReturnReasonExistsResponseMultiError) AllErrors() []error { return m }
 
-// ReturnResponseValidationError is the validation error returned by
-// ReturnResponse.Validate if the designated constraints aren't met.
-type ReturnResponseValidationError struct {
+// CheckReturnReasonExistsResponseValidationError is the validation error
+// returned by CheckReturnReasonExistsResponse.Validate if the designated
+// constraints aren't met.
+type CheckReturnReasonExistsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5843,22 +8272,24 @@ type ReturnResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ReturnResponseValidationError) Field() string { return e.field }
+func (e CheckReturnReasonExistsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ReturnResponseValidationError) Reason() string { return e.reason }
+func (e CheckReturnReasonExistsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ReturnResponseValidationError) Cause() error { return e.cause }
+func (e CheckReturnReasonExistsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ReturnResponseValidationError) Key() bool { return e.key }
+func (e CheckReturnReasonExistsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ReturnResponseValidationError) ErrorName() string { return "ReturnResponseValidationError" }
+func (e CheckReturnReasonExistsResponseValidationError) ErrorName() string {
+	return "CheckReturnReasonExistsResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e ReturnResponseValidationError) Error() string {
+func (e CheckReturnReasonExistsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5870,14 +8301,14 @@ func (e ReturnResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sReturnResponse.%s: %s%s",
+		"invalid %sCheckReturnReasonExistsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ReturnResponseValidationError{}
+var _ error = CheckReturnReasonExistsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -5885,34 +8316,34 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ReturnResponseValidationError{}
+} = CheckReturnReasonExistsResponseValidationError{}
 
-// Validate checks the field values on ProcessReturnRequest with the rules
+// Validate checks the field values on CreateStatusRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ProcessReturnRequest) Validate() error {
+func (m *CreateStatusRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ProcessReturnRequest with the rules
+// ValidateAll checks the field values on CreateStatusRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ProcessReturnRequestMultiError, or nil if none found.
-func (m *ProcessReturnRequest) ValidateAll() error {
+// CreateStatusRequestMultiError, or nil if none found.
+func (m *CreateStatusRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ProcessReturnRequest) validate(all bool) error {
+func (m *CreateStatusRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetOrderId() <= 0 {
-		err := ProcessReturnRequestValidationError{
-			field:  "OrderId",
-			reason: "value must be greater than 0",
+	if utf8.RuneCountInString(m.GetStatusName()) < 1 {
+		err := CreateStatusRequestValidationError{
+			field:  "StatusName",
+			reason: "value length must be at least 1 runes",
 		}
 		if !all {
 			return err
@@ -5921,19 +8352,19 @@ func (m *ProcessReturnRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return ProcessReturnRequestMultiError(errors)
+		return CreateStatusRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ProcessReturnRequestMultiError is an error wrapping multiple validation
-// errors returned by ProcessReturnRequest.ValidateAll() if the designated
+// CreateStatusRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateStatusRequest.ValidateAll() if the designated
 // constraints aren't met.
-type ProcessReturnRequestMultiError []error
+type CreateStatusRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ProcessReturnRequestMultiError) Error() string {
+func (m CreateStatusRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5942,11 +8373,11 @@ func (m ProcessReturnRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ProcessReturnRequestMultiError) AllErrors() []error { return m }
+func (m CreateStatusRequestMultiError) AllErrors() []error { return m }
 
-// ProcessReturnRequestValidationError is the validation error returned by
-// ProcessReturnRequest.Validate if the designated constraints aren't met.
-type ProcessReturnRequestValidationError struct {
+// CreateStatusRequestValidationError is the validation error returned by
+// CreateStatusRequest.Validate if the designated constraints aren't met.
+type CreateStatusRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5954,24 +8385,24 @@ type ProcessReturnRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ProcessReturnRequestValidationError) Field() string { return e.field }
+func (e CreateStatusRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ProcessReturnRequestValidationError) Reason() string { return e.reason }
+func (e CreateStatusRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ProcessReturnRequestValidationError) Cause() error { return e.cause }
+func (e CreateStatusRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ProcessReturnRequestValidationError) Key() bool { return e.key }
+func (e CreateStatusRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ProcessReturnRequestValidationError) ErrorName() string {
-	return "ProcessReturnRequestValidationError"
+func (e CreateStatusRequestValidationError) ErrorName() string {
+	return "CreateStatusRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ProcessReturnRequestValidationError) Error() string {
+func (e CreateStatusRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5983,14 +8414,14 @@ func (e ProcessReturnRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sProcessReturnRequest.%s: %s%s",
+		"invalid %sCreateStatusRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ProcessReturnRequestValidationError{}
+var _ error = CreateStatusRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -5998,46 +8429,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ProcessReturnRequestValidationError{}
+} = CreateStatusRequestValidationError{}
 
-// Validate checks the field values on ProcessReturnResponse with the rules
+// Validate checks the field values on CreateStatusResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ProcessReturnResponse) Validate() error {
+func (m *CreateStatusResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ProcessReturnResponse with the rules
+// ValidateAll checks the field values on CreateStatusResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ProcessReturnResponseMultiError, or nil if none found.
-func (m *ProcessReturnResponse) ValidateAll() error {
+// CreateStatusResponseMultiError, or nil if none found.
+func (m *CreateStatusResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ProcessReturnResponse) validate(all bool) error {
+func (m *CreateStatusResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for StatusId
+
 	// no validation rules for Message
 
 	if len(errors) > 0 {
-		return ProcessReturnResponseMultiError(errors)
+		return CreateStatusResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ProcessReturnResponseMultiError is an error wrapping multiple validation
-// errors returned by ProcessReturnResponse.ValidateAll() if the designated
+// CreateStatusResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateStatusResponse.ValidateAll() if the designated
 // constraints aren't met.
-type ProcessReturnResponseMultiError []error
+type CreateStatusResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ProcessReturnResponseMultiError) Error() string {
+func (m CreateStatusResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6046,11 +8479,11 @@ func (m ProcessReturnResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ProcessReturnResponseMultiError) AllErrors() []error { return m }
+func (m CreateStatusResponseMultiError) AllErrors() []error { return m }
 
-// ProcessReturnResponseValidationError is the validation error returned by
-// ProcessReturnResponse.Validate if the designated constraints aren't met.
-type ProcessReturnResponseValidationError struct {
+// CreateStatusResponseValidationError is the validation error returned by
+// CreateStatusResponse.Validate if the designated constraints aren't met.
+type CreateStatusResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6058,24 +8491,24 @@ type ProcessReturnResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ProcessReturnResponseValidationError) Field() string { return e.field }
+func (e CreateStatusResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ProcessReturnResponseValidationError) Reason() string { return e.reason }
+func (e CreateStatusResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ProcessReturnResponseValidationError) Cause() error { return e.cause }
+func (e CreateStatusResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ProcessReturnResponseValidationError) Key() bool { return e.key }
+func (e CreateStatusResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ProcessReturnResponseValidationError) ErrorName() string {
-	return "ProcessReturnResponseValidationError"
+func (e CreateStatusResponseValidationError) ErrorName() string {
+	return "CreateStatusResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ProcessReturnResponseValidationError) Error() string {
+func (e CreateStatusResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6087,14 +8520,14 @@ func (e ProcessReturnResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sProcessReturnResponse.%s: %s%s",
+		"invalid %sCreateStatusResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ProcessReturnResponseValidationError{}
+var _ error = CreateStatusResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -6102,34 +8535,34 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ProcessReturnResponseValidationError{}
+} = CreateStatusResponseValidationError{}
 
-// Validate checks the field values on CreateReturnReasonRequest with the rules
+// Validate checks the field values on GetStatusByIDRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateReturnReasonRequest) Validate() error {
+func (m *GetStatusByIDRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateReturnReasonRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetStatusByIDRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateReturnReasonRequestMultiError, or nil if none found.
-func (m *CreateReturnReasonRequest) ValidateAll() error {
+// GetStatusByIDRequestMultiError, or nil if none found.
+func (m *GetStatusByIDRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateReturnReasonRequest) validate(all bool) error {
+func (m *GetStatusByIDRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetReason()) < 1 {
-		err := CreateReturnReasonRequestValidationError{
-			field:  "Reason",
-			reason: "value length must be at least 1 runes",
+	if m.GetStatusId() <= 0 {
+		err := GetStatusByIDRequestValidationError{
+			field:  "StatusId",
+			reason: "value must be greater than 0",
 		}
 		if !all {
 			return err
@@ -6138,19 +8571,19 @@ func (m *CreateReturnReasonRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return CreateReturnReasonRequestMultiError(errors)
+		return GetStatusByIDRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateReturnReasonRequestMultiError is an error wrapping multiple validation
-// errors returned by CreateReturnReasonRequest.ValidateAll() if the
-// designated constraints aren't met.
-type CreateReturnReasonRequestMultiError []error
+// GetStatusByIDRequestMultiError is an error wrapping multiple validation
+// errors returned by GetStatusByIDRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetStatusByIDRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateReturnReasonRequestMultiError) Error() string {
+func (m GetStatusByIDRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6159,11 +8592,11 @@ func (m CreateReturnReasonRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateReturnReasonRequestMultiError) AllErrors() []error { return m }
+func (m GetStatusByIDRequestMultiError) AllErrors() []error { return m }
 
-// CreateReturnReasonRequestValidationError is the validation error returned by
-// CreateReturnReasonRequest.Validate if the designated constraints aren't met.
-type CreateReturnReasonRequestValidationError struct {
+// GetStatusByIDRequestValidationError is the validation error returned by
+// GetStatusByIDRequest.Validate if the designated constraints aren't met.
+type GetStatusByIDRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6171,24 +8604,24 @@ type CreateReturnReasonRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateReturnReasonRequestValidationError) Field() string { return e.field }
+func (e GetStatusByIDRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateReturnReasonRequestValidationError) Reason() string { return e.reason }
+func (e GetStatusByIDRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateReturnReasonRequestValidationError) Cause() error { return e.cause }
+func (e GetStatusByIDRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateReturnReasonRequestValidationError) Key() bool { return e.key }
+func (e GetStatusByIDRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateReturnReasonRequestValidationError) ErrorName() string {
-	return "CreateReturnReasonRequestValidationError"
+func (e GetStatusByIDRequestValidationError) ErrorName() string {
+	return "GetStatusByIDRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateReturnReasonRequestValidationError) Error() string {
+func (e GetStatusByIDRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6200,14 +8633,14 @@ func (e CreateReturnReasonRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateReturnReasonRequest.%s: %s%s",
+		"invalid %sGetStatusByIDRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateReturnReasonRequestValidationError{}
+var _ error = GetStatusByIDRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -6215,48 +8648,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateReturnReasonRequestValidationError{}
+} = GetStatusByIDRequestValidationError{}
 
-// Validate checks the field values on CreateReturnReasonResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on GetStatusByIDResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateReturnReasonResponse) Validate() error {
+func (m *GetStatusByIDResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateReturnReasonResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetStatusByIDResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateReturnReasonResponseMultiError, or nil if none found.
-func (m *CreateReturnReasonResponse) ValidateAll() error {
+// GetStatusByIDResponseMultiError, or nil if none found.
+func (m *GetStatusByIDResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateReturnReasonResponse) validate(all bool) error {
+func (m *GetStatusByIDResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for ReasonId
+	// no validation rules for StatusId
 
-	// no validation rules for Message
+	// no validation rules for StatusName
 
 	if len(errors) > 0 {
-		return CreateReturnReasonResponseMultiError(errors)
+		return GetStatusByIDResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateReturnReasonResponseMultiError is an error wrapping multiple
-// validation errors returned by CreateReturnReasonResponse.ValidateAll() if
-// the designated constraints aren't met.
-type CreateReturnReasonResponseMultiError []error
+// GetStatusByIDResponseMultiError is an error wrapping multiple validation
+// errors returned by GetStatusByIDResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetStatusByIDResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateReturnReasonResponseMultiError) Error() string {
+func (m GetStatusByIDResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6265,11 +8698,11 @@ func (m CreateReturnReasonResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateReturnReasonResponseMultiError) AllErrors() []error { return m }
+func (m GetStatusByIDResponseMultiError) AllErrors() []error { return m }
 
-// CreateReturnReasonResponseValidationError is the validation error returned
-// by CreateReturnReasonResponse.Validate if the designated constraints aren't met.
-type CreateReturnReasonResponseValidationError struct {
+// GetStatusByIDResponseValidationError is the validation error returned by
+// GetStatusByIDResponse.Validate if the designated constraints aren't met.
+type GetStatusByIDResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6277,24 +8710,24 @@ type CreateReturnReasonResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateReturnReasonResponseValidationError) Field() string { return e.field }
+func (e GetStatusByIDResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateReturnReasonResponseValidationError) Reason() string { return e.reason }
+func (e GetStatusByIDResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateReturnReasonResponseValidationError) Cause() error { return e.cause }
+func (e GetStatusByIDResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateReturnReasonResponseValidationError) Key() bool { return e.key }
+func (e GetStatusByIDResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateReturnReasonResponseValidationError) ErrorName() string {
-	return "CreateReturnReasonResponseValidationError"
+func (e GetStatusByIDResponseValidationError) ErrorName() string {
+	return "GetStatusByIDResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateReturnReasonResponseValidationError) Error() string {
+func (e GetStatusByIDResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6306,14 +8739,14 @@ func (e CreateReturnReasonResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateReturnReasonResponse.%s: %s%s",
+		"invalid %sGetStatusByIDResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateReturnReasonResponseValidationError{}
+var _ error = GetStatusByIDResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -6321,55 +8754,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateReturnReasonResponseValidationError{}
+} = GetStatusByIDResponseValidationError{}
 
-// Validate checks the field values on GetReturnReasonRequest with the rules
+// Validate checks the field values on GetAllStatusesRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetReturnReasonRequest) Validate() error {
+func (m *GetAllStatusesRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetReturnReasonRequest with the rules
+// ValidateAll checks the field values on GetAllStatusesRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetReturnReasonRequestMultiError, or nil if none found.
-func (m *GetReturnReasonRequest) ValidateAll() error {
+// GetAllStatusesRequestMultiError, or nil if none found.
+func (m *GetAllStatusesRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetReturnReasonRequest) validate(all bool) error {
+func (m *GetAllStatusesRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetReasonId() <= 0 {
-		err := GetReturnReasonRequestValidationError{
-			field:  "ReasonId",
-			reason: "value must be greater than 0",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
 	if len(errors) > 0 {
-		return GetReturnReasonRequestMultiError(errors)
+		return GetAllStatusesRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetReturnReasonRequestMultiError is an error wrapping multiple validation
-// errors returned by GetReturnReasonRequest.ValidateAll() if the designated
+// GetAllStatusesRequestMultiError is an error wrapping multiple validation
+// errors returned by GetAllStatusesRequest.ValidateAll() if the designated
 // constraints aren't met.
-type GetReturnReasonRequestMultiError []error
+type GetAllStatusesRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetReturnReasonRequestMultiError) Error() string {
+func (m GetAllStatusesRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6378,11 +8800,11 @@ func (m GetReturnReasonRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetReturnReasonRequestMultiError) AllErrors() []error { return m }
+func (m GetAllStatusesRequestMultiError) AllErrors() []error { return m }
 
-// GetReturnReasonRequestValidationError is the validation error returned by
-// GetReturnReasonRequest.Validate if the designated constraints aren't met.
-type GetReturnReasonRequestValidationError struct {
+// GetAllStatusesRequestValidationError is the validation error returned by
+// GetAllStatusesRequest.Validate if the designated constraints aren't met.
+type GetAllStatusesRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6390,24 +8812,24 @@ type GetReturnReasonRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetReturnReasonRequestValidationError) Field() string { return e.field }
+func (e GetAllStatusesRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetReturnReasonRequestValidationError) Reason() string { return e.reason }
+func (e GetAllStatusesRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetReturnReasonRequestValidationError) Cause() error { return e.cause }
+func (e GetAllStatusesRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetReturnReasonRequestValidationError) Key() bool { return e.key }
+func (e GetAllStatusesRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetReturnReasonRequestValidationError) ErrorName() string {
-	return "GetReturnReasonRequestValidationError"
+func (e GetAllStatusesRequestValidationError) ErrorName() string {
+	return "GetAllStatusesRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetReturnReasonRequestValidationError) Error() string {
+func (e GetAllStatusesRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6419,14 +8841,14 @@ func (e GetReturnReasonRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetReturnReasonRequest.%s: %s%s",
+		"invalid %sGetAllStatusesRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetReturnReasonRequestValidationError{}
+var _ error = GetAllStatusesRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -6434,48 +8856,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetReturnReasonRequestValidationError{}
+} = GetAllStatusesRequestValidationError{}
 
-// Validate checks the field values on GetReturnReasonResponse with the rules
+// Validate checks the field values on GetAllStatusesResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetReturnReasonResponse) Validate() error {
+func (m *GetAllStatusesResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetReturnReasonResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetAllStatusesResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetReturnReasonResponseMultiError, or nil if none found.
-func (m *GetReturnReasonResponse) ValidateAll() error {
+// GetAllStatusesResponseMultiError, or nil if none found.
+func (m *GetAllStatusesResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetReturnReasonResponse) validate(all bool) error {
-	if m == nil {
-		return nil
+func (m *GetAllStatusesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetStatuses() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetAllStatusesResponseValidationError{
+						field:  fmt.Sprintf("Statuses[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetAllStatusesResponseValidationError{
+						field:  fmt.Sprintf("Statuses[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetAllStatusesResponseValidationError{
+					field:  fmt.Sprintf("Statuses[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
 	}
 
-	var errors []error
-
-	// no validation rules for ReasonId
-
-	// no validation rules for Reason
-
 	if len(errors) > 0 {
-		return GetReturnReasonResponseMultiError(errors)
+		return GetAllStatusesResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetReturnReasonResponseMultiError is an error wrapping multiple validation
-// errors returned by GetReturnReasonResponse.ValidateAll() if the designated
+// GetAllStatusesResponseMultiError is an error wrapping multiple validation
+// errors returned by GetAllStatusesResponse.ValidateAll() if the designated
 // constraints aren't met.
-type GetReturnReasonResponseMultiError []error
+type GetAllStatusesResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetReturnReasonResponseMultiError) Error() string {
+func (m GetAllStatusesResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6484,11 +8936,11 @@ func (m GetReturnReasonResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetReturnReasonResponseMultiError) AllErrors() []error { return m }
+func (m GetAllStatusesResponseMultiError) AllErrors() []error { return m }
 
-// GetReturnReasonResponseValidationError is the validation error returned by
-// GetReturnReasonResponse.Validate if the designated constraints aren't met.
-type GetReturnReasonResponseValidationError struct {
+// GetAllStatusesResponseValidationError is the validation error returned by
+// GetAllStatusesResponse.Validate if the designated constraints aren't met.
+type GetAllStatusesResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6496,24 +8948,24 @@ type GetReturnReasonResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetReturnReasonResponseValidationError) Field() string { return e.field }
+func (e GetAllStatusesResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetReturnReasonResponseValidationError) Reason() string { return e.reason }
+func (e GetAllStatusesResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetReturnReasonResponseValidationError) Cause() error { return e.cause }
+func (e GetAllStatusesResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetReturnReasonResponseValidationError) Key() bool { return e.key }
+func (e GetAllStatusesResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetReturnReasonResponseValidationError) ErrorName() string {
-	return "GetReturnReasonResponseValidationError"
+func (e GetAllStatusesResponseValidationError) ErrorName() string {
+	return "GetAllStatusesResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetReturnReasonResponseValidationError) Error() string {
+func (e GetAllStatusesResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6525,14 +8977,14 @@ func (e GetReturnReasonResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetReturnReasonResponse.%s: %s%s",
+		"invalid %sGetAllStatusesResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetReturnReasonResponseValidationError{}
+var _ error = GetAllStatusesResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -6540,78 +8992,66 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetReturnReasonResponseValidationError{}
+} = GetAllStatusesResponseValidationError{}
 
-// Validate checks the field values on GetAllReturnReasonsResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on UpdateStatusRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetAllReturnReasonsResponse) Validate() error {
+func (m *UpdateStatusRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetAllReturnReasonsResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on UpdateStatusRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetAllReturnReasonsResponseMultiError, or nil if none found.
-func (m *GetAllReturnReasonsResponse) ValidateAll() error {
+// UpdateStatusRequestMultiError, or nil if none found.
+func (m *UpdateStatusRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetAllReturnReasonsResponse) validate(all bool) error {
+func (m *UpdateStatusRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetReasons() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetAllReturnReasonsResponseValidationError{
-						field:  fmt.Sprintf("Reasons[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, GetAllReturnReasonsResponseValidationError{
-						field:  fmt.Sprintf("Reasons[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return GetAllReturnReasonsResponseValidationError{
-					field:  fmt.Sprintf("Reasons[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
+	if m.GetStatusId() <= 0 {
+		err := UpdateStatusRequestValidationError{
+			field:  "StatusId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
+	}
 
+	if utf8.RuneCountInString(m.GetStatusName()) < 1 {
+		err := UpdateStatusRequestValidationError{
+			field:  "StatusName",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
 	if len(errors) > 0 {
-		return GetAllReturnReasonsResponseMultiError(errors)
+		return UpdateStatusRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetAllReturnReasonsResponseMultiError is an error wrapping multiple
-// validation errors returned by GetAllReturnReasonsResponse.ValidateAll() if
-// the designated constraints aren't met.
-type GetAllReturnReasonsResponseMultiError []error
+// UpdateStatusRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateStatusRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateStatusRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetAllReturnReasonsResponseMultiError) Error() string {
+func (m UpdateStatusRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6620,12 +9060,11 @@ func (m GetAllReturnReasonsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetAllReturnReasonsResponseMultiError) AllErrors() []error { return m }
+func (m UpdateStatusRequestMultiError) AllErrors() []error { return m }
 
-// GetAllReturnReasonsResponseValidationError is the validation error returned
-// by GetAllReturnReasonsResponse.Validate if the designated constraints
-// aren't met.
-type GetAllReturnReasonsResponseValidationError struct {
+// UpdateStatusRequestValidationError is the validation error returned by
+// UpdateStatusRequest.Validate if the designated constraints aren't met.
+type UpdateStatusRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6633,24 +9072,24 @@ type GetAllReturnReasonsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetAllReturnReasonsResponseValidationError) Field() string { return e.field }
+func (e UpdateStatusRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetAllReturnReasonsResponseValidationError) Reason() string { return e.reason }
+func (e UpdateStatusRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetAllReturnReasonsResponseValidationError) Cause() error { return e.cause }
+func (e UpdateStatusRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetAllReturnReasonsResponseValidationError) Key() bool { return e.key }
+func (e UpdateStatusRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetAllReturnReasonsResponseValidationError) ErrorName() string {
-	return "GetAllReturnReasonsResponseValidationError"
+func (e UpdateStatusRequestValidationError) ErrorName() string {
+	return "UpdateStatusRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetAllReturnReasonsResponseValidationError) Error() string {
+func (e UpdateStatusRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6662,14 +9101,14 @@ func (e GetAllReturnReasonsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetAllReturnReasonsResponse.%s: %s%s",
+		"invalid %sUpdateStatusRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetAllReturnReasonsResponseValidationError{}
+var _ error = UpdateStatusRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -6677,66 +9116,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetAllReturnReasonsResponseValidationError{}
+} = UpdateStatusRequestValidationError{}
 
-// Validate checks the field values on UpdateReturnReasonRequest with the rules
+// Validate checks the field values on UpdateStatusResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateReturnReasonRequest) Validate() error {
+func (m *UpdateStatusResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateReturnReasonRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on UpdateStatusResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateReturnReasonRequestMultiError, or nil if none found.
-func (m *UpdateReturnReasonRequest) ValidateAll() error {
+// UpdateStatusResponseMultiError, or nil if none found.
+func (m *UpdateStatusResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateReturnReasonRequest) validate(all bool) error {
+func (m *UpdateStatusResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetReasonId() <= 0 {
-		err := UpdateReturnReasonRequestValidationError{
-			field:  "ReasonId",
-			reason: "value must be greater than 0",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if utf8.RuneCountInString(m.GetReason()) < 1 {
-		err := UpdateReturnReasonRequestValidationError{
-			field:  "Reason",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Message
 
 	if len(errors) > 0 {
-		return UpdateReturnReasonRequestMultiError(errors)
+		return UpdateStatusResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateReturnReasonRequestMultiError is an error wrapping multiple validation
-// errors returned by UpdateReturnReasonRequest.ValidateAll() if the
-// designated constraints aren't met.
-type UpdateReturnReasonRequestMultiError []error
+// UpdateStatusResponseMultiError is an error wrapping multiple validation
+// errors returned by UpdateStatusResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateStatusResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateReturnReasonRequestMultiError) Error() string {
+func (m UpdateStatusResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6745,11 +9164,11 @@ func (m UpdateReturnReasonRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateReturnReasonRequestMultiError) AllErrors() []error { return m }
+func (m UpdateStatusResponseMultiError) AllErrors() []error { return m }
 
-// UpdateReturnReasonRequestValidationError is the validation error returned by
-// UpdateReturnReasonRequest.Validate if the designated constraints aren't met.
-type UpdateReturnReasonRequestValidationError struct {
+// UpdateStatusResponseValidationError is the validation error returned by
+// UpdateStatusResponse.Validate if the designated constraints aren't met.
+type UpdateStatusResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6757,24 +9176,24 @@ type UpdateReturnReasonRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateReturnReasonRequestValidationError) Field() string { return e.field }
+func (e UpdateStatusResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateReturnReasonRequestValidationError) Reason() string { return e.reason }
+func (e UpdateStatusResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateReturnReasonRequestValidationError) Cause() error { return e.cause }
+func (e UpdateStatusResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateReturnReasonRequestValidationError) Key() bool { return e.key }
+func (e UpdateStatusResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateReturnReasonRequestValidationError) ErrorName() string {
-	return "UpdateReturnReasonRequestValidationError"
+func (e UpdateStatusResponseValidationError) ErrorName() string {
+	return "UpdateStatusResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateReturnReasonRequestValidationError) Error() string {
+func (e UpdateStatusResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6786,14 +9205,14 @@ func (e UpdateReturnReasonRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateReturnReasonRequest.%s: %s%s",
+		"invalid %sUpdateStatusResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateReturnReasonRequestValidationError{}
+var _ error = UpdateStatusResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -6801,46 +9220,55 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateReturnReasonRequestValidationError{}
+} = UpdateStatusResponseValidationError{}
 
-// Validate checks the field values on UpdateReturnReasonResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on DeleteStatusRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateReturnReasonResponse) Validate() error {
+func (m *DeleteStatusRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateReturnReasonResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on DeleteStatusRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateReturnReasonResponseMultiError, or nil if none found.
-func (m *UpdateReturnReasonResponse) ValidateAll() error {
+// DeleteStatusRequestMultiError, or nil if none found.
+func (m *DeleteStatusRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateReturnReasonResponse) validate(all bool) error {
+func (m *DeleteStatusRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Message
+	if m.GetStatusId() <= 0 {
+		err := DeleteStatusRequestValidationError{
+			field:  "StatusId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return UpdateReturnReasonResponseMultiError(errors)
+		return DeleteStatusRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateReturnReasonResponseMultiError is an error wrapping multiple
-// validation errors returned by UpdateReturnReasonResponse.ValidateAll() if
-// the designated constraints aren't met.
-type UpdateReturnReasonResponseMultiError []error
+// DeleteStatusRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteStatusRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteStatusRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateReturnReasonResponseMultiError) Error() string {
+func (m DeleteStatusRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6849,11 +9277,11 @@ func (m UpdateReturnReasonResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateReturnReasonResponseMultiError) AllErrors() []error { return m }
+func (m DeleteStatusRequestMultiError) AllErrors() []error { return m }
 
-// UpdateReturnReasonResponseValidationError is the validation error returned
-// by UpdateReturnReasonResponse.Validate if the designated constraints aren't met.
-type UpdateReturnReasonResponseValidationError struct {
+// DeleteStatusRequestValidationError is the validation error returned by
+// DeleteStatusRequest.Validate if the designated constraints aren't met.
+type DeleteStatusRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6861,24 +9289,24 @@ type UpdateReturnReasonResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateReturnReasonResponseValidationError) Field() string { return e.field }
+func (e DeleteStatusRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateReturnReasonResponseValidationError) Reason() string { return e.reason }
+func (e DeleteStatusRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateReturnReasonResponseValidationError) Cause() error { return e.cause }
+func (e DeleteStatusRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateReturnReasonResponseValidationError) Key() bool { return e.key }
+func (e DeleteStatusRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateReturnReasonResponseValidationError) ErrorName() string {
-	return "UpdateReturnReasonResponseValidationError"
+func (e DeleteStatusRequestValidationError) ErrorName() string {
+	return "DeleteStatusRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateReturnReasonResponseValidationError) Error() string {
+func (e DeleteStatusRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6890,14 +9318,14 @@ func (e UpdateReturnReasonResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateReturnReasonResponse.%s: %s%s",
+		"invalid %sDeleteStatusRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateReturnReasonResponseValidationError{}
+var _ error = DeleteStatusRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -6905,55 +9333,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateReturnReasonResponseValidationError{}
+} = DeleteStatusRequestValidationError{}
 
-// Validate checks the field values on DeleteReturnReasonRequest with the rules
+// Validate checks the field values on DeleteStatusResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteReturnReasonRequest) Validate() error {
+func (m *DeleteStatusResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteReturnReasonRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on DeleteStatusResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteReturnReasonRequestMultiError, or nil if none found.
-func (m *DeleteReturnReasonRequest) ValidateAll() error {
+// DeleteStatusResponseMultiError, or nil if none found.
+func (m *DeleteStatusResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteReturnReasonRequest) validate(all bool) error {
+func (m *DeleteStatusResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetReasonId() <= 0 {
-		err := DeleteReturnReasonRequestValidationError{
-			field:  "ReasonId",
-			reason: "value must be greater than 0",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Message
 
 	if len(errors) > 0 {
-		return DeleteReturnReasonRequestMultiError(errors)
+		return DeleteStatusResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteReturnReasonRequestMultiError is an error wrapping multiple validation
-// errors returned by DeleteReturnReasonRequest.ValidateAll() if the
-// designated constraints aren't met.
-type DeleteReturnReasonRequestMultiError []error
+// DeleteStatusResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteStatusResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteStatusResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteReturnReasonRequestMultiError) Error() string {
+func (m DeleteStatusResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6962,11 +9381,11 @@ func (m DeleteReturnReasonRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteReturnReasonRequestMultiError) AllErrors() []error { return m }
+func (m DeleteStatusResponseMultiError) AllErrors() []error { return m }
 
-// DeleteReturnReasonRequestValidationError is the validation error returned by
-// DeleteReturnReasonRequest.Validate if the designated constraints aren't met.
-type DeleteReturnReasonRequestValidationError struct {
+// DeleteStatusResponseValidationError is the validation error returned by
+// DeleteStatusResponse.Validate if the designated constraints aren't met.
+type DeleteStatusResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6974,24 +9393,24 @@ type DeleteReturnReasonRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteReturnReasonRequestValidationError) Field() string { return e.field }
+func (e DeleteStatusResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteReturnReasonRequestValidationError) Reason() string { return e.reason }
+func (e DeleteStatusResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteReturnReasonRequestValidationError) Cause() error { return e.cause }
+func (e DeleteStatusResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteReturnReasonRequestValidationError) Key() bool { return e.key }
+func (e DeleteStatusResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteReturnReasonRequestValidationError) ErrorName() string {
-	return "DeleteReturnReasonRequestValidationError"
+func (e DeleteStatusResponseValidationError) ErrorName() string {
+	return "DeleteStatusResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteReturnReasonRequestValidationError) Error() string {
+func (e DeleteStatusResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7003,14 +9422,14 @@ func (e DeleteReturnReasonRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteReturnReasonRequest.%s: %s%s",
+		"invalid %sDeleteStatusResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteReturnReasonRequestValidationError{}
+var _ error = DeleteStatusResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -7018,46 +9437,55 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteReturnReasonRequestValidationError{}
+} = DeleteStatusResponseValidationError{}
 
-// Validate checks the field values on DeleteReturnReasonResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on GetStatusByNameRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteReturnReasonResponse) Validate() error {
+func (m *GetStatusByNameRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteReturnReasonResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetStatusByNameRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteReturnReasonResponseMultiError, or nil if none found.
-func (m *DeleteReturnReasonResponse) ValidateAll() error {
+// GetStatusByNameRequestMultiError, or nil if none found.
+func (m *GetStatusByNameRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteReturnReasonResponse) validate(all bool) error {
+func (m *GetStatusByNameRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Message
+	if utf8.RuneCountInString(m.GetStatusName()) < 1 {
+		err := GetStatusByNameRequestValidationError{
+			field:  "StatusName",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return DeleteReturnReasonResponseMultiError(errors)
+		return GetStatusByNameRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteReturnReasonResponseMultiError is an error wrapping multiple
-// validation errors returned by DeleteReturnReasonResponse.ValidateAll() if
-// the designated constraints aren't met.
-type DeleteReturnReasonResponseMultiError []error
+// GetStatusByNameRequestMultiError is an error wrapping multiple validation
+// errors returned by GetStatusByNameRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetStatusByNameRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteReturnReasonResponseMultiError) Error() string {
+func (m GetStatusByNameRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7066,11 +9494,11 @@ func (m DeleteReturnReasonResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteReturnReasonResponseMultiError) AllErrors() []error { return m }
+func (m GetStatusByNameRequestMultiError) AllErrors() []error { return m }
 
-// DeleteReturnReasonResponseValidationError is the validation error returned
-// by DeleteReturnReasonResponse.Validate if the designated constraints aren't met.
-type DeleteReturnReasonResponseValidationError struct {
+// GetStatusByNameRequestValidationError is the validation error returned by
+// GetStatusByNameRequest.Validate if the designated constraints aren't met.
+type GetStatusByNameRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7078,24 +9506,24 @@ type DeleteReturnReasonResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteReturnReasonResponseValidationError) Field() string { return e.field }
+func (e GetStatusByNameRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteReturnReasonResponseValidationError) Reason() string { return e.reason }
+func (e GetStatusByNameRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteReturnReasonResponseValidationError) Cause() error { return e.cause }
+func (e GetStatusByNameRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteReturnReasonResponseValidationError) Key() bool { return e.key }
+func (e GetStatusByNameRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteReturnReasonResponseValidationError) ErrorName() string {
-	return "DeleteReturnReasonResponseValidationError"
+func (e GetStatusByNameRequestValidationError) ErrorName() string {
+	return "GetStatusByNameRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteReturnReasonResponseValidationError) Error() string {
+func (e GetStatusByNameRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7107,14 +9535,14 @@ func (e DeleteReturnReasonResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteReturnReasonResponse.%s: %s%s",
+		"invalid %sGetStatusByNameRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteReturnReasonResponseValidationError{}
+var _ error = GetStatusByNameRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -7122,55 +9550,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteReturnReasonResponseValidationError{}
+} = GetStatusByNameRequestValidationError{}
 
-// Validate checks the field values on CheckReturnReasonExistsRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on GetStatusByNameResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CheckReturnReasonExistsRequest) Validate() error {
+func (m *GetStatusByNameResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CheckReturnReasonExistsRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// CheckReturnReasonExistsRequestMultiError, or nil if none found.
-func (m *CheckReturnReasonExistsRequest) ValidateAll() error {
+// ValidateAll checks the field values on GetStatusByNameResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetStatusByNameResponseMultiError, or nil if none found.
+func (m *GetStatusByNameResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CheckReturnReasonExistsRequest) validate(all bool) error {
+func (m *GetStatusByNameResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetReasonId() <= 0 {
-		err := CheckReturnReasonExistsRequestValidationError{
-			field:  "ReasonId",
-			reason: "value must be greater than 0",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for StatusId
+
+	// no validation rules for StatusName
 
 	if len(errors) > 0 {
-		return CheckReturnReasonExistsRequestMultiError(errors)
+		return GetStatusByNameResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CheckReturnReasonExistsRequestMultiError is an error wrapping multiple
-// validation errors returned by CheckReturnReasonExistsRequest.ValidateAll()
-// if the designated constraints aren't met.
-type CheckReturnReasonExistsRequestMultiError []error
+// GetStatusByNameResponseMultiError is an error wrapping multiple validation
+// errors returned by GetStatusByNameResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetStatusByNameResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CheckReturnReasonExistsRequestMultiError) Error() string {
+func (m GetStatusByNameResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7179,12 +9600,11 @@ func (m CheckReturnReasonExistsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CheckReturnReasonExistsRequestMultiError) AllErrors() []error { return m }
+func (m GetStatusByNameResponseMultiError) AllErrors() []error { return m }
 
-// CheckReturnReasonExistsRequestValidationError is the validation error
-// returned by CheckReturnReasonExistsRequest.Validate if the designated
-// constraints aren't met.
-type CheckReturnReasonExistsRequestValidationError struct {
+// GetStatusByNameResponseValidationError is the validation error returned by
+// GetStatusByNameResponse.Validate if the designated constraints aren't met.
+type GetStatusByNameResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7192,24 +9612,24 @@ type CheckReturnReasonExistsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CheckReturnReasonExistsRequestValidationError) Field() string { return e.field }
+func (e GetStatusByNameResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CheckReturnReasonExistsRequestValidationError) Reason() string { return e.reason }
+func (e GetStatusByNameResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CheckReturnReasonExistsRequestValidationError) Cause() error { return e.cause }
+func (e GetStatusByNameResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CheckReturnReasonExistsRequestValidationError) Key() bool { return e.key }
+func (e GetStatusByNameResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CheckReturnReasonExistsRequestValidationError) ErrorName() string {
-	return "CheckReturnReasonExistsRequestValidationError"
+func (e GetStatusByNameResponseValidationError) ErrorName() string {
+	return "GetStatusByNameResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CheckReturnReasonExistsRequestValidationError) Error() string {
+func (e GetStatusByNameResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7221,14 +9641,14 @@ func (e CheckReturnReasonExistsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCheckReturnReasonExistsRequest.%s: %s%s",
+		"invalid %sGetStatusByNameResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CheckReturnReasonExistsRequestValidationError{}
+var _ error = GetStatusByNameResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -7236,46 +9656,55 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CheckReturnReasonExistsRequestValidationError{}
+} = GetStatusByNameResponseValidationError{}
 
-// Validate checks the field values on CheckReturnReasonExistsResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on CheckStatusExistsRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CheckReturnReasonExistsResponse) Validate() error {
+func (m *CheckStatusExistsRequest) Validate() error {
 	return m.validate(false)
 }
-
-// ValidateAll checks the field values on CheckReturnReasonExistsResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// CheckReturnReasonExistsResponseMultiError, or nil if none found.
-func (m *CheckReturnReasonExistsResponse) ValidateAll() error {
+
+// ValidateAll checks the field values on CheckStatusExistsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CheckStatusExistsRequestMultiError, or nil if none found.
+func (m *CheckStatusExistsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CheckReturnReasonExistsResponse) validate(all bool) error {
+func (m *CheckStatusExistsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Exists
+	if m.GetStatusId() <= 0 {
+		err := CheckStatusExistsRequestValidationError{
+			field:  "StatusId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return CheckReturnReasonExistsResponseMultiError(errors)
+		return CheckStatusExistsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CheckReturnReasonExistsResponseMultiError is an error wrapping multiple
-// validation errors returned by CheckReturnReasonExistsResponse.ValidateAll()
-// if the designated constraints aren't met.
-type CheckReturnReasonExistsResponseMultiError []error
+// CheckStatusExistsRequestMultiError is an error wrapping multiple validation
+// errors returned by CheckStatusExistsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CheckStatusExistsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CheckReturnReasonExistsResponseMultiError) Error() string {
+func (m CheckStatusExistsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7284,12 +9713,11 @@ func (m CheckReturnReasonExistsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CheckReturnReasonExistsResponseMultiError) AllErrors() []error { return m }
+func (m CheckStatusExistsRequestMultiError) AllErrors() []error { return m }
 
-// CheckReturnReasonExistsResponseValidationError is the validation error
-// returned by CheckReturnReasonExistsResponse.Validate if the designated
-// constraints aren't met.
-type CheckReturnReasonExistsResponseValidationError struct {
+// CheckStatusExistsRequestValidationError is the validation error returned by
+// CheckStatusExistsRequest.Validate if the designated constraints aren't met.
+type CheckStatusExistsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7297,24 +9725,24 @@ type CheckReturnReasonExistsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CheckReturnReasonExistsResponseValidationError) Field() string { return e.field }
+func (e CheckStatusExistsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CheckReturnReasonExistsResponseValidationError) Reason() string { return e.reason }
+func (e CheckStatusExistsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CheckReturnReasonExistsResponseValidationError) Cause() error { return e.cause }
+func (e CheckStatusExistsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CheckReturnReasonExistsResponseValidationError) Key() bool { return e.key }
+func (e CheckStatusExistsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CheckReturnReasonExistsResponseValidationError) ErrorName() string {
-	return "CheckReturnReasonExistsResponseValidationError"
+func (e CheckStatusExistsRequestValidationError) ErrorName() string {
+	return "CheckStatusExistsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CheckReturnReasonExistsResponseValidationError) Error() string {
+func (e CheckStatusExistsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7326,14 +9754,14 @@ func (e CheckReturnReasonExistsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCheckReturnReasonExistsResponse.%s: %s%s",
+		"invalid %sCheckStatusExistsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CheckReturnReasonExistsResponseValidationError{}
+var _ error = CheckStatusExistsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -7341,55 +9769,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CheckReturnReasonExistsResponseValidationError{}
+} = CheckStatusExistsRequestValidationError{}
 
-// Validate checks the field values on CreateStatusRequest with the rules
+// Validate checks the field values on CheckStatusExistsResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateStatusRequest) Validate() error {
+func (m *CheckStatusExistsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateStatusRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on CheckStatusExistsResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateStatusRequestMultiError, or nil if none found.
-func (m *CreateStatusRequest) ValidateAll() error {
+// CheckStatusExistsResponseMultiError, or nil if none found.
+func (m *CheckStatusExistsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateStatusRequest) validate(all bool) error {
+func (m *CheckStatusExistsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetStatusName()) < 1 {
-		err := CreateStatusRequestValidationError{
-			field:  "StatusName",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Exists
 
 	if len(errors) > 0 {
-		return CreateStatusRequestMultiError(errors)
+		return CheckStatusExistsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateStatusRequestMultiError is an error wrapping multiple validation
-// errors returned by CreateStatusRequest.ValidateAll() if the designated
-// constraints aren't met.
-type CreateStatusRequestMultiError []error
+// CheckStatusExistsResponseMultiError is an error wrapping multiple validation
+// errors returned by CheckStatusExistsResponse.ValidateAll() if the
+// designated constraints aren't met.
+type CheckStatusExistsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateStatusRequestMultiError) Error() string {
+func (m CheckStatusExistsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7398,11 +9817,11 @@ func (m CreateStatusRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateStatusRequestMultiError) AllErrors() []error { return m }
+func (m CheckStatusExistsResponseMultiError) AllErrors() []error { return m }
 
-// CreateStatusRequestValidationError is the validation error returned by
-// CreateStatusRequest.Validate if the designated constraints aren't met.
-type CreateStatusRequestValidationError struct {
+// CheckStatusExistsResponseValidationError is the validation error returned by
+// CheckStatusExistsResponse.Validate if the designated constraints aren't met.
+type CheckStatusExistsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7410,24 +9829,24 @@ type CreateStatusRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateStatusRequestValidationError) Field() string { return e.field }
+func (e CheckStatusExistsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateStatusRequestValidationError) Reason() string { return e.reason }
+func (e CheckStatusExistsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateStatusRequestValidationError) Cause() error { return e.cause }
+func (e CheckStatusExistsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateStatusRequestValidationError) Key() bool { return e.key }
+func (e CheckStatusExistsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateStatusRequestValidationError) ErrorName() string {
-	return "CreateStatusRequestValidationError"
+func (e CheckStatusExistsResponseValidationError) ErrorName() string {
+	return "CheckStatusExistsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateStatusRequestValidationError) Error() string {
+func (e CheckStatusExistsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7439,14 +9858,14 @@ func (e CreateStatusRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateStatusRequest.%s: %s%s",
+		"invalid %sCheckStatusExistsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateStatusRequestValidationError{}
+var _ error = CheckStatusExistsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -7454,48 +9873,55 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateStatusRequestValidationError{}
+} = CheckStatusExistsResponseValidationError{}
 
-// Validate checks the field values on CreateStatusResponse with the rules
+// Validate checks the field values on SetWorkerCountRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateStatusResponse) Validate() error {
+func (m *SetWorkerCountRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateStatusResponse with the rules
+// ValidateAll checks the field values on SetWorkerCountRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateStatusResponseMultiError, or nil if none found.
-func (m *CreateStatusResponse) ValidateAll() error {
+// SetWorkerCountRequestMultiError, or nil if none found.
+func (m *SetWorkerCountRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateStatusResponse) validate(all bool) error {
+func (m *SetWorkerCountRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for StatusId
-
-	// no validation rules for Message
+	if m.GetCount() <= 0 {
+		err := SetWorkerCountRequestValidationError{
+			field:  "Count",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return CreateStatusResponseMultiError(errors)
+		return SetWorkerCountRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateStatusResponseMultiError is an error wrapping multiple validation
-// errors returned by CreateStatusResponse.ValidateAll() if the designated
+// SetWorkerCountRequestMultiError is an error wrapping multiple validation
+// errors returned by SetWorkerCountRequest.ValidateAll() if the designated
 // constraints aren't met.
-type CreateStatusResponseMultiError []error
+type SetWorkerCountRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateStatusResponseMultiError) Error() string {
+func (m SetWorkerCountRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7504,11 +9930,11 @@ func (m CreateStatusResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateStatusResponseMultiError) AllErrors() []error { return m }
+func (m SetWorkerCountRequestMultiError) AllErrors() []error { return m }
 
-// CreateStatusResponseValidationError is the validation error returned by
-// CreateStatusResponse.Validate if the designated constraints aren't met.
-type CreateStatusResponseValidationError struct {
+// SetWorkerCountRequestValidationError is the validation error returned by
+// SetWorkerCountRequest.Validate if the designated constraints aren't met.
+type SetWorkerCountRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7516,24 +9942,24 @@ type CreateStatusResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateStatusResponseValidationError) Field() string { return e.field }
+func (e SetWorkerCountRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateStatusResponseValidationError) Reason() string { return e.reason }
+func (e SetWorkerCountRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateStatusResponseValidationError) Cause() error { return e.cause }
+func (e SetWorkerCountRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateStatusResponseValidationError) Key() bool { return e.key }
+func (e SetWorkerCountRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateStatusResponseValidationError) ErrorName() string {
-	return "CreateStatusResponseValidationError"
+func (e SetWorkerCountRequestValidationError) ErrorName() string {
+	return "SetWorkerCountRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateStatusResponseValidationError) Error() string {
+func (e SetWorkerCountRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7545,14 +9971,14 @@ func (e CreateStatusResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateStatusResponse.%s: %s%s",
+		"invalid %sSetWorkerCountRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateStatusResponseValidationError{}
+var _ error = SetWorkerCountRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -7560,55 +9986,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateStatusResponseValidationError{}
+} = SetWorkerCountRequestValidationError{}
 
-// Validate checks the field values on GetStatusByIDRequest with the rules
+// Validate checks the field values on SetWorkerCountResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetStatusByIDRequest) Validate() error {
+func (m *SetWorkerCountResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetStatusByIDRequest with the rules
+// ValidateAll checks the field values on SetWorkerCountResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetStatusByIDRequestMultiError, or nil if none found.
-func (m *GetStatusByIDRequest) ValidateAll() error {
+// SetWorkerCountResponseMultiError, or nil if none found.
+func (m *SetWorkerCountResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetStatusByIDRequest) validate(all bool) error {
+func (m *SetWorkerCountResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetStatusId() <= 0 {
-		err := GetStatusByIDRequestValidationError{
-			field:  "StatusId",
-			reason: "value must be greater than 0",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for NewCount
 
 	if len(errors) > 0 {
-		return GetStatusByIDRequestMultiError(errors)
+		return SetWorkerCountResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetStatusByIDRequestMultiError is an error wrapping multiple validation
-// errors returned by GetStatusByIDRequest.ValidateAll() if the designated
+// SetWorkerCountResponseMultiError is an error wrapping multiple validation
+// errors returned by SetWorkerCountResponse.ValidateAll() if the designated
 // constraints aren't met.
-type GetStatusByIDRequestMultiError []error
+type SetWorkerCountResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetStatusByIDRequestMultiError) Error() string {
+func (m SetWorkerCountResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7617,11 +10034,11 @@ func (m GetStatusByIDRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetStatusByIDRequestMultiError) AllErrors() []error { return m }
+func (m SetWorkerCountResponseMultiError) AllErrors() []error { return m }
 
-// GetStatusByIDRequestValidationError is the validation error returned by
-// GetStatusByIDRequest.Validate if the designated constraints aren't met.
-type GetStatusByIDRequestValidationError struct {
+// SetWorkerCountResponseValidationError is the validation error returned by
+// SetWorkerCountResponse.Validate if the designated constraints aren't met.
+type SetWorkerCountResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7629,24 +10046,24 @@ type GetStatusByIDRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetStatusByIDRequestValidationError) Field() string { return e.field }
+func (e SetWorkerCountResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetStatusByIDRequestValidationError) Reason() string { return e.reason }
+func (e SetWorkerCountResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetStatusByIDRequestValidationError) Cause() error { return e.cause }
+func (e SetWorkerCountResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetStatusByIDRequestValidationError) Key() bool { return e.key }
+func (e SetWorkerCountResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetStatusByIDRequestValidationError) ErrorName() string {
-	return "GetStatusByIDRequestValidationError"
+func (e SetWorkerCountResponseValidationError) ErrorName() string {
+	return "SetWorkerCountResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetStatusByIDRequestValidationError) Error() string {
+func (e SetWorkerCountResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7658,14 +10075,14 @@ func (e GetStatusByIDRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetStatusByIDRequest.%s: %s%s",
+		"invalid %sSetWorkerCountResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetStatusByIDRequestValidationError{}
+var _ error = SetWorkerCountResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -7673,48 +10090,62 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetStatusByIDRequestValidationError{}
+} = SetWorkerCountResponseValidationError{}
 
-// Validate checks the field values on GetStatusByIDResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on GetWorkerPoolStatsResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetStatusByIDResponse) Validate() error {
+func (m *GetWorkerPoolStatsResponse) Validate() error {
 	return m.validate(false)
 }
-
-// ValidateAll checks the field values on GetStatusByIDResponse with the rules
-// defined in the proto definition for this message. If any rules are
+
+// ValidateAll checks the field values on GetWorkerPoolStatsResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetStatusByIDResponseMultiError, or nil if none found.
-func (m *GetStatusByIDResponse) ValidateAll() error {
+// GetWorkerPoolStatsResponseMultiError, or nil if none found.
+func (m *GetWorkerPoolStatsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetStatusByIDResponse) validate(all bool) error {
+func (m *GetWorkerPoolStatsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for StatusId
+	// no validation rules for Workers
 
-	// no validation rules for StatusName
+	// no validation rules for ActiveWorkers
+
+	// no validation rules for QueueDepth
+
+	// no validation rules for QueueCapacity
+
+	// no validation rules for CompletedTasks
+
+	// no validation rules for FailedTasks
+
+	// no validation rules for PanickedTasks
+
+	// no validation rules for AvgWaitTimeMs
+
+	// no validation rules for RejectedTasks
 
 	if len(errors) > 0 {
-		return GetStatusByIDResponseMultiError(errors)
+		return GetWorkerPoolStatsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetStatusByIDResponseMultiError is an error wrapping multiple validation
-// errors returned by GetStatusByIDResponse.ValidateAll() if the designated
-// constraints aren't met.
-type GetStatusByIDResponseMultiError []error
+// GetWorkerPoolStatsResponseMultiError is an error wrapping multiple
+// validation errors returned by GetWorkerPoolStatsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetWorkerPoolStatsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetStatusByIDResponseMultiError) Error() string {
+func (m GetWorkerPoolStatsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7723,11 +10154,11 @@ func (m GetStatusByIDResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetStatusByIDResponseMultiError) AllErrors() []error { return m }
+func (m GetWorkerPoolStatsResponseMultiError) AllErrors() []error { return m }
 
-// GetStatusByIDResponseValidationError is the validation error returned by
-// GetStatusByIDResponse.Validate if the designated constraints aren't met.
-type GetStatusByIDResponseValidationError struct {
+// GetWorkerPoolStatsResponseValidationError is the validation error returned
+// by GetWorkerPoolStatsResponse.Validate if the designated constraints aren't met.
+type GetWorkerPoolStatsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7735,24 +10166,24 @@ type GetStatusByIDResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetStatusByIDResponseValidationError) Field() string { return e.field }
+func (e GetWorkerPoolStatsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetStatusByIDResponseValidationError) Reason() string { return e.reason }
+func (e GetWorkerPoolStatsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetStatusByIDResponseValidationError) Cause() error { return e.cause }
+func (e GetWorkerPoolStatsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetStatusByIDResponseValidationError) Key() bool { return e.key }
+func (e GetWorkerPoolStatsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetStatusByIDResponseValidationError) ErrorName() string {
-	return "GetStatusByIDResponseValidationError"
+func (e GetWorkerPoolStatsResponseValidationError) ErrorName() string {
+	return "GetWorkerPoolStatsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetStatusByIDResponseValidationError) Error() string {
+func (e GetWorkerPoolStatsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7764,14 +10195,14 @@ func (e GetStatusByIDResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetStatusByIDResponse.%s: %s%s",
+		"invalid %sGetWorkerPoolStatsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetStatusByIDResponseValidationError{}
+var _ error = GetWorkerPoolStatsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -7779,44 +10210,64 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetStatusByIDResponseValidationError{}
+} = GetWorkerPoolStatsResponseValidationError{}
 
-// Validate checks the field values on GetAllStatusesRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetAllStatusesRequest) Validate() error {
+// Validate checks the field values on Job with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *Job) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetAllStatusesRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetAllStatusesRequestMultiError, or nil if none found.
-func (m *GetAllStatusesRequest) ValidateAll() error {
+// ValidateAll checks the field values on Job with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in JobMultiError, or nil if none found.
+func (m *Job) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetAllStatusesRequest) validate(all bool) error {
+func (m *Job) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Name
+
+	// no validation rules for Schedule
+
+	// no validation rules for Running
+
+	// no validation rules for LastRunAt
+
+	// no validation rules for LastDurationMs
+
+	// no validation rules for LastOutcome
+
+	// no validation rules for LastError
+
+	// no validation rules for NextRunAt
+
+	// no validation rules for Runs
+
+	// no validation rules for Failures
+
+	// no validation rules for LastSummary
+
 	if len(errors) > 0 {
-		return GetAllStatusesRequestMultiError(errors)
+		return JobMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetAllStatusesRequestMultiError is an error wrapping multiple validation
-// errors returned by GetAllStatusesRequest.ValidateAll() if the designated
-// constraints aren't met.
-type GetAllStatusesRequestMultiError []error
+// JobMultiError is an error wrapping multiple validation errors returned by
+// Job.ValidateAll() if the designated constraints aren't met.
+type JobMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetAllStatusesRequestMultiError) Error() string {
+func (m JobMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7825,11 +10276,11 @@ func (m GetAllStatusesRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetAllStatusesRequestMultiError) AllErrors() []error { return m }
+func (m JobMultiError) AllErrors() []error { return m }
 
-// GetAllStatusesRequestValidationError is the validation error returned by
-// GetAllStatusesRequest.Validate if the designated constraints aren't met.
-type GetAllStatusesRequestValidationError struct {
+// JobValidationError is the validation error returned by Job.Validate if the
+// designated constraints aren't met.
+type JobValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7837,24 +10288,22 @@ type GetAllStatusesRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetAllStatusesRequestValidationError) Field() string { return e.field }
+func (e JobValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetAllStatusesRequestValidationError) Reason() string { return e.reason }
+func (e JobValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetAllStatusesRequestValidationError) Cause() error { return e.cause }
+func (e JobValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetAllStatusesRequestValidationError) Key() bool { return e.key }
+func (e JobValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetAllStatusesRequestValidationError) ErrorName() string {
-	return "GetAllStatusesRequestValidationError"
-}
+func (e JobValidationError) ErrorName() string { return "JobValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GetAllStatusesRequestValidationError) Error() string {
+func (e JobValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7866,14 +10315,14 @@ func (e GetAllStatusesRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetAllStatusesRequest.%s: %s%s",
+		"invalid %sJob.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetAllStatusesRequestValidationError{}
+var _ error = JobValidationError{}
 
 var _ interface {
 	Field() string
@@ -7881,47 +10330,47 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetAllStatusesRequestValidationError{}
+} = JobValidationError{}
 
-// Validate checks the field values on GetAllStatusesResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetAllStatusesResponse) Validate() error {
+// Validate checks the field values on ListJobsResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListJobsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetAllStatusesResponse with the rules
+// ValidateAll checks the field values on ListJobsResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetAllStatusesResponseMultiError, or nil if none found.
-func (m *GetAllStatusesResponse) ValidateAll() error {
+// ListJobsResponseMultiError, or nil if none found.
+func (m *ListJobsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetAllStatusesResponse) validate(all bool) error {
+func (m *ListJobsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetStatuses() {
+	for idx, item := range m.GetJobs() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetAllStatusesResponseValidationError{
-						field:  fmt.Sprintf("Statuses[%v]", idx),
+					errors = append(errors, ListJobsResponseValidationError{
+						field:  fmt.Sprintf("Jobs[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, GetAllStatusesResponseValidationError{
-						field:  fmt.Sprintf("Statuses[%v]", idx),
+					errors = append(errors, ListJobsResponseValidationError{
+						field:  fmt.Sprintf("Jobs[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -7929,8 +10378,8 @@ func (m *GetAllStatusesResponse) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return GetAllStatusesResponseValidationError{
-					field:  fmt.Sprintf("Statuses[%v]", idx),
+				return ListJobsResponseValidationError{
+					field:  fmt.Sprintf("Jobs[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -7939,20 +10388,22 @@ func (m *GetAllStatusesResponse) validate(all bool) error {
 
 	}
 
+	// no validation rules for Leader
+
 	if len(errors) > 0 {
-		return GetAllStatusesResponseMultiError(errors)
+		return ListJobsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetAllStatusesResponseMultiError is an error wrapping multiple validation
-// errors returned by GetAllStatusesResponse.ValidateAll() if the designated
-// constraints aren't met.
-type GetAllStatusesResponseMultiError []error
+// ListJobsResponseMultiError is an error wrapping multiple validation errors
+// returned by ListJobsResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ListJobsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetAllStatusesResponseMultiError) Error() string {
+func (m ListJobsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7961,11 +10412,11 @@ func (m GetAllStatusesResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetAllStatusesResponseMultiError) AllErrors() []error { return m }
+func (m ListJobsResponseMultiError) AllErrors() []error { return m }
 
-// GetAllStatusesResponseValidationError is the validation error returned by
-// GetAllStatusesResponse.Validate if the designated constraints aren't met.
-type GetAllStatusesResponseValidationError struct {
+// ListJobsResponseValidationError is the validation error returned by
+// ListJobsResponse.Validate if the designated constraints aren't met.
+type ListJobsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7973,24 +10424,22 @@ type GetAllStatusesResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetAllStatusesResponseValidationError) Field() string { return e.field }
+func (e ListJobsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetAllStatusesResponseValidationError) Reason() string { return e.reason }
+func (e ListJobsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetAllStatusesResponseValidationError) Cause() error { return e.cause }
+func (e ListJobsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetAllStatusesResponseValidationError) Key() bool { return e.key }
+func (e ListJobsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetAllStatusesResponseValidationError) ErrorName() string {
-	return "GetAllStatusesResponseValidationError"
-}
+func (e ListJobsResponseValidationError) ErrorName() string { return "ListJobsResponseValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GetAllStatusesResponseValidationError) Error() string {
+func (e ListJobsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8002,14 +10451,14 @@ func (e GetAllStatusesResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetAllStatusesResponse.%s: %s%s",
+		"invalid %sListJobsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetAllStatusesResponseValidationError{}
+var _ error = ListJobsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -8017,44 +10466,33 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetAllStatusesResponseValidationError{}
+} = ListJobsResponseValidationError{}
 
-// Validate checks the field values on UpdateStatusRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateStatusRequest) Validate() error {
+// Validate checks the field values on TriggerJobRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *TriggerJobRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateStatusRequest with the rules
+// ValidateAll checks the field values on TriggerJobRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateStatusRequestMultiError, or nil if none found.
-func (m *UpdateStatusRequest) ValidateAll() error {
+// TriggerJobRequestMultiError, or nil if none found.
+func (m *TriggerJobRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateStatusRequest) validate(all bool) error {
+func (m *TriggerJobRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetStatusId() <= 0 {
-		err := UpdateStatusRequestValidationError{
-			field:  "StatusId",
-			reason: "value must be greater than 0",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if utf8.RuneCountInString(m.GetStatusName()) < 1 {
-		err := UpdateStatusRequestValidationError{
-			field:  "StatusName",
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := TriggerJobRequestValidationError{
+			field:  "Name",
 			reason: "value length must be at least 1 runes",
 		}
 		if !all {
@@ -8064,19 +10502,19 @@ func (m *UpdateStatusRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return UpdateStatusRequestMultiError(errors)
+		return TriggerJobRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateStatusRequestMultiError is an error wrapping multiple validation
-// errors returned by UpdateStatusRequest.ValidateAll() if the designated
-// constraints aren't met.
-type UpdateStatusRequestMultiError []error
+// TriggerJobRequestMultiError is an error wrapping multiple validation errors
+// returned by TriggerJobRequest.ValidateAll() if the designated constraints
+// aren't met.
+type TriggerJobRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateStatusRequestMultiError) Error() string {
+func (m TriggerJobRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8085,11 +10523,11 @@ func (m UpdateStatusRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateStatusRequestMultiError) AllErrors() []error { return m }
+func (m TriggerJobRequestMultiError) AllErrors() []error { return m }
 
-// UpdateStatusRequestValidationError is the validation error returned by
-// UpdateStatusRequest.Validate if the designated constraints aren't met.
-type UpdateStatusRequestValidationError struct {
+// TriggerJobRequestValidationError is the validation error returned by
+// TriggerJobRequest.Validate if the designated constraints aren't met.
+type TriggerJobRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8097,24 +10535,24 @@ type UpdateStatusRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateStatusRequestValidationError) Field() string { return e.field }
+func (e TriggerJobRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateStatusRequestValidationError) Reason() string { return e.reason }
+func (e TriggerJobRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateStatusRequestValidationError) Cause() error { return e.cause }
+func (e TriggerJobRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateStatusRequestValidationError) Key() bool { return e.key }
+func (e TriggerJobRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateStatusRequestValidationError) ErrorName() string {
-	return "UpdateStatusRequestValidationError"
+func (e TriggerJobRequestValidationError) ErrorName() string {
+	return "TriggerJobRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateStatusRequestValidationError) Error() string {
+func (e TriggerJobRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8126,14 +10564,14 @@ func (e UpdateStatusRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateStatusRequest.%s: %s%s",
+		"invalid %sTriggerJobRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateStatusRequestValidationError{}
+var _ error = TriggerJobRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -8141,46 +10579,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateStatusRequestValidationError{}
+} = TriggerJobRequestValidationError{}
 
-// Validate checks the field values on UpdateStatusResponse with the rules
+// Validate checks the field values on TriggerJobResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateStatusResponse) Validate() error {
+func (m *TriggerJobResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateStatusResponse with the rules
+// ValidateAll checks the field values on TriggerJobResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateStatusResponseMultiError, or nil if none found.
-func (m *UpdateStatusResponse) ValidateAll() error {
+// TriggerJobResponseMultiError, or nil if none found.
+func (m *TriggerJobResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateStatusResponse) validate(all bool) error {
+func (m *TriggerJobResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Message
+	if all {
+		switch v := interface{}(m.GetJob()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TriggerJobResponseValidationError{
+					field:  "Job",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TriggerJobResponseValidationError{
+					field:  "Job",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetJob()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TriggerJobResponseValidationError{
+				field:  "Job",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return UpdateStatusResponseMultiError(errors)
+		return TriggerJobResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateStatusResponseMultiError is an error wrapping multiple validation
-// errors returned by UpdateStatusResponse.ValidateAll() if the designated
-// constraints aren't met.
-type UpdateStatusResponseMultiError []error
+// TriggerJobResponseMultiError is an error wrapping multiple validation errors
+// returned by TriggerJobResponse.ValidateAll() if the designated constraints
+// aren't met.
+type TriggerJobResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateStatusResponseMultiError) Error() string {
+func (m TriggerJobResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8189,11 +10654,11 @@ func (m UpdateStatusResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateStatusResponseMultiError) AllErrors() []error { return m }
+func (m TriggerJobResponseMultiError) AllErrors() []error { return m }
 
-// UpdateStatusResponseValidationError is the validation error returned by
-// UpdateStatusResponse.Validate if the designated constraints aren't met.
-type UpdateStatusResponseValidationError struct {
+// TriggerJobResponseValidationError is the validation error returned by
+// TriggerJobResponse.Validate if the designated constraints aren't met.
+type TriggerJobResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8201,24 +10666,24 @@ type UpdateStatusResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateStatusResponseValidationError) Field() string { return e.field }
+func (e TriggerJobResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateStatusResponseValidationError) Reason() string { return e.reason }
+func (e TriggerJobResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateStatusResponseValidationError) Cause() error { return e.cause }
+func (e TriggerJobResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateStatusResponseValidationError) Key() bool { return e.key }
+func (e TriggerJobResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateStatusResponseValidationError) ErrorName() string {
-	return "UpdateStatusResponseValidationError"
+func (e TriggerJobResponseValidationError) ErrorName() string {
+	return "TriggerJobResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateStatusResponseValidationError) Error() string {
+func (e TriggerJobResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8230,14 +10695,14 @@ func (e UpdateStatusResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateStatusResponse.%s: %s%s",
+		"invalid %sTriggerJobResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateStatusResponseValidationError{}
+var _ error = TriggerJobResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -8245,55 +10710,59 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateStatusResponseValidationError{}
+} = TriggerJobResponseValidationError{}
 
-// Validate checks the field values on DeleteStatusRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteStatusRequest) Validate() error {
+// Validate checks the field values on CacheStats with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CacheStats) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteStatusRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// DeleteStatusRequestMultiError, or nil if none found.
-func (m *DeleteStatusRequest) ValidateAll() error {
+// ValidateAll checks the field values on CacheStats with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CacheStatsMultiError, or
+// nil if none found.
+func (m *CacheStats) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteStatusRequest) validate(all bool) error {
+func (m *CacheStats) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetStatusId() <= 0 {
-		err := DeleteStatusRequestValidationError{
-			field:  "StatusId",
-			reason: "value must be greater than 0",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Name
+
+	// no validation rules for Hits
+
+	// no validation rules for Misses
+
+	// no validation rules for Errors
+
+	// no validation rules for Loads
+
+	// no validation rules for LoadErrors
+
+	// no validation rules for AvgLoadTimeMs
+
+	// no validation rules for Evictions
 
 	if len(errors) > 0 {
-		return DeleteStatusRequestMultiError(errors)
+		return CacheStatsMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteStatusRequestMultiError is an error wrapping multiple validation
-// errors returned by DeleteStatusRequest.ValidateAll() if the designated
-// constraints aren't met.
-type DeleteStatusRequestMultiError []error
+// CacheStatsMultiError is an error wrapping multiple validation errors
+// returned by CacheStats.ValidateAll() if the designated constraints aren't met.
+type CacheStatsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteStatusRequestMultiError) Error() string {
+func (m CacheStatsMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8301,12 +10770,12 @@ func (m DeleteStatusRequestMultiError) Error() string {
 	return strings.Join(msgs, "; ")
 }
 
-// AllErrors returns a list of validation violation errors.
-func (m DeleteStatusRequestMultiError) AllErrors() []error { return m }
-
-// DeleteStatusRequestValidationError is the validation error returned by
-// DeleteStatusRequest.Validate if the designated constraints aren't met.
-type DeleteStatusRequestValidationError struct {
+// AllErrors returns a list of validation violation errors.
+func (m CacheStatsMultiError) AllErrors() []error { return m }
+
+// CacheStatsValidationError is the validation error returned by
+// CacheStats.Validate if the designated constraints aren't met.
+type CacheStatsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8314,24 +10783,22 @@ type DeleteStatusRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteStatusRequestValidationError) Field() string { return e.field }
+func (e CacheStatsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteStatusRequestValidationError) Reason() string { return e.reason }
+func (e CacheStatsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteStatusRequestValidationError) Cause() error { return e.cause }
+func (e CacheStatsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteStatusRequestValidationError) Key() bool { return e.key }
+func (e CacheStatsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteStatusRequestValidationError) ErrorName() string {
-	return "DeleteStatusRequestValidationError"
-}
+func (e CacheStatsValidationError) ErrorName() string { return "CacheStatsValidationError" }
 
 // Error satisfies the builtin error interface
-func (e DeleteStatusRequestValidationError) Error() string {
+func (e CacheStatsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8343,14 +10810,14 @@ func (e DeleteStatusRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteStatusRequest.%s: %s%s",
+		"invalid %sCacheStats.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteStatusRequestValidationError{}
+var _ error = CacheStatsValidationError{}
 
 var _ interface {
 	Field() string
@@ -8358,46 +10825,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteStatusRequestValidationError{}
+} = CacheStatsValidationError{}
 
-// Validate checks the field values on DeleteStatusResponse with the rules
+// Validate checks the field values on GetCacheStatsResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteStatusResponse) Validate() error {
+func (m *GetCacheStatsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteStatusResponse with the rules
+// ValidateAll checks the field values on GetCacheStatsResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteStatusResponseMultiError, or nil if none found.
-func (m *DeleteStatusResponse) ValidateAll() error {
+// GetCacheStatsResponseMultiError, or nil if none found.
+func (m *GetCacheStatsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteStatusResponse) validate(all bool) error {
+func (m *GetCacheStatsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Message
+	for idx, item := range m.GetCaches() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetCacheStatsResponseValidationError{
+						field:  fmt.Sprintf("Caches[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetCacheStatsResponseValidationError{
+						field:  fmt.Sprintf("Caches[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetCacheStatsResponseValidationError{
+					field:  fmt.Sprintf("Caches[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
 
 	if len(errors) > 0 {
-		return DeleteStatusResponseMultiError(errors)
+		return GetCacheStatsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteStatusResponseMultiError is an error wrapping multiple validation
-// errors returned by DeleteStatusResponse.ValidateAll() if the designated
+// GetCacheStatsResponseMultiError is an error wrapping multiple validation
+// errors returned by GetCacheStatsResponse.ValidateAll() if the designated
 // constraints aren't met.
-type DeleteStatusResponseMultiError []error
+type GetCacheStatsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteStatusResponseMultiError) Error() string {
+func (m GetCacheStatsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8406,11 +10905,11 @@ func (m DeleteStatusResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteStatusResponseMultiError) AllErrors() []error { return m }
+func (m GetCacheStatsResponseMultiError) AllErrors() []error { return m }
 
-// DeleteStatusResponseValidationError is the validation error returned by
-// DeleteStatusResponse.Validate if the designated constraints aren't met.
-type DeleteStatusResponseValidationError struct {
+// GetCacheStatsResponseValidationError is the validation error returned by
+// GetCacheStatsResponse.Validate if the designated constraints aren't met.
+type GetCacheStatsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8418,24 +10917,24 @@ type DeleteStatusResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteStatusResponseValidationError) Field() string { return e.field }
+func (e GetCacheStatsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteStatusResponseValidationError) Reason() string { return e.reason }
+func (e GetCacheStatsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteStatusResponseValidationError) Cause() error { return e.cause }
+func (e GetCacheStatsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteStatusResponseValidationError) Key() bool { return e.key }
+func (e GetCacheStatsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteStatusResponseValidationError) ErrorName() string {
-	return "DeleteStatusResponseValidationError"
+func (e GetCacheStatsResponseValidationError) ErrorName() string {
+	return "GetCacheStatsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteStatusResponseValidationError) Error() string {
+func (e GetCacheStatsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8447,14 +10946,14 @@ func (e DeleteStatusResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteStatusResponse.%s: %s%s",
+		"invalid %sGetCacheStatsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteStatusResponseValidationError{}
+var _ error = GetCacheStatsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -8462,33 +10961,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteStatusResponseValidationError{}
+} = GetCacheStatsResponseValidationError{}
 
-// Validate checks the field values on GetStatusByNameRequest with the rules
+// Validate checks the field values on InspectCacheKeyRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetStatusByNameRequest) Validate() error {
+func (m *InspectCacheKeyRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetStatusByNameRequest with the rules
+// ValidateAll checks the field values on InspectCacheKeyRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetStatusByNameRequestMultiError, or nil if none found.
-func (m *GetStatusByNameRequest) ValidateAll() error {
+// InspectCacheKeyRequestMultiError, or nil if none found.
+func (m *InspectCacheKeyRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetStatusByNameRequest) validate(all bool) error {
+func (m *InspectCacheKeyRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetStatusName()) < 1 {
-		err := GetStatusByNameRequestValidationError{
-			field:  "StatusName",
+	if utf8.RuneCountInString(m.GetCache()) < 1 {
+		err := InspectCacheKeyRequestValidationError{
+			field:  "Cache",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetKey()) < 1 {
+		err := InspectCacheKeyRequestValidationError{
+			field:  "Key",
 			reason: "value length must be at least 1 runes",
 		}
 		if !all {
@@ -8498,19 +11008,19 @@ func (m *GetStatusByNameRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return GetStatusByNameRequestMultiError(errors)
+		return InspectCacheKeyRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetStatusByNameRequestMultiError is an error wrapping multiple validation
-// errors returned by GetStatusByNameRequest.ValidateAll() if the designated
+// InspectCacheKeyRequestMultiError is an error wrapping multiple validation
+// errors returned by InspectCacheKeyRequest.ValidateAll() if the designated
 // constraints aren't met.
-type GetStatusByNameRequestMultiError []error
+type InspectCacheKeyRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetStatusByNameRequestMultiError) Error() string {
+func (m InspectCacheKeyRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8519,11 +11029,11 @@ func (m GetStatusByNameRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetStatusByNameRequestMultiError) AllErrors() []error { return m }
+func (m InspectCacheKeyRequestMultiError) AllErrors() []error { return m }
 
-// GetStatusByNameRequestValidationError is the validation error returned by
-// GetStatusByNameRequest.Validate if the designated constraints aren't met.
-type GetStatusByNameRequestValidationError struct {
+// InspectCacheKeyRequestValidationError is the validation error returned by
+// InspectCacheKeyRequest.Validate if the designated constraints aren't met.
+type InspectCacheKeyRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8531,24 +11041,24 @@ type GetStatusByNameRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetStatusByNameRequestValidationError) Field() string { return e.field }
+func (e InspectCacheKeyRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetStatusByNameRequestValidationError) Reason() string { return e.reason }
+func (e InspectCacheKeyRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetStatusByNameRequestValidationError) Cause() error { return e.cause }
+func (e InspectCacheKeyRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetStatusByNameRequestValidationError) Key() bool { return e.key }
+func (e InspectCacheKeyRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetStatusByNameRequestValidationError) ErrorName() string {
-	return "GetStatusByNameRequestValidationError"
+func (e InspectCacheKeyRequestValidationError) ErrorName() string {
+	return "InspectCacheKeyRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetStatusByNameRequestValidationError) Error() string {
+func (e InspectCacheKeyRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8560,14 +11070,14 @@ func (e GetStatusByNameRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetStatusByNameRequest.%s: %s%s",
+		"invalid %sInspectCacheKeyRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetStatusByNameRequestValidationError{}
+var _ error = InspectCacheKeyRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -8575,48 +11085,54 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetStatusByNameRequestValidationError{}
+} = InspectCacheKeyRequestValidationError{}
 
-// Validate checks the field values on GetStatusByNameResponse with the rules
+// Validate checks the field values on InspectCacheKeyResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetStatusByNameResponse) Validate() error {
+func (m *InspectCacheKeyResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetStatusByNameResponse with the
+// ValidateAll checks the field values on InspectCacheKeyResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetStatusByNameResponseMultiError, or nil if none found.
-func (m *GetStatusByNameResponse) ValidateAll() error {
+// InspectCacheKeyResponseMultiError, or nil if none found.
+func (m *InspectCacheKeyResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetStatusByNameResponse) validate(all bool) error {
+func (m *InspectCacheKeyResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for StatusId
+	// no validation rules for Key
 
-	// no validation rules for StatusName
+	// no validation rules for Value
+
+	// no validation rules for NotFound
+
+	// no validation rules for ExpiresAt
+
+	// no validation rules for LoadDurationMs
 
 	if len(errors) > 0 {
-		return GetStatusByNameResponseMultiError(errors)
+		return InspectCacheKeyResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetStatusByNameResponseMultiError is an error wrapping multiple validation
-// errors returned by GetStatusByNameResponse.ValidateAll() if the designated
+// InspectCacheKeyResponseMultiError is an error wrapping multiple validation
+// errors returned by InspectCacheKeyResponse.ValidateAll() if the designated
 // constraints aren't met.
-type GetStatusByNameResponseMultiError []error
+type InspectCacheKeyResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetStatusByNameResponseMultiError) Error() string {
+func (m InspectCacheKeyResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8625,11 +11141,11 @@ func (m GetStatusByNameResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetStatusByNameResponseMultiError) AllErrors() []error { return m }
+func (m InspectCacheKeyResponseMultiError) AllErrors() []error { return m }
 
-// GetStatusByNameResponseValidationError is the validation error returned by
-// GetStatusByNameResponse.Validate if the designated constraints aren't met.
-type GetStatusByNameResponseValidationError struct {
+// InspectCacheKeyResponseValidationError is the validation error returned by
+// InspectCacheKeyResponse.Validate if the designated constraints aren't met.
+type InspectCacheKeyResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8637,24 +11153,24 @@ type GetStatusByNameResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetStatusByNameResponseValidationError) Field() string { return e.field }
+func (e InspectCacheKeyResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetStatusByNameResponseValidationError) Reason() string { return e.reason }
+func (e InspectCacheKeyResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetStatusByNameResponseValidationError) Cause() error { return e.cause }
+func (e InspectCacheKeyResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetStatusByNameResponseValidationError) Key() bool { return e.key }
+func (e InspectCacheKeyResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetStatusByNameResponseValidationError) ErrorName() string {
-	return "GetStatusByNameResponseValidationError"
+func (e InspectCacheKeyResponseValidationError) ErrorName() string {
+	return "InspectCacheKeyResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetStatusByNameResponseValidationError) Error() string {
+func (e InspectCacheKeyResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8666,14 +11182,14 @@ func (e GetStatusByNameResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetStatusByNameResponse.%s: %s%s",
+		"invalid %sInspectCacheKeyResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetStatusByNameResponseValidationError{}
+var _ error = InspectCacheKeyResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -8681,34 +11197,34 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetStatusByNameResponseValidationError{}
+} = InspectCacheKeyResponseValidationError{}
 
-// Validate checks the field values on CheckStatusExistsRequest with the rules
+// Validate checks the field values on InvalidateCacheRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CheckStatusExistsRequest) Validate() error {
+func (m *InvalidateCacheRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CheckStatusExistsRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on InvalidateCacheRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CheckStatusExistsRequestMultiError, or nil if none found.
-func (m *CheckStatusExistsRequest) ValidateAll() error {
+// InvalidateCacheRequestMultiError, or nil if none found.
+func (m *InvalidateCacheRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CheckStatusExistsRequest) validate(all bool) error {
+func (m *InvalidateCacheRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetStatusId() <= 0 {
-		err := CheckStatusExistsRequestValidationError{
-			field:  "StatusId",
-			reason: "value must be greater than 0",
+	if utf8.RuneCountInString(m.GetCache()) < 1 {
+		err := InvalidateCacheRequestValidationError{
+			field:  "Cache",
+			reason: "value length must be at least 1 runes",
 		}
 		if !all {
 			return err
@@ -8717,19 +11233,19 @@ func (m *CheckStatusExistsRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return CheckStatusExistsRequestMultiError(errors)
+		return InvalidateCacheRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CheckStatusExistsRequestMultiError is an error wrapping multiple validation
-// errors returned by CheckStatusExistsRequest.ValidateAll() if the designated
+// InvalidateCacheRequestMultiError is an error wrapping multiple validation
+// errors returned by InvalidateCacheRequest.ValidateAll() if the designated
 // constraints aren't met.
-type CheckStatusExistsRequestMultiError []error
+type InvalidateCacheRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CheckStatusExistsRequestMultiError) Error() string {
+func (m InvalidateCacheRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8738,11 +11254,11 @@ func (m CheckStatusExistsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CheckStatusExistsRequestMultiError) AllErrors() []error { return m }
+func (m InvalidateCacheRequestMultiError) AllErrors() []error { return m }
 
-// CheckStatusExistsRequestValidationError is the validation error returned by
-// CheckStatusExistsRequest.Validate if the designated constraints aren't met.
-type CheckStatusExistsRequestValidationError struct {
+// InvalidateCacheRequestValidationError is the validation error returned by
+// InvalidateCacheRequest.Validate if the designated constraints aren't met.
+type InvalidateCacheRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8750,24 +11266,24 @@ type CheckStatusExistsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CheckStatusExistsRequestValidationError) Field() string { return e.field }
+func (e InvalidateCacheRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CheckStatusExistsRequestValidationError) Reason() string { return e.reason }
+func (e InvalidateCacheRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CheckStatusExistsRequestValidationError) Cause() error { return e.cause }
+func (e InvalidateCacheRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CheckStatusExistsRequestValidationError) Key() bool { return e.key }
+func (e InvalidateCacheRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CheckStatusExistsRequestValidationError) ErrorName() string {
-	return "CheckStatusExistsRequestValidationError"
+func (e InvalidateCacheRequestValidationError) ErrorName() string {
+	return "InvalidateCacheRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CheckStatusExistsRequestValidationError) Error() string {
+func (e InvalidateCacheRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8779,14 +11295,14 @@ func (e CheckStatusExistsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCheckStatusExistsRequest.%s: %s%s",
+		"invalid %sInvalidateCacheRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CheckStatusExistsRequestValidationError{}
+var _ error = InvalidateCacheRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -8794,46 +11310,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CheckStatusExistsRequestValidationError{}
+} = InvalidateCacheRequestValidationError{}
 
-// Validate checks the field values on CheckStatusExistsResponse with the rules
+// Validate checks the field values on InvalidateCacheResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CheckStatusExistsResponse) Validate() error {
+func (m *InvalidateCacheResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CheckStatusExistsResponse with the
+// ValidateAll checks the field values on InvalidateCacheResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CheckStatusExistsResponseMultiError, or nil if none found.
-func (m *CheckStatusExistsResponse) ValidateAll() error {
+// InvalidateCacheResponseMultiError, or nil if none found.
+func (m *InvalidateCacheResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CheckStatusExistsResponse) validate(all bool) error {
+func (m *InvalidateCacheResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Exists
-
 	if len(errors) > 0 {
-		return CheckStatusExistsResponseMultiError(errors)
+		return InvalidateCacheResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CheckStatusExistsResponseMultiError is an error wrapping multiple validation
-// errors returned by CheckStatusExistsResponse.ValidateAll() if the
-// designated constraints aren't met.
-type CheckStatusExistsResponseMultiError []error
+// InvalidateCacheResponseMultiError is an error wrapping multiple validation
+// errors returned by InvalidateCacheResponse.ValidateAll() if the designated
+// constraints aren't met.
+type InvalidateCacheResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CheckStatusExistsResponseMultiError) Error() string {
+func (m InvalidateCacheResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8842,11 +11356,11 @@ func (m CheckStatusExistsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CheckStatusExistsResponseMultiError) AllErrors() []error { return m }
+func (m InvalidateCacheResponseMultiError) AllErrors() []error { return m }
 
-// CheckStatusExistsResponseValidationError is the validation error returned by
-// CheckStatusExistsResponse.Validate if the designated constraints aren't met.
-type CheckStatusExistsResponseValidationError struct {
+// InvalidateCacheResponseValidationError is the validation error returned by
+// InvalidateCacheResponse.Validate if the designated constraints aren't met.
+type InvalidateCacheResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8854,24 +11368,24 @@ type CheckStatusExistsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CheckStatusExistsResponseValidationError) Field() string { return e.field }
+func (e InvalidateCacheResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CheckStatusExistsResponseValidationError) Reason() string { return e.reason }
+func (e InvalidateCacheResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CheckStatusExistsResponseValidationError) Cause() error { return e.cause }
+func (e InvalidateCacheResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CheckStatusExistsResponseValidationError) Key() bool { return e.key }
+func (e InvalidateCacheResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CheckStatusExistsResponseValidationError) ErrorName() string {
-	return "CheckStatusExistsResponseValidationError"
+func (e InvalidateCacheResponseValidationError) ErrorName() string {
+	return "InvalidateCacheResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CheckStatusExistsResponseValidationError) Error() string {
+func (e InvalidateCacheResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8883,14 +11397,14 @@ func (e CheckStatusExistsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCheckStatusExistsResponse.%s: %s%s",
+		"invalid %sInvalidateCacheResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CheckStatusExistsResponseValidationError{}
+var _ error = InvalidateCacheResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -8898,34 +11412,34 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CheckStatusExistsResponseValidationError{}
+} = InvalidateCacheResponseValidationError{}
 
-// Validate checks the field values on SetWorkerCountRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SetWorkerCountRequest) Validate() error {
+// Validate checks the field values on FlushCacheRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *FlushCacheRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SetWorkerCountRequest with the rules
+// ValidateAll checks the field values on FlushCacheRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// SetWorkerCountRequestMultiError, or nil if none found.
-func (m *SetWorkerCountRequest) ValidateAll() error {
+// FlushCacheRequestMultiError, or nil if none found.
+func (m *FlushCacheRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SetWorkerCountRequest) validate(all bool) error {
+func (m *FlushCacheRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetCount() <= 0 {
-		err := SetWorkerCountRequestValidationError{
-			field:  "Count",
-			reason: "value must be greater than 0",
+	if utf8.RuneCountInString(m.GetCache()) < 1 {
+		err := FlushCacheRequestValidationError{
+			field:  "Cache",
+			reason: "value length must be at least 1 runes",
 		}
 		if !all {
 			return err
@@ -8934,19 +11448,19 @@ func (m *SetWorkerCountRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return SetWorkerCountRequestMultiError(errors)
+		return FlushCacheRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// SetWorkerCountRequestMultiError is an error wrapping multiple validation
-// errors returned by SetWorkerCountRequest.ValidateAll() if the designated
-// constraints aren't met.
-type SetWorkerCountRequestMultiError []error
+// FlushCacheRequestMultiError is an error wrapping multiple validation errors
+// returned by FlushCacheRequest.ValidateAll() if the designated constraints
+// aren't met.
+type FlushCacheRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SetWorkerCountRequestMultiError) Error() string {
+func (m FlushCacheRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8955,11 +11469,11 @@ func (m SetWorkerCountRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SetWorkerCountRequestMultiError) AllErrors() []error { return m }
+func (m FlushCacheRequestMultiError) AllErrors() []error { return m }
 
-// SetWorkerCountRequestValidationError is the validation error returned by
-// SetWorkerCountRequest.Validate if the designated constraints aren't met.
-type SetWorkerCountRequestValidationError struct {
+// FlushCacheRequestValidationError is the validation error returned by
+// FlushCacheRequest.Validate if the designated constraints aren't met.
+type FlushCacheRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8967,24 +11481,24 @@ type SetWorkerCountRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e SetWorkerCountRequestValidationError) Field() string { return e.field }
+func (e FlushCacheRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SetWorkerCountRequestValidationError) Reason() string { return e.reason }
+func (e FlushCacheRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SetWorkerCountRequestValidationError) Cause() error { return e.cause }
+func (e FlushCacheRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SetWorkerCountRequestValidationError) Key() bool { return e.key }
+func (e FlushCacheRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SetWorkerCountRequestValidationError) ErrorName() string {
-	return "SetWorkerCountRequestValidationError"
+func (e FlushCacheRequestValidationError) ErrorName() string {
+	return "FlushCacheRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SetWorkerCountRequestValidationError) Error() string {
+func (e FlushCacheRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8996,14 +11510,14 @@ func (e SetWorkerCountRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSetWorkerCountRequest.%s: %s%s",
+		"invalid %sFlushCacheRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SetWorkerCountRequestValidationError{}
+var _ error = FlushCacheRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -9011,46 +11525,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SetWorkerCountRequestValidationError{}
+} = FlushCacheRequestValidationError{}
 
-// Validate checks the field values on SetWorkerCountResponse with the rules
+// Validate checks the field values on FlushCacheResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SetWorkerCountResponse) Validate() error {
+func (m *FlushCacheResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SetWorkerCountResponse with the rules
+// ValidateAll checks the field values on FlushCacheResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// SetWorkerCountResponseMultiError, or nil if none found.
-func (m *SetWorkerCountResponse) ValidateAll() error {
+// FlushCacheResponseMultiError, or nil if none found.
+func (m *FlushCacheResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SetWorkerCountResponse) validate(all bool) error {
+func (m *FlushCacheResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for NewCount
+	// no validation rules for DeletedKeys
 
 	if len(errors) > 0 {
-		return SetWorkerCountResponseMultiError(errors)
+		return FlushCacheResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// SetWorkerCountResponseMultiError is an error wrapping multiple validation
-// errors returned by SetWorkerCountResponse.ValidateAll() if the designated
-// constraints aren't met.
-type SetWorkerCountResponseMultiError []error
+// FlushCacheResponseMultiError is an error wrapping multiple validation errors
+// returned by FlushCacheResponse.ValidateAll() if the designated constraints
+// aren't met.
+type FlushCacheResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SetWorkerCountResponseMultiError) Error() string {
+func (m FlushCacheResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9059,11 +11573,11 @@ func (m SetWorkerCountResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SetWorkerCountResponseMultiError) AllErrors() []error { return m }
+func (m FlushCacheResponseMultiError) AllErrors() []error { return m }
 
-// SetWorkerCountResponseValidationError is the validation error returned by
-// SetWorkerCountResponse.Validate if the designated constraints aren't met.
-type SetWorkerCountResponseValidationError struct {
+// FlushCacheResponseValidationError is the validation error returned by
+// FlushCacheResponse.Validate if the designated constraints aren't met.
+type FlushCacheResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9071,24 +11585,24 @@ type SetWorkerCountResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e SetWorkerCountResponseValidationError) Field() string { return e.field }
+func (e FlushCacheResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SetWorkerCountResponseValidationError) Reason() string { return e.reason }
+func (e FlushCacheResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SetWorkerCountResponseValidationError) Cause() error { return e.cause }
+func (e FlushCacheResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SetWorkerCountResponseValidationError) Key() bool { return e.key }
+func (e FlushCacheResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SetWorkerCountResponseValidationError) ErrorName() string {
-	return "SetWorkerCountResponseValidationError"
+func (e FlushCacheResponseValidationError) ErrorName() string {
+	return "FlushCacheResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SetWorkerCountResponseValidationError) Error() string {
+func (e FlushCacheResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9100,14 +11614,14 @@ func (e SetWorkerCountResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSetWorkerCountResponse.%s: %s%s",
+		"invalid %sFlushCacheResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SetWorkerCountResponseValidationError{}
+var _ error = FlushCacheResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -9115,4 +11629,4 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SetWorkerCountResponseValidationError{}
+} = FlushCacheResponseValidationError{}
@@ -33,8 +33,10 @@ const (
 	APIService_UpdateOrder_FullMethodName             = "/api.v1.APIService/UpdateOrder"
 	APIService_DeleteOrder_FullMethodName             = "/api.v1.APIService/DeleteOrder"
 	APIService_GetOrdersByUserID_FullMethodName       = "/api.v1.APIService/GetOrdersByUserID"
+	APIService_ListOrders_FullMethodName              = "/api.v1.APIService/ListOrders"
 	APIService_IssueOrder_FullMethodName              = "/api.v1.APIService/IssueOrder"
 	APIService_SeedOrders_FullMethodName              = "/api.v1.APIService/SeedOrders"
+	APIService_GetOrderHistory_FullMethodName         = "/api.v1.APIService/GetOrderHistory"
 	APIService_CreatePackaging_FullMethodName         = "/api.v1.APIService/CreatePackaging"
 	APIService_GetPackaging_FullMethodName            = "/api.v1.APIService/GetPackaging"
 	APIService_GetAllPackaging_FullMethodName         = "/api.v1.APIService/GetAllPackaging"
@@ -44,6 +46,7 @@ const (
 	APIService_GetReturns_FullMethodName              = "/api.v1.APIService/GetReturns"
 	APIService_GetReturnByOrderID_FullMethodName      = "/api.v1.APIService/GetReturnByOrderID"
 	APIService_GetReturnsByUserID_FullMethodName      = "/api.v1.APIService/GetReturnsByUserID"
+	APIService_ListReturns_FullMethodName             = "/api.v1.APIService/ListReturns"
 	APIService_UpdateReturn_FullMethodName            = "/api.v1.APIService/UpdateReturn"
 	APIService_DeleteReturn_FullMethodName            = "/api.v1.APIService/DeleteReturn"
 	APIService_ProcessReturn_FullMethodName           = "/api.v1.APIService/ProcessReturn"
@@ -61,6 +64,14 @@ const (
 	APIService_GetStatusByName_FullMethodName         = "/api.v1.APIService/GetStatusByName"
 	APIService_CheckStatusExists_FullMethodName       = "/api.v1.APIService/CheckStatusExists"
 	APIService_SetWorkerCount_FullMethodName          = "/api.v1.APIService/SetWorkerCount"
+	APIService_GetWorkerPoolStats_FullMethodName      = "/api.v1.APIService/GetWorkerPoolStats"
+	APIService_ListJobs_FullMethodName                = "/api.v1.APIService/ListJobs"
+	APIService_TriggerJob_FullMethodName              = "/api.v1.APIService/TriggerJob"
+	APIService_GetCacheStats_FullMethodName           = "/api.v1.APIService/GetCacheStats"
+	APIService_InspectCacheKey_FullMethodName         = "/api.v1.APIService/InspectCacheKey"
+	APIService_InvalidateCache_FullMethodName         = "/api.v1.APIService/InvalidateCache"
+	APIService_FlushCache_FullMethodName              = "/api.v1.APIService/FlushCache"
+	APIService_WarmCache_FullMethodName               = "/api.v1.APIService/WarmCache"
 )
 
 // APIServiceClient is the client API for APIService service.
@@ -84,8 +95,10 @@ type APIServiceClient interface {
 	UpdateOrder(ctx context.Context, in *UpdateOrderRequest, opts ...grpc.CallOption) (*UpdateOrderResponse, error)
 	DeleteOrder(ctx context.Context, in *DeleteOrderRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	GetOrdersByUserID(ctx context.Context, in *GetOrdersByUserIDRequest, opts ...grpc.CallOption) (*GetOrdersByUserIDResponse, error)
+	ListOrders(ctx context.Context, in *ListOrdersRequest, opts ...grpc.CallOption) (*ListOrdersResponse, error)
 	IssueOrder(ctx context.Context, in *IssueOrderRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	SeedOrders(ctx context.Context, in *SeedOrdersRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetOrderHistory(ctx context.Context, in *GetOrderHistoryRequest, opts ...grpc.CallOption) (*GetOrderHistoryResponse, error)
 	// ------------- Packaging -------------
 	CreatePackaging(ctx context.Context, in *CreatePackagingRequest, opts ...grpc.CallOption) (*CreatePackagingResponse, error)
 	GetPackaging(ctx context.Context, in *GetPackagingRequest, opts ...grpc.CallOption) (*GetPackagingResponse, error)
@@ -97,6 +110,7 @@ type APIServiceClient interface {
 	GetReturns(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetReturnsResponse, error)
 	GetReturnByOrderID(ctx context.Context, in *GetReturnByOrderIDRequest, opts ...grpc.CallOption) (*GetReturnByOrderIDResponse, error)
 	GetReturnsByUserID(ctx context.Context, in *GetReturnsByUserIDRequest, opts ...grpc.CallOption) (*GetReturnsByUserIDResponse, error)
+	ListReturns(ctx context.Context, in *ListReturnsRequest, opts ...grpc.CallOption) (*ListReturnsResponse, error)
 	UpdateReturn(ctx context.Context, in *UpdateReturnRequest, opts ...grpc.CallOption) (*UpdateReturnResponse, error)
 	DeleteReturn(ctx context.Context, in *DeleteReturnRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	ProcessReturn(ctx context.Context, in *ProcessReturnRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
@@ -117,6 +131,16 @@ type APIServiceClient interface {
 	CheckStatusExists(ctx context.Context, in *CheckStatusExistsRequest, opts ...grpc.CallOption) (*CheckStatusExistsResponse, error)
 	// ------------- Worker -------------
 	SetWorkerCount(ctx context.Context, in *SetWorkerCountRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetWorkerPoolStats(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetWorkerPoolStatsResponse, error)
+	// ------------- Jobs -------------
+	ListJobs(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListJobsResponse, error)
+	TriggerJob(ctx context.Context, in *TriggerJobRequest, opts ...grpc.CallOption) (*TriggerJobResponse, error)
+	// ------------- Cache -------------
+	GetCacheStats(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetCacheStatsResponse, error)
+	InspectCacheKey(ctx context.Context, in *InspectCacheKeyRequest, opts ...grpc.CallOption) (*InspectCacheKeyResponse, error)
+	InvalidateCache(ctx context.Context, in *InvalidateCacheRequest, opts ...grpc.CallOption) (*InvalidateCacheResponse, error)
+	FlushCache(ctx context.Context, in *FlushCacheRequest, opts ...grpc.CallOption) (*FlushCacheResponse, error)
+	WarmCache(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type aPIServiceClient struct {
@@ -257,6 +281,16 @@ func (c *aPIServiceClient) GetOrdersByUserID(ctx context.Context, in *GetOrdersB
 	return out, nil
 }
 
+func (c *aPIServiceClient) ListOrders(ctx context.Context, in *ListOrdersRequest, opts ...grpc.CallOption) (*ListOrdersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListOrdersResponse)
+	err := c.cc.Invoke(ctx, APIService_ListOrders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *aPIServiceClient) IssueOrder(ctx context.Context, in *IssueOrderRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
@@ -277,6 +311,16 @@ func (c *aPIServiceClient) SeedOrders(ctx context.Context, in *SeedOrdersRequest
 	return out, nil
 }
 
+func (c *aPIServiceClient) GetOrderHistory(ctx context.Context, in *GetOrderHistoryRequest, opts ...grpc.CallOption) (*GetOrderHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetOrderHistoryResponse)
+	err := c.cc.Invoke(ctx, APIService_GetOrderHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *aPIServiceClient) CreatePackaging(ctx context.Context, in *CreatePackagingRequest, opts ...grpc.CallOption) (*CreatePackagingResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreatePackagingResponse)
@@ -367,6 +411,16 @@ func (c *aPIServiceClient) GetReturnsByUserID(ctx context.Context, in *GetReturn
 	return out, nil
 }
 
+func (c *aPIServiceClient) ListReturns(ctx context.Context, in *ListReturnsRequest, opts ...grpc.CallOption) (*ListReturnsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListReturnsResponse)
+	err := c.cc.Invoke(ctx, APIService_ListReturns_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *aPIServiceClient) UpdateReturn(ctx context.Context, in *UpdateReturnRequest, opts ...grpc.CallOption) (*UpdateReturnResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(UpdateReturnResponse)
@@ -537,6 +591,86 @@ func (c *aPIServiceClient) SetWorkerCount(ctx context.Context, in *SetWorkerCoun
 	return out, nil
 }
 
+func (c *aPIServiceClient) GetWorkerPoolStats(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetWorkerPoolStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetWorkerPoolStatsResponse)
+	err := c.cc.Invoke(ctx, APIService_GetWorkerPoolStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) ListJobs(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListJobsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListJobsResponse)
+	err := c.cc.Invoke(ctx, APIService_ListJobs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) TriggerJob(ctx context.Context, in *TriggerJobRequest, opts ...grpc.CallOption) (*TriggerJobResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TriggerJobResponse)
+	err := c.cc.Invoke(ctx, APIService_TriggerJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) GetCacheStats(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetCacheStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCacheStatsResponse)
+	err := c.cc.Invoke(ctx, APIService_GetCacheStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) InspectCacheKey(ctx context.Context, in *InspectCacheKeyRequest, opts ...grpc.CallOption) (*InspectCacheKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InspectCacheKeyResponse)
+	err := c.cc.Invoke(ctx, APIService_InspectCacheKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) InvalidateCache(ctx context.Context, in *InvalidateCacheRequest, opts ...grpc.CallOption) (*InvalidateCacheResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InvalidateCacheResponse)
+	err := c.cc.Invoke(ctx, APIService_InvalidateCache_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) FlushCache(ctx context.Context, in *FlushCacheRequest, opts ...grpc.CallOption) (*FlushCacheResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FlushCacheResponse)
+	err := c.cc.Invoke(ctx, APIService_FlushCache_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) WarmCache(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, APIService_WarmCache_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // APIServiceServer is the server API for APIService service.
 // All implementations must embed UnimplementedAPIServiceServer
 // for forward compatibility.
@@ -558,8 +692,10 @@ type APIServiceServer interface {
 	UpdateOrder(context.Context, *UpdateOrderRequest) (*UpdateOrderResponse, error)
 	DeleteOrder(context.Context, *DeleteOrderRequest) (*emptypb.Empty, error)
 	GetOrdersByUserID(context.Context, *GetOrdersByUserIDRequest) (*GetOrdersByUserIDResponse, error)
+	ListOrders(context.Context, *ListOrdersRequest) (*ListOrdersResponse, error)
 	IssueOrder(context.Context, *IssueOrderRequest) (*emptypb.Empty, error)
 	SeedOrders(context.Context, *SeedOrdersRequest) (*emptypb.Empty, error)
+	GetOrderHistory(context.Context, *GetOrderHistoryRequest) (*GetOrderHistoryResponse, error)
 	// ------------- Packaging -------------
 	CreatePackaging(context.Context, *CreatePackagingRequest) (*CreatePackagingResponse, error)
 	GetPackaging(context.Context, *GetPackagingRequest) (*GetPackagingResponse, error)
@@ -571,6 +707,7 @@ type APIServiceServer interface {
 	GetReturns(context.Context, *emptypb.Empty) (*GetReturnsResponse, error)
 	GetReturnByOrderID(context.Context, *GetReturnByOrderIDRequest) (*GetReturnByOrderIDResponse, error)
 	GetReturnsByUserID(context.Context, *GetReturnsByUserIDRequest) (*GetReturnsByUserIDResponse, error)
+	ListReturns(context.Context, *ListReturnsRequest) (*ListReturnsResponse, error)
 	UpdateReturn(context.Context, *UpdateReturnRequest) (*UpdateReturnResponse, error)
 	DeleteReturn(context.Context, *DeleteReturnRequest) (*emptypb.Empty, error)
 	ProcessReturn(context.Context, *ProcessReturnRequest) (*emptypb.Empty, error)
@@ -591,6 +728,16 @@ type APIServiceServer interface {
 	CheckStatusExists(context.Context, *CheckStatusExistsRequest) (*CheckStatusExistsResponse, error)
 	// ------------- Worker -------------
 	SetWorkerCount(context.Context, *SetWorkerCountRequest) (*emptypb.Empty, error)
+	GetWorkerPoolStats(context.Context, *emptypb.Empty) (*GetWorkerPoolStatsResponse, error)
+	// ------------- Jobs -------------
+	ListJobs(context.Context, *emptypb.Empty) (*ListJobsResponse, error)
+	TriggerJob(context.Context, *TriggerJobRequest) (*TriggerJobResponse, error)
+	// ------------- Cache -------------
+	GetCacheStats(context.Context, *emptypb.Empty) (*GetCacheStatsResponse, error)
+	InspectCacheKey(context.Context, *InspectCacheKeyRequest) (*InspectCacheKeyResponse, error)
+	InvalidateCache(context.Context, *InvalidateCacheRequest) (*InvalidateCacheResponse, error)
+	FlushCache(context.Context, *FlushCacheRequest) (*FlushCacheResponse, error)
+	WarmCache(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
 	mustEmbedUnimplementedAPIServiceServer()
 }
 
@@ -640,12 +787,18 @@ func (UnimplementedAPIServiceServer) DeleteOrder(context.Context, *DeleteOrderRe
 func (UnimplementedAPIServiceServer) GetOrdersByUserID(context.Context, *GetOrdersByUserIDRequest) (*GetOrdersByUserIDResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetOrdersByUserID not implemented")
 }
+func (UnimplementedAPIServiceServer) ListOrders(context.Context, *ListOrdersRequest) (*ListOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOrders not implemented")
+}
 func (UnimplementedAPIServiceServer) IssueOrder(context.Context, *IssueOrderRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method IssueOrder not implemented")
 }
 func (UnimplementedAPIServiceServer) SeedOrders(context.Context, *SeedOrdersRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SeedOrders not implemented")
 }
+func (UnimplementedAPIServiceServer) GetOrderHistory(context.Context, *GetOrderHistoryRequest) (*GetOrderHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrderHistory not implemented")
+}
 func (UnimplementedAPIServiceServer) CreatePackaging(context.Context, *CreatePackagingRequest) (*CreatePackagingResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreatePackaging not implemented")
 }
@@ -673,6 +826,9 @@ func (UnimplementedAPIServiceServer) GetReturnByOrderID(context.Context, *GetRet
 func (UnimplementedAPIServiceServer) GetReturnsByUserID(context.Context, *GetReturnsByUserIDRequest) (*GetReturnsByUserIDResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetReturnsByUserID not implemented")
 }
+func (UnimplementedAPIServiceServer) ListReturns(context.Context, *ListReturnsRequest) (*ListReturnsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReturns not implemented")
+}
 func (UnimplementedAPIServiceServer) UpdateReturn(context.Context, *UpdateReturnRequest) (*UpdateReturnResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateReturn not implemented")
 }
@@ -724,6 +880,30 @@ func (UnimplementedAPIServiceServer) CheckStatusExists(context.Context, *CheckSt
 func (UnimplementedAPIServiceServer) SetWorkerCount(context.Context, *SetWorkerCountRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetWorkerCount not implemented")
 }
+func (UnimplementedAPIServiceServer) GetWorkerPoolStats(context.Context, *emptypb.Empty) (*GetWorkerPoolStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkerPoolStats not implemented")
+}
+func (UnimplementedAPIServiceServer) ListJobs(context.Context, *emptypb.Empty) (*ListJobsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListJobs not implemented")
+}
+func (UnimplementedAPIServiceServer) TriggerJob(context.Context, *TriggerJobRequest) (*TriggerJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TriggerJob not implemented")
+}
+func (UnimplementedAPIServiceServer) GetCacheStats(context.Context, *emptypb.Empty) (*GetCacheStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCacheStats not implemented")
+}
+func (UnimplementedAPIServiceServer) InspectCacheKey(context.Context, *InspectCacheKeyRequest) (*InspectCacheKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InspectCacheKey not implemented")
+}
+func (UnimplementedAPIServiceServer) InvalidateCache(context.Context, *InvalidateCacheRequest) (*InvalidateCacheResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InvalidateCache not implemented")
+}
+func (UnimplementedAPIServiceServer) FlushCache(context.Context, *FlushCacheRequest) (*FlushCacheResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FlushCache not implemented")
+}
+func (UnimplementedAPIServiceServer) WarmCache(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WarmCache not implemented")
+}
 func (UnimplementedAPIServiceServer) mustEmbedUnimplementedAPIServiceServer() {}
 func (UnimplementedAPIServiceServer) testEmbeddedByValue()                    {}
 
@@ -979,6 +1159,24 @@ func _APIService_GetOrdersByUserID_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _APIService_ListOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).ListOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: APIService_ListOrders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).ListOrders(ctx, req.(*ListOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _APIService_IssueOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(IssueOrderRequest)
 	if err := dec(in); err != nil {
@@ -1015,6 +1213,24 @@ func _APIService_SeedOrders_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _APIService_GetOrderHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).GetOrderHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: APIService_GetOrderHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).GetOrderHistory(ctx, req.(*GetOrderHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _APIService_CreatePackaging_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreatePackagingRequest)
 	if err := dec(in); err != nil {
@@ -1177,6 +1393,24 @@ func _APIService_GetReturnsByUserID_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _APIService_ListReturns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReturnsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).ListReturns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: APIService_ListReturns_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).ListReturns(ctx, req.(*ListReturnsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _APIService_UpdateReturn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateReturnRequest)
 	if err := dec(in); err != nil {
@@ -1483,6 +1717,150 @@ func _APIService_SetWorkerCount_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _APIService_GetWorkerPoolStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).GetWorkerPoolStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: APIService_GetWorkerPoolStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).GetWorkerPoolStats(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_ListJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).ListJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: APIService_ListJobs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).ListJobs(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_TriggerJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TriggerJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).TriggerJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: APIService_TriggerJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).TriggerJob(ctx, req.(*TriggerJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_GetCacheStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).GetCacheStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: APIService_GetCacheStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).GetCacheStats(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_InspectCacheKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InspectCacheKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).InspectCacheKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: APIService_InspectCacheKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).InspectCacheKey(ctx, req.(*InspectCacheKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_InvalidateCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InvalidateCacheRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).InvalidateCache(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: APIService_InvalidateCache_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).InvalidateCache(ctx, req.(*InvalidateCacheRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_FlushCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FlushCacheRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).FlushCache(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: APIService_FlushCache_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).FlushCache(ctx, req.(*FlushCacheRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_WarmCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).WarmCache(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: APIService_WarmCache_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).WarmCache(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // APIService_ServiceDesc is the grpc.ServiceDesc for APIService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1542,6 +1920,10 @@ var APIService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetOrdersByUserID",
 			Handler:    _APIService_GetOrdersByUserID_Handler,
 		},
+		{
+			MethodName: "ListOrders",
+			Handler:    _APIService_ListOrders_Handler,
+		},
 		{
 			MethodName: "IssueOrder",
 			Handler:    _APIService_IssueOrder_Handler,
@@ -1550,6 +1932,10 @@ var APIService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SeedOrders",
 			Handler:    _APIService_SeedOrders_Handler,
 		},
+		{
+			MethodName: "GetOrderHistory",
+			Handler:    _APIService_GetOrderHistory_Handler,
+		},
 		{
 			MethodName: "CreatePackaging",
 			Handler:    _APIService_CreatePackaging_Handler,
@@ -1586,6 +1972,10 @@ var APIService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetReturnsByUserID",
 			Handler:    _APIService_GetReturnsByUserID_Handler,
 		},
+		{
+			MethodName: "ListReturns",
+			Handler:    _APIService_ListReturns_Handler,
+		},
 		{
 			MethodName: "UpdateReturn",
 			Handler:    _APIService_UpdateReturn_Handler,
@@ -1654,6 +2044,38 @@ var APIService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetWorkerCount",
 			Handler:    _APIService_SetWorkerCount_Handler,
 		},
+		{
+			MethodName: "GetWorkerPoolStats",
+			Handler:    _APIService_GetWorkerPoolStats_Handler,
+		},
+		{
+			MethodName: "ListJobs",
+			Handler:    _APIService_ListJobs_Handler,
+		},
+		{
+			MethodName: "TriggerJob",
+			Handler:    _APIService_TriggerJob_Handler,
+		},
+		{
+			MethodName: "GetCacheStats",
+			Handler:    _APIService_GetCacheStats_Handler,
+		},
+		{
+			MethodName: "InspectCacheKey",
+			Handler:    _APIService_InspectCacheKey_Handler,
+		},
+		{
+			MethodName: "InvalidateCache",
+			Handler:    _APIService_InvalidateCache_Handler,
+		},
+		{
+			MethodName: "FlushCache",
+			Handler:    _APIService_FlushCache_Handler,
+		},
+		{
+			MethodName: "WarmCache",
+			Handler:    _APIService_WarmCache_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "order_service.proto",
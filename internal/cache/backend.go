@@ -0,0 +1,93 @@
+package cache
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// Backend - реализация кэша
+type Backend string
+
+const (
+	BackendRedis  Backend = "redis"  // Общий кэш в Redis
+	BackendMemory Backend = "memory" // Кэш в памяти процесса, Redis не нужен
+	BackendTiered Backend = "tiered" // Кэш в памяти процесса перед Redis
+)
+
+// ParseBackend разбирает название реализации кэша из конфигурации
+func ParseBackend(name string) (Backend, error) {
+	switch name {
+	case "", string(BackendRedis):
+		return BackendRedis, nil
+	case string(BackendMemory):
+		return BackendMemory, nil
+	case string(BackendTiered):
+		return BackendTiered, nil
+	default:
+		return "", fmt.Errorf("неизвестная реализация кэша: %s", name)
+	}
+}
+
+// BusBackend - реализация шины сброса кэша между репликами
+type BusBackend string
+
+const (
+	BusRedis    BusBackend = "redis"    // Pub/sub Redis
+	BusPostgres BusBackend = "postgres" // LISTEN/NOTIFY PostgreSQL, Redis не нужен
+	BusNone     BusBackend = "none"     // Без согласования: для одной реплики или кэша только в Redis
+)
+
+// ParseBusBackend разбирает название реализации шины сброса кэша из конфигурации
+func ParseBusBackend(name string) (BusBackend, error) {
+	switch name {
+	case "", string(BusRedis):
+		return BusRedis, nil
+	case string(BusPostgres):
+		return BusPostgres, nil
+	case string(BusNone):
+		return BusNone, nil
+	default:
+		return "", fmt.Errorf("неизвестная реализация шины сброса кэша: %s", name)
+	}
+}
+
+// Options задает реализацию кэша и ее параметры
+type Options struct {
+	Name       string        // Имя кэша в метриках, например orders; вытеснения из памяти процесса засчитываются ему
+	Backend    Backend       // Реализация кэша
+	DefaultTTL time.Duration // Время жизни записей по умолчанию
+	LocalTTL   time.Duration // Максимальное время жизни записей локального уровня для BackendTiered
+	MaxEntries int           // Максимальное количество записей в памяти процесса
+	MaxBytes   int           // Максимальный размер записей в памяти процесса в байтах
+	// Local - общее хранилище записей в памяти процесса. Кэши с общим хранилищем, как и кэши в одном Redis,
+	// видят сброс ключей друг друга. nil - отдельное хранилище с параметрами MaxEntries и MaxBytes.
+	Local *LRUStore
+}
+
+// New создает кэш выбранной реализации. Для BackendMemory client не используется.
+func New[K comparable, V any](client *redis.Client, options Options) Cache[K, V] {
+	local := func() *LRUCache[K, V] {
+		store := options.Local
+		if store == nil {
+			store = NewLRUStore(LRUConfig{
+				DefaultTTL: options.DefaultTTL,
+				MaxEntries: options.MaxEntries,
+				MaxBytes:   options.MaxBytes,
+			})
+		}
+		c := NewLRUCacheWithStore[K, V](store)
+		c.counters = countersFor(options.Name)
+		return c
+	}
+
+	switch options.Backend {
+	case BackendMemory:
+		return local()
+	case BackendTiered:
+		return NewTieredCache[K, V](local(), NewRedisCache[K, V](client, CacheConfig{DefaultTTL: options.DefaultTTL}), options.LocalTTL)
+	default:
+		return NewRedisCache[K, V](client, CacheConfig{DefaultTTL: options.DefaultTTL})
+	}
+}
@@ -3,17 +3,65 @@ package cache
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"time"
 
 	"github.com/go-redis/redis/v8"
 )
 
+// ErrNotFound возвращается Get и GetSlice, если ключа нет в кэше или срок его хранения истек
+var ErrNotFound = errors.New("данные не найдены в кэше")
+
+// Cache - кэш с универсальными типами ключей и значений.
+// Значения хранятся в JSON, поэтому Get может прочитать значение в любой совместимый тип, например строку из SetString.
+// Реализации: RedisCache, LRUCache в памяти процесса и TieredCache с локальным уровнем перед общим.
+type Cache[K comparable, V any] interface {
+	// Set сохраняет значение; ttl переопределяет время жизни по умолчанию
+	Set(ctx context.Context, key K, value V, ttl ...time.Duration) error
+	// Get читает значение в dest; если ключа нет, возвращает ErrNotFound
+	Get(ctx context.Context, key K, dest interface{}) error
+	// Delete удаляет значение; удаление отсутствующего ключа не считается ошибкой
+	Delete(ctx context.Context, key K) error
+	// Exists проверяет наличие ключа
+	Exists(ctx context.Context, key K) (bool, error)
+	// Keys возвращает ключи, подходящие под glob-шаблон, например "user_orders_*".
+	// Обходит все пространство ключей, поэтому для сброса связанных записей используются теги.
+	Keys(ctx context.Context, pattern string) ([]string, error)
+	// SetSlice сохраняет срез значений
+	SetSlice(ctx context.Context, key K, values []V, ttl ...time.Duration) error
+	// GetSlice читает срез значений в dest; если ключа нет, возвращает ErrNotFound
+	GetSlice(ctx context.Context, key K, dest *[]V) error
+	// SetString сохраняет строку
+	SetString(ctx context.Context, key K, value string, ttl ...time.Duration) error
+	// SetBytes сохраняет уже сериализованное значение без изменений
+	SetBytes(ctx context.Context, key K, data []byte, ttl ...time.Duration) error
+	// GetBytes возвращает сохраненное значение без десериализации; если ключа нет, возвращает ErrNotFound
+	GetBytes(ctx context.Context, key K) ([]byte, error)
+	// Tag регистрирует ключ в тегах, например "user:42", чтобы сбросить его через InvalidateTags.
+	// ttl - время жизни записи: регистрация хранится не меньше ее; 0 - без ограничения
+	Tag(ctx context.Context, key K, ttl time.Duration, tags ...string) error
+	// InvalidateTags удаляет все ключи, зарегистрированные в тегах, и возвращает их.
+	// Время работы пропорционально количеству ключей в тегах, а не размеру кэша.
+	InvalidateTags(ctx context.Context, tags ...string) ([]string, error)
+	// TagGeneration возвращает поколение тегов: InvalidateTags любого из них меняет поколение
+	TagGeneration(ctx context.Context, tags ...string) (string, error)
+	// SetTagged сохраняет сериализованное значение и регистрирует его в тегах одной атомарной операцией,
+	// если поколение тегов не изменилось с generation. Возвращает false, если теги были сброшены и значение не сохранено.
+	// ttl - время жизни записи; 0 - без ограничения
+	SetTagged(ctx context.Context, key K, data []byte, ttl time.Duration, generation string, tags ...string) (bool, error)
+}
+
+// scanCount - количество ключей, которое Redis просматривает за одну команду SCAN
+const scanCount = 1000
+
 // CacheConfig содержит параметры конфигурации для кэша
 type CacheConfig struct {
 	DefaultTTL time.Duration
 }
 
+var _ Cache[string, int] = (*RedisCache[string, int])(nil)
+
 // RedisCache представляет структуру для работы с Redis с универсальными типами ключей и значений
 type RedisCache[K comparable, V any] struct {
 	client *redis.Client
@@ -52,7 +100,7 @@ func (c *RedisCache[K, V]) Set(ctx context.Context, key K, value V, ttl ...time.
 func (c *RedisCache[K, V]) Get(ctx context.Context, key K, dest interface{}) error {
 	val, err := c.client.Get(ctx, fmt.Sprintf("%v", key)).Result()
 	if err == redis.Nil {
-		return fmt.Errorf("данные не найдены в кэше по ключу: %v", key)
+		return fmt.Errorf("%w по ключу: %v", ErrNotFound, key)
 	} else if err != nil {
 		return fmt.Errorf("ошибка при получении данных из Redis: %w", err)
 	}
@@ -85,10 +133,15 @@ func (c *RedisCache[K, V]) Exists(ctx context.Context, key K) (bool, error) {
 	return count > 0, nil
 }
 
-// Keys возвращает все ключи по шаблону
+// Keys возвращает все ключи по шаблону.
+// Ключи читаются через SCAN порциями, чтобы не блокировать Redis командой KEYS на большой базе.
 func (c *RedisCache[K, V]) Keys(ctx context.Context, pattern string) ([]string, error) {
-	keys, err := c.client.Keys(ctx, pattern).Result()
-	if err != nil {
+	var keys []string
+	iter := c.client.Scan(ctx, 0, pattern, scanCount).Iterator()
+	for iter.Next(ctx) {
+		keys = append(keys, iter.Val())
+	}
+	if err := iter.Err(); err != nil {
 		return nil, fmt.Errorf("ошибка при получении ключей из Redis: %w", err)
 	}
 	return keys, nil
@@ -118,7 +171,7 @@ func (c *RedisCache[K, V]) SetSlice(ctx context.Context, key K, values []V, ttl
 func (c *RedisCache[K, V]) GetSlice(ctx context.Context, key K, dest *[]V) error {
 	val, err := c.client.Get(ctx, fmt.Sprintf("%v", key)).Result()
 	if err == redis.Nil {
-		return fmt.Errorf("данные не найдены в кэше по ключу: %v", key)
+		return fmt.Errorf("%w по ключу: %v", ErrNotFound, key)
 	} else if err != nil {
 		return fmt.Errorf("ошибка при получении данных из Redis: %w", err)
 	}
@@ -131,16 +184,47 @@ func (c *RedisCache[K, V]) GetSlice(ctx context.Context, key K, dest *[]V) error
 	return nil
 }
 
-// SetString сохраняет строку в Redis с временем жизни (TTL)
+// SetString сохраняет строку в Redis с временем жизни (TTL).
+// Строка сохраняется в JSON, как и остальные значения, чтобы ее можно было прочитать через Get.
 func (c *RedisCache[K, V]) SetString(ctx context.Context, key K, value string, ttl ...time.Duration) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Errorf("ошибка сериализации строки для кэша: %w", err)
+	}
+
 	expiration := c.config.DefaultTTL
 	if len(ttl) > 0 {
 		expiration = ttl[0]
 	}
 
-	err := c.client.Set(ctx, fmt.Sprintf("%v", key), value, expiration).Err()
+	err = c.client.Set(ctx, fmt.Sprintf("%v", key), data, expiration).Err()
 	if err != nil {
 		return fmt.Errorf("ошибка сохранения строки в Redis: %w", err)
 	}
 	return nil
 }
+
+// SetBytes сохраняет уже сериализованное значение в Redis с временем жизни (TTL)
+func (c *RedisCache[K, V]) SetBytes(ctx context.Context, key K, data []byte, ttl ...time.Duration) error {
+	expiration := c.config.DefaultTTL
+	if len(ttl) > 0 {
+		expiration = ttl[0]
+	}
+
+	err := c.client.Set(ctx, fmt.Sprintf("%v", key), data, expiration).Err()
+	if err != nil {
+		return fmt.Errorf("ошибка сохранения данных в Redis: %w", err)
+	}
+	return nil
+}
+
+// GetBytes возвращает значение из Redis без десериализации
+func (c *RedisCache[K, V]) GetBytes(ctx context.Context, key K) ([]byte, error) {
+	data, err := c.client.Get(ctx, fmt.Sprintf("%v", key)).Bytes()
+	if err == redis.Nil {
+		return nil, fmt.Errorf("%w по ключу: %v", ErrNotFound, key)
+	} else if err != nil {
+		return nil, fmt.Errorf("ошибка при получении данных из Redis: %w", err)
+	}
+	return data, nil
+}
@@ -0,0 +1,134 @@
+package cache
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+)
+
+// invalidationBatchSize - максимальное количество ключей в одном сообщении о сбросе кэша.
+// Ограничивает размер сообщения: уведомление PostgreSQL не может быть больше 8000 байт.
+const invalidationBatchSize = 100
+
+// Invalidation - сообщение о сброшенных записях кэша для других реплик
+type Invalidation struct {
+	Source string   `json:"source"`          // ID реплики-отправителя
+	Keys   []string `json:"keys,omitempty"`  // Удаленные ключи
+	Tags   []string `json:"tags,omitempty"`  // Сброшенные теги
+	Purge  bool     `json:"purge,omitempty"` // Сбросить весь кэш в памяти процесса
+}
+
+// InvalidationBus доставляет сообщения о сбросе кэша всем репликам, в том числе отправителю.
+// Реализации: RedisBus (pub/sub), PostgresBus (LISTEN/NOTIFY) и MemoryBus для тестов.
+type InvalidationBus interface {
+	// Publish рассылает сообщение подписчикам
+	Publish(ctx context.Context, message Invalidation) error
+	// Subscribe передает сообщения в handler до отмены ctx.
+	// Доставка не гарантирована: если подписка прерывалась и сообщения могли быть потеряны,
+	// после ее восстановления в handler передается сообщение с Purge.
+	Subscribe(ctx context.Context, handler func(Invalidation)) error
+}
+
+// Coherence поддерживает согласованность кэшей в памяти процесса между репликами.
+// Сброс записей через кэши, обернутые WithCoherence, рассылается через шину,
+// а сообщения других реплик удаляют записи из хранилища в памяти процесса этой реплики.
+// Задержка сброса на других репликах равна времени доставки сообщения. Если шина недоступна,
+// устаревшая запись живет не дольше своего времени жизни в памяти процесса (например, LocalTTL для tiered).
+type Coherence struct {
+	bus    InvalidationBus
+	store  *LRUStore
+	source string
+}
+
+// NewCoherence создает согласование хранилища store с другими репликами через шину bus
+func NewCoherence(bus InvalidationBus, store *LRUStore) *Coherence {
+	return &Coherence{
+		bus:    bus,
+		store:  store,
+		source: newReplicaID(),
+	}
+}
+
+// Run применяет сообщения других реплик до отмены контекста
+func (c *Coherence) Run(ctx context.Context) {
+	log.Printf("Согласование кэша запущено, реплика %s", c.source)
+	if err := c.bus.Subscribe(ctx, c.apply); err != nil && ctx.Err() == nil {
+		log.Printf("Ошибка подписки на сброс кэша: %v", err)
+	}
+}
+
+// apply удаляет из хранилища записи, сброшенные другой репликой
+func (c *Coherence) apply(message Invalidation) {
+	if message.Source == c.source {
+		return
+	}
+
+	if message.Purge {
+		c.store.Purge()
+		log.Printf("Кэш в памяти процесса сброшен полностью: сообщения о сбросе могли быть потеряны")
+		return
+	}
+	for _, key := range message.Keys {
+		c.store.delete(key)
+	}
+	c.store.invalidateTags(message.Tags)
+}
+
+// publish рассылает сброс ключей и тегов сообщениями не больше invalidationBatchSize ключей
+func (c *Coherence) publish(ctx context.Context, keys, tags []string) error {
+	for len(keys) > 0 || len(tags) > 0 {
+		batch := keys
+		if len(batch) > invalidationBatchSize {
+			batch = batch[:invalidationBatchSize]
+		}
+
+		message := Invalidation{Source: c.source, Keys: batch, Tags: tags}
+		if err := c.bus.Publish(ctx, message); err != nil {
+			return fmt.Errorf("ошибка рассылки сброса кэша: %w", err)
+		}
+		keys, tags = keys[len(batch):], nil
+	}
+	return nil
+}
+
+// coherentCache - кэш, рассылающий удаление записей другим репликам
+type coherentCache[K comparable, V any] struct {
+	Cache[K, V]
+	coherence *Coherence
+}
+
+// WithCoherence возвращает кэш, который рассылает Delete и InvalidateTags через coherence.
+// Если coherence равен nil, кэш возвращается без изменений.
+func WithCoherence[K comparable, V any](cache Cache[K, V], coherence *Coherence) Cache[K, V] {
+	if coherence == nil {
+		return cache
+	}
+	return &coherentCache[K, V]{Cache: cache, coherence: coherence}
+}
+
+// Delete удаляет значение и рассылает удаление другим репликам
+func (c *coherentCache[K, V]) Delete(ctx context.Context, key K) error {
+	if err := c.Cache.Delete(ctx, key); err != nil {
+		return err
+	}
+	return c.coherence.publish(ctx, []string{fmt.Sprintf("%v", key)}, nil)
+}
+
+// InvalidateTags удаляет ключи тегов и рассылает сброс другим репликам.
+// Сброс рассылается и при ошибке удаления: другие реплики сбросят хотя бы свои записи тегов.
+func (c *coherentCache[K, V]) InvalidateTags(ctx context.Context, tags ...string) ([]string, error) {
+	keys, err := c.Cache.InvalidateTags(ctx, tags...)
+	return keys, errors.Join(err, c.coherence.publish(ctx, keys, tags))
+}
+
+// newReplicaID генерирует случайный идентификатор реплики
+func newReplicaID() string {
+	var b [8]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		panic(fmt.Sprintf("crypto/rand: %v", err))
+	}
+	return hex.EncodeToString(b[:])
+}
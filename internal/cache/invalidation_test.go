@@ -0,0 +1,167 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testReplica - реплика приложения с двухуровневым кэшем, общим L2 и согласованием L1 через шину
+type testReplica struct {
+	cache     *LoadingCache[string, testValue]
+	store     *LRUStore
+	coherence *Coherence
+}
+
+// newTestReplicas создает реплики с общим L2 и запускает согласование их L1 через общую шину
+func newTestReplicas(t *testing.T, bus *MemoryBus, count int) []testReplica {
+	ctx, cancel := context.WithCancel(context.Background())
+	shared := NewLRUCache[string, testValue](LRUConfig{})
+
+	replicas := make([]testReplica, count)
+	var wg sync.WaitGroup
+	for i := range replicas {
+		store := NewLRUStore(LRUConfig{})
+		coherence := NewCoherence(bus, store)
+		tiered := NewTieredCache[string, testValue](NewLRUCacheWithStore[string, testValue](store), shared, time.Hour)
+		replicas[i] = testReplica{
+			cache:     NewLoadingCache(WithCoherence[string, testValue](tiered, coherence), LoadOptions{TTL: time.Hour}),
+			store:     store,
+			coherence: coherence,
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			coherence.Run(ctx)
+		}()
+	}
+	t.Cleanup(func() {
+		cancel()
+		wg.Wait()
+	})
+
+	require.Eventually(t, func() bool { return bus.subscribers() == count }, time.Second, time.Millisecond)
+	return replicas
+}
+
+// subscribers возвращает количество подписчиков шины
+func (b *MemoryBus) subscribers() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return len(b.handlers)
+}
+
+// loadPrice загружает цену упаковки через кэш реплики
+func loadPrice(t *testing.T, replica testReplica, price int) int {
+	value, err := replica.cache.GetOrLoad(context.Background(), "packaging_1", func(context.Context) (testValue, error) {
+		return testValue{ID: price}, nil
+	}, WithTags("packaging"))
+	require.NoError(t, err)
+	return value.ID
+}
+
+func TestCoherenceInvalidateTags(t *testing.T) {
+	replicas := newTestReplicas(t, NewMemoryBus(), 2)
+	first, second := replicas[0], replicas[1]
+
+	// Обе реплики держат цену в L1
+	assert.Equal(t, 100, loadPrice(t, first, 100))
+	assert.Equal(t, 100, loadPrice(t, second, 200))
+	assert.Equal(t, 1, second.store.Len())
+
+	// Изменение цены на первой реплике сбрасывает L1 второй реплики, хотя запись попала в ее L1 из L2 без тегов
+	keys, err := first.cache.InvalidateTags(context.Background(), "packaging")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"packaging_1"}, keys)
+	assert.Zero(t, second.store.Len())
+
+	assert.Equal(t, 200, loadPrice(t, second, 200))
+	assert.Equal(t, 200, loadPrice(t, first, 300))
+}
+
+func TestCoherenceDelete(t *testing.T) {
+	replicas := newTestReplicas(t, NewMemoryBus(), 3)
+
+	for _, replica := range replicas {
+		loadPrice(t, replica, 100)
+	}
+
+	require.NoError(t, replicas[0].cache.Delete(context.Background(), "packaging_1"))
+	for i, replica := range replicas {
+		assert.Zero(t, replica.store.Len(), "реплика %d удаляет запись", i)
+	}
+}
+
+func TestCoherenceSkipsOwnMessagesAndPurges(t *testing.T) {
+	store := NewLRUStore(LRUConfig{})
+	coherence := NewCoherence(NewMemoryBus(), store)
+	require.NoError(t, store.setJSON("order_1", testValue{ID: 1}, nil, nil))
+	require.NoError(t, store.setJSON("order_2", testValue{ID: 2}, nil, nil))
+
+	coherence.apply(Invalidation{Source: coherence.source, Keys: []string{"order_1"}})
+	assert.Equal(t, 2, store.Len(), "собственные сообщения реплики пропускаются")
+
+	coherence.apply(Invalidation{Source: "other", Keys: []string{"order_1"}})
+	assert.Equal(t, 1, store.Len())
+
+	coherence.apply(Invalidation{Purge: true})
+	assert.Zero(t, store.Len(), "после потери сообщений хранилище сбрасывается полностью")
+}
+
+func TestCoherencePublishBatches(t *testing.T) {
+	bus := NewMemoryBus()
+	coherence := NewCoherence(bus, NewLRUStore(LRUConfig{}))
+
+	var mu sync.Mutex
+	var messages []Invalidation
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_ = bus.Subscribe(ctx, func(message Invalidation) {
+			mu.Lock()
+			defer mu.Unlock()
+			messages = append(messages, message)
+		})
+	}()
+	require.Eventually(t, func() bool { return bus.subscribers() == 1 }, time.Second, time.Millisecond)
+
+	keys := make([]string, 2*invalidationBatchSize+1)
+	for i := range keys {
+		keys[i] = fmt.Sprintf("user_orders_%d", i)
+	}
+	require.NoError(t, coherence.publish(context.Background(), keys, []string{"orders:lists"}))
+	cancel()
+	<-done
+
+	require.Len(t, messages, 3)
+	assert.Equal(t, []string{"orders:lists"}, messages[0].Tags)
+	assert.Empty(t, messages[1].Tags)
+	assert.Len(t, messages[2].Keys, 1)
+	for _, message := range messages {
+		assert.Equal(t, coherence.source, message.Source)
+	}
+}
+
+func TestWithCoherenceNil(t *testing.T) {
+	c := NewLRUCache[string, testValue](LRUConfig{})
+	assert.Same(t, c, WithCoherence[string, testValue](c, nil))
+}
+
+func TestParseBusBackend(t *testing.T) {
+	for name, want := range map[string]BusBackend{"": BusRedis, "redis": BusRedis, "postgres": BusPostgres, "none": BusNone} {
+		backend, err := ParseBusBackend(name)
+		require.NoError(t, err)
+		assert.Equal(t, want, backend)
+	}
+
+	_, err := ParseBusBackend("kafka")
+	assert.Error(t, err)
+}
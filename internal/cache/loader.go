@@ -0,0 +1,324 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"math/rand"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+)
+
+// LoadOptions задает параметры загрузки значений через GetOrLoad
+type LoadOptions struct {
+	// Name - имя кэша, например orders. Именованный кэш ведет счетчики для метрик (GetStats)
+	// и регистрирует все записи в теге своего пространства имен, чтобы их можно было сбросить через Flush.
+	Name        string
+	TTL         time.Duration // Время жизни загруженных значений по умолчанию
+	NegativeTTL time.Duration // Время жизни отметки об отсутствии записи; 0 - отсутствие не кэшируется
+	NotFound    error         // Ошибка загрузчика об отсутствии записи, например repository.ErrNotFound
+	// EarlyRefreshBeta управляет вероятностным обновлением до истечения TTL (алгоритм XFetch):
+	// чем больше значение и дольше загрузка, тем раньше значение обновляется. 0 - обновление только после истечения TTL.
+	EarlyRefreshBeta float64
+}
+
+// namespaceTagPrefix - префикс тега пространства имен именованного кэша
+const namespaceTagPrefix = "cache:"
+
+// LoadOption задает параметры сохранения значения, загруженного через GetOrLoad
+type LoadOption func(*loadSettings)
+
+// loadSettings - параметры сохранения загруженного значения
+type loadSettings struct {
+	ttl         time.Duration
+	tags        []string
+	generation  string // Поколение тегов до загрузки
+	uncacheable bool   // Поколение тегов неизвестно, поэтому значение не сохраняется
+}
+
+// WithTTL переопределяет время жизни загруженного значения
+func WithTTL(ttl time.Duration) LoadOption {
+	return func(settings *loadSettings) {
+		settings.ttl = ttl
+	}
+}
+
+// WithTags регистрирует загруженное значение, в том числе отметку об отсутствии записи, в тегах
+func WithTags(tags ...string) LoadOption {
+	return func(settings *loadSettings) {
+		settings.tags = append(settings.tags, tags...)
+	}
+}
+
+// LoadingCache - кэш с загрузкой отсутствующих значений.
+// Одновременные промахи по одному ключу объединяются в одну загрузку, поэтому истечение или сброс
+// популярного ключа не приводит к лавине одинаковых запросов в базу данных.
+// Значения GetOrLoad хранятся в конверте с временем загрузки, поэтому читать их нужно только через GetOrLoad;
+// запись и сброс через методы Cache работают с теми же ключами.
+type LoadingCache[K comparable, V any] struct {
+	Cache[K, V]
+	options  LoadOptions
+	counters *counters
+	group    singleflight.Group
+	now      func() time.Time
+	random   func() float64
+}
+
+// loadEntry - конверт значения, загруженного через GetOrLoad
+type loadEntry struct {
+	Value     json.RawMessage `json:"value,omitempty"`
+	NotFound  bool            `json:"not_found,omitempty"`
+	Delta     time.Duration   `json:"delta"`      // Длительность загрузки значения
+	ExpiresAt time.Time       `json:"expires_at"` // Время истечения TTL
+}
+
+// Entry - запись кэша для диагностики
+type Entry struct {
+	Key          string
+	Value        json.RawMessage // Сохраненное значение в JSON; пусто для отметки об отсутствии записи
+	NotFound     bool            // Запись - отметка об отсутствии записи в источнике
+	ExpiresAt    time.Time       // Время истечения TTL; нулевое значение - без ограничения или неизвестно
+	LoadDuration time.Duration   // Длительность загрузки значения
+}
+
+// NewLoadingCache создает кэш с загрузкой отсутствующих значений поверх cache
+func NewLoadingCache[K comparable, V any](cache Cache[K, V], options LoadOptions) *LoadingCache[K, V] {
+	return &LoadingCache[K, V]{
+		Cache:    cache,
+		options:  options,
+		counters: countersFor(options.Name),
+		now:      time.Now,
+		random:   func() float64 { return 1 - rand.Float64() }, // (0, 1]: логарифм нуля не определен
+	}
+}
+
+// Name возвращает имя кэша
+func (c *LoadingCache[K, V]) Name() string {
+	return c.options.Name
+}
+
+// Inspect возвращает запись кэша по ключу; если ключа нет, возвращает ErrNotFound.
+// Значение, сохраненное не через GetOrLoad, возвращается без конверта.
+func (c *LoadingCache[K, V]) Inspect(ctx context.Context, key K) (Entry, error) {
+	data, err := c.GetBytes(ctx, key)
+	if err != nil {
+		return Entry{}, err
+	}
+
+	entry := Entry{Key: fmt.Sprintf("%v", key)}
+	var loaded loadEntry
+	if err := json.Unmarshal(data, &loaded); err != nil || (loaded.Value == nil && !loaded.NotFound) {
+		entry.Value = data
+		return entry, nil
+	}
+
+	entry.Value = loaded.Value
+	entry.NotFound = loaded.NotFound
+	entry.ExpiresAt = loaded.ExpiresAt
+	entry.LoadDuration = loaded.Delta
+	return entry, nil
+}
+
+// Flush удаляет все записи, сохраненные кэшем через GetOrLoad, и возвращает их ключи.
+// Записи находятся по тегу пространства имен кэша, поэтому работает только для именованного кэша.
+// Тег разбирается пачками, поэтому сброс большого кэша не блокирует Redis.
+func (c *LoadingCache[K, V]) Flush(ctx context.Context) ([]string, error) {
+	if c.options.Name == "" {
+		return nil, errors.New("сброс пространства имен доступен только для именованного кэша")
+	}
+	return c.InvalidateTags(ctx, namespaceTagPrefix+c.options.Name)
+}
+
+// GetOrLoad возвращает значение из кэша, а при промахе загружает его через load и сохраняет в кэш
+func (c *LoadingCache[K, V]) GetOrLoad(ctx context.Context, key K, load func(ctx context.Context) (V, error), options ...LoadOption) (V, error) {
+	return getOrLoad(ctx, c, key, load, options)
+}
+
+// GetOrLoadSlice возвращает срез значений из кэша, а при промахе загружает его через load и сохраняет в кэш
+func (c *LoadingCache[K, V]) GetOrLoadSlice(ctx context.Context, key K, load func(ctx context.Context) ([]V, error), options ...LoadOption) ([]V, error) {
+	return getOrLoad(ctx, c, key, load, options)
+}
+
+// GetOrLoadString возвращает строку из кэша, а при промахе загружает ее через load и сохраняет в кэш
+func (c *LoadingCache[K, V]) GetOrLoadString(ctx context.Context, key K, load func(ctx context.Context) (string, error), options ...LoadOption) (string, error) {
+	return getOrLoad(ctx, c, key, load, options)
+}
+
+// getOrLoad читает конверт значения и загружает значение при промахе, отметке устаревания или решении обновить его заранее.
+// Ошибка чтения из кэша (например, недоступность Redis) считается промахом.
+func getOrLoad[K comparable, V any, T any](ctx context.Context, c *LoadingCache[K, V], key K, load func(ctx context.Context) (T, error), options []LoadOption) (T, error) {
+	entry, cached := c.lookup(ctx, key)
+	if cached && !c.refreshEarly(entry) {
+		value, err := decodeEntry[T](entry, key, c.options.NotFound)
+		if err == nil || entry.NotFound {
+			c.counters.hit()
+			return value, err
+		}
+		log.Printf("Некорректная запись кэша по ключу %v, значение будет загружено заново: %v", key, err)
+		c.counters.fail()
+		cached = false
+	}
+	c.counters.miss()
+
+	// Тип значения входит в ключ загрузки, чтобы загрузки разных типов по одному ключу не смешивались
+	var zero T
+	flightKey := fmt.Sprintf("%T:%v", zero, key)
+	result := c.group.DoChan(flightKey, func() (interface{}, error) {
+		// Загрузка продолжается, даже если вызвавший ее запрос отменен: ее результат ждут другие запросы
+		return c.load(context.WithoutCancel(ctx), key, func(ctx context.Context) (interface{}, error) {
+			return load(ctx)
+		}, options)
+	})
+
+	select {
+	case res := <-result:
+		if res.Err != nil {
+			// Если не удалось обновить значение заранее, возвращается еще не истекшее значение из кэша
+			if cached {
+				log.Printf("Ошибка досрочного обновления кэша по ключу %v: %v", key, res.Err)
+				return decodeEntry[T](entry, key, c.options.NotFound)
+			}
+			return zero, res.Err
+		}
+		return res.Val.(T), nil
+	case <-ctx.Done():
+		return zero, ctx.Err()
+	}
+}
+
+// lookup возвращает конверт значения из кэша
+func (c *LoadingCache[K, V]) lookup(ctx context.Context, key K) (loadEntry, bool) {
+	data, err := c.GetBytes(ctx, key)
+	if err != nil {
+		if !errors.Is(err, ErrNotFound) {
+			log.Printf("Ошибка чтения кэша по ключу %v: %v", key, err)
+			c.counters.fail()
+		}
+		return loadEntry{}, false
+	}
+
+	var entry loadEntry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		log.Printf("Некорректная запись кэша по ключу %v, значение будет загружено заново: %v", key, err)
+		c.counters.fail()
+		return loadEntry{}, false
+	}
+	return entry, true
+}
+
+// refreshEarly решает, обновить ли значение до истечения TTL.
+// Вероятность обновления растет по мере приближения к ExpiresAt, поэтому популярный ключ обновляет один запрос,
+// а не все запросы сразу после истечения TTL.
+func (c *LoadingCache[K, V]) refreshEarly(entry loadEntry) bool {
+	if c.options.EarlyRefreshBeta <= 0 || entry.NotFound || entry.ExpiresAt.IsZero() {
+		return false
+	}
+
+	gap := time.Duration(float64(entry.Delta) * c.options.EarlyRefreshBeta * -math.Log(c.random()))
+	return !c.now().Add(gap).Before(entry.ExpiresAt)
+}
+
+// load загружает значение и сохраняет его в кэш вместе с длительностью загрузки.
+// Если загрузчик вернул ошибку NotFound и NegativeTTL задан, в кэш сохраняется отметка об отсутствии записи.
+// Записи именованного кэша регистрируются и в теге его пространства имен.
+// Поколение тегов запоминается до загрузки: если теги сброшены во время загрузки, значение могло устареть и не сохраняется.
+func (c *LoadingCache[K, V]) load(ctx context.Context, key K, load func(ctx context.Context) (interface{}, error), options []LoadOption) (interface{}, error) {
+	settings := loadSettings{ttl: c.options.TTL}
+	for _, option := range options {
+		option(&settings)
+	}
+	if c.options.Name != "" {
+		settings.tags = append(settings.tags, namespaceTagPrefix+c.options.Name)
+	}
+	if len(settings.tags) > 0 {
+		generation, err := c.TagGeneration(ctx, settings.tags...)
+		if err != nil {
+			log.Printf("Ошибка получения поколения тегов %v, значение по ключу %v не будет сохранено в кэш: %v", settings.tags, key, err)
+			c.counters.fail()
+			settings.uncacheable = true
+		}
+		settings.generation = generation
+	}
+
+	start := c.now()
+	value, err := load(ctx)
+	delta := c.now().Sub(start)
+
+	if err != nil {
+		notFound := c.options.NotFound != nil && errors.Is(err, c.options.NotFound)
+		c.counters.load(delta, !notFound)
+		if notFound && c.options.NegativeTTL > 0 {
+			settings.ttl = c.options.NegativeTTL
+			c.store(ctx, key, loadEntry{NotFound: true, Delta: delta}, settings)
+		}
+		return nil, err
+	}
+	c.counters.load(delta, false)
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		log.Printf("Ошибка сериализации данных для кэша по ключу %v: %v", key, err)
+		return value, nil
+	}
+
+	c.store(ctx, key, loadEntry{Value: data, Delta: delta}, settings)
+	return value, nil
+}
+
+// store сохраняет конверт значения вместе с регистрацией в тегах. Ошибка записи только логируется: значение уже загружено.
+func (c *LoadingCache[K, V]) store(ctx context.Context, key K, entry loadEntry, settings loadSettings) {
+	if settings.uncacheable {
+		return
+	}
+	if settings.ttl > 0 {
+		entry.ExpiresAt = c.now().Add(settings.ttl)
+	}
+
+	data, err := json.Marshal(entry)
+	if err != nil {
+		log.Printf("Ошибка сериализации данных для кэша по ключу %v: %v", key, err)
+		return
+	}
+
+	if len(settings.tags) == 0 {
+		if err := c.SetBytes(ctx, key, data, settings.ttl); err != nil {
+			log.Printf("Ошибка сохранения данных в кэш по ключу %v: %v", key, err)
+			c.counters.fail()
+		}
+		return
+	}
+
+	stored, err := c.SetTagged(ctx, key, data, settings.ttl, settings.generation, settings.tags...)
+	if err != nil {
+		// Запись без тегов не будет сброшена инвалидацией, поэтому после неудачной записи ключ удаляется
+		log.Printf("Ошибка сохранения данных в кэш по ключу %v с тегами %v: %v", key, settings.tags, err)
+		c.counters.fail()
+		if err := c.Delete(ctx, key); err != nil {
+			log.Printf("Ошибка удаления кэша по ключу %v: %v", key, err)
+		}
+		return
+	}
+	if !stored {
+		log.Printf("Значение по ключу %v не сохранено в кэш: теги %v сброшены во время загрузки", key, settings.tags)
+	}
+}
+
+// decodeEntry возвращает значение из конверта. Для отметки об отсутствии записи возвращается ошибка, обернутая в notFound.
+func decodeEntry[T any](entry loadEntry, key interface{}, notFound error) (T, error) {
+	var value T
+	if entry.NotFound {
+		if notFound == nil {
+			notFound = ErrNotFound
+		}
+		return value, fmt.Errorf("%w: отсутствие записи по ключу %v получено из кэша", notFound, key)
+	}
+
+	if err := json.Unmarshal(entry.Value, &value); err != nil {
+		return value, fmt.Errorf("ошибка при десериализации данных из кэша: %w", err)
+	}
+	return value, nil
+}
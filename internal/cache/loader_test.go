@@ -0,0 +1,214 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var errRecordNotFound = errors.New("запись не найдена")
+
+// newTestLoading создает кэш с загрузкой поверх кэша в памяти процесса с управляемыми часами
+func newTestLoading(options LoadOptions) (*LoadingCache[string, testValue], *LRUCache[string, testValue], *fakeClock) {
+	local, clock := newTestLRU(LRUConfig{})
+	c := NewLoadingCache[string, testValue](local, options)
+	c.now = clock.Now
+	return c, local, clock
+}
+
+func TestLoadingCacheCoalescesLoads(t *testing.T) {
+	ctx := context.Background()
+	c, _, _ := newTestLoading(LoadOptions{TTL: time.Minute})
+
+	var calls atomic.Int32
+	release := make(chan struct{})
+	load := func(context.Context) ([]testValue, error) {
+		calls.Add(1)
+		<-release
+		return []testValue{{ID: 1}, {ID: 2}}, nil
+	}
+
+	const callers = 20
+	var wg sync.WaitGroup
+	results := make([][]testValue, callers)
+	for i := 0; i < callers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			values, err := c.GetOrLoadSlice(ctx, "all_orders", load)
+			assert.NoError(t, err)
+			results[i] = values
+		}(i)
+	}
+
+	// Даем запросам дойти до ожидания загрузки
+	require.Eventually(t, func() bool { return calls.Load() == 1 }, time.Second, time.Millisecond)
+	time.Sleep(10 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	assert.Equal(t, int32(1), calls.Load(), "одновременные промахи объединяются в одну загрузку")
+	for _, values := range results {
+		assert.Len(t, values, 2)
+	}
+
+	// Следующее чтение обслуживается кэшем
+	values, err := c.GetOrLoadSlice(ctx, "all_orders", load)
+	require.NoError(t, err)
+	assert.Len(t, values, 2)
+	assert.Equal(t, int32(1), calls.Load())
+}
+
+func TestLoadingCacheNegativeEntry(t *testing.T) {
+	ctx := context.Background()
+	c, _, clock := newTestLoading(LoadOptions{TTL: time.Minute, NegativeTTL: 10 * time.Second, NotFound: errRecordNotFound})
+
+	calls := 0
+	load := func(context.Context) (testValue, error) {
+		calls++
+		return testValue{}, errRecordNotFound
+	}
+
+	_, err := c.GetOrLoad(ctx, "order_404", load)
+	assert.ErrorIs(t, err, errRecordNotFound)
+
+	_, err = c.GetOrLoad(ctx, "order_404", load)
+	assert.ErrorIs(t, err, errRecordNotFound, "отметка об отсутствии сохраняет ошибку загрузчика")
+	assert.Equal(t, 1, calls)
+
+	clock.now = clock.now.Add(11 * time.Second)
+	_, err = c.GetOrLoad(ctx, "order_404", load)
+	assert.ErrorIs(t, err, errRecordNotFound)
+	assert.Equal(t, 2, calls, "отметка об отсутствии истекает через NegativeTTL")
+}
+
+func TestLoadingCacheDoesNotCacheOtherErrors(t *testing.T) {
+	ctx := context.Background()
+	c, _, _ := newTestLoading(LoadOptions{TTL: time.Minute, NegativeTTL: time.Minute, NotFound: errRecordNotFound})
+
+	calls := 0
+	load := func(context.Context) (testValue, error) {
+		calls++
+		if calls == 1 {
+			return testValue{}, errUnavailable
+		}
+		return testValue{ID: 1}, nil
+	}
+
+	_, err := c.GetOrLoad(ctx, "order_1", load)
+	assert.ErrorIs(t, err, errUnavailable)
+
+	value, err := c.GetOrLoad(ctx, "order_1", load)
+	require.NoError(t, err)
+	assert.Equal(t, 1, value.ID)
+}
+
+func TestLoadingCacheEarlyRefresh(t *testing.T) {
+	ctx := context.Background()
+	c, _, clock := newTestLoading(LoadOptions{TTL: time.Minute, EarlyRefreshBeta: 1})
+
+	version := 0
+	load := func(context.Context) (testValue, error) {
+		version++
+		clock.now = clock.now.Add(time.Second) // Загрузка длится секунду
+		return testValue{ID: version}, nil
+	}
+
+	_, err := c.GetOrLoad(ctx, "status_1", load)
+	require.NoError(t, err)
+
+	// -ln(r) = 1: значение обновляется не раньше чем за секунду до истечения TTL
+	c.random = func() float64 { return 1 / 2.718281828459045 }
+
+	clock.now = clock.now.Add(50 * time.Second)
+	value, err := c.GetOrLoad(ctx, "status_1", load)
+	require.NoError(t, err)
+	assert.Equal(t, 1, value.ID, "задолго до истечения TTL значение не обновляется")
+
+	clock.now = clock.now.Add(9*time.Second + 500*time.Millisecond)
+	value, err = c.GetOrLoad(ctx, "status_1", load)
+	require.NoError(t, err)
+	assert.Equal(t, 2, value.ID, "незадолго до истечения TTL значение обновляется заранее")
+}
+
+func TestLoadingCacheEarlyRefreshFailureReturnsCached(t *testing.T) {
+	ctx := context.Background()
+	c, _, clock := newTestLoading(LoadOptions{TTL: time.Minute, EarlyRefreshBeta: 1})
+	c.random = func() float64 { return 1e-300 } // Обновление заранее при каждом чтении
+
+	_, err := c.GetOrLoad(ctx, "status_1", func(context.Context) (testValue, error) {
+		clock.now = clock.now.Add(time.Second)
+		return testValue{ID: 1}, nil
+	})
+	require.NoError(t, err)
+
+	value, err := c.GetOrLoad(ctx, "status_1", func(context.Context) (testValue, error) {
+		return testValue{}, errUnavailable
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 1, value.ID, "при ошибке досрочного обновления возвращается еще не истекшее значение")
+}
+
+func TestLoadingCacheSharedReadErrorIsMiss(t *testing.T) {
+	ctx := context.Background()
+	shared := &failingCache[string, testValue]{LRUCache: NewLRUCache[string, testValue](LRUConfig{})}
+	c := NewLoadingCache[string, testValue](shared, LoadOptions{TTL: time.Minute})
+
+	calls := 0
+	for i := 0; i < 2; i++ {
+		value, err := c.GetOrLoadString(ctx, "user_name_1", func(context.Context) (string, error) {
+			calls++
+			return "Иван", nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "Иван", value)
+	}
+	assert.Equal(t, 2, calls, "при недоступном кэше значение загружается напрямую")
+}
+
+func TestLoadingCacheDeleteInvalidates(t *testing.T) {
+	ctx := context.Background()
+	c, _, _ := newTestLoading(LoadOptions{TTL: time.Minute})
+
+	version := 0
+	load := func(context.Context) (testValue, error) {
+		version++
+		return testValue{ID: version}, nil
+	}
+
+	_, err := c.GetOrLoad(ctx, "order_1", load)
+	require.NoError(t, err)
+	require.NoError(t, c.Delete(ctx, "order_1"))
+
+	value, err := c.GetOrLoad(ctx, "order_1", load)
+	require.NoError(t, err)
+	assert.Equal(t, 2, value.ID)
+}
+
+func TestLoadingCacheCanceledCaller(t *testing.T) {
+	c, _, _ := newTestLoading(LoadOptions{TTL: time.Minute})
+
+	release := make(chan struct{})
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := c.GetOrLoad(ctx, "order_1", func(context.Context) (testValue, error) {
+		<-release
+		return testValue{ID: 1}, nil
+	})
+	assert.ErrorIs(t, err, context.Canceled)
+
+	// Загрузка продолжается для остальных запросов
+	close(release)
+	value, err := c.GetOrLoad(context.Background(), "order_1", func(context.Context) (testValue, error) {
+		return testValue{ID: 2}, nil
+	})
+	require.NoError(t, err)
+	assert.Contains(t, []int{1, 2}, value.ID)
+}
@@ -0,0 +1,435 @@
+package cache
+
+import (
+	"container/list"
+	"context"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// LRUConfig содержит параметры кэша в памяти процесса
+type LRUConfig struct {
+	DefaultTTL time.Duration // Время жизни записи по умолчанию; 0 - без ограничения
+	MaxEntries int           // Максимальное количество записей; 0 - без ограничения
+	MaxBytes   int           // Максимальный суммарный размер ключей и значений в байтах; 0 - без ограничения
+}
+
+// tagGenerationBuckets - количество счетчиков поколений тегов в памяти процесса.
+// Тег отображается в счетчик по хешу, поэтому память не растет с числом тегов;
+// совпадение счетчика у разных тегов лишь изредка отбрасывает загруженное значение.
+const tagGenerationBuckets = 1024
+
+// LRUStore - хранилище записей кэша в памяти процесса с ограничением размера и временем жизни записей.
+// При превышении MaxEntries или MaxBytes вытесняются давно не использованные записи.
+// Просроченные записи удаляются при обращении к ним или при вытеснении.
+// Одно хранилище может использоваться несколькими LRUCache с разными типами значений: как и в Redis,
+// у них общее пространство ключей, поэтому сброс ключа через один кэш виден всем остальным.
+type LRUStore struct {
+	mu      sync.Mutex
+	config  LRUConfig
+	entries map[string]*list.Element
+	order   *list.List                     // Начало списка - последние использованные записи
+	tags    map[string]map[string]struct{} // Ключи записей по тегам
+	size    int
+	now     func() time.Time
+	// Поколения тегов: сброс тега увеличивает его счетчик, Purge - эпоху всего хранилища
+	generations [tagGenerationBuckets]uint64
+	epoch       uint64
+}
+
+// LRUCache - кэш в памяти процесса поверх LRUStore
+type LRUCache[K comparable, V any] struct {
+	store    *LRUStore
+	counters *counters // Счетчики кэша, которому засчитываются вытеснения его записей
+}
+
+// lruEntry - запись кэша в памяти процесса
+type lruEntry struct {
+	key       string
+	data      []byte
+	expiresAt time.Time // Нулевое значение - без ограничения времени жизни
+	tags      []string  // Теги, в которых зарегистрирована запись
+	owner     *counters // Счетчики кэша, сохранившего запись
+}
+
+var _ Cache[string, int] = (*LRUCache[string, int])(nil)
+
+// NewLRUStore создает хранилище записей в памяти процесса
+func NewLRUStore(config LRUConfig) *LRUStore {
+	return &LRUStore{
+		config:  config,
+		entries: make(map[string]*list.Element),
+		order:   list.New(),
+		tags:    make(map[string]map[string]struct{}),
+		now:     time.Now,
+	}
+}
+
+// NewLRUCache создает кэш в памяти процесса с собственным хранилищем
+func NewLRUCache[K comparable, V any](config LRUConfig) *LRUCache[K, V] {
+	return NewLRUCacheWithStore[K, V](NewLRUStore(config))
+}
+
+// NewLRUCacheWithStore создает кэш в памяти процесса поверх общего хранилища store
+func NewLRUCacheWithStore[K comparable, V any](store *LRUStore) *LRUCache[K, V] {
+	return &LRUCache[K, V]{store: store}
+}
+
+// Set сохраняет объект в кэше с временем жизни (TTL)
+func (c *LRUCache[K, V]) Set(_ context.Context, key K, value V, ttl ...time.Duration) error {
+	return c.store.setJSON(fmt.Sprintf("%v", key), value, ttl, c.counters)
+}
+
+// Get возвращает объект из кэша и десериализует его
+func (c *LRUCache[K, V]) Get(_ context.Context, key K, dest interface{}) error {
+	return c.store.getJSON(fmt.Sprintf("%v", key), dest)
+}
+
+// Delete удаляет объект из кэша по ключу
+func (c *LRUCache[K, V]) Delete(_ context.Context, key K) error {
+	c.store.delete(fmt.Sprintf("%v", key))
+	return nil
+}
+
+// Exists проверяет наличие непросроченного объекта в кэше по ключу
+func (c *LRUCache[K, V]) Exists(_ context.Context, key K) (bool, error) {
+	return c.store.exists(fmt.Sprintf("%v", key)), nil
+}
+
+// Keys возвращает ключи непросроченных записей по glob-шаблону
+func (c *LRUCache[K, V]) Keys(_ context.Context, pattern string) ([]string, error) {
+	return c.store.keys(pattern)
+}
+
+// SetSlice сохраняет срез объектов в кэше с временем жизни (TTL)
+func (c *LRUCache[K, V]) SetSlice(_ context.Context, key K, values []V, ttl ...time.Duration) error {
+	return c.store.setJSON(fmt.Sprintf("%v", key), values, ttl, c.counters)
+}
+
+// GetSlice возвращает срез объектов из кэша и десериализует его
+func (c *LRUCache[K, V]) GetSlice(_ context.Context, key K, dest *[]V) error {
+	return c.store.getJSON(fmt.Sprintf("%v", key), dest)
+}
+
+// SetString сохраняет строку в кэше с временем жизни (TTL)
+func (c *LRUCache[K, V]) SetString(_ context.Context, key K, value string, ttl ...time.Duration) error {
+	return c.store.setJSON(fmt.Sprintf("%v", key), value, ttl, c.counters)
+}
+
+// SetBytes сохраняет уже сериализованное значение в кэше с временем жизни (TTL)
+func (c *LRUCache[K, V]) SetBytes(_ context.Context, key K, data []byte, ttl ...time.Duration) error {
+	c.store.setBytes(fmt.Sprintf("%v", key), append([]byte(nil), data...), ttl, c.counters)
+	return nil
+}
+
+// GetBytes возвращает копию сохраненного значения без десериализации
+func (c *LRUCache[K, V]) GetBytes(_ context.Context, key K) ([]byte, error) {
+	data, ok := c.store.getBytes(fmt.Sprintf("%v", key))
+	if !ok {
+		return nil, fmt.Errorf("%w по ключу: %v", ErrNotFound, key)
+	}
+	return append([]byte(nil), data...), nil
+}
+
+// Tag регистрирует запись в тегах. Регистрация удаляется вместе с записью, поэтому ttl не используется.
+func (c *LRUCache[K, V]) Tag(_ context.Context, key K, _ time.Duration, tags ...string) error {
+	c.store.tag(fmt.Sprintf("%v", key), tags)
+	return nil
+}
+
+// InvalidateTags удаляет записи, зарегистрированные в тегах, и возвращает их ключи
+func (c *LRUCache[K, V]) InvalidateTags(_ context.Context, tags ...string) ([]string, error) {
+	return c.store.invalidateTags(tags), nil
+}
+
+// TagGeneration возвращает поколение тегов
+func (c *LRUCache[K, V]) TagGeneration(_ context.Context, tags ...string) (string, error) {
+	c.store.mu.Lock()
+	defer c.store.mu.Unlock()
+
+	return c.store.tagGeneration(tags), nil
+}
+
+// SetTagged сохраняет значение и регистрирует его в тегах, если поколение тегов не изменилось
+func (c *LRUCache[K, V]) SetTagged(_ context.Context, key K, data []byte, ttl time.Duration, generation string, tags ...string) (bool, error) {
+	return c.store.setTagged(fmt.Sprintf("%v", key), append([]byte(nil), data...), ttl, generation, tags, c.counters), nil
+}
+
+// Len возвращает количество записей в хранилище кэша, включая еще не удаленные просроченные
+func (c *LRUCache[K, V]) Len() int {
+	return c.store.Len()
+}
+
+// Purge удаляет все записи хранилища кэша
+func (c *LRUCache[K, V]) Purge() {
+	c.store.Purge()
+}
+
+// keys возвращает ключи непросроченных записей по glob-шаблону
+func (c *LRUStore) keys(pattern string) ([]string, error) {
+	if _, err := path.Match(pattern, ""); err != nil {
+		return nil, fmt.Errorf("некорректный шаблон ключей %q: %w", pattern, err)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+	var keys []string
+	for key, elem := range c.entries {
+		if elem.Value.(*lruEntry).expired(now) {
+			continue
+		}
+		if matched, _ := path.Match(pattern, key); matched {
+			keys = append(keys, key)
+		}
+	}
+	return keys, nil
+}
+
+// Len возвращает количество записей, включая еще не удаленные просроченные
+func (c *LRUStore) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.order.Len()
+}
+
+// Purge удаляет все записи
+func (c *LRUStore) Purge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries = make(map[string]*list.Element)
+	c.order.Init()
+	c.tags = make(map[string]map[string]struct{})
+	c.size = 0
+	c.epoch++
+}
+
+// tag регистрирует непросроченную запись в тегах. Если записи нет, регистрировать нечего.
+func (c *LRUStore) tag(key string, tags []string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.tagLocked(key, tags)
+}
+
+// setTagged сохраняет запись и регистрирует ее в тегах, если поколение тегов равно generation
+func (c *LRUStore) setTagged(key string, data []byte, ttl time.Duration, generation string, tags []string, owner *counters) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.tagGeneration(tags) != generation {
+		return false
+	}
+	c.store(key, data, ttl, owner)
+	c.tagLocked(key, tags)
+	return true
+}
+
+// tagGeneration возвращает эпоху хранилища и счетчики поколений тегов через запятую. Вызывается под c.mu.
+func (c *LRUStore) tagGeneration(tags []string) string {
+	generations := make([]string, 0, 1+len(tags))
+	generations = append(generations, strconv.FormatUint(c.epoch, 10))
+	for _, tag := range tags {
+		generations = append(generations, strconv.FormatUint(c.generations[tagBucket(tag)], 10))
+	}
+	return strings.Join(generations, ",")
+}
+
+// tagBucket возвращает номер счетчика поколения тега
+func tagBucket(tag string) int {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(tag))
+	return int(h.Sum32() % tagGenerationBuckets)
+}
+
+// tagLocked регистрирует непросроченную запись в тегах. Вызывается под c.mu.
+func (c *LRUStore) tagLocked(key string, tags []string) {
+	entry, ok := c.lookup(key)
+	if !ok {
+		return
+	}
+
+	for _, tag := range tags {
+		keys, ok := c.tags[tag]
+		if !ok {
+			keys = make(map[string]struct{})
+			c.tags[tag] = keys
+		}
+		if _, registered := keys[key]; !registered {
+			keys[key] = struct{}{}
+			entry.tags = append(entry.tags, tag)
+		}
+	}
+}
+
+// invalidateTags удаляет записи, зарегистрированные в тегах, и возвращает их ключи.
+// Поколение тегов меняется, поэтому значение, загрузка которого началась до сброса, не будет сохранено через setTagged.
+func (c *LRUStore) invalidateTags(tags []string) []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var keys []string
+	for _, tag := range tags {
+		c.generations[tagBucket(tag)]++
+		for key := range c.tags[tag] {
+			c.remove(c.entries[key])
+			keys = append(keys, key)
+		}
+		delete(c.tags, tag)
+	}
+	return keys
+}
+
+// delete удаляет запись по ключу
+func (c *LRUStore) delete(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.entries[key]; ok {
+		c.remove(elem)
+	}
+}
+
+// exists проверяет наличие непросроченной записи по ключу
+func (c *LRUStore) exists(key string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	_, ok := c.lookup(key)
+	return ok
+}
+
+// setJSON сериализует значение и сохраняет его под ключом key от имени кэша owner.
+// Значение хранится сериализованным, поэтому изменение объекта после Set или Get не меняет запись в кэше.
+func (c *LRUStore) setJSON(key string, value interface{}, ttl []time.Duration, owner *counters) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Errorf("ошибка сериализации данных для кэша: %w", err)
+	}
+
+	c.setBytes(key, data, ttl, owner)
+	return nil
+}
+
+// setBytes сохраняет сериализованное значение под ключом key от имени кэша owner
+func (c *LRUStore) setBytes(key string, data []byte, ttl []time.Duration, owner *counters) {
+	expiration := c.config.DefaultTTL
+	if len(ttl) > 0 {
+		expiration = ttl[0]
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.store(key, data, expiration, owner)
+}
+
+// getJSON десериализует значение под ключом key в dest
+func (c *LRUStore) getJSON(key string, dest interface{}) error {
+	data, ok := c.getBytes(key)
+	if !ok {
+		return fmt.Errorf("%w по ключу: %v", ErrNotFound, key)
+	}
+
+	if err := json.Unmarshal(data, dest); err != nil {
+		return fmt.Errorf("ошибка при десериализации данных из кэша: %w", err)
+	}
+	return nil
+}
+
+// getBytes возвращает сериализованное значение под ключом key. Возвращаемый срез нельзя изменять.
+func (c *LRUStore) getBytes(key string) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.lookup(key)
+	if !ok {
+		return nil, false
+	}
+	return entry.data, true
+}
+
+// store сохраняет запись и вытесняет давно не использованные записи сверх ограничений. Вызывается под c.mu.
+// Запись, которая одна превышает MaxBytes, не сохраняется, а прежнее значение ключа удаляется.
+// Вытеснение записи засчитывается кэшу, который ее сохранил.
+func (c *LRUStore) store(key string, data []byte, ttl time.Duration, owner *counters) {
+	if elem, ok := c.entries[key]; ok {
+		c.remove(elem)
+	}
+
+	entry := &lruEntry{key: key, data: data, owner: owner}
+	if ttl > 0 {
+		entry.expiresAt = c.now().Add(ttl)
+	}
+	if c.config.MaxBytes > 0 && entry.size() > c.config.MaxBytes {
+		return
+	}
+
+	c.entries[key] = c.order.PushFront(entry)
+	c.size += entry.size()
+
+	for c.overLimit() {
+		evicted := c.order.Back()
+		evicted.Value.(*lruEntry).owner.evict()
+		c.remove(evicted)
+	}
+}
+
+// lookup возвращает непросроченную запись и отмечает ее как последнюю использованную. Вызывается под c.mu.
+func (c *LRUStore) lookup(key string) (*lruEntry, bool) {
+	elem, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+
+	entry := elem.Value.(*lruEntry)
+	if entry.expired(c.now()) {
+		c.remove(elem)
+		return nil, false
+	}
+
+	c.order.MoveToFront(elem)
+	return entry, true
+}
+
+// remove удаляет запись и ее регистрацию в тегах. Вызывается под c.mu.
+func (c *LRUStore) remove(elem *list.Element) {
+	entry := c.order.Remove(elem).(*lruEntry)
+	delete(c.entries, entry.key)
+	c.size -= entry.size()
+
+	for _, tag := range entry.tags {
+		delete(c.tags[tag], entry.key)
+		if len(c.tags[tag]) == 0 {
+			delete(c.tags, tag)
+		}
+	}
+}
+
+// overLimit проверяет, превышены ли ограничения размера кэша. Вызывается под c.mu.
+func (c *LRUStore) overLimit() bool {
+	if c.order.Len() == 0 {
+		return false
+	}
+	return (c.config.MaxEntries > 0 && c.order.Len() > c.config.MaxEntries) ||
+		(c.config.MaxBytes > 0 && c.size > c.config.MaxBytes)
+}
+
+// expired проверяет, истек ли срок хранения записи
+func (e *lruEntry) expired(now time.Time) bool {
+	return !e.expiresAt.IsZero() && !now.Before(e.expiresAt)
+}
+
+// size возвращает размер записи, учитываемый в MaxBytes
+func (e *lruEntry) size() int {
+	return len(e.key) + len(e.data)
+}
@@ -0,0 +1,171 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testValue - значение для проверки кэша
+type testValue struct {
+	ID   int
+	Name string
+}
+
+// fakeClock - управляемые часы для проверки времени жизни записей
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time { return c.now }
+
+// newTestLRU создает кэш в памяти процесса с управляемыми часами
+func newTestLRU(config LRUConfig) (*LRUCache[string, testValue], *fakeClock) {
+	clock := &fakeClock{now: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+	c := NewLRUCache[string, testValue](config)
+	c.store.now = clock.Now
+	return c, clock
+}
+
+func TestLRUCacheGetSet(t *testing.T) {
+	ctx := context.Background()
+	c, _ := newTestLRU(LRUConfig{})
+
+	var value testValue
+	assert.ErrorIs(t, c.Get(ctx, "missing", &value), ErrNotFound)
+
+	require.NoError(t, c.Set(ctx, "value_1", testValue{ID: 1, Name: "Коробка"}))
+	require.NoError(t, c.Get(ctx, "value_1", &value))
+	assert.Equal(t, testValue{ID: 1, Name: "Коробка"}, value)
+
+	// Значение хранится сериализованным: изменение прочитанного объекта не меняет кэш
+	value.Name = "Пакет"
+	var again testValue
+	require.NoError(t, c.Get(ctx, "value_1", &again))
+	assert.Equal(t, "Коробка", again.Name)
+
+	require.NoError(t, c.SetString(ctx, "name_1", "Коробка"))
+	var name string
+	require.NoError(t, c.Get(ctx, "name_1", &name), "строка из SetString читается через Get")
+	assert.Equal(t, "Коробка", name)
+
+	require.NoError(t, c.SetSlice(ctx, "all", []testValue{{ID: 1}, {ID: 2}}))
+	var values []testValue
+	require.NoError(t, c.GetSlice(ctx, "all", &values))
+	assert.Len(t, values, 2)
+
+	require.NoError(t, c.Delete(ctx, "value_1"))
+	exists, err := c.Exists(ctx, "value_1")
+	require.NoError(t, err)
+	assert.False(t, exists)
+	assert.NoError(t, c.Delete(ctx, "value_1"), "удаление отсутствующего ключа не считается ошибкой")
+}
+
+func TestLRUCacheTTL(t *testing.T) {
+	ctx := context.Background()
+	c, clock := newTestLRU(LRUConfig{DefaultTTL: time.Minute})
+
+	require.NoError(t, c.Set(ctx, "default", testValue{ID: 1}))
+	require.NoError(t, c.Set(ctx, "short", testValue{ID: 2}, time.Second))
+	require.NoError(t, c.Set(ctx, "forever", testValue{ID: 3}, 0))
+
+	clock.now = clock.now.Add(2 * time.Second)
+	var value testValue
+	assert.ErrorIs(t, c.Get(ctx, "short", &value), ErrNotFound)
+	assert.NoError(t, c.Get(ctx, "default", &value))
+
+	clock.now = clock.now.Add(time.Hour)
+	assert.ErrorIs(t, c.Get(ctx, "default", &value), ErrNotFound)
+	assert.NoError(t, c.Get(ctx, "forever", &value), "запись с нулевым TTL не истекает")
+
+	keys, err := c.Keys(ctx, "*")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"forever"}, keys)
+}
+
+func TestLRUCacheEvictsLeastRecentlyUsed(t *testing.T) {
+	ctx := context.Background()
+	c, _ := newTestLRU(LRUConfig{MaxEntries: 2})
+
+	require.NoError(t, c.Set(ctx, "a", testValue{ID: 1}))
+	require.NoError(t, c.Set(ctx, "b", testValue{ID: 2}))
+
+	var value testValue
+	require.NoError(t, c.Get(ctx, "a", &value), "чтение делает запись последней использованной")
+	require.NoError(t, c.Set(ctx, "c", testValue{ID: 3}))
+
+	assert.Equal(t, 2, c.Len())
+	assert.ErrorIs(t, c.Get(ctx, "b", &value), ErrNotFound, "вытесняется давно не использованная запись")
+	assert.NoError(t, c.Get(ctx, "a", &value))
+	assert.NoError(t, c.Get(ctx, "c", &value))
+}
+
+func TestLRUCacheMaxBytes(t *testing.T) {
+	ctx := context.Background()
+	c, _ := newTestLRU(LRUConfig{MaxBytes: 100})
+
+	for i := 0; i < 10; i++ {
+		require.NoError(t, c.Set(ctx, fmt.Sprintf("key_%d", i), testValue{ID: i}))
+	}
+	assert.LessOrEqual(t, c.store.size, 100)
+	assert.Less(t, c.Len(), 10)
+
+	var value testValue
+	assert.NoError(t, c.Get(ctx, "key_9", &value), "последняя запись не вытесняется")
+
+	require.NoError(t, c.SetString(ctx, "key_9", string(make([]byte, 200))))
+	assert.ErrorIs(t, c.Get(ctx, "key_9", &value), ErrNotFound, "запись больше MaxBytes не сохраняется и удаляет прежнее значение")
+}
+
+func TestLRUCacheKeys(t *testing.T) {
+	ctx := context.Background()
+	c, _ := newTestLRU(LRUConfig{})
+
+	for _, key := range []string{"user_orders_1", "user_orders_2", "order_1", "all_orders"} {
+		require.NoError(t, c.Set(ctx, key, testValue{}))
+	}
+
+	keys, err := c.Keys(ctx, "user_orders_*")
+	require.NoError(t, err)
+	sort.Strings(keys)
+	assert.Equal(t, []string{"user_orders_1", "user_orders_2"}, keys)
+
+	_, err = c.Keys(ctx, "[")
+	assert.Error(t, err)
+}
+
+func TestLRUCacheSharedStore(t *testing.T) {
+	ctx := context.Background()
+	store := NewLRUStore(LRUConfig{MaxEntries: 2})
+	orders := NewLRUCacheWithStore[string, testValue](store)
+	names := NewLRUCacheWithStore[string, string](store)
+
+	// Кэши с разными типами значений используют общее пространство ключей, как в Redis
+	require.NoError(t, orders.Set(ctx, "order_1", testValue{ID: 1}))
+	require.NoError(t, names.Delete(ctx, "order_1"))
+	var value testValue
+	assert.ErrorIs(t, orders.Get(ctx, "order_1", &value), ErrNotFound, "сброс через другой кэш виден всем кэшам хранилища")
+
+	// Ограничения хранилища общие для всех кэшей
+	require.NoError(t, orders.Set(ctx, "order_2", testValue{ID: 2}))
+	require.NoError(t, names.SetString(ctx, "user_name_1", "Иван"))
+	require.NoError(t, names.SetString(ctx, "user_name_2", "Петр"))
+	assert.Equal(t, 2, store.Len())
+	assert.ErrorIs(t, orders.Get(ctx, "order_2", &value), ErrNotFound)
+}
+
+func TestParseBackend(t *testing.T) {
+	for name, want := range map[string]Backend{"": BackendRedis, "redis": BackendRedis, "memory": BackendMemory, "tiered": BackendTiered} {
+		backend, err := ParseBackend(name)
+		require.NoError(t, err)
+		assert.Equal(t, want, backend)
+	}
+
+	_, err := ParseBackend("memcached")
+	assert.Error(t, err)
+}
@@ -0,0 +1,52 @@
+package cache
+
+import (
+	"context"
+	"sync"
+)
+
+// MemoryBus - шина сброса кэша в памяти процесса.
+// Доставляет сообщения подписчикам синхронно; используется в тестах, чтобы смоделировать несколько реплик в одном процессе.
+type MemoryBus struct {
+	mu       sync.Mutex
+	handlers map[int]func(Invalidation)
+	next     int
+}
+
+var _ InvalidationBus = (*MemoryBus)(nil)
+
+// NewMemoryBus создает шину сброса кэша в памяти процесса
+func NewMemoryBus() *MemoryBus {
+	return &MemoryBus{handlers: make(map[int]func(Invalidation))}
+}
+
+// Publish передает сообщение всем подписчикам
+func (b *MemoryBus) Publish(_ context.Context, message Invalidation) error {
+	b.mu.Lock()
+	handlers := make([]func(Invalidation), 0, len(b.handlers))
+	for _, handler := range b.handlers {
+		handlers = append(handlers, handler)
+	}
+	b.mu.Unlock()
+
+	for _, handler := range handlers {
+		handler(message)
+	}
+	return nil
+}
+
+// Subscribe передает сообщения в handler до отмены ctx
+func (b *MemoryBus) Subscribe(ctx context.Context, handler func(Invalidation)) error {
+	b.mu.Lock()
+	id := b.next
+	b.next++
+	b.handlers[id] = handler
+	b.mu.Unlock()
+
+	<-ctx.Done()
+
+	b.mu.Lock()
+	delete(b.handlers, id)
+	b.mu.Unlock()
+	return nil
+}
@@ -0,0 +1,93 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/jackc/pgx/v4/pgxpool"
+
+	"homework1/internal/dao"
+)
+
+// unlistenTimeout ограничивает отмену подписки при завершении работы
+const unlistenTimeout = 5 * time.Second
+
+// PostgresBus - шина сброса кэша через LISTEN/NOTIFY PostgreSQL.
+// Позволяет согласовать кэши в памяти процесса между репликами без Redis.
+type PostgresBus struct {
+	pool       *pgxpool.Pool
+	channel    string
+	retryDelay time.Duration // Пауза перед повторной подпиской после разрыва соединения
+}
+
+var _ InvalidationBus = (*PostgresBus)(nil)
+
+// NewPostgresBus создает шину сброса кэша на канале channel
+func NewPostgresBus(dbPool *pgxpool.Pool, channel string, retryDelay time.Duration) *PostgresBus {
+	return &PostgresBus{
+		pool:       dbPool,
+		channel:    channel,
+		retryDelay: retryDelay,
+	}
+}
+
+// Publish отправляет сообщение уведомлением в канал
+func (b *PostgresBus) Publish(ctx context.Context, message Invalidation) error {
+	data, err := json.Marshal(message)
+	if err != nil {
+		return fmt.Errorf("ошибка сериализации сообщения о сбросе кэша: %w", err)
+	}
+	return dao.Notify(ctx, b.channel, string(data), b.pool)
+}
+
+// Subscribe передает уведомления канала в handler до отмены ctx.
+// После разрыва соединения подписка восстанавливается через retryDelay, и передается сообщение с Purge.
+func (b *PostgresBus) Subscribe(ctx context.Context, handler func(Invalidation)) error {
+	for first := true; ; first = false {
+		err := b.listen(ctx, handler, !first)
+		if ctx.Err() != nil {
+			return nil
+		}
+		log.Printf("Подписка на сброс кэша прервана, повтор через %s: %v", b.retryDelay, err)
+
+		select {
+		case <-time.After(b.retryDelay):
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+// listen подписывается на канал и передает уведомления в handler до ошибки соединения
+func (b *PostgresBus) listen(ctx context.Context, handler func(Invalidation), resubscribed bool) error {
+	listener, err := dao.Listen(ctx, b.channel, b.pool)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		closeCtx, cancel := context.WithTimeout(context.Background(), unlistenTimeout)
+		defer cancel()
+		_ = listener.Close(closeCtx)
+	}()
+
+	if resubscribed {
+		handler(Invalidation{Purge: true})
+	}
+
+	for {
+		payload, err := listener.Wait(ctx)
+		if err != nil {
+			return err
+		}
+
+		var message Invalidation
+		if err := json.Unmarshal([]byte(payload), &message); err != nil {
+			log.Printf("Некорректное сообщение о сбросе кэша в канале %s: %v", b.channel, err)
+			continue
+		}
+		handler(message)
+	}
+}
@@ -0,0 +1,81 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// redisBusBuffer - размер буфера входящих сообщений подписки Redis
+const redisBusBuffer = 100
+
+// RedisBus - шина сброса кэша через pub/sub Redis.
+// Клиент Redis переподключается сам; сообщения, опубликованные во время разрыва, теряются.
+type RedisBus struct {
+	client  *redis.Client
+	channel string
+}
+
+var _ InvalidationBus = (*RedisBus)(nil)
+
+// NewRedisBus создает шину сброса кэша на канале channel
+func NewRedisBus(client *redis.Client, channel string) *RedisBus {
+	return &RedisBus{
+		client:  client,
+		channel: channel,
+	}
+}
+
+// Publish публикует сообщение в канал
+func (b *RedisBus) Publish(ctx context.Context, message Invalidation) error {
+	data, err := json.Marshal(message)
+	if err != nil {
+		return fmt.Errorf("ошибка сериализации сообщения о сбросе кэша: %w", err)
+	}
+
+	if err := b.client.Publish(ctx, b.channel, data).Err(); err != nil {
+		return fmt.Errorf("ошибка публикации в канал Redis %s: %w", b.channel, err)
+	}
+	return nil
+}
+
+// Subscribe передает сообщения канала в handler до отмены ctx.
+// Повторное подтверждение подписки означает переподключение, после которого передается сообщение с Purge.
+func (b *RedisBus) Subscribe(ctx context.Context, handler func(Invalidation)) error {
+	pubsub := b.client.Subscribe(ctx, b.channel)
+	defer pubsub.Close()
+
+	subscribed := false
+	messages := pubsub.ChannelWithSubscriptions(ctx, redisBusBuffer)
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case received, ok := <-messages:
+			if !ok {
+				return nil
+			}
+
+			switch msg := received.(type) {
+			case *redis.Subscription:
+				if msg.Kind != "subscribe" {
+					continue
+				}
+				if subscribed {
+					handler(Invalidation{Purge: true})
+				}
+				subscribed = true
+			case *redis.Message:
+				var message Invalidation
+				if err := json.Unmarshal([]byte(msg.Payload), &message); err != nil {
+					log.Printf("Некорректное сообщение о сбросе кэша в канале Redis %s: %v", b.channel, err)
+					continue
+				}
+				handler(message)
+			}
+		}
+	}
+}
@@ -0,0 +1,155 @@
+package cache
+
+import (
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// LoadBuckets - верхние границы интервалов гистограммы длительности загрузки в секундах
+var LoadBuckets = []float64{.001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5}
+
+// Stats - снимок счетчиков именованного кэша с момента запуска
+type Stats struct {
+	Name        string   // Имя кэша, например orders
+	Hits        uint64   // Запросы, обслуженные из кэша, в том числе отметкой об отсутствии записи
+	Misses      uint64   // Запросы, для которых значение загружалось из источника
+	Errors      uint64   // Ошибки чтения и записи кэша
+	Loads       uint64   // Загрузки из источника; одновременные промахи по ключу объединяются в одну загрузку
+	LoadErrors  uint64   // Загрузки, завершившиеся ошибкой, кроме отсутствия записи
+	LoadSeconds float64  // Суммарная длительность загрузок в секундах
+	LoadBuckets []uint64 // Количество загрузок не дольше соответствующей границы LoadBuckets
+	Evictions   uint64   // Записи, вытесненные из памяти процесса из-за ограничения размера
+}
+
+// counters - счетчики именованного кэша. Счетчики общие для всех кэшей с одним именем.
+type counters struct {
+	name       string
+	hits       atomic.Uint64
+	misses     atomic.Uint64
+	errors     atomic.Uint64
+	loadErrors atomic.Uint64
+	evictions  atomic.Uint64
+
+	mu          sync.Mutex
+	loads       uint64
+	loadSeconds float64
+	loadBuckets []uint64 // Количество загрузок по интервалам, не накопительное
+}
+
+// registry хранит счетчики кэшей по именам
+var registry = struct {
+	mu     sync.Mutex
+	caches map[string]*counters
+}{caches: make(map[string]*counters)}
+
+// countersFor возвращает счетчики кэша с именем name; для пустого имени счетчики не ведутся
+func countersFor(name string) *counters {
+	if name == "" {
+		return nil
+	}
+
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+
+	c, ok := registry.caches[name]
+	if !ok {
+		c = &counters{name: name, loadBuckets: make([]uint64, len(LoadBuckets))}
+		registry.caches[name] = c
+	}
+	return c
+}
+
+// GetStats возвращает снимки счетчиков всех именованных кэшей, отсортированные по имени
+func GetStats() []Stats {
+	registry.mu.Lock()
+	caches := make([]*counters, 0, len(registry.caches))
+	for _, c := range registry.caches {
+		caches = append(caches, c)
+	}
+	registry.mu.Unlock()
+
+	sort.Slice(caches, func(i, j int) bool { return caches[i].name < caches[j].name })
+
+	stats := make([]Stats, len(caches))
+	for i, c := range caches {
+		stats[i] = c.snapshot()
+	}
+	return stats
+}
+
+// hit учитывает запрос, обслуженный из кэша
+func (c *counters) hit() {
+	if c != nil {
+		c.hits.Add(1)
+	}
+}
+
+// miss учитывает запрос, для которого значение загружается из источника
+func (c *counters) miss() {
+	if c != nil {
+		c.misses.Add(1)
+	}
+}
+
+// fail учитывает ошибку чтения или записи кэша
+func (c *counters) fail() {
+	if c != nil {
+		c.errors.Add(1)
+	}
+}
+
+// evict учитывает вытеснение записи из памяти процесса
+func (c *counters) evict() {
+	if c != nil {
+		c.evictions.Add(1)
+	}
+}
+
+// load учитывает загрузку из источника длительностью delta
+func (c *counters) load(delta time.Duration, failed bool) {
+	if c == nil {
+		return
+	}
+	if failed {
+		c.loadErrors.Add(1)
+	}
+
+	seconds := delta.Seconds()
+	bucket := sort.SearchFloat64s(LoadBuckets, seconds)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.loads++
+	c.loadSeconds += seconds
+	if bucket < len(c.loadBuckets) {
+		c.loadBuckets[bucket]++
+	}
+}
+
+// snapshot возвращает снимок счетчиков с накопительными интервалами гистограммы
+func (c *counters) snapshot() Stats {
+	stats := Stats{
+		Name:       c.name,
+		Hits:       c.hits.Load(),
+		Misses:     c.misses.Load(),
+		Errors:     c.errors.Load(),
+		LoadErrors: c.loadErrors.Load(),
+		Evictions:  c.evictions.Load(),
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	stats.Loads = c.loads
+	stats.LoadSeconds = c.loadSeconds
+	stats.LoadBuckets = make([]uint64, len(c.loadBuckets))
+	var total uint64
+	for i, count := range c.loadBuckets {
+		total += count
+		stats.LoadBuckets[i] = total
+	}
+	return stats
+}
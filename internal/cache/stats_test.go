@@ -0,0 +1,115 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// statsFor возвращает снимок счетчиков кэша с именем name
+func statsFor(t *testing.T, name string) Stats {
+	for _, stats := range GetStats() {
+		if stats.Name == name {
+			return stats
+		}
+	}
+	t.Fatalf("нет счетчиков кэша %s", name)
+	return Stats{}
+}
+
+func TestLoadingCacheStats(t *testing.T) {
+	ctx := context.Background()
+	c, _, clock := newTestLoading(LoadOptions{Name: "stats_test", TTL: time.Minute, NegativeTTL: time.Minute, NotFound: errRecordNotFound})
+
+	load := func(context.Context) (testValue, error) {
+		clock.now = clock.now.Add(20 * time.Millisecond)
+		return testValue{ID: 1}, nil
+	}
+	for i := 0; i < 3; i++ {
+		_, err := c.GetOrLoad(ctx, "order_1", load)
+		require.NoError(t, err)
+	}
+
+	missing := func(context.Context) (testValue, error) { return testValue{}, errRecordNotFound }
+	for i := 0; i < 2; i++ {
+		_, err := c.GetOrLoad(ctx, "order_2", missing)
+		require.ErrorIs(t, err, errRecordNotFound)
+	}
+
+	_, err := c.GetOrLoad(ctx, "order_3", func(context.Context) (testValue, error) {
+		return testValue{}, errors.New("база данных недоступна")
+	})
+	require.Error(t, err)
+
+	stats := statsFor(t, "stats_test")
+	assert.Equal(t, uint64(3), stats.Hits, "отметка об отсутствии записи - тоже попадание")
+	assert.Equal(t, uint64(3), stats.Misses)
+	assert.Equal(t, uint64(3), stats.Loads)
+	assert.Equal(t, uint64(1), stats.LoadErrors, "отсутствие записи не считается ошибкой загрузки")
+	assert.Zero(t, stats.Errors)
+	assert.InDelta(t, 0.02, stats.LoadSeconds, 1e-9)
+
+	// Загрузка 20 мс попадает в интервалы от 25 мс, мгновенные загрузки - во все интервалы
+	require.Len(t, stats.LoadBuckets, len(LoadBuckets))
+	assert.Equal(t, uint64(2), stats.LoadBuckets[0])
+	assert.Equal(t, uint64(3), stats.LoadBuckets[len(LoadBuckets)-1])
+}
+
+func TestLRUEvictionsCountedByOwner(t *testing.T) {
+	ctx := context.Background()
+	store := NewLRUStore(LRUConfig{MaxEntries: 2})
+	orders := New[string, testValue](nil, Options{Name: "evictions_orders", Backend: BackendMemory, Local: store})
+	users := New[string, testValue](nil, Options{Name: "evictions_users", Backend: BackendMemory, Local: store})
+
+	require.NoError(t, orders.Set(ctx, "order_1", testValue{ID: 1}))
+	require.NoError(t, users.Set(ctx, "user_1", testValue{ID: 1}))
+	require.NoError(t, users.Set(ctx, "user_2", testValue{ID: 2}))
+	require.NoError(t, users.Set(ctx, "user_3", testValue{ID: 3}))
+
+	assert.Equal(t, uint64(1), statsFor(t, "evictions_orders").Evictions)
+	assert.Equal(t, uint64(1), statsFor(t, "evictions_users").Evictions)
+}
+
+func TestLoadingCacheInspectAndFlush(t *testing.T) {
+	ctx := context.Background()
+	store := NewLRUStore(LRUConfig{})
+	orders := NewLoadingCache(New[string, testValue](nil, Options{Name: "flush_orders", Backend: BackendMemory, Local: store}),
+		LoadOptions{Name: "flush_orders", TTL: time.Minute, NegativeTTL: time.Minute, NotFound: errRecordNotFound})
+	users := NewLoadingCache(New[string, testValue](nil, Options{Name: "flush_users", Backend: BackendMemory, Local: store}),
+		LoadOptions{Name: "flush_users", TTL: time.Minute})
+
+	_, err := orders.GetOrLoad(ctx, "order_1", func(context.Context) (testValue, error) { return testValue{ID: 1}, nil })
+	require.NoError(t, err)
+	_, err = orders.GetOrLoad(ctx, "order_2", func(context.Context) (testValue, error) { return testValue{}, errRecordNotFound })
+	require.ErrorIs(t, err, errRecordNotFound)
+	_, err = users.GetOrLoad(ctx, "user_1", func(context.Context) (testValue, error) { return testValue{ID: 1}, nil })
+	require.NoError(t, err)
+
+	entry, err := orders.Inspect(ctx, "order_1")
+	require.NoError(t, err)
+	assert.Equal(t, "order_1", entry.Key)
+	assert.JSONEq(t, `{"ID":1,"Name":""}`, string(entry.Value))
+	assert.False(t, entry.NotFound)
+	assert.False(t, entry.ExpiresAt.IsZero())
+
+	entry, err = orders.Inspect(ctx, "order_2")
+	require.NoError(t, err)
+	assert.True(t, entry.NotFound)
+	assert.Empty(t, entry.Value)
+
+	_, err = orders.Inspect(ctx, "order_3")
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	// Сброс пространства имен не затрагивает записи другого кэша в том же хранилище
+	keys, err := orders.Flush(ctx)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"order_1", "order_2"}, keys)
+	assert.Equal(t, 1, store.Len())
+
+	_, err = NewLoadingCache[string, testValue](NewLRUCache[string, testValue](LRUConfig{}), LoadOptions{}).Flush(ctx)
+	assert.Error(t, err, "у безымянного кэша нет пространства имен")
+}
@@ -0,0 +1,179 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// tagKeyPrefix - префикс ключей Redis, в которых хранятся множества ключей тегов
+const tagKeyPrefix = "tag:"
+
+// tagGenerationPrefix - префикс ключей Redis, в которых хранятся поколения тегов
+const tagGenerationPrefix = "taggen:"
+
+// tagGenerationCounter - ключ Redis со счетчиком, из которого выдаются поколения тегов.
+// Поколения берутся из общего счетчика, поэтому тег, поколение которого истекло, не получит прежнее значение.
+const tagGenerationCounter = "taggen"
+
+// tagGenerationTTL - время жизни поколения тега; должно быть больше самой долгой загрузки значения
+const tagGenerationTTL = time.Hour
+
+// tagPruneSample - число случайных ключей множества тега, которые проверяются при каждой регистрации ключа
+const tagPruneSample = 5
+
+// tagInvalidateBatch - число ключей, удаляемых одним вызовом invalidateTagScript
+const tagInvalidateBatch = 500
+
+// addToTagLua объявляет функцию скрипта, которая добавляет ключ в множество тега и продлевает время жизни множества
+// до времени жизни записи. Множество не должно истечь раньше любой зарегистрированной в нем записи,
+// поэтому время жизни только увеличивается. Ключи истекших записей и записей, удаленных через другие теги или Delete,
+// остаются в множестве, поэтому функция проверяет несколько случайных ключей множества и убирает отсутствующие:
+// доля устаревших ключей остается ограниченной.
+const addToTagLua = `
+local function addToTag(tagKey, member, ttl, sample)
+	local current = redis.call('PTTL', tagKey)
+	redis.call('SADD', tagKey, member)
+	if ttl <= 0 then
+		redis.call('PERSIST', tagKey)
+	elseif current == -2 or (current >= 0 and current < ttl) then
+		redis.call('PEXPIRE', tagKey, ttl)
+	end
+	local members = redis.call('SRANDMEMBER', tagKey, sample)
+	for _, key in ipairs(members) do
+		if redis.call('EXISTS', key) == 0 then
+			redis.call('SREM', tagKey, key)
+		end
+	end
+end
+`
+
+// tagScript регистрирует ключ ARGV[1] в теге KEYS[1]
+var tagScript = redis.NewScript(addToTagLua + `
+addToTag(KEYS[1], ARGV[1], tonumber(ARGV[2]), tonumber(ARGV[3]))
+return 1
+`)
+
+// setTaggedScript сохраняет значение KEYS[1] и регистрирует его в тегах, если поколение тегов равно ARGV[3].
+// KEYS[2..n+1] - множества тегов, KEYS[n+2..2n+1] - их поколения. Возвращает 0, если поколение изменилось.
+var setTaggedScript = redis.NewScript(addToTagLua + `
+local n = (#KEYS - 1) / 2
+local generations = {}
+for i = 1, n do
+	generations[i] = redis.call('GET', KEYS[n + 1 + i]) or ''
+end
+if table.concat(generations, ',') ~= ARGV[3] then
+	return 0
+end
+
+local ttl = tonumber(ARGV[2])
+if ttl > 0 then
+	redis.call('SET', KEYS[1], ARGV[1], 'PX', ttl)
+else
+	redis.call('SET', KEYS[1], ARGV[1])
+end
+for i = 1, n do
+	addToTag(KEYS[1 + i], KEYS[1], ttl, tonumber(ARGV[4]))
+end
+return 1
+`)
+
+// bumpTagGenerationScript присваивает тегу новое поколение из счетчика KEYS[2]
+var bumpTagGenerationScript = redis.NewScript(`
+local generation = redis.call('INCR', KEYS[2])
+redis.call('SET', KEYS[1], generation, 'PX', tonumber(ARGV[1]))
+return generation
+`)
+
+// invalidateTagScript извлекает из множества тега не больше ARGV[1] ключей, удаляет их и возвращает.
+// Пустое множество Redis удаляет сам. Ключи записей не передаются через KEYS, поэтому скрипт рассчитан на Redis без кластера.
+var invalidateTagScript = redis.NewScript(`
+local keys = redis.call('SPOP', KEYS[1], tonumber(ARGV[1]))
+if #keys > 0 then
+	redis.call('DEL', unpack(keys))
+end
+return keys
+`)
+
+// Tag регистрирует ключ в тегах. Каждый тег хранится в Redis как множество ключей "tag:<тег>".
+func (c *RedisCache[K, V]) Tag(ctx context.Context, key K, ttl time.Duration, tags ...string) error {
+	for _, tag := range tags {
+		err := tagScript.Run(ctx, c.client, []string{tagKeyPrefix + tag}, fmt.Sprintf("%v", key), ttl.Milliseconds(), tagPruneSample).Err()
+		if err != nil {
+			return fmt.Errorf("ошибка регистрации ключа %v в теге %s в Redis: %w", key, tag, err)
+		}
+	}
+	return nil
+}
+
+// InvalidateTags удаляет ключи, зарегистрированные в тегах, и возвращает их.
+// Поколение тега меняется до удаления ключей, поэтому значение, загрузка которого началась до сброса,
+// не будет сохранено после него. Множество тега разбирается пачками по tagInvalidateBatch ключей,
+// чтобы большой тег не блокировал Redis одним скриптом.
+func (c *RedisCache[K, V]) InvalidateTags(ctx context.Context, tags ...string) ([]string, error) {
+	var keys []string
+	for _, tag := range tags {
+		err := bumpTagGenerationScript.Run(ctx, c.client, []string{tagGenerationPrefix + tag, tagGenerationCounter},
+			tagGenerationTTL.Milliseconds()).Err()
+		if err != nil {
+			return keys, fmt.Errorf("ошибка смены поколения тега %s в Redis: %w", tag, err)
+		}
+
+		for {
+			deleted, err := invalidateTagScript.Run(ctx, c.client, []string{tagKeyPrefix + tag}, tagInvalidateBatch).StringSlice()
+			if err != nil {
+				return keys, fmt.Errorf("ошибка инвалидации тега %s в Redis: %w", tag, err)
+			}
+			keys = append(keys, deleted...)
+			if len(deleted) < tagInvalidateBatch {
+				break
+			}
+		}
+	}
+	return keys, nil
+}
+
+// TagGeneration возвращает поколения тегов через запятую; у тега, который не сбрасывался, поколение пустое
+func (c *RedisCache[K, V]) TagGeneration(ctx context.Context, tags ...string) (string, error) {
+	if len(tags) == 0 {
+		return "", nil
+	}
+
+	keys := make([]string, len(tags))
+	for i, tag := range tags {
+		keys[i] = tagGenerationPrefix + tag
+	}
+	values, err := c.client.MGet(ctx, keys...).Result()
+	if err != nil {
+		return "", fmt.Errorf("ошибка получения поколения тегов %v из Redis: %w", tags, err)
+	}
+
+	generations := make([]string, len(values))
+	for i, value := range values {
+		if value != nil {
+			generations[i] = fmt.Sprint(value)
+		}
+	}
+	return strings.Join(generations, ","), nil
+}
+
+// SetTagged сохраняет значение и регистрирует его в тегах одним скриптом, если поколение тегов не изменилось
+func (c *RedisCache[K, V]) SetTagged(ctx context.Context, key K, data []byte, ttl time.Duration, generation string, tags ...string) (bool, error) {
+	keys := make([]string, 0, 1+2*len(tags))
+	keys = append(keys, fmt.Sprintf("%v", key))
+	for _, tag := range tags {
+		keys = append(keys, tagKeyPrefix+tag)
+	}
+	for _, tag := range tags {
+		keys = append(keys, tagGenerationPrefix+tag)
+	}
+
+	stored, err := setTaggedScript.Run(ctx, c.client, keys, data, ttl.Milliseconds(), generation, tagPruneSample).Int()
+	if err != nil {
+		return false, fmt.Errorf("ошибка сохранения ключа %v с тегами %v в Redis: %w", key, tags, err)
+	}
+	return stored == 1, nil
+}
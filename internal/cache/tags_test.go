@@ -0,0 +1,173 @@
+package cache
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLRUCacheInvalidateTags(t *testing.T) {
+	ctx := context.Background()
+	c, _ := newTestLRU(LRUConfig{})
+
+	require.NoError(t, c.Set(ctx, "order_1", testValue{ID: 1}))
+	require.NoError(t, c.Set(ctx, "user_orders_42", testValue{ID: 2}))
+	require.NoError(t, c.Set(ctx, "user_orders_7", testValue{ID: 3}))
+	require.NoError(t, c.Tag(ctx, "order_1", time.Minute, "order:1"))
+	require.NoError(t, c.Tag(ctx, "user_orders_42", time.Minute, "user:42", "orders:lists"))
+	require.NoError(t, c.Tag(ctx, "user_orders_7", time.Minute, "user:7", "orders:lists"))
+
+	keys, err := c.InvalidateTags(ctx, "user:42")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"user_orders_42"}, keys)
+
+	var value testValue
+	assert.ErrorIs(t, c.Get(ctx, "user_orders_42", &value), ErrNotFound)
+	assert.NoError(t, c.Get(ctx, "user_orders_7", &value), "записи других тегов не сбрасываются")
+
+	keys, err = c.InvalidateTags(ctx, "orders:lists", "order:1")
+	require.NoError(t, err)
+	sort.Strings(keys)
+	assert.Equal(t, []string{"order_1", "user_orders_7"}, keys)
+	assert.Zero(t, c.Len())
+	assert.Empty(t, c.store.tags, "регистрация в тегах удаляется вместе с записями")
+}
+
+func TestLRUCacheTagsFollowEntries(t *testing.T) {
+	ctx := context.Background()
+	c, _ := newTestLRU(LRUConfig{MaxEntries: 1})
+
+	// Тег отсутствующей записи не регистрируется
+	require.NoError(t, c.Tag(ctx, "missing", time.Minute, "order:1"))
+	assert.Empty(t, c.store.tags)
+
+	require.NoError(t, c.Set(ctx, "order_1", testValue{ID: 1}))
+	require.NoError(t, c.Tag(ctx, "order_1", time.Minute, "order:1"))
+	require.NoError(t, c.Set(ctx, "order_2", testValue{ID: 2}))
+	assert.Empty(t, c.store.tags, "вытесненная запись удаляется из тегов")
+
+	keys, err := c.InvalidateTags(ctx, "order:1")
+	require.NoError(t, err)
+	assert.Empty(t, keys)
+
+	var value testValue
+	assert.NoError(t, c.Get(ctx, "order_2", &value))
+}
+
+func TestTieredCacheInvalidateTags(t *testing.T) {
+	ctx := context.Background()
+	tiered, local, shared, _ := newTestTiered(time.Minute)
+
+	// Запись другой реплики зарегистрирована в теге только в L2 и попадает в L1 при чтении
+	require.NoError(t, shared.Set(ctx, "order_1", testValue{ID: 1}))
+	require.NoError(t, shared.Tag(ctx, "order_1", time.Minute, "order:1"))
+	var value testValue
+	require.NoError(t, tiered.Get(ctx, "order_1", &value))
+
+	// Запись этой реплики зарегистрирована на обоих уровнях
+	require.NoError(t, tiered.Set(ctx, "order_2", testValue{ID: 2}))
+	require.NoError(t, tiered.Tag(ctx, "order_2", time.Minute, "order:2"))
+
+	keys, err := tiered.InvalidateTags(ctx, "order:1", "order:2")
+	require.NoError(t, err)
+	sort.Strings(keys)
+	assert.Equal(t, []string{"order_1", "order_2"}, keys)
+
+	for _, key := range []string{"order_1", "order_2"} {
+		assert.ErrorIs(t, local.Get(ctx, key, &value), ErrNotFound, "запись %s удаляется из L1", key)
+		assert.ErrorIs(t, shared.Get(ctx, key, &value), ErrNotFound, "запись %s удаляется из L2", key)
+	}
+}
+
+func TestLoadingCacheWithTags(t *testing.T) {
+	ctx := context.Background()
+	c, _, _ := newTestLoading(LoadOptions{TTL: time.Minute, NegativeTTL: time.Minute, NotFound: errRecordNotFound})
+
+	found := false
+	calls := 0
+	load := func(context.Context) (testValue, error) {
+		calls++
+		if !found {
+			return testValue{}, errRecordNotFound
+		}
+		return testValue{ID: 1}, nil
+	}
+
+	_, err := c.GetOrLoad(ctx, "order_1", load, WithTags("order:1"), WithTTL(time.Hour))
+	assert.ErrorIs(t, err, errRecordNotFound)
+
+	// Создание записи сбрасывает отметку об ее отсутствии по тегу
+	found = true
+	_, err = c.InvalidateTags(ctx, "order:1")
+	require.NoError(t, err)
+
+	value, err := c.GetOrLoad(ctx, "order_1", load, WithTags("order:1"), WithTTL(time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, 1, value.ID)
+	assert.Equal(t, 2, calls)
+
+	keys, err := c.InvalidateTags(ctx, "order:1")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"order_1"}, keys)
+}
+
+func TestLoadingCacheDiscardsValueLoadedDuringInvalidation(t *testing.T) {
+	ctx := context.Background()
+	shared := NewLRUCache[string, testValue](LRUConfig{})
+	c := NewLoadingCache[string, testValue](shared, LoadOptions{Name: "orders", TTL: time.Minute})
+
+	calls := 0
+	load := func(ctx context.Context) (testValue, error) {
+		calls++
+		if calls == 1 {
+			// Заказ изменяется и его теги сбрасываются, пока загрузка читает старое значение
+			_, err := c.InvalidateTags(ctx, "order:1")
+			require.NoError(t, err)
+		}
+		return testValue{ID: calls}, nil
+	}
+
+	value, err := c.GetOrLoad(ctx, "order_1", load, WithTags("order:1"))
+	require.NoError(t, err)
+	assert.Equal(t, 1, value.ID)
+
+	exists, err := shared.Exists(ctx, "order_1")
+	require.NoError(t, err)
+	assert.False(t, exists, "значение, загруженное до сброса тегов, не сохраняется")
+
+	value, err = c.GetOrLoad(ctx, "order_1", load, WithTags("order:1"))
+	require.NoError(t, err)
+	assert.Equal(t, 2, value.ID)
+
+	keys, err := c.Flush(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"order_1"}, keys, "значение сохраняется вместе с регистрацией в тегах")
+}
+
+func TestLoadingCacheTagFailureDropsEntry(t *testing.T) {
+	ctx := context.Background()
+	shared := &untaggableCache[string, testValue]{LRUCache: NewLRUCache[string, testValue](LRUConfig{})}
+	c := NewLoadingCache[string, testValue](shared, LoadOptions{TTL: time.Minute})
+
+	_, err := c.GetOrLoad(ctx, "order_1", func(context.Context) (testValue, error) {
+		return testValue{ID: 1}, nil
+	}, WithTags("order:1"))
+	require.NoError(t, err)
+
+	exists, err := shared.Exists(ctx, "order_1")
+	require.NoError(t, err)
+	assert.False(t, exists, "запись без регистрации в тегах не сохраняется")
+}
+
+// untaggableCache - кэш, в котором не удается сохранить запись с тегами
+type untaggableCache[K comparable, V any] struct {
+	*LRUCache[K, V]
+}
+
+func (*untaggableCache[K, V]) SetTagged(context.Context, K, []byte, time.Duration, string, ...string) (bool, error) {
+	return false, errUnavailable
+}
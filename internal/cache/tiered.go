@@ -0,0 +1,181 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// tieredGenerationSeparator разделяет поколения тегов L1 и L2 в поколении TieredCache
+const tieredGenerationSeparator = "|"
+
+// TieredCache - двухуровневый кэш: локальный кэш процесса (L1) перед общим кэшем, например Redis (L2).
+// Чтение сначала обращается к L1, промах L1 читается из L2 и сохраняется в L1.
+// Запись и удаление выполняются на обоих уровнях. Время жизни записей L1 ограничено localTTL,
+// поэтому изменение, сделанное другой репликой через L2, становится видно не позже чем через localTTL.
+type TieredCache[K comparable, V any] struct {
+	local    *LRUCache[K, V]
+	shared   Cache[K, V]
+	localTTL time.Duration
+}
+
+var _ Cache[string, int] = (*TieredCache[string, int])(nil)
+
+// NewTieredCache создает двухуровневый кэш. localTTL ограничивает время жизни записей L1; 0 - без ограничения.
+func NewTieredCache[K comparable, V any](local *LRUCache[K, V], shared Cache[K, V], localTTL time.Duration) *TieredCache[K, V] {
+	return &TieredCache[K, V]{
+		local:    local,
+		shared:   shared,
+		localTTL: localTTL,
+	}
+}
+
+// Set сохраняет объект на обоих уровнях. Ошибка L2 возвращается, но значение остается в L1.
+func (c *TieredCache[K, V]) Set(ctx context.Context, key K, value V, ttl ...time.Duration) error {
+	_ = c.local.Set(ctx, key, value, c.localExpiration(ttl))
+	return c.shared.Set(ctx, key, value, ttl...)
+}
+
+// Get возвращает объект из L1, а при промахе - из L2 с сохранением в L1
+func (c *TieredCache[K, V]) Get(ctx context.Context, key K, dest interface{}) error {
+	if err := c.local.Get(ctx, key, dest); err == nil {
+		return nil
+	}
+
+	if err := c.shared.Get(ctx, key, dest); err != nil {
+		return err
+	}
+	_ = c.local.store.setJSON(fmt.Sprintf("%v", key), dest, []time.Duration{c.localExpiration(nil)}, c.local.counters)
+	return nil
+}
+
+// Delete удаляет объект на обоих уровнях
+func (c *TieredCache[K, V]) Delete(ctx context.Context, key K) error {
+	_ = c.local.Delete(ctx, key)
+	return c.shared.Delete(ctx, key)
+}
+
+// Exists проверяет наличие объекта в L1 или L2
+func (c *TieredCache[K, V]) Exists(ctx context.Context, key K) (bool, error) {
+	if exists, _ := c.local.Exists(ctx, key); exists {
+		return true, nil
+	}
+	return c.shared.Exists(ctx, key)
+}
+
+// Keys возвращает ключи по шаблону из L2, где хранятся записи всех реплик
+func (c *TieredCache[K, V]) Keys(ctx context.Context, pattern string) ([]string, error) {
+	return c.shared.Keys(ctx, pattern)
+}
+
+// SetSlice сохраняет срез объектов на обоих уровнях
+func (c *TieredCache[K, V]) SetSlice(ctx context.Context, key K, values []V, ttl ...time.Duration) error {
+	_ = c.local.SetSlice(ctx, key, values, c.localExpiration(ttl))
+	return c.shared.SetSlice(ctx, key, values, ttl...)
+}
+
+// GetSlice возвращает срез объектов из L1, а при промахе - из L2 с сохранением в L1
+func (c *TieredCache[K, V]) GetSlice(ctx context.Context, key K, dest *[]V) error {
+	if err := c.local.GetSlice(ctx, key, dest); err == nil {
+		return nil
+	}
+
+	if err := c.shared.GetSlice(ctx, key, dest); err != nil {
+		return err
+	}
+	_ = c.local.SetSlice(ctx, key, *dest, c.localExpiration(nil))
+	return nil
+}
+
+// SetString сохраняет строку на обоих уровнях
+func (c *TieredCache[K, V]) SetString(ctx context.Context, key K, value string, ttl ...time.Duration) error {
+	_ = c.local.SetString(ctx, key, value, c.localExpiration(ttl))
+	return c.shared.SetString(ctx, key, value, ttl...)
+}
+
+// SetBytes сохраняет сериализованное значение на обоих уровнях
+func (c *TieredCache[K, V]) SetBytes(ctx context.Context, key K, data []byte, ttl ...time.Duration) error {
+	_ = c.local.SetBytes(ctx, key, data, c.localExpiration(ttl))
+	return c.shared.SetBytes(ctx, key, data, ttl...)
+}
+
+// GetBytes возвращает сериализованное значение из L1, а при промахе - из L2 с сохранением в L1
+func (c *TieredCache[K, V]) GetBytes(ctx context.Context, key K) ([]byte, error) {
+	if data, err := c.local.GetBytes(ctx, key); err == nil {
+		return data, nil
+	}
+
+	data, err := c.shared.GetBytes(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	_ = c.local.SetBytes(ctx, key, data, c.localExpiration(nil))
+	return data, nil
+}
+
+// Tag регистрирует ключ в тегах на обоих уровнях
+func (c *TieredCache[K, V]) Tag(ctx context.Context, key K, ttl time.Duration, tags ...string) error {
+	_ = c.local.Tag(ctx, key, ttl, tags...)
+	return c.shared.Tag(ctx, key, ttl, tags...)
+}
+
+// InvalidateTags удаляет ключи тегов на обоих уровнях.
+// Записи L1, прочитанные из L2, не зарегистрированы в тегах L1, поэтому из L1 удаляются и ключи, удаленные в L2.
+func (c *TieredCache[K, V]) InvalidateTags(ctx context.Context, tags ...string) ([]string, error) {
+	localKeys, _ := c.local.InvalidateTags(ctx, tags...)
+	sharedKeys, err := c.shared.InvalidateTags(ctx, tags...)
+	for _, key := range sharedKeys {
+		c.local.store.delete(key)
+	}
+	return mergeKeys(localKeys, sharedKeys), err
+}
+
+// TagGeneration возвращает поколения тегов обоих уровней
+func (c *TieredCache[K, V]) TagGeneration(ctx context.Context, tags ...string) (string, error) {
+	local, _ := c.local.TagGeneration(ctx, tags...)
+	shared, err := c.shared.TagGeneration(ctx, tags...)
+	if err != nil {
+		return "", err
+	}
+	return local + tieredGenerationSeparator + shared, nil
+}
+
+// SetTagged сохраняет значение с тегами в L2, а затем в L1, если поколение тегов соответствующего уровня не изменилось.
+// Значение, отброшенное L2, не сохраняется и в L1.
+func (c *TieredCache[K, V]) SetTagged(ctx context.Context, key K, data []byte, ttl time.Duration, generation string, tags ...string) (bool, error) {
+	local, shared, _ := strings.Cut(generation, tieredGenerationSeparator)
+	stored, err := c.shared.SetTagged(ctx, key, data, ttl, shared, tags...)
+	if err != nil || !stored {
+		return stored, err
+	}
+	_, _ = c.local.SetTagged(ctx, key, data, c.localExpiration([]time.Duration{ttl}), local, tags...)
+	return true, nil
+}
+
+// localExpiration возвращает время жизни записи L1: запрошенное, но не больше localTTL
+func (c *TieredCache[K, V]) localExpiration(ttl []time.Duration) time.Duration {
+	expiration := c.local.store.config.DefaultTTL
+	if len(ttl) > 0 {
+		expiration = ttl[0]
+	}
+	if c.localTTL > 0 && (expiration <= 0 || expiration > c.localTTL) {
+		return c.localTTL
+	}
+	return expiration
+}
+
+// mergeKeys объединяет списки ключей без повторов
+func mergeKeys(lists ...[]string) []string {
+	seen := make(map[string]struct{})
+	var keys []string
+	for _, list := range lists {
+		for _, key := range list {
+			if _, ok := seen[key]; !ok {
+				seen[key] = struct{}{}
+				keys = append(keys, key)
+			}
+		}
+	}
+	return keys
+}
@@ -0,0 +1,107 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// failingCache - общий кэш, недоступный для чтения и записи, как Redis без соединения
+type failingCache[K comparable, V any] struct {
+	*LRUCache[K, V]
+}
+
+var errUnavailable = errors.New("кэш недоступен")
+
+func (*failingCache[K, V]) Set(context.Context, K, V, ...time.Duration) error { return errUnavailable }
+func (*failingCache[K, V]) Get(context.Context, K, interface{}) error         { return errUnavailable }
+func (*failingCache[K, V]) SetBytes(context.Context, K, []byte, ...time.Duration) error {
+	return errUnavailable
+}
+func (*failingCache[K, V]) GetBytes(context.Context, K) ([]byte, error) { return nil, errUnavailable }
+
+// newTestTiered создает двухуровневый кэш, в котором L2 - кэш в памяти, доступный тесту напрямую
+func newTestTiered(localTTL time.Duration) (*TieredCache[string, testValue], *LRUCache[string, testValue], *LRUCache[string, testValue], *fakeClock) {
+	local, clock := newTestLRU(LRUConfig{DefaultTTL: time.Hour})
+	shared, _ := newTestLRU(LRUConfig{DefaultTTL: time.Hour})
+	return NewTieredCache[string, testValue](local, shared, localTTL), local, shared, clock
+}
+
+func TestTieredCacheReadThrough(t *testing.T) {
+	ctx := context.Background()
+	tiered, local, shared, _ := newTestTiered(time.Minute)
+
+	// Запись другой реплики видна только в L2
+	require.NoError(t, shared.Set(ctx, "status_1", testValue{ID: 1, Name: "Создан"}))
+
+	var value testValue
+	require.NoError(t, tiered.Get(ctx, "status_1", &value))
+	assert.Equal(t, "Создан", value.Name)
+
+	exists, err := local.Exists(ctx, "status_1")
+	require.NoError(t, err)
+	assert.True(t, exists, "промах L1 сохраняется в L1")
+
+	// Следующее чтение обслуживается L1, даже если запись пропала из L2
+	require.NoError(t, shared.Delete(ctx, "status_1"))
+	require.NoError(t, tiered.Get(ctx, "status_1", &value))
+
+	require.NoError(t, shared.SetSlice(ctx, "all", []testValue{{ID: 1}}))
+	var values []testValue
+	require.NoError(t, tiered.GetSlice(ctx, "all", &values))
+	require.NoError(t, shared.Delete(ctx, "all"))
+	require.NoError(t, tiered.GetSlice(ctx, "all", &values))
+	assert.Len(t, values, 1)
+}
+
+func TestTieredCacheWriteAndDelete(t *testing.T) {
+	ctx := context.Background()
+	tiered, local, shared, _ := newTestTiered(time.Minute)
+
+	require.NoError(t, tiered.Set(ctx, "packaging_1", testValue{ID: 1}))
+	for _, c := range []*LRUCache[string, testValue]{local, shared} {
+		exists, err := c.Exists(ctx, "packaging_1")
+		require.NoError(t, err)
+		assert.True(t, exists, "запись выполняется на обоих уровнях")
+	}
+
+	require.NoError(t, tiered.Delete(ctx, "packaging_1"))
+	var value testValue
+	assert.ErrorIs(t, tiered.Get(ctx, "packaging_1", &value), ErrNotFound)
+}
+
+func TestTieredCacheLocalTTL(t *testing.T) {
+	ctx := context.Background()
+	tiered, _, shared, clock := newTestTiered(time.Minute)
+
+	require.NoError(t, tiered.Set(ctx, "status_1", testValue{Name: "Создан"}, time.Hour))
+
+	// Другая реплика изменила запись в L2; L1 отдает прежнее значение не дольше localTTL
+	require.NoError(t, shared.Set(ctx, "status_1", testValue{Name: "Выдан"}))
+	var value testValue
+	require.NoError(t, tiered.Get(ctx, "status_1", &value))
+	assert.Equal(t, "Создан", value.Name)
+
+	clock.now = clock.now.Add(2 * time.Minute)
+	require.NoError(t, tiered.Get(ctx, "status_1", &value))
+	assert.Equal(t, "Выдан", value.Name)
+}
+
+func TestTieredCacheSharedUnavailable(t *testing.T) {
+	ctx := context.Background()
+	local, _ := newTestLRU(LRUConfig{DefaultTTL: time.Hour})
+	shared := &failingCache[string, testValue]{LRUCache: NewLRUCache[string, testValue](LRUConfig{})}
+	tiered := NewTieredCache[string, testValue](local, shared, time.Minute)
+
+	assert.ErrorIs(t, tiered.Set(ctx, "status_1", testValue{ID: 1}), errUnavailable, "ошибка L2 возвращается вызывающему")
+
+	var value testValue
+	require.NoError(t, tiered.Get(ctx, "status_1", &value), "значение остается в L1 и читается без L2")
+	assert.Equal(t, 1, value.ID)
+
+	assert.ErrorIs(t, tiered.Get(ctx, "status_2", &value), errUnavailable)
+}
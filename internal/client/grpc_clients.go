@@ -38,6 +38,86 @@ func (w *APIServiceClientWrapper) SetWorkerCount(ctx context.Context, req *v1.Se
 	return nil
 }
 
+// GetWorkerPoolStats проксирует запрос к GetWorkerPoolStats gRPC методу
+func (w *APIServiceClientWrapper) GetWorkerPoolStats(ctx context.Context) (*v1.GetWorkerPoolStatsResponse, error) {
+	resp, err := w.client.GetWorkerPoolStats(ctx, &emptypb.Empty{})
+	if err != nil {
+		log.Printf("Ошибка вызова GetWorkerPoolStats: %v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+// ListJobs проксирует запрос к ListJobs gRPC методу
+func (w *APIServiceClientWrapper) ListJobs(ctx context.Context) (*v1.ListJobsResponse, error) {
+	resp, err := w.client.ListJobs(ctx, &emptypb.Empty{})
+	if err != nil {
+		log.Printf("Ошибка вызова ListJobs: %v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+// TriggerJob проксирует запрос к TriggerJob gRPC методу
+func (w *APIServiceClientWrapper) TriggerJob(ctx context.Context, req *v1.TriggerJobRequest) (*v1.TriggerJobResponse, error) {
+	resp, err := w.client.TriggerJob(ctx, req)
+	if err != nil {
+		log.Printf("Ошибка вызова TriggerJob: %v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+// GetCacheStats проксирует запрос к GetCacheStats gRPC методу
+func (w *APIServiceClientWrapper) GetCacheStats(ctx context.Context) (*v1.GetCacheStatsResponse, error) {
+	resp, err := w.client.GetCacheStats(ctx, &emptypb.Empty{})
+	if err != nil {
+		log.Printf("Ошибка вызова GetCacheStats: %v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+// InspectCacheKey проксирует запрос к InspectCacheKey gRPC методу
+func (w *APIServiceClientWrapper) InspectCacheKey(ctx context.Context, req *v1.InspectCacheKeyRequest) (*v1.InspectCacheKeyResponse, error) {
+	resp, err := w.client.InspectCacheKey(ctx, req)
+	if err != nil {
+		log.Printf("Ошибка вызова InspectCacheKey: %v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+// InvalidateCache проксирует запрос к InvalidateCache gRPC методу
+func (w *APIServiceClientWrapper) InvalidateCache(ctx context.Context, req *v1.InvalidateCacheRequest) (*v1.InvalidateCacheResponse, error) {
+	resp, err := w.client.InvalidateCache(ctx, req)
+	if err != nil {
+		log.Printf("Ошибка вызова InvalidateCache: %v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+// FlushCache проксирует запрос к FlushCache gRPC методу
+func (w *APIServiceClientWrapper) FlushCache(ctx context.Context, req *v1.FlushCacheRequest) (*v1.FlushCacheResponse, error) {
+	resp, err := w.client.FlushCache(ctx, req)
+	if err != nil {
+		log.Printf("Ошибка вызова FlushCache: %v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+// WarmCache проксирует запрос к WarmCache gRPC методу
+func (w *APIServiceClientWrapper) WarmCache(ctx context.Context) error {
+	_, err := w.client.WarmCache(ctx, &emptypb.Empty{})
+	if err != nil {
+		log.Printf("Ошибка вызова WarmCache: %v", err)
+		return err
+	}
+	return nil
+}
+
 // SeedOrders проксирует запрос к SeedOrders gRPC методу
 func (w *APIServiceClientWrapper) SeedOrders(ctx context.Context, req *v1.SeedOrdersRequest) error {
 	_, err := w.client.SeedOrders(ctx, req)
@@ -79,6 +159,16 @@ func (w *APIServiceClientWrapper) GetOrdersByUserID(ctx context.Context, req *v1
 	return resp, nil
 }
 
+// ListOrders проксирует запрос к ListOrders gRPC методу
+func (w *APIServiceClientWrapper) ListOrders(ctx context.Context, req *v1.ListOrdersRequest) (*v1.ListOrdersResponse, error) {
+	resp, err := w.client.ListOrders(ctx, req)
+	if err != nil {
+		log.Printf("Ошибка вызова ListOrders: %v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
 // GetOrder проксирует запрос к GetOrder gRPC методу
 func (w *APIServiceClientWrapper) GetOrder(ctx context.Context, req *v1.GetOrderRequest) (*v1.GetOrderResponse, error) {
 	resp, err := w.client.GetOrder(ctx, req)
@@ -99,6 +189,16 @@ func (w *APIServiceClientWrapper) IssueOrder(ctx context.Context, req *v1.IssueO
 	return nil
 }
 
+// GetOrderHistory проксирует запрос к GetOrderHistory gRPC методу
+func (w *APIServiceClientWrapper) GetOrderHistory(ctx context.Context, req *v1.GetOrderHistoryRequest) (*v1.GetOrderHistoryResponse, error) {
+	resp, err := w.client.GetOrderHistory(ctx, req)
+	if err != nil {
+		log.Printf("Ошибка вызова GetOrderHistory: %v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
 // DeleteOrder проксирует запрос к DeleteOrder gRPC методу
 func (w *APIServiceClientWrapper) DeleteOrder(ctx context.Context, req *v1.DeleteOrderRequest) error {
 	_, err := w.client.DeleteOrder(ctx, req)
@@ -255,6 +355,16 @@ func (w *APIServiceClientWrapper) GetReturnsByUserID(ctx context.Context, req *v
 	return resp, nil
 }
 
+// ListReturns проксирует запрос к ListReturns gRPC методу
+func (w *APIServiceClientWrapper) ListReturns(ctx context.Context, req *v1.ListReturnsRequest) (*v1.ListReturnsResponse, error) {
+	resp, err := w.client.ListReturns(ctx, req)
+	if err != nil {
+		log.Printf("Ошибка вызова ListReturns: %v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
 // DeleteReturn проксирует запрос к DeleteReturn gRPC методу
 func (w *APIServiceClientWrapper) DeleteReturn(ctx context.Context, req *v1.DeleteReturnRequest) error {
 	_, err := w.client.DeleteReturn(ctx, req)
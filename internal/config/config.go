@@ -5,6 +5,7 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"time"
 )
 
 // Config представляет структуру для конфигурации сервиса
@@ -17,13 +18,68 @@ type Config struct {
 	DBName       string   // Имя базы данных
 	DBHost       string   // Хост базы данных
 	DBPort       string   // Порт базы данных
-	GrpcPort     string   // Порт gRPC
-	HttpPort     string   // Порт HTTP
-	RedisAddr    string   // Адрес Redis
-	RedisDB      int      // Номер базы данных Redis
-	MetricsAddr  string   // Адрес сервера метрик
-	TracingURL   string   // URL для экспорта трейсинга (Jaeger или другой провайдер)
-	ServiceName  string   // Название сервиса для трейсинга
+
+	DBTxMaxAttempts    int           // Максимальное количество попыток транзакции при конфликте сериализации или взаимоблокировке
+	DBTxRetryBaseDelay time.Duration // Задержка перед первым повтором транзакции
+	DBTxRetryMaxDelay  time.Duration // Максимальная задержка между повторами транзакции
+
+	GrpcPort    string // Порт gRPC
+	HttpPort    string // Порт HTTP
+	RedisAddr   string // Адрес Redis
+	RedisDB     int    // Номер базы данных Redis
+	MetricsAddr string // Адрес сервера метрик
+	TracingURL  string // URL для экспорта трейсинга (Jaeger или другой провайдер)
+	ServiceName string // Название сервиса для трейсинга
+
+	CacheBackend             string        // Реализация кэша: redis, memory или tiered
+	CacheDictionaryBackend   string        // Реализация кэша справочников: статусов, упаковки и причин возврата
+	CacheTTL                 time.Duration // Время жизни записей кэша по умолчанию
+	CacheLocalTTL            time.Duration // Максимальное время жизни записей в памяти процесса для tiered
+	CacheMaxEntries          int           // Максимальное количество записей кэша в памяти процесса
+	CacheMaxBytes            int           // Максимальный размер кэша в памяти процесса в байтах
+	CacheNegativeTTL         time.Duration // Время жизни отметки об отсутствии записи в кэше; 0 - отсутствие не кэшируется
+	CacheEarlyRefreshBeta    float64       // Коэффициент досрочного обновления записей кэша; 0 - обновление только после истечения TTL
+	CacheInvalidationBus     string        // Шина сброса кэша в памяти процесса между репликами: redis, postgres или none
+	CacheInvalidationChannel string        // Канал шины сброса кэша
+	CacheInvalidationRetry   time.Duration // Пауза перед повторной подпиской на шину postgres после разрыва соединения
+	CacheWarmTimeout         time.Duration // Ограничение времени прогрева кэша справочников при запуске; 0 - без прогрева
+
+	OutboxPollInterval time.Duration // Интервал опроса таблицы outbox
+	OutboxBatchSize    int           // Размер пачки событий outbox
+	OutboxMaxBackoff   time.Duration // Максимальная задержка повторной публикации события outbox
+
+	EventPublisher     string // Реализация публикатора событий: kafka, file или memory
+	EventPublisherFile string // JSONL-файл событий для публикатора file
+
+	KafkaPartitionKey       string        // Ключ партиционирования событий: order_id или user_id
+	KafkaDLQTopic           string        // Dead-letter топик для сообщений, которые не удалось обработать
+	NotifierMaxRetries      int           // Количество повторных попыток обработки сообщения
+	NotifierRetryBackoff    time.Duration // Задержка перед первой повторной попыткой
+	NotifierRetryMaxBackoff time.Duration // Максимальная задержка между повторными попытками
+	NotifierShutdownTimeout time.Duration // Время на дообработку сообщений при остановке
+	NotifierDedupRetention  time.Duration // Сколько хранить отметки об обработанных событиях
+
+	OrderExpiryNotice time.Duration // За сколько до истечения срока хранения уведомлять клиента
+
+	JobExpiredOrdersSchedule     string        // Расписание проверки просроченных заказов, например "@every 10m" или "*/10 * * * *"
+	ExpiredOrdersBatchSize       int           // Количество просроченных заказов, обрабатываемых одной транзакцией
+	JobExpiryRemindersSchedule   string        // Расписание уведомлений о скором истечении срока хранения
+	SchedulerLeaderLock          string        // Название блокировки выбора лидера; реплики с одинаковым названием выполняют задания по очереди
+	SchedulerLeaderCheckInterval time.Duration // Период попыток стать лидером и проверки соединения лидера
+
+	WorkerPoolSize         int           // Начальное количество воркеров
+	WorkerPoolQueueSize    int           // Размер очереди задач пула воркеров
+	WorkerPoolQueuePolicy  string        // Поведение при заполненной очереди: block, reject или caller_runs
+	WorkerPoolBlockTimeout time.Duration // Максимальное ожидание места в очереди для политики block
+	WorkerPoolRetryAfter   time.Duration // Рекомендуемая клиенту задержка повтора при перегрузке пула
+
+	NotifierRoutes         string        // Правила маршрутизации событий по каналам, например "ORDER_CREATED=email,webhook;RETURN_CREATED=email"
+	NotifierTemplatesDir   string        // Каталог с шаблонами уведомлений (пусто - встроенные шаблоны)
+	NotifierWebhookURL     string        // URL для webhook-канала (пусто - канал отключен)
+	NotifierWebhookTimeout time.Duration // Таймаут запроса webhook-канала
+	NotifierSMTPAddr       string        // Адрес SMTP-сервера (пусто - канал отключен)
+	NotifierSMTPFrom       string        // Адрес отправителя писем
+	NotifierSMTPRecipient  string        // Формат адреса получателя, %d заменяется на ID пользователя
 }
 
 // LoadConfig загружает конфигурацию из переменных окружения
@@ -31,11 +87,15 @@ func LoadConfig() *Config {
 	kafkaBrokers := getEnvAsSlice("KAFKA_BROKERS", []string{"localhost:9092"})
 	kafkaGroupID := getEnv("KAFKA_GROUP_ID", "notifier_group")
 	kafkaTopic := getEnv("KAFKA_TOPIC", "pvz.events-log")
+	kafkaPartitionKey := getEnv("KAFKA_PARTITION_KEY", "order_id")
 	dbUser := getEnv("DB_USER", "postgres")
 	dbPassword := getEnv("DB_PASSWORD", "postgres")
 	dbName := getEnv("DB_NAME", "postgres")
 	dbHost := getEnv("DB_HOST", "localhost")
 	dbPort := getEnv("DB_PORT", "5432")
+	dbTxMaxAttempts := getEnvAsInt("DB_TX_MAX_ATTEMPTS", 3)
+	dbTxRetryBaseDelay := getEnvAsDuration("DB_TX_RETRY_BASE_DELAY", 10*time.Millisecond)
+	dbTxRetryMaxDelay := getEnvAsDuration("DB_TX_RETRY_MAX_DELAY", 200*time.Millisecond)
 	grpcPort := getEnv("GRPC_PORT", "50051")
 	httpPort := getEnv("HTTP_PORT", "8080")
 	redisAddr := getEnv("REDIS_ADDR", "localhost:6379")
@@ -43,13 +103,63 @@ func LoadConfig() *Config {
 	metricsAddr := getEnv("METRICS_ADDR", ":8099")
 	tracingURL := getEnv("TRACING_URL", "http://localhost:14268/api/traces")
 	serviceName := getEnv("SERVICE_NAME", "my-go-service")
+	cacheBackend := getEnv("CACHE_BACKEND", "redis")
+	cacheDictionaryBackend := getEnv("CACHE_DICTIONARY_BACKEND", "tiered")
+	cacheTTL := getEnvAsDuration("CACHE_TTL", 10*time.Minute)
+	cacheLocalTTL := getEnvAsDuration("CACHE_LOCAL_TTL", 30*time.Second)
+	cacheMaxEntries := getEnvAsInt("CACHE_MAX_ENTRIES", 10000)
+	cacheMaxBytes := getEnvAsInt("CACHE_MAX_BYTES", 32<<20)
+	cacheNegativeTTL := getEnvAsDuration("CACHE_NEGATIVE_TTL", 30*time.Second)
+	cacheEarlyRefreshBeta := getEnvAsFloat("CACHE_EARLY_REFRESH_BETA", 1)
+	cacheInvalidationBus := getEnv("CACHE_INVALIDATION_BUS", "redis")
+	cacheInvalidationChannel := getEnv("CACHE_INVALIDATION_CHANNEL", "cache_invalidation")
+	cacheInvalidationRetry := getEnvAsDuration("CACHE_INVALIDATION_RETRY", time.Second)
+	cacheWarmTimeout := getEnvAsDuration("CACHE_WARM_TIMEOUT", 10*time.Second)
+	outboxPollInterval := getEnvAsDuration("OUTBOX_POLL_INTERVAL", time.Second)
+	outboxBatchSize := getEnvAsInt("OUTBOX_BATCH_SIZE", 100)
+	outboxMaxBackoff := getEnvAsDuration("OUTBOX_MAX_BACKOFF", time.Minute)
+	eventPublisher := getEnv("EVENT_PUBLISHER", "kafka")
+	eventPublisherFile := getEnv("EVENT_PUBLISHER_FILE", "events.jsonl")
+	kafkaDLQTopic := getEnv("KAFKA_DLQ_TOPIC", kafkaTopic+".dlq")
+	notifierMaxRetries := getEnvAsInt("NOTIFIER_MAX_RETRIES", 3)
+	notifierRetryBackoff := getEnvAsDuration("NOTIFIER_RETRY_BACKOFF", time.Second)
+	notifierRetryMaxBackoff := getEnvAsDuration("NOTIFIER_RETRY_MAX_BACKOFF", 30*time.Second)
+	notifierShutdownTimeout := getEnvAsDuration("NOTIFIER_SHUTDOWN_TIMEOUT", 30*time.Second)
+	notifierDedupRetention := getEnvAsDuration("NOTIFIER_DEDUP_RETENTION", 7*24*time.Hour)
+	orderExpiryNotice := getEnvAsDuration("ORDER_EXPIRY_NOTICE", 24*time.Hour)
+	jobExpiredOrdersSchedule := getEnv("JOB_EXPIRED_ORDERS_SCHEDULE", "@every 10m")
+	expiredOrdersBatchSize := getEnvAsInt("EXPIRED_ORDERS_BATCH_SIZE", 100)
+	jobExpiryRemindersSchedule := getEnv("JOB_EXPIRY_REMINDERS_SCHEDULE", "@every 10m")
+	schedulerLeaderLock := getEnv("SCHEDULER_LEADER_LOCK", "pvz-scheduler")
+	schedulerLeaderCheckInterval := getEnvAsDuration("SCHEDULER_LEADER_CHECK_INTERVAL", 5*time.Second)
+	workerPoolSize := getEnvAsInt("WORKER_POOL_SIZE", 2)
+	workerPoolQueueSize := getEnvAsInt("WORKER_POOL_QUEUE_SIZE", 100)
+	workerPoolQueuePolicy := getEnv("WORKER_POOL_QUEUE_POLICY", "block")
+	workerPoolBlockTimeout := getEnvAsDuration("WORKER_POOL_BLOCK_TIMEOUT", 2*time.Second)
+	workerPoolRetryAfter := getEnvAsDuration("WORKER_POOL_RETRY_AFTER", time.Second)
+	notifierRoutes := getEnv("NOTIFIER_ROUTES", "ORDER_CREATED=email;ORDER_STORAGE_EXPIRING=email;RETURN_CREATED=email")
+	notifierTemplatesDir := getEnv("NOTIFIER_TEMPLATES_DIR", "")
+	notifierWebhookURL := getEnv("NOTIFIER_WEBHOOK_URL", "")
+	notifierWebhookTimeout := getEnvAsDuration("NOTIFIER_WEBHOOK_TIMEOUT", 5*time.Second)
+	notifierSMTPAddr := getEnv("NOTIFIER_SMTP_ADDR", "localhost:1025")
+	notifierSMTPFrom := getEnv("NOTIFIER_SMTP_FROM", "pvz@example.com")
+	notifierSMTPRecipient := getEnv("NOTIFIER_SMTP_RECIPIENT", "user%d@example.com")
 
-	log.Printf("Конфигурация загружена: brokers=%v, groupID=%s, topic=%s", kafkaBrokers, kafkaGroupID, kafkaTopic)
+	log.Printf("Конфигурация загружена: brokers=%v, groupID=%s, topic=%s, partition_key=%s", kafkaBrokers, kafkaGroupID, kafkaTopic, kafkaPartitionKey)
 	log.Printf("Настройки базы данных: user=%s, dbname=%s, host=%s, port=%s", dbUser, dbName, dbHost, dbPort)
+	log.Printf("Повтор транзакций: attempts=%d, base_delay=%s, max_delay=%s", dbTxMaxAttempts, dbTxRetryBaseDelay, dbTxRetryMaxDelay)
 	log.Printf("gRPC порт: %s, HTTP порт: %s", grpcPort, httpPort)
 	log.Printf("Redis: addr=%s, db=%d", redisAddr, redisDB)
+	log.Printf("Cache: ttl=%s, local_ttl=%s, max_entries=%d, max_bytes=%d", cacheTTL, cacheLocalTTL, cacheMaxEntries, cacheMaxBytes)
+	log.Printf("Cache loading: negative_ttl=%s, early_refresh_beta=%g", cacheNegativeTTL, cacheEarlyRefreshBeta)
+	log.Printf("Cache invalidation: bus=%s, channel=%s, retry=%s", cacheInvalidationBus, cacheInvalidationChannel, cacheInvalidationRetry)
+	log.Printf("Cache warm: timeout=%s", cacheWarmTimeout)
 	log.Printf("Metrics: addr=%s", metricsAddr)
 	log.Printf("Tracing: url=%s, service=%s", tracingURL, serviceName)
+	log.Printf("Outbox: poll=%s, batch=%d, max_backoff=%s", outboxPollInterval, outboxBatchSize, outboxMaxBackoff)
+	log.Printf("Публикатор событий: backend=%s, file=%s", eventPublisher, eventPublisherFile)
+	log.Printf("Notifier: dlq=%s, retries=%d, backoff=%s, max_backoff=%s", kafkaDLQTopic, notifierMaxRetries, notifierRetryBackoff, notifierRetryMaxBackoff)
+	log.Printf("Notifier channels: routes=%s, webhook=%s, smtp=%s", notifierRoutes, notifierWebhookURL, notifierSMTPAddr)
 
 	return &Config{
 		KafkaBrokers: kafkaBrokers,
@@ -60,13 +170,68 @@ func LoadConfig() *Config {
 		DBName:       dbName,
 		DBHost:       dbHost,
 		DBPort:       dbPort,
-		GrpcPort:     grpcPort,
-		HttpPort:     httpPort,
-		RedisAddr:    redisAddr,
-		RedisDB:      redisDB,
-		MetricsAddr:  metricsAddr,
-		TracingURL:   tracingURL,
-		ServiceName:  serviceName,
+
+		DBTxMaxAttempts:    dbTxMaxAttempts,
+		DBTxRetryBaseDelay: dbTxRetryBaseDelay,
+		DBTxRetryMaxDelay:  dbTxRetryMaxDelay,
+
+		GrpcPort:    grpcPort,
+		HttpPort:    httpPort,
+		RedisAddr:   redisAddr,
+		RedisDB:     redisDB,
+		MetricsAddr: metricsAddr,
+		TracingURL:  tracingURL,
+		ServiceName: serviceName,
+
+		CacheBackend:             cacheBackend,
+		CacheDictionaryBackend:   cacheDictionaryBackend,
+		CacheTTL:                 cacheTTL,
+		CacheLocalTTL:            cacheLocalTTL,
+		CacheMaxEntries:          cacheMaxEntries,
+		CacheMaxBytes:            cacheMaxBytes,
+		CacheNegativeTTL:         cacheNegativeTTL,
+		CacheEarlyRefreshBeta:    cacheEarlyRefreshBeta,
+		CacheInvalidationBus:     cacheInvalidationBus,
+		CacheInvalidationChannel: cacheInvalidationChannel,
+		CacheInvalidationRetry:   cacheInvalidationRetry,
+		CacheWarmTimeout:         cacheWarmTimeout,
+
+		OutboxPollInterval: outboxPollInterval,
+		OutboxBatchSize:    outboxBatchSize,
+		OutboxMaxBackoff:   outboxMaxBackoff,
+
+		EventPublisher:     eventPublisher,
+		EventPublisherFile: eventPublisherFile,
+
+		KafkaPartitionKey:       kafkaPartitionKey,
+		KafkaDLQTopic:           kafkaDLQTopic,
+		NotifierMaxRetries:      notifierMaxRetries,
+		NotifierRetryBackoff:    notifierRetryBackoff,
+		NotifierRetryMaxBackoff: notifierRetryMaxBackoff,
+		NotifierShutdownTimeout: notifierShutdownTimeout,
+		NotifierDedupRetention:  notifierDedupRetention,
+
+		OrderExpiryNotice: orderExpiryNotice,
+
+		JobExpiredOrdersSchedule:     jobExpiredOrdersSchedule,
+		ExpiredOrdersBatchSize:       expiredOrdersBatchSize,
+		JobExpiryRemindersSchedule:   jobExpiryRemindersSchedule,
+		SchedulerLeaderLock:          schedulerLeaderLock,
+		SchedulerLeaderCheckInterval: schedulerLeaderCheckInterval,
+
+		WorkerPoolSize:         workerPoolSize,
+		WorkerPoolQueueSize:    workerPoolQueueSize,
+		WorkerPoolQueuePolicy:  workerPoolQueuePolicy,
+		WorkerPoolBlockTimeout: workerPoolBlockTimeout,
+		WorkerPoolRetryAfter:   workerPoolRetryAfter,
+
+		NotifierRoutes:         notifierRoutes,
+		NotifierTemplatesDir:   notifierTemplatesDir,
+		NotifierWebhookURL:     notifierWebhookURL,
+		NotifierWebhookTimeout: notifierWebhookTimeout,
+		NotifierSMTPAddr:       notifierSMTPAddr,
+		NotifierSMTPFrom:       notifierSMTPFrom,
+		NotifierSMTPRecipient:  notifierSMTPRecipient,
 	}
 }
 
@@ -90,6 +255,30 @@ func getEnvAsInt(key string, fallback int) int {
 	return fallback
 }
 
+// getEnvAsFloat возвращает значение переменной окружения как число с плавающей точкой или значение по умолчанию
+func getEnvAsFloat(key string, fallback float64) float64 {
+	if value, exists := os.LookupEnv(key); exists {
+		if floatValue, err := strconv.ParseFloat(value, 64); err == nil {
+			return floatValue
+		} else {
+			log.Printf("Ошибка при преобразовании переменной окружения %s: %v", key, err)
+		}
+	}
+	return fallback
+}
+
+// getEnvAsDuration возвращает значение переменной окружения как длительность (например, "500ms") или значение по умолчанию
+func getEnvAsDuration(key string, fallback time.Duration) time.Duration {
+	if value, exists := os.LookupEnv(key); exists {
+		if duration, err := time.ParseDuration(value); err == nil {
+			return duration
+		} else {
+			log.Printf("Ошибка при преобразовании переменной окружения %s: %v", key, err)
+		}
+	}
+	return fallback
+}
+
 // getEnvAsSlice возвращает значение переменной окружения как срез строк или значение по умолчанию
 func getEnvAsSlice(key string, fallback []string) []string {
 	if value, exists := os.LookupEnv(key); exists {
@@ -53,9 +53,14 @@ func CreateOrder(ctx context.Context, orderService *service.OrderService, userSe
 
 	totalCost, packagingCost := calculateTotalCost(baseCost, packaging.Cost, withFilm)
 
-	orderID, err := orderService.CreateOrder(ctx, userID, packagingID, 1, expirationDate, weight, baseCost, packagingCost, totalCost, withFilm)
+	acceptedStatusID, err := orderService.StatusIDByState(ctx, model.OrderStateAccepted)
 	if err != nil {
-		return 0, fmt.Errorf("ошибка при создании заказа: %v", err)
+		return 0, err
+	}
+
+	orderID, err := orderService.CreateOrder(ctx, userID, packagingID, acceptedStatusID, expirationDate, weight, baseCost, packagingCost, totalCost, withFilm)
+	if err != nil {
+		return 0, fmt.Errorf("ошибка при создании заказа: %w", err)
 	}
 
 	return orderID, nil
@@ -92,12 +97,11 @@ func DeleteOrder(ctx context.Context, orderService *service.OrderService, orderI
 		return fmt.Errorf("неверный формат идентификатора заказа")
 	}
 
-	orderService.DeleteOrder(ctx, orderID)
-	return nil
+	return orderService.DeleteOrder(ctx, orderID)
 }
 
 // IssueOrder помечает заказ как выданный через сервис с использованием worker pool
-func IssueOrder(ctx context.Context, orderService *service.OrderService, statusService *service.StatusService, orderIDStr string) error {
+func IssueOrder(ctx context.Context, orderService *service.OrderService, orderIDStr string) error {
 	orderID, err := parseOrderID(orderIDStr)
 	if err != nil {
 		return err
@@ -116,13 +120,10 @@ func IssueOrder(ctx context.Context, orderService *service.OrderService, statusS
 		return err
 	}
 
-	order.IssueDate = time.Now()
-	if err := updateOrderStatus(ctx, order, "Выдан", statusService); err != nil {
+	if err := orderService.IssueOrder(ctx, orderID); err != nil {
 		return err
 	}
 
-	orderService.UpdateOrder(ctx, *order)
-
 	metrics.IncrementIssuedOrders("issued")
 
 	return nil
@@ -164,14 +165,14 @@ func checkExpiration(order *model.Order) error {
 	return nil
 }
 
-// updateOrderStatus обновляет статус заказа
-func updateOrderStatus(ctx context.Context, order *model.Order, statusName string, statusService *service.StatusService) error {
-	issuedStatusID, err := statusService.GetStatusByName(ctx, statusName)
+// GetOrderHistory возвращает историю смены статусов заказа
+func GetOrderHistory(ctx context.Context, orderService *service.OrderService, orderIDStr string) ([]model.OrderStatusChange, error) {
+	orderID, err := parseOrderID(orderIDStr)
 	if err != nil {
-		return fmt.Errorf("ошибка получения ID статуса '%s': %w", statusName, err)
+		return nil, err
 	}
-	order.StatusID = issuedStatusID
-	return nil
+
+	return orderService.GetOrderHistory(ctx, orderID)
 }
 
 // GetOrdersByUserID возвращает все заказы по UserID
@@ -179,6 +180,11 @@ func GetOrdersByUserID(ctx context.Context, orderService *service.OrderService,
 	return orderService.GetOrdersByUserID(ctx, userID)
 }
 
+// ListOrders возвращает страницу заказов, подходящих под фильтр
+func ListOrders(ctx context.Context, orderService *service.OrderService, filter model.OrderFilter, pageSize int, pageToken string) (model.OrderPage, error) {
+	return orderService.ListOrders(ctx, filter, pageSize, pageToken)
+}
+
 // parseUserID парсит строку в идентификатор пользователя
 func parseUserID(ctx context.Context, userIDStr string, userService *service.UserService) (int, error) {
 	userID, err := strconv.Atoi(userIDStr)
@@ -288,6 +294,5 @@ func calculateTotalCost(baseCost, packagingCost float64, withFilm bool) (float64
 
 // UpdateOrder обновляет заказ через сервис
 func UpdateOrder(ctx context.Context, orderService *service.OrderService, order model.Order) error {
-	orderService.UpdateOrder(ctx, order)
-	return nil
+	return orderService.UpdateOrder(ctx, order)
 }
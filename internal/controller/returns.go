@@ -70,28 +70,15 @@ func GetReturnsByUserID(ctx context.Context, returnService *service.ReturnServic
 	return returnService.GetReturnsByUserID(ctx, userID)
 }
 
-// ProcessReturn обрабатывает возврат для заказа
-func ProcessReturn(ctx context.Context, returnService *service.ReturnService, statusService *service.StatusService, orderID int) error {
-	returnRecord, err := returnService.GetReturnByOrderID(ctx, orderID)
-	if err != nil {
-		return fmt.Errorf("ошибка поиска возврата для заказа с ID %d: %w", orderID, err)
-	}
-
-	if returnRecord == nil {
-		return fmt.Errorf("возврат для заказа с ID %d не найден", orderID)
-	}
-
-	statusID, err := statusService.GetStatusByName(ctx, "Передан курьеру")
-	if err != nil {
-		return fmt.Errorf("ошибка получения статуса 'Передан курьеру': %w", err)
-	}
-
-	returnRecord.StatusID = statusID
+// ListReturns возвращает страницу возвратов, подходящих под фильтр
+func ListReturns(ctx context.Context, returnService *service.ReturnService, filter model.ReturnFilter, pageSize int, pageToken string) (model.ReturnPage, error) {
+	return returnService.ListReturns(ctx, filter, pageSize, pageToken)
+}
 
-	if err := returnService.UpdateReturn(ctx, returnRecord.ReturnID, returnRecord.OrderID, returnRecord.UserID,
-		returnRecord.ReasonID, returnRecord.BaseCost, returnRecord.PackagingCost,
-		returnRecord.TotalCost, returnRecord.PackagingID, returnRecord.StatusID); err != nil {
-		return fmt.Errorf("ошибка обновления статуса возврата с ID %d: %w", returnRecord.ReturnID, err)
+// ProcessReturn обрабатывает возврат для заказа
+func ProcessReturn(ctx context.Context, returnService *service.ReturnService, orderID int) error {
+	if err := returnService.ProcessReturn(ctx, orderID); err != nil {
+		return fmt.Errorf("ошибка обработки возврата для заказа с ID %d: %w", orderID, err)
 	}
 
 	return nil
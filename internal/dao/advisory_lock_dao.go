@@ -0,0 +1,66 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+// AdvisoryLock - сессионная advisory-блокировка PostgreSQL, удерживаемая на выделенном соединении пула.
+// Блокировка действует, пока открыта сессия: при падении процесса или обрыве соединения
+// PostgreSQL снимает ее сам, и ее может захватить другой процесс.
+type AdvisoryLock struct {
+	conn *pgxpool.Conn
+	key  int64
+}
+
+// AdvisoryLockKey возвращает ключ advisory-блокировки для названия
+func AdvisoryLockKey(name string) int64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(name))
+	return int64(h.Sum64())
+}
+
+// TryAdvisoryLock пытается захватить advisory-блокировку без ожидания.
+// Возвращает nil без ошибки, если блокировку удерживает другая сессия.
+func TryAdvisoryLock(ctx context.Context, key int64, pool *pgxpool.Pool) (*AdvisoryLock, error) {
+	conn, err := pool.Acquire(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка получения соединения для advisory-блокировки: %w", err)
+	}
+
+	var locked bool
+	if err := conn.QueryRow(ctx, `SELECT pg_try_advisory_lock($1)`, key).Scan(&locked); err != nil {
+		conn.Release()
+		return nil, fmt.Errorf("ошибка захвата advisory-блокировки %d: %w", key, err)
+	}
+	if !locked {
+		conn.Release()
+		return nil, nil
+	}
+
+	return &AdvisoryLock{conn: conn, key: key}, nil
+}
+
+// Check проверяет, что сессия, удерживающая блокировку, жива.
+// Блокировка снимается только вместе с сессией или через Release, поэтому живая сессия означает, что блокировка удерживается.
+func (l *AdvisoryLock) Check(ctx context.Context) error {
+	if err := l.conn.Ping(ctx); err != nil {
+		return fmt.Errorf("потеряно соединение с advisory-блокировкой %d: %w", l.key, err)
+	}
+	return nil
+}
+
+// Release снимает блокировку и возвращает соединение в пул.
+// Если снять блокировку не удалось, соединение закрывается, чтобы PostgreSQL снял ее вместе с сессией.
+func (l *AdvisoryLock) Release(ctx context.Context) error {
+	defer l.conn.Release()
+
+	if _, err := l.conn.Exec(ctx, `SELECT pg_advisory_unlock($1)`, l.key); err != nil {
+		_ = l.conn.Conn().Close(ctx)
+		return fmt.Errorf("ошибка снятия advisory-блокировки %d: %w", l.key, err)
+	}
+	return nil
+}
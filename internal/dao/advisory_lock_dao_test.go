@@ -0,0 +1,34 @@
+package dao_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"homework1/internal/dao"
+)
+
+// Тест захвата advisory-блокировки двумя сессиями, как двумя репликами приложения
+func TestAdvisoryLock(t *testing.T) {
+	ctx := context.Background()
+	key := dao.AdvisoryLockKey(fmt.Sprintf("advisory-lock-test-%d", time.Now().UnixNano()))
+
+	first, err := dao.TryAdvisoryLock(ctx, key, testDB)
+	require.NoError(t, err, "ошибка при захвате блокировки")
+	require.NotNil(t, first, "свободная блокировка должна быть захвачена")
+	assert.NoError(t, first.Check(ctx))
+
+	second, err := dao.TryAdvisoryLock(ctx, key, testDB)
+	assert.NoError(t, err, "ошибка при захвате блокировки")
+	assert.Nil(t, second, "блокировка другой сессии не должна быть захвачена")
+
+	assert.NoError(t, first.Release(ctx))
+
+	second, err = dao.TryAdvisoryLock(ctx, key, testDB)
+	require.NoError(t, err, "ошибка при захвате блокировки")
+	require.NotNil(t, second, "снятая блокировка должна быть захвачена другой сессией")
+	assert.NoError(t, second.Release(ctx))
+}
@@ -0,0 +1,74 @@
+package dao
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"homework1/internal/model"
+)
+
+// conditions собирает условия WHERE с позиционными параметрами запроса
+type conditions struct {
+	clauses []string
+	args    []interface{}
+}
+
+// add добавляет условие; %s в clause заменяется на номер очередного параметра
+func (c *conditions) add(clause string, arg interface{}) {
+	c.args = append(c.args, arg)
+	c.clauses = append(c.clauses, fmt.Sprintf(clause, fmt.Sprintf("$%d", len(c.args))))
+}
+
+// addID добавляет условие равенства идентификатора, если он задан
+func (c *conditions) addID(column string, id int) {
+	if id != 0 {
+		c.add(column+" = %s", id)
+	}
+}
+
+// addRange добавляет условия полуоткрытого диапазона [from, before) по заданным границам
+func (c *conditions) addRange(column string, from, before time.Time) {
+	if !from.IsZero() {
+		c.add(column+" >= %s", from)
+	}
+	if !before.IsZero() {
+		c.add(column+" < %s", before)
+	}
+}
+
+// where возвращает текст условия WHERE
+func (c *conditions) where() string {
+	return strings.Join(c.clauses, " AND ")
+}
+
+// orderConditions строит условия отбора заказов после курсора afterID
+func orderConditions(filter model.OrderFilter, afterID int) *conditions {
+	c := &conditions{}
+	c.add("order_id > %s", afterID)
+	c.addID("user_id", filter.UserID)
+	c.addID("status_id", filter.StatusID)
+	c.addID("packaging_id", filter.PackagingID)
+	c.addRange("acceptance_date", filter.AcceptedFrom, filter.AcceptedBefore)
+	c.addRange("expiration_date", filter.ExpiresFrom, filter.ExpiresBefore)
+	if filter.Issued != nil {
+		if *filter.Issued {
+			c.clauses = append(c.clauses, "issue_date IS NOT NULL")
+		} else {
+			c.clauses = append(c.clauses, "issue_date IS NULL")
+		}
+	}
+	return c
+}
+
+// returnConditions строит условия отбора возвратов после курсора afterID
+func returnConditions(filter model.ReturnFilter, afterID int) *conditions {
+	c := &conditions{}
+	c.add("return_id > %s", afterID)
+	c.addID("user_id", filter.UserID)
+	c.addID("status_id", filter.StatusID)
+	c.addID("packaging_id", filter.PackagingID)
+	c.addID("reason_id", filter.ReasonID)
+	c.addRange("return_date", filter.ReturnedFrom, filter.ReturnedBefore)
+	return c
+}
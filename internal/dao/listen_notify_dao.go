@@ -0,0 +1,61 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+// Listener - подписка на уведомления канала PostgreSQL (LISTEN), удерживаемая на выделенном соединении пула.
+// Уведомления, отправленные, пока соединение разорвано, не доставляются.
+type Listener struct {
+	conn    *pgxpool.Conn
+	channel string
+}
+
+// Listen подписывается на уведомления канала channel
+func Listen(ctx context.Context, channel string, pool *pgxpool.Pool) (*Listener, error) {
+	conn, err := pool.Acquire(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка получения соединения для подписки на канал %s: %w", channel, err)
+	}
+
+	if _, err := conn.Exec(ctx, "LISTEN "+pgx.Identifier{channel}.Sanitize()); err != nil {
+		conn.Release()
+		return nil, fmt.Errorf("ошибка подписки на канал %s: %w", channel, err)
+	}
+
+	return &Listener{conn: conn, channel: channel}, nil
+}
+
+// Wait ожидает следующее уведомление канала и возвращает его содержимое
+func (l *Listener) Wait(ctx context.Context) (string, error) {
+	notification, err := l.conn.Conn().WaitForNotification(ctx)
+	if err != nil {
+		return "", fmt.Errorf("ошибка ожидания уведомления канала %s: %w", l.channel, err)
+	}
+	return notification.Payload, nil
+}
+
+// Close отменяет подписку и возвращает соединение в пул.
+// Если отменить подписку не удалось, соединение закрывается, чтобы в пул не вернулась сессия с подпиской.
+func (l *Listener) Close(ctx context.Context) error {
+	defer l.conn.Release()
+
+	if _, err := l.conn.Exec(ctx, "UNLISTEN "+pgx.Identifier{l.channel}.Sanitize()); err != nil {
+		_ = l.conn.Conn().Close(ctx)
+		return fmt.Errorf("ошибка отмены подписки на канал %s: %w", l.channel, err)
+	}
+	return nil
+}
+
+// Notify отправляет уведомление payload подписчикам канала channel.
+// Размер уведомления ограничен PostgreSQL: не больше 8000 байт.
+func Notify(ctx context.Context, channel, payload string, pool *pgxpool.Pool) error {
+	if _, err := pool.Exec(ctx, `SELECT pg_notify($1, $2)`, channel, payload); err != nil {
+		return fmt.Errorf("ошибка отправки уведомления в канал %s: %w", channel, err)
+	}
+	return nil
+}
@@ -0,0 +1,43 @@
+package dao_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"homework1/internal/dao"
+)
+
+// Тест доставки уведомлений подписчику канала, как другой реплике приложения
+func TestListenNotify(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	channel := fmt.Sprintf("listen_notify_test_%d", time.Now().UnixNano())
+
+	listener, err := dao.Listen(ctx, channel, testDB)
+	require.NoError(t, err, "ошибка при подписке на канал")
+
+	require.NoError(t, dao.Notify(ctx, channel, "первое", testDB))
+	require.NoError(t, dao.Notify(ctx, channel, "второе", testDB))
+
+	payload, err := listener.Wait(ctx)
+	require.NoError(t, err, "ошибка при ожидании уведомления")
+	assert.Equal(t, "первое", payload)
+
+	payload, err = listener.Wait(ctx)
+	require.NoError(t, err, "ошибка при ожидании уведомления")
+	assert.Equal(t, "второе", payload)
+
+	assert.NoError(t, listener.Close(ctx))
+
+	waitCtx, waitCancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer waitCancel()
+	listener, err = dao.Listen(ctx, channel, testDB)
+	require.NoError(t, err)
+	_, err = listener.Wait(waitCtx)
+	assert.Error(t, err, "уведомления, отправленные до подписки, не доставляются")
+	_ = listener.Close(context.Background())
+}
@@ -0,0 +1,66 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+	"homework1/internal/model"
+)
+
+// CreateNotificationDelivery записывает попытку доставки уведомления с уровнем изоляции Read Committed
+func CreateNotificationDelivery(ctx context.Context, delivery model.NotificationDelivery, pool *pgxpool.Pool) error {
+	return withRetryErr(ctx, func() error {
+		tm := NewTransactionManager(pool)
+
+		tx, conn, err := tm.BeginTransaction(ctx, pgx.ReadCommitted)
+		if err != nil {
+			return err
+		}
+		defer conn.Release()
+
+		_, err = tx.Exec(ctx,
+			`INSERT INTO notification_deliveries (event_id, event_type, channel, recipient, status, error, duration_ms, attempted_at)
+			 VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`,
+			delivery.EventID, delivery.EventType, delivery.Channel, delivery.Recipient, delivery.Status, delivery.Error,
+			delivery.Duration.Milliseconds(), delivery.AttemptedAt)
+		if err != nil {
+			_ = tm.RollbackTransaction(ctx, tx, conn)
+			return fmt.Errorf("ошибка записи попытки доставки события %s: %w", delivery.EventID, err)
+		}
+
+		if err = tm.CommitTransaction(ctx, tx, conn); err != nil {
+			return fmt.Errorf("ошибка подтверждения транзакции: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// IsNotificationDelivered проверяет, было ли уведомление о событии успешно доставлено в канал, с уровнем изоляции Read Committed
+func IsNotificationDelivered(ctx context.Context, eventID, channel string, pool *pgxpool.Pool) (bool, error) {
+	return withRetry(ctx, func() (bool, error) {
+		tm := NewTransactionManager(pool)
+
+		tx, conn, err := tm.BeginTransaction(ctx, pgx.ReadCommitted)
+		if err != nil {
+			return false, err
+		}
+		defer conn.Release()
+
+		var delivered bool
+		err = tx.QueryRow(ctx,
+			`SELECT EXISTS(SELECT 1 FROM notification_deliveries WHERE event_id = $1 AND channel = $2 AND status = $3)`,
+			eventID, channel, model.DeliveryStatusSent).Scan(&delivered)
+		if err != nil {
+			_ = tm.RollbackTransaction(ctx, tx, conn)
+			return false, fmt.Errorf("ошибка проверки доставки события %s: %w", eventID, err)
+		}
+
+		if err = tm.CommitTransaction(ctx, tx, conn); err != nil {
+			return false, fmt.Errorf("ошибка подтверждения транзакции: %w", err)
+		}
+
+		return delivered, nil
+	})
+}
@@ -10,264 +10,551 @@ import (
 	"time"
 )
 
-// CreateOrder создает новый заказ с уровнем изоляции Read Committed
-func CreateOrder(ctx context.Context, order model.Order, pool *pgxpool.Pool) (int, error) {
-	tm := NewTransactionManager(pool)
+// CreateOrder создает новый заказ с уровнем изоляции Read Committed.
+// Хуки выполняются в той же транзакции с ID созданного заказа.
+func CreateOrder(ctx context.Context, order model.Order, pool *pgxpool.Pool, hooks ...TxHook) (int, error) {
+	return withRetry(ctx, func() (int, error) {
+		tm := NewTransactionManager(pool)
+
+		// Начало транзакции
+		tx, conn, err := tm.BeginTransaction(ctx, pgx.ReadCommitted)
+		if err != nil {
+			return 0, err
+		}
 
-	// Начало транзакции
-	tx, conn, err := tm.BeginTransaction(ctx, pgx.ReadCommitted)
-	if err != nil {
-		return 0, err
-	}
+		defer func() {
+			if err != nil {
+				if rollbackErr := tm.RollbackTransaction(ctx, tx, conn); rollbackErr != nil {
+					log.Printf("Ошибка отката транзакции: %v", rollbackErr)
+				}
+			}
+		}()
+
+		// Выполнение SQL-запроса на вставку нового заказа
+		query := `INSERT INTO orders (user_id, acceptance_date, expiration_date, weight, base_cost, packaging_cost, total_cost, packaging_id, status_id, issue_date, with_film) 
+				  VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11) RETURNING order_id;`
 
-	defer func() {
+		var orderID int
+		err = tx.QueryRow(ctx, query,
+			order.UserID, order.AcceptanceDate, order.ExpirationDate, order.Weight,
+			order.BaseCost, order.PackagingCost, order.TotalCost, order.PackagingID,
+			order.StatusID, nullTime(order.IssueDate), order.WithFilm).Scan(&orderID)
 		if err != nil {
-			if rollbackErr := tm.RollbackTransaction(ctx, tx, conn); rollbackErr != nil {
-				log.Printf("Ошибка отката транзакции: %v", rollbackErr)
-			}
+			return 0, fmt.Errorf("ошибка создания заказа: %w", err)
 		}
-	}()
-
-	// Выполнение SQL-запроса на вставку нового заказа
-	query := `INSERT INTO orders (user_id, acceptance_date, expiration_date, weight, base_cost, packaging_cost, total_cost, packaging_id, status_id, issue_date, with_film) 
-			  VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11) RETURNING order_id;`
 
-	var orderID int
-	err = tx.QueryRow(ctx, query,
-		order.UserID, order.AcceptanceDate, order.ExpirationDate, order.Weight,
-		order.BaseCost, order.PackagingCost, order.TotalCost, order.PackagingID,
-		order.StatusID, order.IssueDate, order.WithFilm).Scan(&orderID)
-	if err != nil {
-		return 0, fmt.Errorf("ошибка создания заказа: %w", err)
-	}
+		if err = runTxHooks(ctx, tx, orderID, hooks); err != nil {
+			return 0, err
+		}
 
-	// Подтверждение транзакции
-	if err := tm.CommitTransaction(ctx, tx, conn); err != nil {
-		return 0, fmt.Errorf("ошибка подтверждения транзакции: %w", err)
-	}
+		// Подтверждение транзакции
+		if err := tm.CommitTransaction(ctx, tx, conn); err != nil {
+			return 0, fmt.Errorf("ошибка подтверждения транзакции: %w", err)
+		}
 
-	return orderID, nil
+		return orderID, nil
+	})
 }
 
 // GetOrderByID возвращает заказ по его ID с уровнем изоляции Repeatable Read
 func GetOrderByID(ctx context.Context, orderID int, pool *pgxpool.Pool) (*model.Order, error) {
-	tm := NewTransactionManager(pool)
+	return withRetry(ctx, func() (*model.Order, error) {
+		tm := NewTransactionManager(pool)
 
-	tx, conn, err := tm.BeginTransaction(ctx, pgx.RepeatableRead)
-	if err != nil {
-		return nil, err
-	}
+		tx, conn, err := tm.BeginTransaction(ctx, pgx.RepeatableRead)
+		if err != nil {
+			return nil, err
+		}
 
-	var order model.Order
-	err = tx.QueryRow(ctx,
-		`SELECT order_id, user_id, acceptance_date, expiration_date, weight, base_cost, packaging_cost, total_cost, packaging_id, status_id, issue_date, with_film 
-		 FROM orders WHERE order_id = $1`, orderID).
-		Scan(&order.OrderID, &order.UserID, &order.AcceptanceDate, &order.ExpirationDate, &order.Weight, &order.BaseCost, &order.PackagingCost, &order.TotalCost, &order.PackagingID, &order.StatusID, &order.IssueDate, &order.WithFilm)
-	if err != nil {
-		if rollbackErr := tm.RollbackTransaction(ctx, tx, conn); rollbackErr != nil {
-			log.Printf("Ошибка отката транзакции: %v", rollbackErr)
+		var (
+			order     model.Order
+			issueDate *time.Time
+		)
+		err = tx.QueryRow(ctx,
+			`SELECT order_id, user_id, acceptance_date, expiration_date, weight, base_cost, packaging_cost, total_cost, packaging_id, status_id, issue_date, with_film 
+			 FROM orders WHERE order_id = $1`, orderID).
+			Scan(&order.OrderID, &order.UserID, &order.AcceptanceDate, &order.ExpirationDate, &order.Weight, &order.BaseCost, &order.PackagingCost, &order.TotalCost, &order.PackagingID, &order.StatusID, &issueDate, &order.WithFilm)
+		if err != nil {
+			if rollbackErr := tm.RollbackTransaction(ctx, tx, conn); rollbackErr != nil {
+				log.Printf("Ошибка отката транзакции: %v", rollbackErr)
+			}
+			return nil, fmt.Errorf("ошибка получения заказа с ID %d: %w", orderID, err)
+		}
+		if issueDate != nil {
+			order.IssueDate = *issueDate
 		}
-		return nil, fmt.Errorf("ошибка получения заказа с ID %d: %w", orderID, err)
-	}
 
-	if err = tm.CommitTransaction(ctx, tx, conn); err != nil {
-		return nil, fmt.Errorf("ошибка подтверждения транзакции: %w", err)
-	}
+		if err = tm.CommitTransaction(ctx, tx, conn); err != nil {
+			return nil, fmt.Errorf("ошибка подтверждения транзакции: %w", err)
+		}
 
-	return &order, nil
+		return &order, nil
+	})
 }
 
-// UpdateOrder обновляет заказ с уровнем изоляции Repeatable Read
-func UpdateOrder(ctx context.Context, order model.Order, pool *pgxpool.Pool) error {
-	tm := NewTransactionManager(pool)
+// UpdateOrder обновляет заказ с уровнем изоляции Repeatable Read.
+// Хуки выполняются в той же транзакции.
+func UpdateOrder(ctx context.Context, order model.Order, pool *pgxpool.Pool, hooks ...TxHook) error {
+	return withRetryErr(ctx, func() error {
+		tm := NewTransactionManager(pool)
 
-	tx, conn, err := tm.BeginTransaction(ctx, pgx.RepeatableRead)
-	if err != nil {
-		return err
-	}
+		tx, conn, err := tm.BeginTransaction(ctx, pgx.RepeatableRead)
+		if err != nil {
+			return err
+		}
 
-	_, err = tx.Exec(ctx,
-		`UPDATE orders SET user_id = $2, acceptance_date = $3, expiration_date = $4, weight = $5, base_cost = $6, packaging_cost = $7, total_cost = $8, packaging_id = $9, status_id = $10, issue_date = $11, with_film = $12
-		 WHERE order_id = $1`,
-		order.OrderID, order.UserID, order.AcceptanceDate, order.ExpirationDate, order.Weight, order.BaseCost, order.PackagingCost, order.TotalCost, order.PackagingID, order.StatusID, order.IssueDate, order.WithFilm)
-	if err != nil {
-		if rollbackErr := tm.RollbackTransaction(ctx, tx, conn); rollbackErr != nil {
-			log.Printf("Ошибка отката транзакции: %v", rollbackErr)
+		_, err = tx.Exec(ctx,
+			`UPDATE orders SET user_id = $2, acceptance_date = $3, expiration_date = $4, weight = $5, base_cost = $6, packaging_cost = $7, total_cost = $8, packaging_id = $9, status_id = $10, issue_date = $11, with_film = $12
+			 WHERE order_id = $1`,
+			order.OrderID, order.UserID, order.AcceptanceDate, order.ExpirationDate, order.Weight, order.BaseCost, order.PackagingCost, order.TotalCost, order.PackagingID, order.StatusID, nullTime(order.IssueDate), order.WithFilm)
+		if err != nil {
+			if rollbackErr := tm.RollbackTransaction(ctx, tx, conn); rollbackErr != nil {
+				log.Printf("Ошибка отката транзакции: %v", rollbackErr)
+			}
+			return fmt.Errorf("ошибка обновления заказа с ID %d: %w", order.OrderID, err)
 		}
-		return fmt.Errorf("ошибка обновления заказа с ID %d: %w", order.OrderID, err)
-	}
 
-	if err = tm.CommitTransaction(ctx, tx, conn); err != nil {
-		return fmt.Errorf("ошибка подтверждения транзакции: %w", err)
-	}
+		if err = runTxHooks(ctx, tx, order.OrderID, hooks); err != nil {
+			if rollbackErr := tm.RollbackTransaction(ctx, tx, conn); rollbackErr != nil {
+				log.Printf("Ошибка отката транзакции: %v", rollbackErr)
+			}
+			return err
+		}
+
+		if err = tm.CommitTransaction(ctx, tx, conn); err != nil {
+			return fmt.Errorf("ошибка подтверждения транзакции: %w", err)
+		}
 
-	return nil
+		return nil
+	})
 }
 
-// DeleteOrder удаляет заказ по его ID с уровнем изоляции Serializable
-func DeleteOrder(ctx context.Context, orderID int, pool *pgxpool.Pool) error {
-	tm := NewTransactionManager(pool)
+// DeleteOrder удаляет заказ по его ID с уровнем изоляции Serializable.
+// Хуки выполняются в той же транзакции.
+func DeleteOrder(ctx context.Context, orderID int, pool *pgxpool.Pool, hooks ...TxHook) error {
+	return withRetryErr(ctx, func() error {
+		tm := NewTransactionManager(pool)
 
-	tx, conn, err := tm.BeginTransaction(ctx, pgx.Serializable)
-	if err != nil {
-		return err
-	}
+		tx, conn, err := tm.BeginTransaction(ctx, pgx.Serializable)
+		if err != nil {
+			return err
+		}
 
-	_, err = tx.Exec(ctx, `DELETE FROM orders WHERE order_id = $1`, orderID)
-	if err != nil {
-		if rollbackErr := tm.RollbackTransaction(ctx, tx, conn); rollbackErr != nil {
-			log.Printf("Ошибка отката транзакции: %v", rollbackErr)
+		_, err = tx.Exec(ctx, `DELETE FROM orders WHERE order_id = $1`, orderID)
+		if err != nil {
+			if rollbackErr := tm.RollbackTransaction(ctx, tx, conn); rollbackErr != nil {
+				log.Printf("Ошибка отката транзакции: %v", rollbackErr)
+			}
+			return fmt.Errorf("ошибка удаления заказа с ID %d: %w", orderID, err)
 		}
-		return fmt.Errorf("ошибка удаления заказа с ID %d: %w", orderID, err)
-	}
 
-	if err = tm.CommitTransaction(ctx, tx, conn); err != nil {
-		return fmt.Errorf("ошибка подтверждения транзакции: %w", err)
-	}
+		if err = runTxHooks(ctx, tx, orderID, hooks); err != nil {
+			if rollbackErr := tm.RollbackTransaction(ctx, tx, conn); rollbackErr != nil {
+				log.Printf("Ошибка отката транзакции: %v", rollbackErr)
+			}
+			return err
+		}
 
-	return nil
+		if err = tm.CommitTransaction(ctx, tx, conn); err != nil {
+			return fmt.Errorf("ошибка подтверждения транзакции: %w", err)
+		}
+
+		return nil
+	})
 }
 
 // GetAllOrders возвращает список всех заказов с уровнем изоляции Serializable
 func GetAllOrders(ctx context.Context, pool *pgxpool.Pool) ([]model.Order, error) {
-	tm := NewTransactionManager(pool)
+	return withRetry(ctx, func() ([]model.Order, error) {
+		tm := NewTransactionManager(pool)
 
-	tx, conn, err := tm.BeginTransaction(ctx, pgx.Serializable)
-	if err != nil {
-		return nil, err
-	}
-
-	query := `
-		SELECT order_id, user_id, acceptance_date, expiration_date, weight, base_cost, packaging_cost, total_cost, packaging_id, status_id, issue_date, with_film 
-		FROM orders
-	`
-
-	rows, err := tx.Query(ctx, query)
-	if err != nil {
-		if rollbackErr := tm.RollbackTransaction(ctx, tx, conn); rollbackErr != nil {
-			log.Printf("Ошибка отката транзакции: %v", rollbackErr)
+		tx, conn, err := tm.BeginTransaction(ctx, pgx.Serializable)
+		if err != nil {
+			return nil, err
 		}
-		return nil, fmt.Errorf("ошибка получения всех заказов: %w", err)
-	}
-	defer rows.Close()
 
-	var orders []model.Order
-	for rows.Next() {
-		var order model.Order
-		err := rows.Scan(&order.OrderID, &order.UserID, &order.AcceptanceDate, &order.ExpirationDate, &order.Weight, &order.BaseCost, &order.PackagingCost, &order.TotalCost, &order.PackagingID, &order.StatusID, &order.IssueDate, &order.WithFilm)
+		query := `
+			SELECT order_id, user_id, acceptance_date, expiration_date, weight, base_cost, packaging_cost, total_cost, packaging_id, status_id, issue_date, with_film 
+			FROM orders
+		`
+
+		rows, err := tx.Query(ctx, query)
 		if err != nil {
 			if rollbackErr := tm.RollbackTransaction(ctx, tx, conn); rollbackErr != nil {
 				log.Printf("Ошибка отката транзакции: %v", rollbackErr)
 			}
-			return nil, fmt.Errorf("ошибка сканирования заказа: %w", err)
+			return nil, fmt.Errorf("ошибка получения всех заказов: %w", err)
 		}
-		orders = append(orders, order)
-	}
-	if err = rows.Err(); err != nil {
-		if rollbackErr := tm.RollbackTransaction(ctx, tx, conn); rollbackErr != nil {
-			log.Printf("Ошибка отката транзакции: %v", rollbackErr)
+		defer rows.Close()
+
+		var orders []model.Order
+		for rows.Next() {
+			var (
+				order     model.Order
+				issueDate *time.Time
+			)
+			err := rows.Scan(&order.OrderID, &order.UserID, &order.AcceptanceDate, &order.ExpirationDate, &order.Weight, &order.BaseCost, &order.PackagingCost, &order.TotalCost, &order.PackagingID, &order.StatusID, &issueDate, &order.WithFilm)
+			if err != nil {
+				if rollbackErr := tm.RollbackTransaction(ctx, tx, conn); rollbackErr != nil {
+					log.Printf("Ошибка отката транзакции: %v", rollbackErr)
+				}
+				return nil, fmt.Errorf("ошибка сканирования заказа: %w", err)
+			}
+			if issueDate != nil {
+				order.IssueDate = *issueDate
+			}
+			orders = append(orders, order)
+		}
+		if err = rows.Err(); err != nil {
+			if rollbackErr := tm.RollbackTransaction(ctx, tx, conn); rollbackErr != nil {
+				log.Printf("Ошибка отката транзакции: %v", rollbackErr)
+			}
+			return nil, fmt.Errorf("ошибка итерации по строкам заказов: %w", err)
 		}
-		return nil, fmt.Errorf("ошибка итерации по строкам заказов: %w", err)
-	}
 
-	if err = tm.CommitTransaction(ctx, tx, conn); err != nil {
-		return nil, fmt.Errorf("ошибка подтверждения транзакции: %w", err)
-	}
+		if err = tm.CommitTransaction(ctx, tx, conn); err != nil {
+			return nil, fmt.Errorf("ошибка подтверждения транзакции: %w", err)
+		}
 
-	return orders, nil
+		return orders, nil
+	})
 }
 
 // GetOrdersByUserID возвращает заказы для конкретного пользователя с уровнем изоляции Repeatable Read
 func GetOrdersByUserID(ctx context.Context, userID int, pool *pgxpool.Pool) ([]model.Order, error) {
+	return withRetry(ctx, func() ([]model.Order, error) {
+		tm := NewTransactionManager(pool)
+
+		tx, conn, err := tm.BeginTransaction(ctx, pgx.RepeatableRead)
+		if err != nil {
+			return nil, err
+		}
+
+		query := `
+			SELECT order_id, user_id, acceptance_date, expiration_date, weight, base_cost, packaging_cost, total_cost, packaging_id, status_id, issue_date, with_film 
+			FROM orders WHERE user_id = $1
+		`
+
+		rows, err := tx.Query(ctx, query, userID)
+		if err != nil {
+			if rollbackErr := tm.RollbackTransaction(ctx, tx, conn); rollbackErr != nil {
+				log.Printf("Ошибка отката транзакции: %v", rollbackErr)
+			}
+			return nil, fmt.Errorf("ошибка получения заказов для пользователя с ID %d: %w", userID, err)
+		}
+		defer rows.Close()
+
+		var orders []model.Order
+		for rows.Next() {
+			var (
+				order     model.Order
+				issueDate *time.Time
+			)
+			err := rows.Scan(&order.OrderID, &order.UserID, &order.AcceptanceDate, &order.ExpirationDate, &order.Weight, &order.BaseCost, &order.PackagingCost, &order.TotalCost, &order.PackagingID, &order.StatusID, &issueDate, &order.WithFilm)
+			if err != nil {
+				if rollbackErr := tm.RollbackTransaction(ctx, tx, conn); rollbackErr != nil {
+					log.Printf("Ошибка отката транзакции: %v", rollbackErr)
+				}
+				return nil, fmt.Errorf("ошибка сканирования заказа: %w", err)
+			}
+			if issueDate != nil {
+				order.IssueDate = *issueDate
+			}
+			orders = append(orders, order)
+		}
+		if err = rows.Err(); err != nil {
+			if rollbackErr := tm.RollbackTransaction(ctx, tx, conn); rollbackErr != nil {
+				log.Printf("Ошибка отката транзакции: %v", rollbackErr)
+			}
+			return nil, fmt.Errorf("ошибка итерации по строкам заказов: %w", err)
+		}
+
+		if err = tm.CommitTransaction(ctx, tx, conn); err != nil {
+			return nil, fmt.Errorf("ошибка подтверждения транзакции: %w", err)
+		}
+
+		return orders, nil
+	})
+}
+
+// ListOrders возвращает не более limit заказов с ID больше afterID, подходящих под фильтр, в порядке возрастания ID,
+// с уровнем изоляции Read Committed
+func ListOrders(ctx context.Context, filter model.OrderFilter, afterID, limit int, pool *pgxpool.Pool) ([]model.Order, error) {
+	return withRetry(ctx, func() ([]model.Order, error) {
+		tm := NewTransactionManager(pool)
+
+		tx, conn, err := tm.BeginTransaction(ctx, pgx.ReadCommitted)
+		if err != nil {
+			return nil, err
+		}
+		defer conn.Release()
+
+		conds := orderConditions(filter, afterID)
+		query := fmt.Sprintf(`
+			SELECT order_id, user_id, acceptance_date, expiration_date, weight, base_cost, packaging_cost, total_cost, packaging_id, status_id, issue_date, with_film
+			FROM orders
+			WHERE %s
+			ORDER BY order_id
+			LIMIT %d
+		`, conds.where(), limit)
+
+		rows, err := tx.Query(ctx, query, conds.args...)
+		if err != nil {
+			_ = tm.RollbackTransaction(ctx, tx, conn)
+			return nil, fmt.Errorf("ошибка получения страницы заказов: %w", err)
+		}
+		defer rows.Close()
+
+		orders := make([]model.Order, 0, limit)
+		for rows.Next() {
+			var (
+				order     model.Order
+				issueDate *time.Time
+			)
+			err := rows.Scan(&order.OrderID, &order.UserID, &order.AcceptanceDate, &order.ExpirationDate, &order.Weight, &order.BaseCost, &order.PackagingCost, &order.TotalCost, &order.PackagingID, &order.StatusID, &issueDate, &order.WithFilm)
+			if err != nil {
+				_ = tm.RollbackTransaction(ctx, tx, conn)
+				return nil, fmt.Errorf("ошибка сканирования заказа: %w", err)
+			}
+			if issueDate != nil {
+				order.IssueDate = *issueDate
+			}
+			orders = append(orders, order)
+		}
+		if err = rows.Err(); err != nil {
+			_ = tm.RollbackTransaction(ctx, tx, conn)
+			return nil, fmt.Errorf("ошибка итерации по строкам заказов: %w", err)
+		}
+
+		if err = tm.CommitTransaction(ctx, tx, conn); err != nil {
+			return nil, fmt.Errorf("ошибка подтверждения транзакции: %w", err)
+		}
+
+		return orders, nil
+	})
+}
+
+// ReturnExpiredOrders оформляет возвраты пачки просроченных заказов с уровнем изоляции Read Committed.
+// Заказы блокируются через FOR UPDATE SKIP LOCKED, поэтому параллельные проходы обрабатывают разные заказы.
+// Каждый заказ обрабатывается в своей точке сохранения: вставка возврата, смена статуса заказа и хуки
+// выполняются вместе, а ошибка одного заказа откатывает только его изменения.
+// Если возврат заказа уже существует, новый не создается, а хуки получают существующий возврат.
+func ReturnExpiredOrders(ctx context.Context, batch model.ExpiredOrdersBatch, pool *pgxpool.Pool,
+	newReturn func(order model.Order) model.Return, hooks func(order model.Order, ret model.Return) []TxHook) (model.ExpiredOrdersBatchResult, error) {
+	result := model.ExpiredOrdersBatchResult{Failed: make(map[int]error)}
 	tm := NewTransactionManager(pool)
 
-	tx, conn, err := tm.BeginTransaction(ctx, pgx.RepeatableRead)
+	tx, conn, err := tm.BeginTransaction(ctx, pgx.ReadCommitted)
 	if err != nil {
-		return nil, err
+		return result, err
 	}
+	defer conn.Release()
 
-	query := `
-		SELECT order_id, user_id, acceptance_date, expiration_date, weight, base_cost, packaging_cost, total_cost, packaging_id, status_id, issue_date, with_film 
-		FROM orders WHERE user_id = $1
-	`
+	exclude := batch.Exclude
+	if exclude == nil {
+		exclude = []int{}
+	}
 
-	rows, err := tx.Query(ctx, query, userID)
+	rows, err := tx.Query(ctx,
+		`SELECT o.order_id, o.user_id, o.acceptance_date, o.expiration_date, o.weight, o.base_cost, o.packaging_cost, o.total_cost, o.packaging_id, o.status_id, o.issue_date, o.with_film,
+		        (SELECT MIN(r.return_id) FROM returns r WHERE r.order_id = o.order_id)
+		 FROM orders o
+		 WHERE o.status_id = $1 AND o.expiration_date < $2 AND NOT (o.order_id = ANY($3::int[]))
+		 ORDER BY o.expiration_date, o.order_id
+		 LIMIT $4
+		 FOR UPDATE OF o SKIP LOCKED`, batch.StatusID, batch.Before, exclude, batch.Limit)
 	if err != nil {
-		if rollbackErr := tm.RollbackTransaction(ctx, tx, conn); rollbackErr != nil {
-			log.Printf("Ошибка отката транзакции: %v", rollbackErr)
-		}
-		return nil, fmt.Errorf("ошибка получения заказов для пользователя с ID %d: %w", userID, err)
+		_ = tm.RollbackTransaction(ctx, tx, conn)
+		return result, fmt.Errorf("ошибка получения просроченных заказов: %w", err)
 	}
-	defer rows.Close()
 
-	var orders []model.Order
+	var (
+		orders    []model.Order
+		returnIDs []*int
+	)
 	for rows.Next() {
-		var order model.Order
-		err := rows.Scan(&order.OrderID, &order.UserID, &order.AcceptanceDate, &order.ExpirationDate, &order.Weight, &order.BaseCost, &order.PackagingCost, &order.TotalCost, &order.PackagingID, &order.StatusID, &order.IssueDate, &order.WithFilm)
+		var (
+			order     model.Order
+			issueDate *time.Time
+			returnID  *int
+		)
+		err := rows.Scan(&order.OrderID, &order.UserID, &order.AcceptanceDate, &order.ExpirationDate, &order.Weight, &order.BaseCost, &order.PackagingCost, &order.TotalCost, &order.PackagingID, &order.StatusID, &issueDate, &order.WithFilm, &returnID)
 		if err != nil {
-			if rollbackErr := tm.RollbackTransaction(ctx, tx, conn); rollbackErr != nil {
-				log.Printf("Ошибка отката транзакции: %v", rollbackErr)
-			}
-			return nil, fmt.Errorf("ошибка сканирования заказа: %w", err)
+			rows.Close()
+			_ = tm.RollbackTransaction(ctx, tx, conn)
+			return result, fmt.Errorf("ошибка сканирования заказа: %w", err)
+		}
+		if issueDate != nil {
+			order.IssueDate = *issueDate
 		}
 		orders = append(orders, order)
+		returnIDs = append(returnIDs, returnID)
 	}
+	rows.Close()
 	if err = rows.Err(); err != nil {
-		if rollbackErr := tm.RollbackTransaction(ctx, tx, conn); rollbackErr != nil {
-			log.Printf("Ошибка отката транзакции: %v", rollbackErr)
+		_ = tm.RollbackTransaction(ctx, tx, conn)
+		return result, fmt.Errorf("ошибка итерации по строкам заказов: %w", err)
+	}
+	result.Selected = len(orders)
+
+	for i, order := range orders {
+		ret := newReturn(order)
+		created, err := returnExpiredOrder(ctx, tx, order, &ret, returnIDs[i], hooks)
+		if err != nil {
+			result.Failed[order.OrderID] = err
+			result.Summary.Failed++
+			continue
 		}
-		return nil, fmt.Errorf("ошибка итерации по строкам заказов: %w", err)
+
+		if created {
+			result.Summary.Processed++
+		} else {
+			result.Summary.Skipped++
+		}
+		order.StatusID = ret.StatusID
+		result.Returned = append(result.Returned, order)
 	}
 
 	if err = tm.CommitTransaction(ctx, tx, conn); err != nil {
-		return nil, fmt.Errorf("ошибка подтверждения транзакции: %w", err)
+		return model.ExpiredOrdersBatchResult{}, fmt.Errorf("ошибка подтверждения транзакции: %w", err)
 	}
 
-	return orders, nil
+	return result, nil
 }
 
-// GetExpiredOrders возвращает заказы с истекшим сроком хранения с уровнем изоляции Serializable
-func GetExpiredOrders(ctx context.Context, pool *pgxpool.Pool) ([]model.Order, error) {
-	tm := NewTransactionManager(pool)
-
-	tx, conn, err := tm.BeginTransaction(ctx, pgx.Serializable)
+// returnExpiredOrder оформляет возврат одного заказа в точке сохранения транзакции пачки.
+// Возвращает false, если возврат уже существовал и создана только смена статуса.
+func returnExpiredOrder(ctx context.Context, tx pgx.Tx, order model.Order, ret *model.Return, existingReturnID *int,
+	hooks func(order model.Order, ret model.Return) []TxHook) (bool, error) {
+	savepoint, err := tx.Begin(ctx)
 	if err != nil {
-		return nil, err
+		return false, fmt.Errorf("ошибка создания точки сохранения для заказа с ID %d: %w", order.OrderID, err)
 	}
 
-	rows, err := tx.Query(ctx,
-		`SELECT order_id, user_id, acceptance_date, expiration_date, weight, base_cost, packaging_cost, total_cost, packaging_id, status_id, issue_date, with_film 
-		 FROM orders WHERE expiration_date < $1`, time.Now())
-	if err != nil {
-		if rollbackErr := tm.RollbackTransaction(ctx, tx, conn); rollbackErr != nil {
-			log.Printf("Ошибка отката транзакции: %v", rollbackErr)
+	created := existingReturnID == nil
+	if created {
+		err = savepoint.QueryRow(ctx,
+			`INSERT INTO returns (order_id, user_id, return_date, reason_id, base_cost, packaging_cost, packaging_id, total_cost, status_id)
+			 VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9) RETURNING return_id`,
+			ret.OrderID, ret.UserID, ret.ReturnDate, ret.ReasonID, ret.BaseCost, ret.PackagingCost, ret.PackagingID, ret.TotalCost, ret.StatusID).
+			Scan(&ret.ReturnID)
+		if err != nil {
+			_ = savepoint.Rollback(ctx)
+			return false, fmt.Errorf("ошибка вставки возврата для заказа с ID %d: %w", order.OrderID, err)
 		}
-		return nil, fmt.Errorf("ошибка получения истекших заказов: %w", err)
+	} else {
+		ret.ReturnID = *existingReturnID
 	}
-	defer rows.Close()
 
-	var orders []model.Order
-	for rows.Next() {
-		var order model.Order
-		err := rows.Scan(&order.OrderID, &order.UserID, &order.AcceptanceDate, &order.ExpirationDate, &order.Weight, &order.BaseCost, &order.PackagingCost, &order.TotalCost, &order.PackagingID, &order.StatusID, &order.IssueDate, &order.WithFilm)
+	if _, err = savepoint.Exec(ctx, `UPDATE orders SET status_id = $2 WHERE order_id = $1`, order.OrderID, ret.StatusID); err != nil {
+		_ = savepoint.Rollback(ctx)
+		return false, fmt.Errorf("ошибка обновления статуса заказа с ID %d: %w", order.OrderID, err)
+	}
+
+	if err = runTxHooks(ctx, savepoint, order.OrderID, hooks(order, *ret)); err != nil {
+		_ = savepoint.Rollback(ctx)
+		return false, err
+	}
+
+	if err = savepoint.Commit(ctx); err != nil {
+		return false, fmt.Errorf("ошибка освобождения точки сохранения для заказа с ID %d: %w", order.OrderID, err)
+	}
+	return created, nil
+}
+
+// GetOrdersExpiringBefore возвращает заказы в указанном статусе, срок хранения которых истекает до deadline
+// и о которых еще не отправлено уведомление, с уровнем изоляции Read Committed
+func GetOrdersExpiringBefore(ctx context.Context, deadline time.Time, statusID int, pool *pgxpool.Pool) ([]model.Order, error) {
+	return withRetry(ctx, func() ([]model.Order, error) {
+		tm := NewTransactionManager(pool)
+
+		tx, conn, err := tm.BeginTransaction(ctx, pgx.ReadCommitted)
 		if err != nil {
-			if rollbackErr := tm.RollbackTransaction(ctx, tx, conn); rollbackErr != nil {
-				log.Printf("Ошибка отката транзакции: %v", rollbackErr)
+			return nil, err
+		}
+		defer conn.Release()
+
+		rows, err := tx.Query(ctx,
+			`SELECT order_id, user_id, acceptance_date, expiration_date, weight, base_cost, packaging_cost, total_cost, packaging_id, status_id, issue_date, with_film
+			 FROM orders
+			 WHERE status_id = $1 AND expiration_date >= $2 AND expiration_date < $3 AND expiry_notified_at IS NULL
+			 ORDER BY expiration_date`, statusID, time.Now(), deadline)
+		if err != nil {
+			_ = tm.RollbackTransaction(ctx, tx, conn)
+			return nil, fmt.Errorf("ошибка получения заказов с истекающим сроком хранения: %w", err)
+		}
+		defer rows.Close()
+
+		var orders []model.Order
+		for rows.Next() {
+			var (
+				order     model.Order
+				issueDate *time.Time
+			)
+			err := rows.Scan(&order.OrderID, &order.UserID, &order.AcceptanceDate, &order.ExpirationDate, &order.Weight, &order.BaseCost, &order.PackagingCost, &order.TotalCost, &order.PackagingID, &order.StatusID, &issueDate, &order.WithFilm)
+			if err != nil {
+				_ = tm.RollbackTransaction(ctx, tx, conn)
+				return nil, fmt.Errorf("ошибка сканирования заказа: %w", err)
 			}
-			return nil, fmt.Errorf("ошибка сканирования заказа: %w", err)
+			if issueDate != nil {
+				order.IssueDate = *issueDate
+			}
+			orders = append(orders, order)
 		}
-		orders = append(orders, order)
-	}
-	if err = rows.Err(); err != nil {
-		if rollbackErr := tm.RollbackTransaction(ctx, tx, conn); rollbackErr != nil {
-			log.Printf("Ошибка отката транзакции: %v", rollbackErr)
+		if err = rows.Err(); err != nil {
+			_ = tm.RollbackTransaction(ctx, tx, conn)
+			return nil, fmt.Errorf("ошибка итерации по строкам заказов: %w", err)
 		}
-		return nil, fmt.Errorf("ошибка итерации по строкам заказов: %w", err)
-	}
 
-	if err = tm.CommitTransaction(ctx, tx, conn); err != nil {
-		return nil, fmt.Errorf("ошибка подтверждения транзакции: %w", err)
-	}
+		if err = tm.CommitTransaction(ctx, tx, conn); err != nil {
+			return nil, fmt.Errorf("ошибка подтверждения транзакции: %w", err)
+		}
+
+		return orders, nil
+	})
+}
+
+// MarkOrderExpiryNotified отмечает, что уведомление об истечении срока хранения заказа отправлено,
+// с уровнем изоляции Read Committed. Хуки выполняются в той же транзакции только если отметка поставлена впервые.
+// Возвращает false, если заказ уже был отмечен.
+func MarkOrderExpiryNotified(ctx context.Context, orderID int, pool *pgxpool.Pool, hooks ...TxHook) (bool, error) {
+	return withRetry(ctx, func() (bool, error) {
+		tm := NewTransactionManager(pool)
+
+		tx, conn, err := tm.BeginTransaction(ctx, pgx.ReadCommitted)
+		if err != nil {
+			return false, err
+		}
+		defer conn.Release()
 
-	return orders, nil
+		tag, err := tx.Exec(ctx,
+			`UPDATE orders SET expiry_notified_at = CURRENT_TIMESTAMP WHERE order_id = $1 AND expiry_notified_at IS NULL`, orderID)
+		if err != nil {
+			_ = tm.RollbackTransaction(ctx, tx, conn)
+			return false, fmt.Errorf("ошибка отметки уведомления для заказа с ID %d: %w", orderID, err)
+		}
+		if tag.RowsAffected() == 0 {
+			_ = tm.RollbackTransaction(ctx, tx, conn)
+			return false, nil
+		}
+
+		if err = runTxHooks(ctx, tx, orderID, hooks); err != nil {
+			_ = tm.RollbackTransaction(ctx, tx, conn)
+			return false, err
+		}
+
+		if err = tm.CommitTransaction(ctx, tx, conn); err != nil {
+			return false, fmt.Errorf("ошибка подтверждения транзакции: %w", err)
+		}
+
+		return true, nil
+	})
+}
+
+// nullTime возвращает nil для нулевого времени, чтобы невыданный заказ хранился с issue_date = NULL
+func nullTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
 }
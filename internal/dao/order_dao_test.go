@@ -79,6 +79,65 @@ func TestGetOrderByID(t *testing.T) {
 	assert.Equal(t, order.UserID, fetchedOrder.UserID, "ID пользователя должен совпадать")
 }
 
+// Test ListOrders function
+func TestListOrders(t *testing.T) {
+	ctx := context.Background()
+
+	// Заказы с уникальным сроком хранения, чтобы фильтр не захватил данные других тестов
+	expiration := time.Date(2099, 1, 1, 12, 0, 0, 0, time.UTC)
+	filter := model.OrderFilter{
+		UserID:        3,
+		ExpiresFrom:   expiration.Truncate(24 * time.Hour),
+		ExpiresBefore: expiration.Truncate(24*time.Hour).AddDate(0, 0, 1),
+	}
+	var orderIDs []int
+	for i := 0; i < 3; i++ {
+		orderID, err := dao.CreateOrder(ctx, model.Order{
+			UserID:         3,
+			AcceptanceDate: time.Now(),
+			ExpirationDate: expiration,
+			Weight:         1.0,
+			BaseCost:       10.0,
+			PackagingCost:  1.0,
+			TotalCost:      11.0,
+			PackagingID:    1,
+			StatusID:       1,
+		}, testDB)
+		assert.NoError(t, err, "ошибка при создании заказа")
+		orderIDs = append(orderIDs, orderID)
+	}
+
+	// Первая страница
+	orders, err := dao.ListOrders(ctx, filter, 0, 2, testDB)
+	assert.NoError(t, err, "ошибка при получении страницы заказов")
+	if assert.Len(t, orders, 2, "страница ограничена размером") {
+		assert.Equal(t, orderIDs[0], orders[0].OrderID, "заказы упорядочены по ID")
+	}
+
+	// Страница после курсора
+	orders, err = dao.ListOrders(ctx, filter, orderIDs[1], 2, testDB)
+	assert.NoError(t, err, "ошибка при получении страницы заказов")
+	if assert.Len(t, orders, 1, "последняя страница") {
+		assert.Equal(t, orderIDs[2], orders[0].OrderID, "курсор пропускает прочитанные заказы")
+	}
+
+	// Фильтр по факту выдачи: невыданные заказы хранятся с issue_date = NULL
+	issued := true
+	filter.Issued = &issued
+	orders, err = dao.ListOrders(ctx, filter, 0, 10, testDB)
+	assert.NoError(t, err, "ошибка при получении страницы заказов")
+	assert.Empty(t, orders, "созданные заказы не выданы")
+
+	issued = false
+	orders, err = dao.ListOrders(ctx, filter, 0, 10, testDB)
+	assert.NoError(t, err, "ошибка при получении страницы заказов")
+	assert.Len(t, orders, 3, "все созданные заказы невыданные")
+
+	order, err := dao.GetOrderByID(ctx, orderIDs[0], testDB)
+	assert.NoError(t, err, "ошибка при получении заказа")
+	assert.True(t, order.IssueDate.IsZero(), "дата выдачи невыданного заказа пустая")
+}
+
 // Test UpdateOrder function
 func TestUpdateOrder(t *testing.T) {
 	ctx := context.Background()
@@ -142,3 +201,45 @@ func TestDeleteOrder(t *testing.T) {
 	assert.Error(t, err, "должна возникнуть ошибка при попытке получить удаленный заказ")
 	assert.Nil(t, fetchedOrder, "заказ должен быть nil после удаления")
 }
+
+// Тест оформления возвратов пачки просроченных заказов
+func TestReturnExpiredOrders(t *testing.T) {
+	ctx := context.Background()
+
+	expired := model.Order{
+		UserID:         1,
+		AcceptanceDate: time.Now().Add(-48 * time.Hour),
+		ExpirationDate: time.Now().Add(-24 * time.Hour),
+		Weight:         5.0,
+		BaseCost:       100.0,
+		PackagingCost:  10.0,
+		TotalCost:      110.0,
+		PackagingID:    1,
+		StatusID:       1,
+	}
+	orderID, err := dao.CreateOrder(ctx, expired, testDB)
+	assert.NoError(t, err, "ошибка при создании заказа")
+
+	batch := model.ExpiredOrdersBatch{StatusID: 1, Before: time.Now(), Limit: 1000}
+	newReturn := func(order model.Order) model.Return {
+		return model.Return{OrderID: order.OrderID, UserID: order.UserID, ReturnDate: time.Now(), ReasonID: 1,
+			BaseCost: order.BaseCost, PackagingCost: order.PackagingCost, PackagingID: order.PackagingID, TotalCost: order.TotalCost, StatusID: 3}
+	}
+	noHooks := func(model.Order, model.Return) []dao.TxHook { return nil }
+
+	result, err := dao.ReturnExpiredOrders(ctx, batch, testDB, newReturn, noHooks)
+	assert.NoError(t, err, "ошибка при обработке просроченных заказов")
+	assert.Empty(t, result.Failed)
+	assert.GreaterOrEqual(t, result.Summary.Processed, 1)
+
+	order, err := dao.GetOrderByID(ctx, orderID, testDB)
+	assert.NoError(t, err, "ошибка при получении заказа")
+	assert.Equal(t, 3, order.StatusID, "просроченный заказ должен перейти в статус возврата")
+
+	// Повторный проход не выбирает уже возвращенный заказ
+	result, err = dao.ReturnExpiredOrders(ctx, batch, testDB, newReturn, noHooks)
+	assert.NoError(t, err, "ошибка при повторной обработке просроченных заказов")
+	for _, returned := range result.Returned {
+		assert.NotEqual(t, orderID, returned.OrderID, "заказ не должен обрабатываться повторно")
+	}
+}
@@ -0,0 +1,89 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+	"homework1/internal/model"
+)
+
+// insertOrderStatusHistory добавляет записи истории статусов заказа в рамках переданной транзакции
+func insertOrderStatusHistory(ctx context.Context, tx pgx.Tx, changes []model.OrderStatusChange) error {
+	for _, change := range changes {
+		_, err := tx.Exec(ctx,
+			`INSERT INTO order_status_history (order_id, old_status_id, new_status_id, actor, reason, changed_at)
+			 VALUES ($1, $2, $3, $4, $5, $6)`,
+			change.OrderID, nullableID(change.OldStatusID), nullableID(change.NewStatusID), change.Actor, change.Reason, change.ChangedAt)
+		if err != nil {
+			return fmt.Errorf("ошибка записи истории статусов заказа с ID %d: %w", change.OrderID, err)
+		}
+	}
+	return nil
+}
+
+// GetOrderStatusHistory возвращает историю статусов заказа в хронологическом порядке с уровнем изоляции Read Committed
+func GetOrderStatusHistory(ctx context.Context, orderID int, pool *pgxpool.Pool) ([]model.OrderStatusChange, error) {
+	return withRetry(ctx, func() ([]model.OrderStatusChange, error) {
+		tm := NewTransactionManager(pool)
+
+		tx, conn, err := tm.BeginTransaction(ctx, pgx.ReadCommitted)
+		if err != nil {
+			return nil, err
+		}
+		defer conn.Release()
+
+		rows, err := tx.Query(ctx,
+			`SELECT h.change_id, h.order_id, h.old_status_id, COALESCE(os.status_name, ''), h.new_status_id, COALESCE(ns.status_name, ''),
+			        h.actor, h.reason, h.changed_at
+			 FROM order_status_history h
+			 LEFT JOIN statuses os ON os.status_id = h.old_status_id
+			 LEFT JOIN statuses ns ON ns.status_id = h.new_status_id
+			 WHERE h.order_id = $1
+			 ORDER BY h.changed_at, h.change_id`, orderID)
+		if err != nil {
+			_ = tm.RollbackTransaction(ctx, tx, conn)
+			return nil, fmt.Errorf("ошибка получения истории статусов заказа с ID %d: %w", orderID, err)
+		}
+		defer rows.Close()
+
+		var history []model.OrderStatusChange
+		for rows.Next() {
+			var (
+				change                   model.OrderStatusChange
+				oldStatusID, newStatusID *int
+			)
+			err := rows.Scan(&change.ChangeID, &change.OrderID, &oldStatusID, &change.OldStatusName, &newStatusID, &change.NewStatusName,
+				&change.Actor, &change.Reason, &change.ChangedAt)
+			if err != nil {
+				_ = tm.RollbackTransaction(ctx, tx, conn)
+				return nil, fmt.Errorf("ошибка сканирования записи истории статусов: %w", err)
+			}
+			if oldStatusID != nil {
+				change.OldStatusID = *oldStatusID
+			}
+			if newStatusID != nil {
+				change.NewStatusID = *newStatusID
+			}
+			history = append(history, change)
+		}
+		if err = rows.Err(); err != nil {
+			_ = tm.RollbackTransaction(ctx, tx, conn)
+			return nil, fmt.Errorf("ошибка итерации по истории статусов: %w", err)
+		}
+
+		if err = tm.CommitTransaction(ctx, tx, conn); err != nil {
+			return nil, fmt.Errorf("ошибка подтверждения транзакции: %w", err)
+		}
+
+		return history, nil
+	})
+}
+
+// nullableID преобразует нулевой идентификатор в NULL для записи в базу данных
+func nullableID(id int) *int {
+	if id == 0 {
+		return nil
+	}
+	return &id
+}
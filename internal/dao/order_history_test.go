@@ -0,0 +1,62 @@
+package dao_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"homework1/internal/dao"
+	"homework1/internal/model"
+)
+
+// Test GetOrderStatusHistory function
+func TestGetOrderStatusHistory(t *testing.T) {
+	ctx := context.Background()
+
+	// Создание тестового заказа вместе с первой записью истории
+	order := model.Order{
+		UserID:         1,
+		AcceptanceDate: time.Now(),
+		ExpirationDate: time.Now().Add(24 * time.Hour),
+		Weight:         3.0,
+		BaseCost:       120.0,
+		PackagingCost:  5.0,
+		TotalCost:      125.0,
+		PackagingID:    1,
+		StatusID:       1,
+		WithFilm:       false,
+	}
+	orderID, err := dao.CreateOrder(ctx, order, testDB, dao.WithStatusHistory(model.OrderStatusChange{
+		NewStatusID: 1,
+		Actor:       "test",
+		Reason:      "приемка заказа на ПВЗ",
+		ChangedAt:   time.Now(),
+	}))
+	assert.NoError(t, err, "ошибка при создании заказа")
+
+	// Смена статуса заказа с записью в историю
+	order.OrderID = orderID
+	order.StatusID = 2
+	err = dao.UpdateOrder(ctx, order, testDB, dao.WithStatusHistory(model.OrderStatusChange{
+		OrderID:     orderID,
+		OldStatusID: 1,
+		NewStatusID: 2,
+		Actor:       "test",
+		Reason:      "выдача заказа клиенту",
+		ChangedAt:   time.Now().Add(time.Second),
+	}))
+	assert.NoError(t, err, "ошибка при обновлении заказа")
+
+	// Получение истории в хронологическом порядке
+	history, err := dao.GetOrderStatusHistory(ctx, orderID, testDB)
+	assert.NoError(t, err, "ошибка при получении истории статусов")
+	if assert.Len(t, history, 2, "история должна содержать две записи") {
+		assert.Equal(t, orderID, history[0].OrderID, "ID заказа должен быть проставлен при создании")
+		assert.Zero(t, history[0].OldStatusID, "у первой записи нет предыдущего статуса")
+		assert.Equal(t, 1, history[0].NewStatusID)
+		assert.Equal(t, 1, history[1].OldStatusID)
+		assert.Equal(t, 2, history[1].NewStatusID)
+		assert.Equal(t, "выдача заказа клиенту", history[1].Reason)
+	}
+}
@@ -0,0 +1,117 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+	"homework1/internal/model"
+	"time"
+)
+
+// WithOutboxEvent сохраняет событие в outbox в транзакции основной операции.
+// build получает контекст операции и ID изменяемой записи, что позволяет сформировать событие
+// о еще не созданной сущности и передать в заголовках контекст трассировки.
+func WithOutboxEvent(build func(ctx context.Context, entityID int) (model.OutboxEvent, error)) TxHook {
+	return func(ctx context.Context, tx pgx.Tx, entityID int) error {
+		event, err := build(ctx, entityID)
+		if err != nil {
+			return fmt.Errorf("ошибка формирования события outbox: %w", err)
+		}
+		return insertOutboxEvent(ctx, tx, event)
+	}
+}
+
+// insertOutboxEvent добавляет событие в outbox в рамках переданной транзакции
+func insertOutboxEvent(ctx context.Context, tx pgx.Tx, event model.OutboxEvent) error {
+	_, err := tx.Exec(ctx,
+		`INSERT INTO outbox_events (topic, event_key, aggregate_id, event_type, payload, headers)
+		 VALUES ($1, $2, $3, $4, $5, $6)`,
+		event.Topic, event.EventKey, event.AggregateID, event.EventType, event.Payload, headersOrEmpty(event.Headers))
+	if err != nil {
+		return fmt.Errorf("ошибка записи события %s в outbox: %w", event.EventType, err)
+	}
+	return nil
+}
+
+// headersOrEmpty заменяет отсутствующие заголовки пустым набором, так как колонка headers не допускает NULL
+func headersOrEmpty(headers map[string]string) map[string]string {
+	if headers == nil {
+		return map[string]string{}
+	}
+	return headers
+}
+
+// RelayOutboxEvents публикует пачку неотправленных событий outbox с уровнем изоляции Read Committed.
+// Строки блокируются через FOR UPDATE SKIP LOCKED, поэтому несколько реле не публикуют одно событие одновременно.
+// Событие не выбирается, пока не отправлены более ранние события с тем же ключом, что сохраняет порядок по ключу.
+// При ошибке публикации событие откладывается на время, возвращаемое backoff по числу попыток.
+// Возвращает количество успешно опубликованных событий.
+func RelayOutboxEvents(ctx context.Context, pool *pgxpool.Pool, limit int,
+	publish func(model.OutboxEvent) error, backoff func(attempts int) time.Duration) (int, error) {
+	tm := NewTransactionManager(pool)
+
+	tx, conn, err := tm.BeginTransaction(ctx, pgx.ReadCommitted)
+	if err != nil {
+		return 0, err
+	}
+	defer conn.Release()
+
+	rows, err := tx.Query(ctx,
+		`SELECT e.event_id, e.topic, e.event_key, e.aggregate_id, e.event_type, e.payload, e.headers, e.attempts, e.created_at
+		 FROM outbox_events e
+		 WHERE e.sent_at IS NULL
+		   AND e.next_attempt_at <= CURRENT_TIMESTAMP
+		   AND NOT EXISTS (
+		       SELECT 1 FROM outbox_events p
+		       WHERE p.sent_at IS NULL AND p.event_key = e.event_key AND p.event_id < e.event_id
+		   )
+		 ORDER BY e.event_id
+		 LIMIT $1
+		 FOR UPDATE SKIP LOCKED`, limit)
+	if err != nil {
+		_ = tm.RollbackTransaction(ctx, tx, conn)
+		return 0, fmt.Errorf("ошибка выборки событий outbox: %w", err)
+	}
+
+	var events []model.OutboxEvent
+	for rows.Next() {
+		var event model.OutboxEvent
+		err := rows.Scan(&event.EventID, &event.Topic, &event.EventKey, &event.AggregateID, &event.EventType,
+			&event.Payload, &event.Headers, &event.Attempts, &event.CreatedAt)
+		if err != nil {
+			rows.Close()
+			_ = tm.RollbackTransaction(ctx, tx, conn)
+			return 0, fmt.Errorf("ошибка сканирования события outbox: %w", err)
+		}
+		events = append(events, event)
+	}
+	rows.Close()
+
+	sent := 0
+	for _, event := range events {
+		if publishErr := publish(event); publishErr != nil {
+			delay := backoff(event.Attempts + 1)
+			_, err = tx.Exec(ctx,
+				`UPDATE outbox_events
+				 SET attempts = attempts + 1, last_error = $2, next_attempt_at = CURRENT_TIMESTAMP + make_interval(secs => $3)
+				 WHERE event_id = $1`,
+				event.EventID, publishErr.Error(), delay.Seconds())
+		} else {
+			sent++
+			_, err = tx.Exec(ctx,
+				`UPDATE outbox_events SET sent_at = CURRENT_TIMESTAMP, last_error = NULL WHERE event_id = $1`,
+				event.EventID)
+		}
+		if err != nil {
+			_ = tm.RollbackTransaction(ctx, tx, conn)
+			return 0, fmt.Errorf("ошибка обновления события outbox с ID %d: %w", event.EventID, err)
+		}
+	}
+
+	if err = tm.CommitTransaction(ctx, tx, conn); err != nil {
+		return 0, fmt.Errorf("ошибка подтверждения транзакции: %w", err)
+	}
+
+	return sent, nil
+}
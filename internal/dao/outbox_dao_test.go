@@ -0,0 +1,74 @@
+package dao_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"homework1/internal/dao"
+	"homework1/internal/model"
+)
+
+// Тест записи события в outbox вместе с заказом и его публикации реле
+func TestOutboxEventRelay(t *testing.T) {
+	ctx := context.Background()
+
+	order := model.Order{
+		UserID:         1,
+		AcceptanceDate: time.Now(),
+		ExpirationDate: time.Now().Add(24 * time.Hour),
+		Weight:         2.0,
+		BaseCost:       50.0,
+		PackagingCost:  5.0,
+		TotalCost:      55.0,
+		PackagingID:    1,
+		StatusID:       1,
+	}
+
+	orderID, err := dao.CreateOrder(ctx, order, testDB, dao.WithOutboxEvent(func(_ context.Context, entityID int) (model.OutboxEvent, error) {
+		return model.OutboxEvent{
+			Topic:       "pvz.events-log",
+			EventKey:    "outbox-test",
+			AggregateID: entityID,
+			EventType:   "create",
+			Payload:     []byte(`{"OrderID": 0}`),
+			Headers:     map[string]string{"idempotency-key": "outbox-test"},
+		}, nil
+	}))
+	assert.NoError(t, err, "ошибка при создании заказа")
+
+	noBackoff := func(int) time.Duration { return 0 }
+
+	// Неудачная публикация не должна помечать событие отправленным
+	_, err = dao.RelayOutboxEvents(ctx, testDB, 1000, func(event model.OutboxEvent) error {
+		return errors.New("брокер недоступен")
+	}, noBackoff)
+	assert.NoError(t, err, "ошибка при публикации событий outbox")
+
+	var published []model.OutboxEvent
+	_, err = dao.RelayOutboxEvents(ctx, testDB, 1000, func(event model.OutboxEvent) error {
+		published = append(published, event)
+		return nil
+	}, noBackoff)
+	assert.NoError(t, err, "ошибка при публикации событий outbox")
+
+	var found *model.OutboxEvent
+	for i := range published {
+		if published[i].EventKey == "outbox-test" && published[i].AggregateID == orderID {
+			found = &published[i]
+		}
+	}
+	if assert.NotNil(t, found, "событие о созданном заказе должно быть опубликовано") {
+		assert.Equal(t, 1, found.Attempts, "неудачная попытка должна быть учтена")
+		assert.Equal(t, "outbox-test", found.Headers["idempotency-key"], "заголовки события должны сохраняться")
+	}
+
+	// Отправленное событие не публикуется повторно
+	_, err = dao.RelayOutboxEvents(ctx, testDB, 1000, func(event model.OutboxEvent) error {
+		assert.NotEqual(t, orderID, event.AggregateID, "событие не должно публиковаться повторно")
+		return nil
+	}, noBackoff)
+	assert.NoError(t, err, "ошибка при публикации событий outbox")
+}
@@ -0,0 +1,89 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+	"time"
+)
+
+// ClaimEvent занимает событие для обработки с уровнем изоляции Read Committed.
+// Возвращает false, если событие уже обработано или обрабатывается другим получателем:
+// вставка с ON CONFLICT DO NOTHING атомарна, поэтому занять событие может только один получатель.
+func ClaimEvent(ctx context.Context, eventID, eventType string, pool *pgxpool.Pool) (bool, error) {
+	return withRetry(ctx, func() (bool, error) {
+		tm := NewTransactionManager(pool)
+
+		tx, conn, err := tm.BeginTransaction(ctx, pgx.ReadCommitted)
+		if err != nil {
+			return false, err
+		}
+		defer conn.Release()
+
+		tag, err := tx.Exec(ctx,
+			`INSERT INTO processed_events (event_id, event_type) VALUES ($1, $2) ON CONFLICT (event_id) DO NOTHING`,
+			eventID, eventType)
+		if err != nil {
+			_ = tm.RollbackTransaction(ctx, tx, conn)
+			return false, fmt.Errorf("ошибка отметки обработки события %s: %w", eventID, err)
+		}
+
+		if err = tm.CommitTransaction(ctx, tx, conn); err != nil {
+			return false, fmt.Errorf("ошибка подтверждения транзакции: %w", err)
+		}
+
+		return tag.RowsAffected() == 1, nil
+	})
+}
+
+// ReleaseEvent снимает отметку с события, обработка которого не удалась, с уровнем изоляции Read Committed
+func ReleaseEvent(ctx context.Context, eventID string, pool *pgxpool.Pool) error {
+	return withRetryErr(ctx, func() error {
+		tm := NewTransactionManager(pool)
+
+		tx, conn, err := tm.BeginTransaction(ctx, pgx.ReadCommitted)
+		if err != nil {
+			return err
+		}
+		defer conn.Release()
+
+		_, err = tx.Exec(ctx, `DELETE FROM processed_events WHERE event_id = $1`, eventID)
+		if err != nil {
+			_ = tm.RollbackTransaction(ctx, tx, conn)
+			return fmt.Errorf("ошибка снятия отметки обработки события %s: %w", eventID, err)
+		}
+
+		if err = tm.CommitTransaction(ctx, tx, conn); err != nil {
+			return fmt.Errorf("ошибка подтверждения транзакции: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// DeleteProcessedEventsBefore удаляет отметки об обработке событий старше deadline с уровнем изоляции Read Committed.
+// Возвращает количество удаленных отметок.
+func DeleteProcessedEventsBefore(ctx context.Context, deadline time.Time, pool *pgxpool.Pool) (int64, error) {
+	return withRetry(ctx, func() (int64, error) {
+		tm := NewTransactionManager(pool)
+
+		tx, conn, err := tm.BeginTransaction(ctx, pgx.ReadCommitted)
+		if err != nil {
+			return 0, err
+		}
+		defer conn.Release()
+
+		tag, err := tx.Exec(ctx, `DELETE FROM processed_events WHERE processed_at < $1`, deadline)
+		if err != nil {
+			_ = tm.RollbackTransaction(ctx, tx, conn)
+			return 0, fmt.Errorf("ошибка удаления отметок об обработке событий: %w", err)
+		}
+
+		if err = tm.CommitTransaction(ctx, tx, conn); err != nil {
+			return 0, fmt.Errorf("ошибка подтверждения транзакции: %w", err)
+		}
+
+		return tag.RowsAffected(), nil
+	})
+}
@@ -0,0 +1,38 @@
+package dao_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"homework1/internal/dao"
+)
+
+// Тест занятия события, снятия отметки и удаления устаревших отметок
+func TestProcessedEvents(t *testing.T) {
+	ctx := context.Background()
+	eventID := fmt.Sprintf("processed-test-%d", time.Now().UnixNano())
+
+	claimed, err := dao.ClaimEvent(ctx, eventID, "EVENT_TYPE_ORDER_CREATED", testDB)
+	assert.NoError(t, err, "ошибка при занятии события")
+	assert.True(t, claimed, "новое событие должно заниматься")
+
+	claimed, err = dao.ClaimEvent(ctx, eventID, "EVENT_TYPE_ORDER_CREATED", testDB)
+	assert.NoError(t, err, "повторное занятие не должно быть ошибкой")
+	assert.False(t, claimed, "занятое событие не должно заниматься повторно")
+
+	assert.NoError(t, dao.ReleaseEvent(ctx, eventID, testDB), "ошибка при снятии отметки")
+	claimed, err = dao.ClaimEvent(ctx, eventID, "EVENT_TYPE_ORDER_CREATED", testDB)
+	assert.NoError(t, err, "ошибка при занятии события")
+	assert.True(t, claimed, "событие со снятой отметкой должно заниматься снова")
+
+	deleted, err := dao.DeleteProcessedEventsBefore(ctx, time.Now().Add(time.Hour), testDB)
+	assert.NoError(t, err, "ошибка при удалении отметок")
+	assert.GreaterOrEqual(t, deleted, int64(1))
+
+	claimed, err = dao.ClaimEvent(ctx, eventID, "EVENT_TYPE_ORDER_CREATED", testDB)
+	assert.NoError(t, err, "ошибка при занятии события")
+	assert.True(t, claimed, "удаленная отметка не должна учитываться")
+}
@@ -11,294 +11,403 @@ import (
 
 // ReadReturns читает все возвраты из базы данных с уровнем изоляции Read Committed
 func ReadReturns(ctx context.Context, pool *pgxpool.Pool) ([]model.Return, error) {
-	tm := NewTransactionManager(pool)
+	return withRetry(ctx, func() ([]model.Return, error) {
+		tm := NewTransactionManager(pool)
 
-	tx, conn, err := tm.BeginTransaction(ctx, pgx.ReadCommitted)
-	if err != nil {
-		return nil, err
-	}
-	defer conn.Release()
-
-	query := `SELECT return_id, order_id, user_id, return_date, reason_id, base_cost, packaging_cost, packaging_id, total_cost, status_id FROM returns`
-
-	rows, err := tx.Query(ctx, query)
-	if err != nil {
-		if rollbackErr := tm.RollbackTransaction(ctx, tx, conn); rollbackErr != nil {
-			return nil, fmt.Errorf("ошибка отката транзакции: %w", rollbackErr)
+		tx, conn, err := tm.BeginTransaction(ctx, pgx.ReadCommitted)
+		if err != nil {
+			return nil, err
 		}
-		return nil, fmt.Errorf("ошибка чтения возвратов: %w", err)
-	}
-	defer rows.Close()
+		defer conn.Release()
 
-	var returns []model.Return
-	for rows.Next() {
-		var ret model.Return
-		err := rows.Scan(&ret.ReturnID, &ret.OrderID, &ret.UserID, &ret.ReturnDate, &ret.ReasonID, &ret.BaseCost, &ret.PackagingCost, &ret.PackagingID, &ret.TotalCost, &ret.StatusID)
+		query := `SELECT return_id, order_id, user_id, return_date, reason_id, base_cost, packaging_cost, packaging_id, total_cost, status_id FROM returns`
+
+		rows, err := tx.Query(ctx, query)
 		if err != nil {
 			if rollbackErr := tm.RollbackTransaction(ctx, tx, conn); rollbackErr != nil {
 				return nil, fmt.Errorf("ошибка отката транзакции: %w", rollbackErr)
 			}
-			return nil, fmt.Errorf("ошибка обработки строки: %w", err)
+			return nil, fmt.Errorf("ошибка чтения возвратов: %w", err)
+		}
+		defer rows.Close()
+
+		var returns []model.Return
+		for rows.Next() {
+			var ret model.Return
+			err := rows.Scan(&ret.ReturnID, &ret.OrderID, &ret.UserID, &ret.ReturnDate, &ret.ReasonID, &ret.BaseCost, &ret.PackagingCost, &ret.PackagingID, &ret.TotalCost, &ret.StatusID)
+			if err != nil {
+				if rollbackErr := tm.RollbackTransaction(ctx, tx, conn); rollbackErr != nil {
+					return nil, fmt.Errorf("ошибка отката транзакции: %w", rollbackErr)
+				}
+				return nil, fmt.Errorf("ошибка обработки строки: %w", err)
+			}
+			returns = append(returns, ret)
 		}
-		returns = append(returns, ret)
-	}
 
-	if err = tm.CommitTransaction(ctx, tx, conn); err != nil {
-		return nil, fmt.Errorf("ошибка подтверждения транзакции: %w", err)
-	}
+		if err = tm.CommitTransaction(ctx, tx, conn); err != nil {
+			return nil, fmt.Errorf("ошибка подтверждения транзакции: %w", err)
+		}
 
-	return returns, nil
+		return returns, nil
+	})
 }
 
 // WriteReturns записывает возвраты в базу данных с уровнем изоляции Read Committed
 func WriteReturns(ctx context.Context, returns []model.Return, pool *pgxpool.Pool) error {
-	tm := NewTransactionManager(pool)
-
-	tx, conn, err := tm.BeginTransaction(ctx, pgx.ReadCommitted)
-	if err != nil {
-		return err
-	}
-	defer conn.Release()
+	return withRetryErr(ctx, func() error {
+		tm := NewTransactionManager(pool)
 
-	for _, ret := range returns {
-		query := `INSERT INTO returns (order_id, user_id, return_date, reason_id, base_cost, packaging_cost, packaging_id, total_cost, status_id)
-            VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
-            ON CONFLICT (return_id) DO UPDATE
-            SET order_id = $1, user_id = $2, return_date = $3, reason_id = $4, base_cost = $5, packaging_cost = $6, packaging_id = $7, total_cost = $8, status_id = $9`
-
-		_, err := tx.Exec(ctx, query, ret.OrderID, ret.UserID, ret.ReturnDate, ret.ReasonID, ret.BaseCost, ret.PackagingCost, ret.PackagingID, ret.TotalCost, ret.StatusID)
+		tx, conn, err := tm.BeginTransaction(ctx, pgx.ReadCommitted)
 		if err != nil {
-			if rollbackErr := tm.RollbackTransaction(ctx, tx, conn); rollbackErr != nil {
-				return fmt.Errorf("ошибка отката транзакции: %w", rollbackErr)
+			return err
+		}
+		defer conn.Release()
+
+		for _, ret := range returns {
+			query := `INSERT INTO returns (order_id, user_id, return_date, reason_id, base_cost, packaging_cost, packaging_id, total_cost, status_id)
+	            VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	            ON CONFLICT (return_id) DO UPDATE
+	            SET order_id = $1, user_id = $2, return_date = $3, reason_id = $4, base_cost = $5, packaging_cost = $6, packaging_id = $7, total_cost = $8, status_id = $9`
+
+			_, err := tx.Exec(ctx, query, ret.OrderID, ret.UserID, ret.ReturnDate, ret.ReasonID, ret.BaseCost, ret.PackagingCost, ret.PackagingID, ret.TotalCost, ret.StatusID)
+			if err != nil {
+				if rollbackErr := tm.RollbackTransaction(ctx, tx, conn); rollbackErr != nil {
+					return fmt.Errorf("ошибка отката транзакции: %w", rollbackErr)
+				}
+				return fmt.Errorf("ошибка записи возврата: %w", err)
 			}
-			return fmt.Errorf("ошибка записи возврата: %w", err)
 		}
-	}
 
-	if err = tm.CommitTransaction(ctx, tx, conn); err != nil {
-		return fmt.Errorf("ошибка подтверждения транзакции: %w", err)
-	}
+		if err = tm.CommitTransaction(ctx, tx, conn); err != nil {
+			return fmt.Errorf("ошибка подтверждения транзакции: %w", err)
+		}
 
-	return nil
+		return nil
+	})
 }
 
 // FindReturnByOrderID ищет возврат по идентификатору заказа с уровнем изоляции Repeatable Read
 func FindReturnByOrderID(ctx context.Context, orderID int, pool *pgxpool.Pool) (*model.Return, error) {
-	tm := NewTransactionManager(pool)
+	return withRetry(ctx, func() (*model.Return, error) {
+		tm := NewTransactionManager(pool)
 
-	tx, conn, err := tm.BeginTransaction(ctx, pgx.RepeatableRead)
-	if err != nil {
-		return nil, err
-	}
-	defer conn.Release()
+		tx, conn, err := tm.BeginTransaction(ctx, pgx.RepeatableRead)
+		if err != nil {
+			return nil, err
+		}
+		defer conn.Release()
 
-	query := `SELECT return_id, order_id, user_id, return_date, reason_id, base_cost, packaging_cost, packaging_id, total_cost, status_id FROM returns WHERE order_id = $1`
+		query := `SELECT return_id, order_id, user_id, return_date, reason_id, base_cost, packaging_cost, packaging_id, total_cost, status_id FROM returns WHERE order_id = $1`
 
-	row := tx.QueryRow(ctx, query, orderID)
+		row := tx.QueryRow(ctx, query, orderID)
 
-	var ret model.Return
-	err = row.Scan(&ret.ReturnID, &ret.OrderID, &ret.UserID, &ret.ReturnDate, &ret.ReasonID, &ret.BaseCost, &ret.PackagingCost, &ret.PackagingID, &ret.TotalCost, &ret.StatusID)
-	if err != nil {
-		if rollbackErr := tm.RollbackTransaction(ctx, tx, conn); rollbackErr != nil {
-			return nil, fmt.Errorf("ошибка отката транзакции: %w", rollbackErr)
+		var ret model.Return
+		err = row.Scan(&ret.ReturnID, &ret.OrderID, &ret.UserID, &ret.ReturnDate, &ret.ReasonID, &ret.BaseCost, &ret.PackagingCost, &ret.PackagingID, &ret.TotalCost, &ret.StatusID)
+		if err != nil {
+			if rollbackErr := tm.RollbackTransaction(ctx, tx, conn); rollbackErr != nil {
+				return nil, fmt.Errorf("ошибка отката транзакции: %w", rollbackErr)
+			}
+			return nil, fmt.Errorf("возврат с order_id %d не найден: %w", orderID, err)
 		}
-		return nil, fmt.Errorf("возврат с order_id %d не найден: %w", orderID, err)
-	}
 
-	if err = tm.CommitTransaction(ctx, tx, conn); err != nil {
-		return nil, fmt.Errorf("ошибка подтверждения транзакции: %w", err)
-	}
+		if err = tm.CommitTransaction(ctx, tx, conn); err != nil {
+			return nil, fmt.Errorf("ошибка подтверждения транзакции: %w", err)
+		}
 
-	return &ret, nil
+		return &ret, nil
+	})
 }
 
-// DeleteReturn удаляет возврат из базы данных по идентификатору возврата с уровнем изоляции Serializable
-func DeleteReturn(ctx context.Context, returnID int, pool *pgxpool.Pool) error {
-	tm := NewTransactionManager(pool)
+// DeleteReturn удаляет возврат из базы данных по идентификатору возврата с уровнем изоляции Serializable.
+// Хуки выполняются в той же транзакции.
+func DeleteReturn(ctx context.Context, returnID int, pool *pgxpool.Pool, hooks ...TxHook) error {
+	return withRetryErr(ctx, func() error {
+		tm := NewTransactionManager(pool)
+
+		tx, conn, err := tm.BeginTransaction(ctx, pgx.Serializable)
+		if err != nil {
+			return err
+		}
+		defer conn.Release()
 
-	tx, conn, err := tm.BeginTransaction(ctx, pgx.Serializable)
-	if err != nil {
-		return err
-	}
-	defer conn.Release()
+		query := `DELETE FROM returns WHERE return_id = $1`
 
-	query := `DELETE FROM returns WHERE return_id = $1`
+		_, err = tx.Exec(ctx, query, returnID)
+		if err != nil {
+			if rollbackErr := tm.RollbackTransaction(ctx, tx, conn); rollbackErr != nil {
+				return fmt.Errorf("ошибка отката транзакции: %w", rollbackErr)
+			}
+			return fmt.Errorf("ошибка удаления возврата с ID %d: %w", returnID, err)
+		}
 
-	_, err = tx.Exec(ctx, query, returnID)
-	if err != nil {
-		if rollbackErr := tm.RollbackTransaction(ctx, tx, conn); rollbackErr != nil {
-			return fmt.Errorf("ошибка отката транзакции: %w", rollbackErr)
+		if err = runTxHooks(ctx, tx, returnID, hooks); err != nil {
+			_ = tm.RollbackTransaction(ctx, tx, conn)
+			return err
 		}
-		return fmt.Errorf("ошибка удаления возврата с ID %d: %w", returnID, err)
-	}
 
-	if err = tm.CommitTransaction(ctx, tx, conn); err != nil {
-		return fmt.Errorf("ошибка подтверждения транзакции: %w", err)
-	}
+		if err = tm.CommitTransaction(ctx, tx, conn); err != nil {
+			return fmt.Errorf("ошибка подтверждения транзакции: %w", err)
+		}
 
-	return nil
+		return nil
+	})
 }
 
 // FindReturnsByUserID ищет возвраты по идентификатору пользователя с уровнем изоляции Read Committed
 func FindReturnsByUserID(ctx context.Context, userID int, pool *pgxpool.Pool) ([]model.Return, error) {
-	tm := NewTransactionManager(pool)
+	return withRetry(ctx, func() ([]model.Return, error) {
+		tm := NewTransactionManager(pool)
+
+		tx, conn, err := tm.BeginTransaction(ctx, pgx.ReadCommitted)
+		if err != nil {
+			return nil, err
+		}
+		defer conn.Release()
+
+		query := `SELECT return_id, order_id, user_id, return_date, reason_id, base_cost, packaging_cost, packaging_id, total_cost, status_id FROM returns WHERE user_id = $1`
+
+		rows, err := tx.Query(ctx, query, userID)
+		if err != nil {
+			if rollbackErr := tm.RollbackTransaction(ctx, tx, conn); rollbackErr != nil {
+				return nil, fmt.Errorf("ошибка отката транзакции: %w", rollbackErr)
+			}
+			return nil, fmt.Errorf("ошибка поиска возвратов для пользователя с ID %d: %w", userID, err)
+		}
+		defer rows.Close()
+
+		var returns []model.Return
+		for rows.Next() {
+			var ret model.Return
+			err := rows.Scan(&ret.ReturnID, &ret.OrderID, &ret.UserID, &ret.ReturnDate, &ret.ReasonID, &ret.BaseCost, &ret.PackagingCost, &ret.PackagingID, &ret.TotalCost, &ret.StatusID)
+			if err != nil {
+				if rollbackErr := tm.RollbackTransaction(ctx, tx, conn); rollbackErr != nil {
+					return nil, fmt.Errorf("ошибка отката транзакции: %w", rollbackErr)
+				}
+				return nil, fmt.Errorf("ошибка обработки строки: %w", err)
+			}
+			returns = append(returns, ret)
+		}
+
+		if err = tm.CommitTransaction(ctx, tx, conn); err != nil {
+			return nil, fmt.Errorf("ошибка подтверждения транзакции: %w", err)
+		}
 
-	tx, conn, err := tm.BeginTransaction(ctx, pgx.ReadCommitted)
-	if err != nil {
-		return nil, err
-	}
-	defer conn.Release()
+		return returns, nil
+	})
+}
 
-	query := `SELECT return_id, order_id, user_id, return_date, reason_id, base_cost, packaging_cost, packaging_id, total_cost, status_id FROM returns WHERE user_id = $1`
+// ListReturns возвращает не более limit возвратов с ID больше afterID, подходящих под фильтр, в порядке возрастания ID,
+// с уровнем изоляции Read Committed
+func ListReturns(ctx context.Context, filter model.ReturnFilter, afterID, limit int, pool *pgxpool.Pool) ([]model.Return, error) {
+	return withRetry(ctx, func() ([]model.Return, error) {
+		tm := NewTransactionManager(pool)
 
-	rows, err := tx.Query(ctx, query, userID)
-	if err != nil {
-		if rollbackErr := tm.RollbackTransaction(ctx, tx, conn); rollbackErr != nil {
-			return nil, fmt.Errorf("ошибка отката транзакции: %w", rollbackErr)
+		tx, conn, err := tm.BeginTransaction(ctx, pgx.ReadCommitted)
+		if err != nil {
+			return nil, err
 		}
-		return nil, fmt.Errorf("ошибка поиска возвратов для пользователя с ID %d: %w", userID, err)
-	}
-	defer rows.Close()
+		defer conn.Release()
 
-	var returns []model.Return
-	for rows.Next() {
-		var ret model.Return
-		err := rows.Scan(&ret.ReturnID, &ret.OrderID, &ret.UserID, &ret.ReturnDate, &ret.ReasonID, &ret.BaseCost, &ret.PackagingCost, &ret.PackagingID, &ret.TotalCost, &ret.StatusID)
+		conds := returnConditions(filter, afterID)
+		query := fmt.Sprintf(`SELECT return_id, order_id, user_id, return_date, reason_id, base_cost, packaging_cost, packaging_id, total_cost, status_id FROM returns WHERE %s ORDER BY return_id LIMIT %d`,
+			conds.where(), limit)
+
+		rows, err := tx.Query(ctx, query, conds.args...)
 		if err != nil {
 			if rollbackErr := tm.RollbackTransaction(ctx, tx, conn); rollbackErr != nil {
 				return nil, fmt.Errorf("ошибка отката транзакции: %w", rollbackErr)
 			}
-			return nil, fmt.Errorf("ошибка обработки строки: %w", err)
+			return nil, fmt.Errorf("ошибка получения страницы возвратов: %w", err)
+		}
+		defer rows.Close()
+
+		returns := make([]model.Return, 0, limit)
+		for rows.Next() {
+			var ret model.Return
+			err := rows.Scan(&ret.ReturnID, &ret.OrderID, &ret.UserID, &ret.ReturnDate, &ret.ReasonID, &ret.BaseCost, &ret.PackagingCost, &ret.PackagingID, &ret.TotalCost, &ret.StatusID)
+			if err != nil {
+				if rollbackErr := tm.RollbackTransaction(ctx, tx, conn); rollbackErr != nil {
+					return nil, fmt.Errorf("ошибка отката транзакции: %w", rollbackErr)
+				}
+				return nil, fmt.Errorf("ошибка обработки строки: %w", err)
+			}
+			returns = append(returns, ret)
+		}
+		if err = rows.Err(); err != nil {
+			_ = tm.RollbackTransaction(ctx, tx, conn)
+			return nil, fmt.Errorf("ошибка итерации по строкам возвратов: %w", err)
 		}
-		returns = append(returns, ret)
-	}
 
-	if err = tm.CommitTransaction(ctx, tx, conn); err != nil {
-		return nil, fmt.Errorf("ошибка подтверждения транзакции: %w", err)
-	}
+		if err = tm.CommitTransaction(ctx, tx, conn); err != nil {
+			return nil, fmt.Errorf("ошибка подтверждения транзакции: %w", err)
+		}
 
-	return returns, nil
+		return returns, nil
+	})
 }
 
 // FindExpiredReturns ищет возвраты, которые не были завершены курьером в установленные сроки с уровнем изоляции Serializable
 func FindExpiredReturns(ctx context.Context, pool *pgxpool.Pool) ([]model.Return, error) {
-	tm := NewTransactionManager(pool)
-
-	tx, conn, err := tm.BeginTransaction(ctx, pgx.Serializable)
-	if err != nil {
-		return nil, err
-	}
-	defer conn.Release()
+	return withRetry(ctx, func() ([]model.Return, error) {
+		tm := NewTransactionManager(pool)
 
-	query := `SELECT return_id, order_id, user_id, return_date, reason_id, base_cost, packaging_cost, packaging_id, total_cost, status_id 
-              FROM returns WHERE return_date < $1 AND status_id != $2`
-
-	rows, err := tx.Query(ctx, query, time.Now(), 4) // Assuming status ID 4 means "Completed"
-	if err != nil {
-		if rollbackErr := tm.RollbackTransaction(ctx, tx, conn); rollbackErr != nil {
-			return nil, fmt.Errorf("ошибка отката транзакции: %w", rollbackErr)
+		tx, conn, err := tm.BeginTransaction(ctx, pgx.Serializable)
+		if err != nil {
+			return nil, err
 		}
-		return nil, fmt.Errorf("ошибка поиска истекших возвратов: %w", err)
-	}
-	defer rows.Close()
+		defer conn.Release()
 
-	var expiredReturns []model.Return
-	for rows.Next() {
-		var ret model.Return
-		err := rows.Scan(&ret.ReturnID, &ret.OrderID, &ret.UserID, &ret.ReturnDate, &ret.ReasonID, &ret.BaseCost, &ret.PackagingCost, &ret.PackagingID, &ret.TotalCost, &ret.StatusID)
+		query := `SELECT return_id, order_id, user_id, return_date, reason_id, base_cost, packaging_cost, packaging_id, total_cost, status_id 
+	              FROM returns WHERE return_date < $1 AND status_id != $2`
+
+		rows, err := tx.Query(ctx, query, time.Now(), 4) // Assuming status ID 4 means "Completed"
 		if err != nil {
 			if rollbackErr := tm.RollbackTransaction(ctx, tx, conn); rollbackErr != nil {
 				return nil, fmt.Errorf("ошибка отката транзакции: %w", rollbackErr)
 			}
-			return nil, fmt.Errorf("ошибка обработки строки: %w", err)
+			return nil, fmt.Errorf("ошибка поиска истекших возвратов: %w", err)
+		}
+		defer rows.Close()
+
+		var expiredReturns []model.Return
+		for rows.Next() {
+			var ret model.Return
+			err := rows.Scan(&ret.ReturnID, &ret.OrderID, &ret.UserID, &ret.ReturnDate, &ret.ReasonID, &ret.BaseCost, &ret.PackagingCost, &ret.PackagingID, &ret.TotalCost, &ret.StatusID)
+			if err != nil {
+				if rollbackErr := tm.RollbackTransaction(ctx, tx, conn); rollbackErr != nil {
+					return nil, fmt.Errorf("ошибка отката транзакции: %w", rollbackErr)
+				}
+				return nil, fmt.Errorf("ошибка обработки строки: %w", err)
+			}
+			expiredReturns = append(expiredReturns, ret)
 		}
-		expiredReturns = append(expiredReturns, ret)
-	}
 
-	if err = tm.CommitTransaction(ctx, tx, conn); err != nil {
-		return nil, fmt.Errorf("ошибка подтверждения транзакции: %w", err)
-	}
+		if err = tm.CommitTransaction(ctx, tx, conn); err != nil {
+			return nil, fmt.Errorf("ошибка подтверждения транзакции: %w", err)
+		}
 
-	return expiredReturns, nil
+		return expiredReturns, nil
+	})
 }
 
-// UpdateReturn обновляет данные о возврате в базе данных с уровнем изоляции Read Committed
-func UpdateReturn(ctx context.Context, ret model.Return, pool *pgxpool.Pool) error {
-	tm := NewTransactionManager(pool)
-
-	tx, conn, err := tm.BeginTransaction(ctx, pgx.ReadCommitted)
-	if err != nil {
-		return err
-	}
-	defer conn.Release()
-
-	_, err = tx.Exec(ctx,
-		`UPDATE returns 
-		SET order_id = $1, user_id = $2, return_date = $3, reason_id = $4, base_cost = $5, packaging_cost = $6, packaging_id = $7, total_cost = $8, status_id = $9
-		WHERE return_id = $10`,
-		ret.OrderID, ret.UserID, ret.ReturnDate, ret.ReasonID, ret.BaseCost, ret.PackagingCost, ret.PackagingID, ret.TotalCost, ret.StatusID, ret.ReturnID)
-	if err != nil {
-		_ = tm.RollbackTransaction(ctx, tx, conn)
-		return fmt.Errorf("ошибка обновления возврата с ID %d: %w", ret.ReturnID, err)
-	}
-
-	if err = tm.CommitTransaction(ctx, tx, conn); err != nil {
-		return fmt.Errorf("ошибка подтверждения транзакции: %w", err)
-	}
-
-	return nil
+// UpdateReturn обновляет данные о возврате в базе данных с уровнем изоляции Read Committed.
+// Хуки выполняются в той же транзакции.
+func UpdateReturn(ctx context.Context, ret model.Return, pool *pgxpool.Pool, hooks ...TxHook) error {
+	return withRetryErr(ctx, func() error {
+		tm := NewTransactionManager(pool)
+
+		tx, conn, err := tm.BeginTransaction(ctx, pgx.ReadCommitted)
+		if err != nil {
+			return err
+		}
+		defer conn.Release()
+
+		_, err = tx.Exec(ctx,
+			`UPDATE returns 
+			SET order_id = $1, user_id = $2, return_date = $3, reason_id = $4, base_cost = $5, packaging_cost = $6, packaging_id = $7, total_cost = $8, status_id = $9
+			WHERE return_id = $10`,
+			ret.OrderID, ret.UserID, ret.ReturnDate, ret.ReasonID, ret.BaseCost, ret.PackagingCost, ret.PackagingID, ret.TotalCost, ret.StatusID, ret.ReturnID)
+		if err != nil {
+			_ = tm.RollbackTransaction(ctx, tx, conn)
+			return fmt.Errorf("ошибка обновления возврата с ID %d: %w", ret.ReturnID, err)
+		}
+
+		if err = runTxHooks(ctx, tx, ret.ReturnID, hooks); err != nil {
+			_ = tm.RollbackTransaction(ctx, tx, conn)
+			return err
+		}
+
+		if err = tm.CommitTransaction(ctx, tx, conn); err != nil {
+			return fmt.Errorf("ошибка подтверждения транзакции: %w", err)
+		}
+
+		return nil
+	})
 }
 
 // CreateReturn создает новый возврат на основе данных заказа с уровнем изоляции Read Committed
 func CreateReturn(ctx context.Context, newReturn model.Return, pool *pgxpool.Pool) error {
-	tm := NewTransactionManager(pool)
+	return withRetryErr(ctx, func() error {
+		tm := NewTransactionManager(pool)
 
-	tx, conn, err := tm.BeginTransaction(ctx, pgx.ReadCommitted)
-	if err != nil {
-		return err
-	}
-	defer conn.Release()
+		tx, conn, err := tm.BeginTransaction(ctx, pgx.ReadCommitted)
+		if err != nil {
+			return err
+		}
+		defer conn.Release()
+
+		_, err = tx.Exec(ctx,
+			`INSERT INTO returns (order_id, user_id, return_date, reason_id, base_cost, packaging_cost, packaging_id, total_cost, status_id)
+	         VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`,
+			newReturn.OrderID, newReturn.UserID, newReturn.ReturnDate, newReturn.ReasonID, newReturn.BaseCost, newReturn.PackagingCost, newReturn.PackagingID, newReturn.TotalCost, newReturn.StatusID)
+
+		if err != nil {
+			_ = tm.RollbackTransaction(ctx, tx, conn)
+			return fmt.Errorf("ошибка вставки возврата в базу данных: %w", err)
+		}
+
+		if err = tm.CommitTransaction(ctx, tx, conn); err != nil {
+			return fmt.Errorf("ошибка подтверждения транзакции: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// GetReturnByID возвращает возврат по его идентификатору с уровнем изоляции Read Committed
+func GetReturnByID(ctx context.Context, returnID int, pool *pgxpool.Pool) (*model.Return, error) {
+	return withRetry(ctx, func() (*model.Return, error) {
+		tm := NewTransactionManager(pool)
+
+		tx, conn, err := tm.BeginTransaction(ctx, pgx.ReadCommitted)
+		if err != nil {
+			return nil, err
+		}
+		defer conn.Release()
 
-	_, err = tx.Exec(ctx,
-		`INSERT INTO returns (order_id, user_id, return_date, reason_id, base_cost, packaging_cost, packaging_id, total_cost, status_id)
-         VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`,
-		newReturn.OrderID, newReturn.UserID, newReturn.ReturnDate, newReturn.ReasonID, newReturn.BaseCost, newReturn.PackagingCost, newReturn.PackagingID, newReturn.TotalCost, newReturn.StatusID)
+		query := `SELECT return_id, order_id, user_id, return_date, reason_id, base_cost, packaging_cost, packaging_id, total_cost, status_id FROM returns WHERE return_id = $1`
 
-	if err != nil {
-		_ = tm.RollbackTransaction(ctx, tx, conn)
-		return fmt.Errorf("ошибка вставки возврата в базу данных: %w", err)
-	}
+		var ret model.Return
+		err = tx.QueryRow(ctx, query, returnID).
+			Scan(&ret.ReturnID, &ret.OrderID, &ret.UserID, &ret.ReturnDate, &ret.ReasonID, &ret.BaseCost, &ret.PackagingCost, &ret.PackagingID, &ret.TotalCost, &ret.StatusID)
+		if err != nil {
+			_ = tm.RollbackTransaction(ctx, tx, conn)
+			return nil, fmt.Errorf("возврат с ID %d не найден: %w", returnID, err)
+		}
 
-	if err = tm.CommitTransaction(ctx, tx, conn); err != nil {
-		return fmt.Errorf("ошибка подтверждения транзакции: %w", err)
-	}
+		if err = tm.CommitTransaction(ctx, tx, conn); err != nil {
+			return nil, fmt.Errorf("ошибка подтверждения транзакции: %w", err)
+		}
 
-	return nil
+		return &ret, nil
+	})
 }
 
 // CheckReturnExists проверяет, существует ли возврат с данным returnID
 func CheckReturnExists(ctx context.Context, returnID int, pool *pgxpool.Pool) (bool, error) {
-	tm := NewTransactionManager(pool)
+	return withRetry(ctx, func() (bool, error) {
+		tm := NewTransactionManager(pool)
 
-	tx, conn, err := tm.BeginTransaction(ctx, pgx.ReadCommitted)
-	if err != nil {
-		return false, err
-	}
-	defer conn.Release()
+		tx, conn, err := tm.BeginTransaction(ctx, pgx.ReadCommitted)
+		if err != nil {
+			return false, err
+		}
+		defer conn.Release()
 
-	var exists bool
-	query := `SELECT EXISTS(SELECT 1 FROM returns WHERE return_id = $1)`
-	err = tx.QueryRow(ctx, query, returnID).Scan(&exists)
-	if err != nil {
-		if rollbackErr := tm.RollbackTransaction(ctx, tx, conn); rollbackErr != nil {
-			return false, fmt.Errorf("ошибка отката транзакции: %w", rollbackErr)
+		var exists bool
+		query := `SELECT EXISTS(SELECT 1 FROM returns WHERE return_id = $1)`
+		err = tx.QueryRow(ctx, query, returnID).Scan(&exists)
+		if err != nil {
+			if rollbackErr := tm.RollbackTransaction(ctx, tx, conn); rollbackErr != nil {
+				return false, fmt.Errorf("ошибка отката транзакции: %w", rollbackErr)
+			}
+			return false, fmt.Errorf("ошибка проверки существования возврата с ID %d: %w", returnID, err)
 		}
-		return false, fmt.Errorf("ошибка проверки существования возврата с ID %d: %w", returnID, err)
-	}
 
-	if err = tm.CommitTransaction(ctx, tx, conn); err != nil {
-		return false, fmt.Errorf("ошибка подтверждения транзакции: %w", err)
-	}
+		if err = tm.CommitTransaction(ctx, tx, conn); err != nil {
+			return false, fmt.Errorf("ошибка подтверждения транзакции: %w", err)
+		}
 
-	return exists, nil
+		return exists, nil
+	})
 }
@@ -8,17 +8,45 @@ import (
 )
 
 // TransactionManager управляет транзакциями через пул соединений.
+// Если в контексте есть транзакция единицы работы (см. RunInTx), новые транзакции присоединяются к ней
+// как точки сохранения, а не открываются на отдельном соединении.
 type TransactionManager struct {
 	pool *pgxpool.Pool
 }
 
+// TxConn - соединение, на котором выполняется транзакция.
+// Для транзакции, присоединенной к единице работы, соединением владеет единица работы, и Release ничего не делает.
+type TxConn struct {
+	conn *pgxpool.Conn
+}
+
+// Release возвращает соединение в пул. Повторный вызов ничего не делает.
+func (c *TxConn) Release() {
+	if c.conn != nil {
+		c.conn.Release()
+		c.conn = nil
+	}
+}
+
+// txKey - ключ контекста для транзакции единицы работы
+type txKey struct{}
+
 // NewTransactionManager создает новый менеджер транзакций с использованием пула соединений.
 func NewTransactionManager(pool *pgxpool.Pool) *TransactionManager {
 	return &TransactionManager{pool: pool}
 }
 
 // BeginTransaction начинает новую транзакцию с заданным уровнем изоляции.
-func (tm *TransactionManager) BeginTransaction(ctx context.Context, isoLevel pgx.TxIsoLevel) (pgx.Tx, *pgxpool.Conn, error) {
+// Внутри единицы работы открывается точка сохранения в ее транзакции, а уровень изоляции определяет единица работы.
+func (tm *TransactionManager) BeginTransaction(ctx context.Context, isoLevel pgx.TxIsoLevel) (pgx.Tx, *TxConn, error) {
+	if outer, ok := ctx.Value(txKey{}).(pgx.Tx); ok {
+		tx, err := outer.Begin(ctx)
+		if err != nil {
+			return nil, nil, fmt.Errorf("ошибка создания точки сохранения: %w", err)
+		}
+		return tx, &TxConn{}, nil
+	}
+
 	conn, err := tm.pool.Acquire(ctx)
 	if err != nil {
 		return nil, nil, fmt.Errorf("ошибка получения соединения из пула: %w", err)
@@ -33,11 +61,11 @@ func (tm *TransactionManager) BeginTransaction(ctx context.Context, isoLevel pgx
 		return nil, nil, fmt.Errorf("ошибка начала транзакции: %w", err)
 	}
 
-	return tx, conn, nil
+	return tx, &TxConn{conn: conn}, nil
 }
 
 // CommitTransaction подтверждает транзакцию и освобождает соединение обратно в пул.
-func (tm *TransactionManager) CommitTransaction(ctx context.Context, tx pgx.Tx, conn *pgxpool.Conn) error {
+func (tm *TransactionManager) CommitTransaction(ctx context.Context, tx pgx.Tx, conn *TxConn) error {
 	if err := tx.Commit(ctx); err != nil {
 		return fmt.Errorf("ошибка подтверждения транзакции: %w", err)
 	}
@@ -46,10 +74,51 @@ func (tm *TransactionManager) CommitTransaction(ctx context.Context, tx pgx.Tx,
 }
 
 // RollbackTransaction откатывает транзакцию и освобождает соединение обратно в пул.
-func (tm *TransactionManager) RollbackTransaction(ctx context.Context, tx pgx.Tx, conn *pgxpool.Conn) error {
+func (tm *TransactionManager) RollbackTransaction(ctx context.Context, tx pgx.Tx, conn *TxConn) error {
 	if err := tx.Rollback(ctx); err != nil {
 		return fmt.Errorf("ошибка отката транзакции: %w", err)
 	}
 	conn.Release()
 	return nil
 }
+
+// RunInTx выполняет fn как единицу работы в одной транзакции с уровнем изоляции isoLevel.
+// Функции dao, вызванные с контекстом, переданным в fn, присоединяются к этой транзакции.
+// Транзакция подтверждается, если fn завершилась без ошибки, и откатывается в противном случае, в том числе при панике.
+// Если единица работы уже открыта в ctx, fn выполняется в точке сохранения ее транзакции.
+// Транзакция не рассчитана на параллельное использование: вызовы dao внутри fn должны выполняться последовательно.
+// При конфликте сериализации или взаимоблокировке единица работы повторяется целиком, поэтому fn может вызываться
+// несколько раз и не должна иметь побочных эффектов вне транзакции.
+func RunInTx(ctx context.Context, pool *pgxpool.Pool, isoLevel pgx.TxIsoLevel, fn func(ctx context.Context) error) error {
+	return withRetryErr(ctx, func() error {
+		return runInTx(ctx, pool, isoLevel, fn)
+	})
+}
+
+// runInTx выполняет одну попытку единицы работы
+func runInTx(ctx context.Context, pool *pgxpool.Pool, isoLevel pgx.TxIsoLevel, fn func(ctx context.Context) error) (err error) {
+	tm := NewTransactionManager(pool)
+
+	tx, conn, err := tm.BeginTransaction(ctx, isoLevel)
+	if err != nil {
+		return err
+	}
+	defer conn.Release()
+
+	committed := false
+	defer func() {
+		if !committed {
+			_ = tx.Rollback(ctx)
+		}
+	}()
+
+	if err = fn(context.WithValue(ctx, txKey{}, tx)); err != nil {
+		return err
+	}
+
+	committed = true
+	if err = tm.CommitTransaction(ctx, tx, conn); err != nil {
+		return fmt.Errorf("ошибка подтверждения транзакции: %w", err)
+	}
+	return nil
+}
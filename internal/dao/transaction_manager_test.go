@@ -0,0 +1,78 @@
+package dao_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/stretchr/testify/assert"
+	"homework1/internal/dao"
+	"homework1/internal/model"
+)
+
+// testUnitOfWorkOrder возвращает заказ для тестов единицы работы
+func testUnitOfWorkOrder() model.Order {
+	return model.Order{
+		UserID:         1,
+		AcceptanceDate: time.Now(),
+		ExpirationDate: time.Now().Add(24 * time.Hour),
+		Weight:         1.0,
+		BaseCost:       10.0,
+		PackagingCost:  1.0,
+		TotalCost:      11.0,
+		PackagingID:    1,
+		StatusID:       1,
+	}
+}
+
+// Тест отката всех вызовов DAO единицы работы при ошибке
+func TestRunInTxRollback(t *testing.T) {
+	ctx := context.Background()
+	errAbort := errors.New("отмена единицы работы")
+
+	var orderID int
+	err := dao.RunInTx(ctx, testDB, pgx.ReadCommitted, func(ctx context.Context) error {
+		var err error
+		orderID, err = dao.CreateOrder(ctx, testUnitOfWorkOrder(), testDB)
+		assert.NoError(t, err, "ошибка при создании заказа")
+
+		_, err = dao.GetOrderByID(ctx, orderID, testDB)
+		assert.NoError(t, err, "заказ должен быть виден внутри единицы работы")
+		return errAbort
+	})
+	assert.ErrorIs(t, err, errAbort)
+
+	_, err = dao.GetOrderByID(ctx, orderID, testDB)
+	assert.Error(t, err, "заказ из отмененной единицы работы не должен сохраниться")
+}
+
+// Тест подтверждения единицы работы и отката вложенной единицы работы
+func TestRunInTxCommitWithNested(t *testing.T) {
+	ctx := context.Background()
+
+	var committedID, nestedID int
+	err := dao.RunInTx(ctx, testDB, pgx.RepeatableRead, func(ctx context.Context) error {
+		var err error
+		committedID, err = dao.CreateOrder(ctx, testUnitOfWorkOrder(), testDB)
+		if err != nil {
+			return err
+		}
+
+		nestedErr := dao.RunInTx(ctx, testDB, pgx.RepeatableRead, func(ctx context.Context) error {
+			nestedID, err = dao.CreateOrder(ctx, testUnitOfWorkOrder(), testDB)
+			assert.NoError(t, err, "ошибка при создании заказа во вложенной единице работы")
+			return errors.New("ошибка вложенной единицы работы")
+		})
+		assert.Error(t, nestedErr)
+		return nil
+	})
+	assert.NoError(t, err, "ошибка при подтверждении единицы работы")
+
+	_, err = dao.GetOrderByID(ctx, committedID, testDB)
+	assert.NoError(t, err, "заказ подтвержденной единицы работы должен сохраниться")
+
+	_, err = dao.GetOrderByID(ctx, nestedID, testDB)
+	assert.Error(t, err, "заказ откаченной вложенной единицы работы не должен сохраниться")
+}
@@ -0,0 +1,36 @@
+package dao
+
+import (
+	"context"
+	"github.com/jackc/pgx/v4"
+	"homework1/internal/model"
+)
+
+// TxHook выполняет дополнительную запись в транзакции основной операции DAO.
+// entityID - идентификатор изменяемой записи; для вставок это идентификатор созданной записи.
+type TxHook func(ctx context.Context, tx pgx.Tx, entityID int) error
+
+// runTxHooks последовательно выполняет хуки в рамках транзакции
+func runTxHooks(ctx context.Context, tx pgx.Tx, entityID int, hooks []TxHook) error {
+	for _, hook := range hooks {
+		if err := hook(ctx, tx, entityID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WithStatusHistory сохраняет записи истории статусов заказа в транзакции основной операции.
+// Для записей без OrderID используется идентификатор изменяемого заказа.
+func WithStatusHistory(changes ...model.OrderStatusChange) TxHook {
+	return func(ctx context.Context, tx pgx.Tx, entityID int) error {
+		history := make([]model.OrderStatusChange, len(changes))
+		copy(history, changes)
+		for i := range history {
+			if history[i].OrderID == 0 {
+				history[i].OrderID = entityID
+			}
+		}
+		return insertOrderStatusHistory(ctx, tx, history)
+	}
+}
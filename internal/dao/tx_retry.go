@@ -0,0 +1,130 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+)
+
+// Коды SQLSTATE конфликтов транзакций, после которых транзакцию можно безопасно повторить
+const (
+	sqlStateSerializationFailure = "40001"
+	sqlStateDeadlockDetected     = "40P01"
+)
+
+// RetryPolicy задает повтор транзакций, прерванных из-за конфликта сериализации или взаимоблокировки
+type RetryPolicy struct {
+	MaxAttempts int           // Максимальное количество попыток, включая первую
+	BaseDelay   time.Duration // Задержка перед первым повтором; удваивается с каждой попыткой
+	MaxDelay    time.Duration // Максимальная задержка перед повтором
+}
+
+// DefaultRetryPolicy - политика повтора транзакций по умолчанию
+var DefaultRetryPolicy = RetryPolicy{MaxAttempts: 3, BaseDelay: 10 * time.Millisecond, MaxDelay: 200 * time.Millisecond}
+
+// TxRetryStats - счетчики повторов транзакций
+type TxRetryStats struct {
+	SerializationRetries uint64 // Повторы после ошибки сериализации (40001)
+	DeadlockRetries      uint64 // Повторы после взаимоблокировки (40P01)
+	Exhausted            uint64 // Транзакции, не выполненные после всех попыток
+}
+
+var (
+	retryPolicyMu sync.RWMutex
+	retryPolicy   = DefaultRetryPolicy
+
+	serializationRetries atomic.Uint64
+	deadlockRetries      atomic.Uint64
+	retriesExhausted     atomic.Uint64
+)
+
+// SetRetryPolicy задает политику повтора транзакций для всех функций DAO
+func SetRetryPolicy(policy RetryPolicy) {
+	if policy.MaxAttempts < 1 {
+		policy.MaxAttempts = 1
+	}
+
+	retryPolicyMu.Lock()
+	defer retryPolicyMu.Unlock()
+	retryPolicy = policy
+}
+
+// GetTxRetryStats возвращает счетчики повторов транзакций
+func GetTxRetryStats() TxRetryStats {
+	return TxRetryStats{
+		SerializationRetries: serializationRetries.Load(),
+		DeadlockRetries:      deadlockRetries.Load(),
+		Exhausted:            retriesExhausted.Load(),
+	}
+}
+
+// IsTxConflict проверяет, прервана ли транзакция из-за конфликта сериализации или взаимоблокировки
+func IsTxConflict(err error) bool {
+	return txConflictCode(err) != ""
+}
+
+// txConflictCode возвращает SQLSTATE конфликта транзакций или пустую строку для остальных ошибок
+func txConflictCode(err error) string {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && (pgErr.Code == sqlStateSerializationFailure || pgErr.Code == sqlStateDeadlockDetected) {
+		return pgErr.Code
+	}
+	return ""
+}
+
+// withRetry выполняет транзакцию fn и повторяет ее с экспоненциальной задержкой со случайным разбросом,
+// если она прервана из-за конфликта сериализации или взаимоблокировки.
+// Внутри единицы работы fn выполняется один раз: повторить можно только всю единицу работы целиком.
+func withRetry[T any](ctx context.Context, fn func() (T, error)) (T, error) {
+	if _, ok := ctx.Value(txKey{}).(pgx.Tx); ok {
+		return fn()
+	}
+
+	retryPolicyMu.RLock()
+	policy := retryPolicy
+	retryPolicyMu.RUnlock()
+
+	delay := policy.BaseDelay
+	for attempt := 1; ; attempt++ {
+		result, err := fn()
+		code := txConflictCode(err)
+		if code == "" {
+			return result, err
+		}
+		if attempt >= policy.MaxAttempts {
+			retriesExhausted.Add(1)
+			return result, err
+		}
+
+		if code == sqlStateDeadlockDetected {
+			deadlockRetries.Add(1)
+		} else {
+			serializationRetries.Add(1)
+		}
+
+		// Случайный разброс не дает конфликтующим транзакциям повторяться одновременно
+		wait := delay/2 + time.Duration(rand.Int63n(int64(delay/2)+1))
+		select {
+		case <-time.After(wait):
+		case <-ctx.Done():
+			return result, err
+		}
+		if delay *= 2; delay > policy.MaxDelay {
+			delay = policy.MaxDelay
+		}
+	}
+}
+
+// withRetryErr выполняет транзакцию fn без результата с повтором при конфликте
+func withRetryErr(ctx context.Context, fn func() error) error {
+	_, err := withRetry(ctx, func() (struct{}, error) {
+		return struct{}{}, fn()
+	})
+	return err
+}
@@ -0,0 +1,69 @@
+package dao_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/stretchr/testify/assert"
+	"homework1/internal/dao"
+)
+
+// conflictingUpdate обновляет заказ внутри единицы работы после того, как его изменила параллельная транзакция,
+// что приводит к ошибке сериализации на уровне Repeatable Read
+func conflictingUpdate(ctx context.Context, t *testing.T, orderID int, concurrent bool) error {
+	order, err := dao.GetOrderByID(ctx, orderID, testDB)
+	if err != nil {
+		return err
+	}
+
+	if concurrent {
+		other := *order
+		other.Weight++
+		assert.NoError(t, dao.UpdateOrder(context.Background(), other, testDB), "ошибка параллельного обновления заказа")
+	}
+
+	order.TotalCost++
+	return dao.UpdateOrder(ctx, *order, testDB)
+}
+
+// Тест повтора единицы работы после ошибки сериализации
+func TestRunInTxRetriesSerializationFailure(t *testing.T) {
+	ctx := context.Background()
+	dao.SetRetryPolicy(dao.RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond, MaxDelay: 5 * time.Millisecond})
+	defer dao.SetRetryPolicy(dao.DefaultRetryPolicy)
+
+	orderID, err := dao.CreateOrder(ctx, testUnitOfWorkOrder(), testDB)
+	assert.NoError(t, err, "ошибка при создании заказа")
+
+	before := dao.GetTxRetryStats()
+	attempts := 0
+	err = dao.RunInTx(ctx, testDB, pgx.RepeatableRead, func(ctx context.Context) error {
+		attempts++
+		return conflictingUpdate(ctx, t, orderID, attempts == 1)
+	})
+	assert.NoError(t, err, "единица работы должна выполниться после повтора")
+	assert.Equal(t, 2, attempts, "единица работы должна быть повторена один раз")
+	assert.Equal(t, before.SerializationRetries+1, dao.GetTxRetryStats().SerializationRetries, "повтор должен быть учтен")
+}
+
+// Тест ошибки после исчерпания попыток
+func TestRunInTxRetriesExhausted(t *testing.T) {
+	ctx := context.Background()
+	dao.SetRetryPolicy(dao.RetryPolicy{MaxAttempts: 2, BaseDelay: time.Millisecond, MaxDelay: 5 * time.Millisecond})
+	defer dao.SetRetryPolicy(dao.DefaultRetryPolicy)
+
+	orderID, err := dao.CreateOrder(ctx, testUnitOfWorkOrder(), testDB)
+	assert.NoError(t, err, "ошибка при создании заказа")
+
+	before := dao.GetTxRetryStats()
+	attempts := 0
+	err = dao.RunInTx(ctx, testDB, pgx.RepeatableRead, func(ctx context.Context) error {
+		attempts++
+		return conflictingUpdate(ctx, t, orderID, true)
+	})
+	assert.True(t, dao.IsTxConflict(err), "ожидалась ошибка конфликта транзакций, получено: %v", err)
+	assert.Equal(t, 2, attempts, "количество попыток должно быть ограничено политикой")
+	assert.Equal(t, before.Exhausted+1, dao.GetTxRetryStats().Exhausted, "исчерпание попыток должно быть учтено")
+}
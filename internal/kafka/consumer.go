@@ -2,16 +2,43 @@ package kafka
 
 import (
 	"context"
-	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
 	"time"
 
 	"github.com/IBM/sarama"
+	eventsv1 "homework1/internal/api/events/v1"
 )
 
-// NotifierHandler представляет обработчик для consumer группы
-type NotifierHandler struct{}
+// EventProcessor выполняет побочные эффекты обработки события (например, отправку уведомлений)
+type EventProcessor interface {
+	Process(ctx context.Context, event *eventsv1.OrderEvent) error
+}
+
+// NotifierHandler представляет обработчик для consumer группы.
+// Сообщение, которое не удалось обработать после всех повторных попыток, переносится в dead-letter топик.
+// Событие занимается в processed до обработки, поэтому повторно доставленное сообщение не обрабатывается дважды.
+type NotifierHandler struct {
+	retry        RetryPolicy
+	dlq          *DeadLetterQueue
+	processor    EventProcessor
+	processed    ProcessedEventStore
+	drainTimeout time.Duration
+}
+
+// NewNotifierHandler создает обработчик с политикой повторов, dead-letter топиком, обработчиком событий
+// и хранилищем обработанных событий. Если processed равен nil, повторные сообщения не отсеиваются.
+// drainTimeout ограничивает дообработку текущего сообщения после завершения сессии; 0 - без ограничения.
+func NewNotifierHandler(retry RetryPolicy, dlq *DeadLetterQueue, processor EventProcessor, processed ProcessedEventStore, drainTimeout time.Duration) NotifierHandler {
+	return NotifierHandler{
+		retry:        retry,
+		dlq:          dlq,
+		processor:    processor,
+		processed:    processed,
+		drainTimeout: drainTimeout,
+	}
+}
 
 // Setup вызывается при запуске consumer группы
 func (NotifierHandler) Setup(_ sarama.ConsumerGroupSession) error {
@@ -19,75 +46,174 @@ func (NotifierHandler) Setup(_ sarama.ConsumerGroupSession) error {
 	return nil
 }
 
-// Cleanup вызывается при завершении работы consumer группы
-func (NotifierHandler) Cleanup(_ sarama.ConsumerGroupSession) error {
-	log.Println("Consumer группа завершила работу")
+// Cleanup вызывается при завершении сессии (ребалансировка или остановка) и фиксирует отмеченные смещения
+func (NotifierHandler) Cleanup(sess sarama.ConsumerGroupSession) error {
+	sess.Commit()
+	log.Println("Сессия consumer группы завершена, смещения зафиксированы")
 	return nil
 }
 
-// ConsumeClaim отвечает за обработку сообщений из Kafka
-func (NotifierHandler) ConsumeClaim(sess sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
-	for message := range claim.Messages() {
+// ConsumeClaim отвечает за обработку сообщений из Kafka.
+// Смещение фиксируется только после успешной обработки или переноса сообщения в DLQ;
+// если перенос не удался, обработка раздела прекращается, чтобы не зафиксировать смещение за потерянным сообщением.
+// При завершении сессии новые сообщения не берутся, а текущее дообрабатывается не дольше drainTimeout
+// и отмечается; не успевшее обработаться сообщение не отмечается и будет доставлено повторно.
+func (h NotifierHandler) ConsumeClaim(sess sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
+	for {
+		// Завершение сессии проверяется первым, чтобы не взять новое сообщение из уже заполненного канала
+		if sess.Context().Err() != nil {
+			return nil
+		}
+
+		var message *sarama.ConsumerMessage
+		select {
+		case msg, ok := <-claim.Messages():
+			if !ok {
+				return nil
+			}
+			message = msg
+		case <-sess.Context().Done():
+			return nil
+		}
+
 		log.Printf("Получено сообщение: Key = %s, Topic = %s, Partition = %d, Offset = %d",
 			string(message.Key), message.Topic, message.Partition, message.Offset)
 
-		err := processMessage(message)
+		ctx, cancel := h.messageContext(sess.Context())
+		attempts, err := h.processWithRetry(ctx, message)
+		interrupted := ctx.Err() != nil
+		cancel()
 		if err != nil {
-			log.Printf("Ошибка при обработке сообщения с Offset = %d: %v. Начинаем повторные попытки.", message.Offset, err)
-
-			for i := 1; i <= 3; i++ {
-				time.Sleep(2 * time.Second)
-				log.Printf("Повторная попытка %d для сообщения с Offset = %d", i, message.Offset)
-				err = processMessage(message)
-				if err == nil {
-					log.Printf("Сообщение успешно обработано после %d попытки. Offset = %d", i, message.Offset)
-					break
-				}
-				log.Printf("Ошибка при повторной обработке сообщения с Offset = %d на %d попытке: %v", message.Offset, i, err)
+			if interrupted {
+				log.Printf("Обработка сообщения с Offset = %d не завершилась за %s после завершения сессии", message.Offset, h.drainTimeout)
+				return nil
 			}
 
-			if err != nil {
-				log.Printf("Сообщение не удалось обработать после 3 попыток. Offset = %d. Пропускаем сообщение.", message.Offset)
-				continue
+			log.Printf("Сообщение не удалось обработать после %d попыток. Offset = %d. Переносим в DLQ.", attempts, message.Offset)
+			if dlqErr := h.dlq.Send(message, err, attempts); dlqErr != nil {
+				return fmt.Errorf("ошибка переноса сообщения с Offset = %d в DLQ: %w", message.Offset, dlqErr)
 			}
 		}
 
 		sess.MarkMessage(message, "")
 		sess.Commit()
 
-		log.Printf("Смещение зафиксировано и сообщение с Offset = %d успешно обработано", message.Offset)
+		log.Printf("Смещение зафиксировано для сообщения с Offset = %d", message.Offset)
 	}
-	return nil
 }
 
-// processMessage обрабатывает сообщение из Kafka
-func processMessage(message *sarama.ConsumerMessage) error {
-	var orderMsg OrderMessage
-	err := json.Unmarshal(message.Value, &orderMsg)
+// messageContext возвращает контекст обработки сообщения, который не отменяется вместе с сессией:
+// после ее завершения на дообработку отводится drainTimeout
+func (h NotifierHandler) messageContext(sessCtx context.Context) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(context.WithoutCancel(sessCtx))
+	done := make(chan struct{})
+	go func() {
+		select {
+		case <-sessCtx.Done():
+		case <-done:
+			return
+		}
+		if h.drainTimeout <= 0 {
+			return
+		}
+
+		timer := time.NewTimer(h.drainTimeout)
+		defer timer.Stop()
+		select {
+		case <-timer.C:
+			cancel()
+		case <-done:
+		}
+	}()
+
+	return ctx, func() {
+		close(done)
+		cancel()
+	}
+}
+
+// processWithRetry обрабатывает сообщение с повторными попытками и экспоненциальной задержкой.
+// Возвращает количество выполненных попыток и последнюю ошибку.
+func (h NotifierHandler) processWithRetry(ctx context.Context, message *sarama.ConsumerMessage) (int, error) {
+	err := h.processMessage(ctx, message)
+	attempts := 1
+	for retry := 1; err != nil && retry <= h.retry.MaxRetries; retry++ {
+		delay := h.retry.Backoff(retry)
+		log.Printf("Ошибка при обработке сообщения с Offset = %d: %v. Повторная попытка %d через %s", message.Offset, err, retry, delay)
+		if !sleep(ctx, delay) {
+			return attempts, ctx.Err()
+		}
+
+		err = h.processMessage(ctx, message)
+		attempts++
+	}
+	return attempts, err
+}
+
+// processMessage обрабатывает сообщение из Kafka.
+// Контекст трассировки из заголовков сообщения передается обработчику событий.
+// Событие занимается до обработки, а при ошибке отметка снимается, чтобы повторная попытка обработала его снова.
+func (h NotifierHandler) processMessage(ctx context.Context, message *sarama.ConsumerMessage) error {
+	event, err := DecodeEvent(message.Value)
 	if err != nil {
-		return fmt.Errorf("json.Unmarshal error: %v", err)
+		return fmt.Errorf("ошибка разбора события: %w", err)
 	}
+	ctx = ExtractTraceContext(ctx, message.Headers)
+
+	if h.processed != nil {
+		claimed, err := h.processed.Claim(ctx, event.GetEventId(), event.GetEventType().String())
+		if err != nil {
+			return fmt.Errorf("ошибка отметки обработки события %s: %w", event.GetEventId(), err)
+		}
+		if !claimed {
+			log.Printf("Событие %s уже обработано, сообщение с Offset = %d пропущено", event.GetEventId(), message.Offset)
+			return nil
+		}
+	}
+
+	if err := h.handleEvent(ctx, event); err != nil {
+		if h.processed != nil {
+			if releaseErr := h.processed.Release(ctx, event.GetEventId()); releaseErr != nil {
+				return errors.Join(err, fmt.Errorf("ошибка снятия отметки обработки события %s: %w", event.GetEventId(), releaseErr))
+			}
+		}
+		return err
+	}
+
+	log.Printf("Событие %s (версия схемы %d) успешно обработано: %s", event.GetEventId(), event.GetSchemaVersion(), event.GetDescription())
+	return nil
+}
 
-	switch orderMsg.Operation {
-	case "error":
-		return fmt.Errorf("произошла ошибка во время обработки заказа ID = %d", orderMsg.OrderID)
-	case "create":
-		log.Printf("Обработан заказ ID = %d, операция: create", orderMsg.OrderID)
-	case "update":
-		log.Printf("Обновлен заказ ID = %d, операция: update", orderMsg.OrderID)
-	case "delete":
-		log.Printf("Удален заказ ID = %d, операция: delete", orderMsg.OrderID)
-	case "create_return":
-		log.Printf("Обработан возврат для заказа ID = %d, операция: create_return", orderMsg.OrderID)
-	case "update_return":
-		log.Printf("Обновлен возврат для заказа ID = %d, операция: update_return", orderMsg.OrderID)
-	case "delete_return":
-		log.Printf("Удален возврат для заказа ID = %d, операция: delete_return", orderMsg.OrderID)
+// handleEvent выполняет обработку события в зависимости от его типа
+func (h NotifierHandler) handleEvent(ctx context.Context, event *eventsv1.OrderEvent) error {
+	orderID := event.GetAggregateId()
+	switch event.GetEventType() {
+	case eventsv1.EventType_EVENT_TYPE_ERROR:
+		// Событие сообщает об ошибке сервиса и само по себе корректно, поэтому не повторяется и не переносится в DLQ
+		log.Printf("Получено событие об ошибке при обработке заказа ID = %d: %s", orderID, event.GetError().GetMessage())
+	case eventsv1.EventType_EVENT_TYPE_ORDER_CREATED:
+		log.Printf("Обработан заказ ID = %d, событие: %s", orderID, event.GetEventType())
+	case eventsv1.EventType_EVENT_TYPE_ORDER_UPDATED, eventsv1.EventType_EVENT_TYPE_ORDER_ISSUED:
+		log.Printf("Обновлен заказ ID = %d, событие: %s", orderID, event.GetEventType())
+	case eventsv1.EventType_EVENT_TYPE_ORDER_DELETED:
+		log.Printf("Удален заказ ID = %d, событие: %s", orderID, event.GetEventType())
+	case eventsv1.EventType_EVENT_TYPE_ORDER_STORAGE_EXPIRING:
+		log.Printf("Истекает срок хранения заказа ID = %d, событие: %s", orderID, event.GetEventType())
+	case eventsv1.EventType_EVENT_TYPE_RETURN_CREATED:
+		log.Printf("Обработан возврат для заказа ID = %d, событие: %s", orderID, event.GetEventType())
+	case eventsv1.EventType_EVENT_TYPE_RETURN_UPDATED:
+		log.Printf("Обновлен возврат для заказа ID = %d, событие: %s", orderID, event.GetEventType())
+	case eventsv1.EventType_EVENT_TYPE_RETURN_DELETED:
+		log.Printf("Удален возврат для заказа ID = %d, событие: %s", orderID, event.GetEventType())
 	default:
-		return fmt.Errorf("неизвестная операция: %s для заказа ID = %d", orderMsg.Operation, orderMsg.OrderID)
+		return fmt.Errorf("неизвестный тип события: %s для заказа ID = %d", event.GetEventType(), orderID)
 	}
 
-	log.Printf("Сообщение успешно обработано: %+v", orderMsg)
+	if h.processor != nil {
+		if err := h.processor.Process(ctx, event); err != nil {
+			return fmt.Errorf("ошибка обработки события %s: %w", event.GetEventId(), err)
+		}
+	}
 	return nil
 }
 
@@ -101,8 +227,9 @@ func NewConsumerGroup(brokers []string, groupID string) (sarama.ConsumerGroup, e
 	}
 
 	config.Consumer.Offsets.Initial = sarama.OffsetOldest
-	config.Consumer.Offsets.AutoCommit.Enable = true
-	config.Consumer.Offsets.AutoCommit.Interval = 1 * time.Second
+	// Смещения фиксируются вручную после обработки сообщения, автоматическая фиксация отключена
+	config.Consumer.Offsets.AutoCommit.Enable = false
+	config.Consumer.Return.Errors = true
 
 	consumerGroup, err := sarama.NewConsumerGroup(brokers, groupID, config)
 	if err != nil {
@@ -112,13 +239,46 @@ func NewConsumerGroup(brokers []string, groupID string) (sarama.ConsumerGroup, e
 	return consumerGroup, nil
 }
 
-// StartConsumer запускает процесс потребления сообщений Kafka в отдельной горутине
-func StartConsumer(consumerGroup sarama.ConsumerGroup, topics []string, handler NotifierHandler) {
-	go func() {
-		for {
-			if err := consumerGroup.Consume(context.Background(), topics, handler); err != nil {
-				log.Fatalf("Ошибка при потреблении сообщений: %v", err)
+// RunConsumer потребляет сообщения до отмены контекста или закрытия группы.
+// Сессия consumer группы завершается при каждой ребалансировке, поэтому Consume вызывается в цикле;
+// ошибки брокера не завершают процесс, а повторяются с экспоненциальной задержкой.
+func RunConsumer(ctx context.Context, consumerGroup sarama.ConsumerGroup, topics []string, handler sarama.ConsumerGroupHandler, retry RetryPolicy) error {
+	go logConsumerErrors(ctx, consumerGroup)
+
+	failures := 0
+	for ctx.Err() == nil {
+		err := consumerGroup.Consume(ctx, topics, handler)
+		switch {
+		case errors.Is(err, sarama.ErrClosedConsumerGroup):
+			return nil
+		case err != nil:
+			failures++
+			delay := retry.Backoff(failures)
+			log.Printf("Ошибка при потреблении сообщений: %v. Повторное подключение через %s", err, delay)
+			if !sleep(ctx, delay) {
+				return nil
+			}
+		default:
+			failures = 0
+			if ctx.Err() == nil {
+				log.Println("Сессия consumer группы завершена, переподключение после ребалансировки")
 			}
 		}
-	}()
+	}
+	return nil
+}
+
+// logConsumerErrors логирует асинхронные ошибки consumer группы до отмены контекста
+func logConsumerErrors(ctx context.Context, consumerGroup sarama.ConsumerGroup) {
+	for {
+		select {
+		case err, ok := <-consumerGroup.Errors():
+			if !ok {
+				return
+			}
+			log.Printf("Ошибка consumer группы: %v", err)
+		case <-ctx.Done():
+			return
+		}
+	}
 }
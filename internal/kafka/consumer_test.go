@@ -0,0 +1,204 @@
+package kafka
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	eventsv1 "homework1/internal/api/events/v1"
+	"homework1/internal/model"
+)
+
+// fakeConsumerGroup возвращает заранее заданные ошибки из Consume
+type fakeConsumerGroup struct {
+	results []error
+	calls   int
+	errors  chan error
+}
+
+func (g *fakeConsumerGroup) Consume(_ context.Context, _ []string, _ sarama.ConsumerGroupHandler) error {
+	result := g.results[g.calls]
+	g.calls++
+	return result
+}
+
+func (g *fakeConsumerGroup) Errors() <-chan error        { return g.errors }
+func (g *fakeConsumerGroup) Close() error                { return nil }
+func (g *fakeConsumerGroup) Pause(_ map[string][]int32)  {}
+func (g *fakeConsumerGroup) Resume(_ map[string][]int32) {}
+func (g *fakeConsumerGroup) PauseAll()                   {}
+func (g *fakeConsumerGroup) ResumeAll()                  {}
+
+// TestRunConsumerRetriesErrors проверяет, что ошибки брокера повторяются, а закрытие группы завершает цикл
+func TestRunConsumerRetriesErrors(t *testing.T) {
+	t.Parallel()
+	group := &fakeConsumerGroup{
+		results: []error{errors.New("брокер недоступен"), nil, sarama.ErrClosedConsumerGroup},
+		errors:  make(chan error),
+	}
+
+	err := RunConsumer(context.Background(), group, []string{"test-topic"}, NotifierHandler{}, RetryPolicy{InitialBackoff: time.Millisecond})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, group.calls, "после ошибки и ребалансировки Consume должен вызываться повторно")
+}
+
+// TestRunConsumerStopsOnCancel проверяет, что отмена контекста прерывает ожидание переподключения
+func TestRunConsumerStopsOnCancel(t *testing.T) {
+	t.Parallel()
+	group := &fakeConsumerGroup{
+		results: []error{errors.New("брокер недоступен")},
+		errors:  make(chan error),
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(10*time.Millisecond, cancel)
+
+	err := RunConsumer(ctx, group, []string{"test-topic"}, NotifierHandler{}, RetryPolicy{InitialBackoff: time.Hour})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, group.calls)
+}
+
+// countingProcessor считает вызовы обработчика событий
+type countingProcessor struct {
+	calls int
+	err   error
+}
+
+func (p *countingProcessor) Process(_ context.Context, _ *eventsv1.OrderEvent) error {
+	p.calls++
+	return p.err
+}
+
+// TestProcessMessageSkipsProcessedEvents проверяет, что повторно доставленное событие не обрабатывается дважды
+func TestProcessMessageSkipsProcessedEvents(t *testing.T) {
+	t.Parallel()
+	processor := &countingProcessor{err: errors.New("канал недоступен")}
+	store := NewMemoryProcessedEventStore()
+	handler := NewNotifierHandler(RetryPolicy{}, nil, processor, store, 0)
+
+	event := NewOrderEvent(eventsv1.EventType_EVENT_TYPE_ORDER_CREATED, model.Order{OrderID: 1}, "")
+	value, err := MarshalEvent(event)
+	require.NoError(t, err)
+	message := &sarama.ConsumerMessage{Value: value}
+
+	// Неудачная обработка снимает отметку с события
+	assert.Error(t, handler.processMessage(context.Background(), message))
+	assert.NotContains(t, store.processed, event.GetEventId())
+
+	processor.err = nil
+	assert.NoError(t, handler.processMessage(context.Background(), message))
+	assert.NoError(t, handler.processMessage(context.Background(), message))
+	assert.Equal(t, 2, processor.calls, "после успешной обработки повторное сообщение должно пропускаться")
+}
+
+// redeliveryProcessor повторно доставляет то же сообщение во время обработки, как после ребалансировки
+type redeliveryProcessor struct {
+	handler *NotifierHandler
+	message *sarama.ConsumerMessage
+	calls   int
+	err     error
+}
+
+func (p *redeliveryProcessor) Process(ctx context.Context, _ *eventsv1.OrderEvent) error {
+	p.calls++
+	if p.calls == 1 {
+		p.err = p.handler.processMessage(ctx, p.message)
+	}
+	return nil
+}
+
+// TestProcessMessageSkipsEventInProgress проверяет, что сообщение, доставленное повторно во время обработки, пропускается
+func TestProcessMessageSkipsEventInProgress(t *testing.T) {
+	t.Parallel()
+	value, err := MarshalEvent(NewOrderEvent(eventsv1.EventType_EVENT_TYPE_ORDER_CREATED, model.Order{OrderID: 1}, ""))
+	require.NoError(t, err)
+
+	processor := &redeliveryProcessor{message: &sarama.ConsumerMessage{Value: value}}
+	handler := NewNotifierHandler(RetryPolicy{}, nil, processor, NewMemoryProcessedEventStore(), 0)
+	processor.handler = &handler
+
+	assert.NoError(t, handler.processMessage(context.Background(), processor.message))
+	assert.NoError(t, processor.err)
+	assert.Equal(t, 1, processor.calls, "занятое событие не должно обрабатываться повторно")
+}
+
+// TestProcessMessageHandlesErrorEvents проверяет, что событие об ошибке сервиса обрабатывается, а не уходит в DLQ
+func TestProcessMessageHandlesErrorEvents(t *testing.T) {
+	t.Parallel()
+	processor := &countingProcessor{}
+	store := NewMemoryProcessedEventStore()
+	handler := NewNotifierHandler(RetryPolicy{}, nil, processor, store, 0)
+
+	value, err := MarshalEvent(NewErrorEvent("IssueOrder", 1, "заказ не найден"))
+	require.NoError(t, err)
+
+	assert.NoError(t, handler.processMessage(context.Background(), &sarama.ConsumerMessage{Value: value}))
+	assert.Equal(t, 1, processor.calls)
+}
+
+// fakeSession - сессия consumer группы, запоминающая отмеченные сообщения
+type fakeSession struct {
+	sarama.ConsumerGroupSession
+	ctx    context.Context
+	marked []int64
+}
+
+func (s *fakeSession) Context() context.Context { return s.ctx }
+func (s *fakeSession) Commit()                  {}
+func (s *fakeSession) MarkMessage(msg *sarama.ConsumerMessage, _ string) {
+	s.marked = append(s.marked, msg.Offset)
+}
+
+// fakeClaim - раздел с заранее заданными сообщениями
+type fakeClaim struct {
+	sarama.ConsumerGroupClaim
+	messages chan *sarama.ConsumerMessage
+}
+
+func (c *fakeClaim) Messages() <-chan *sarama.ConsumerMessage { return c.messages }
+
+// shutdownProcessor завершает сессию во время обработки и ждет окончания обработки или отмены контекста
+type shutdownProcessor struct {
+	stopSession context.CancelFunc
+	delay       time.Duration
+}
+
+func (p *shutdownProcessor) Process(ctx context.Context, _ *eventsv1.OrderEvent) error {
+	p.stopSession()
+	select {
+	case <-time.After(p.delay):
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// TestConsumeClaimDrainsOnShutdown проверяет, что при завершении сессии текущее сообщение дообрабатывается и отмечается,
+// следующее не берется, а сообщение, не уложившееся в drainTimeout, не отмечается
+func TestConsumeClaimDrainsOnShutdown(t *testing.T) {
+	t.Parallel()
+	event := NewOrderEvent(eventsv1.EventType_EVENT_TYPE_ORDER_CREATED, model.Order{OrderID: 1}, "")
+	value, err := MarshalEvent(event)
+	require.NoError(t, err)
+
+	consume := func(delay, drainTimeout time.Duration) []int64 {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		claim := &fakeClaim{messages: make(chan *sarama.ConsumerMessage, 2)}
+		claim.messages <- &sarama.ConsumerMessage{Offset: 1, Value: value}
+		claim.messages <- &sarama.ConsumerMessage{Offset: 2, Value: value}
+		sess := &fakeSession{ctx: ctx}
+
+		handler := NewNotifierHandler(RetryPolicy{}, nil, &shutdownProcessor{stopSession: cancel, delay: delay}, nil, drainTimeout)
+		require.NoError(t, handler.ConsumeClaim(sess, claim))
+		return sess.marked
+	}
+
+	assert.Equal(t, []int64{1}, consume(10*time.Millisecond, time.Second))
+	assert.Empty(t, consume(time.Second, 10*time.Millisecond))
+}
@@ -0,0 +1,108 @@
+package kafka
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/IBM/sarama"
+)
+
+// Заголовки, которые добавляются к сообщению при переносе в dead-letter топик
+const (
+	HeaderDLQReason            = "dlq-reason"
+	HeaderDLQOriginalTopic     = "dlq-original-topic"
+	HeaderDLQOriginalPartition = "dlq-original-partition"
+	HeaderDLQOriginalOffset    = "dlq-original-offset"
+	HeaderDLQAttempts          = "dlq-attempts"
+	HeaderDLQFailedAt          = "dlq-failed-at"
+
+	dlqHeaderPrefix = "dlq-"
+)
+
+// DeadLetterQueue публикует сообщения, которые не удалось обработать, в dead-letter топик
+type DeadLetterQueue struct {
+	producer sarama.SyncProducer
+	topic    string
+}
+
+// NewDeadLetterQueue создает продюсера dead-letter топика
+func NewDeadLetterQueue(brokers []string, topic string) (*DeadLetterQueue, error) {
+	syncProducer, err := sarama.NewSyncProducer(brokers, newProducerConfig())
+	if err != nil {
+		return nil, fmt.Errorf("sarama.NewSyncProducer: %w", err)
+	}
+
+	return &DeadLetterQueue{
+		producer: syncProducer,
+		topic:    topic,
+	}, nil
+}
+
+// Send переносит сообщение в dead-letter топик, сохраняя ключ, исходные заголовки и причину ошибки
+func (q *DeadLetterQueue) Send(message *sarama.ConsumerMessage, reason error, attempts int) error {
+	headers := make([]sarama.RecordHeader, 0, len(message.Headers)+6)
+	for _, header := range message.Headers {
+		if header != nil {
+			headers = append(headers, *header)
+		}
+	}
+	headers = append(headers,
+		sarama.RecordHeader{Key: []byte(HeaderDLQReason), Value: []byte(reason.Error())},
+		sarama.RecordHeader{Key: []byte(HeaderDLQOriginalTopic), Value: []byte(message.Topic)},
+		sarama.RecordHeader{Key: []byte(HeaderDLQOriginalPartition), Value: []byte(strconv.Itoa(int(message.Partition)))},
+		sarama.RecordHeader{Key: []byte(HeaderDLQOriginalOffset), Value: []byte(strconv.FormatInt(message.Offset, 10))},
+		sarama.RecordHeader{Key: []byte(HeaderDLQAttempts), Value: []byte(strconv.Itoa(attempts))},
+		sarama.RecordHeader{Key: []byte(HeaderDLQFailedAt), Value: []byte(time.Now().UTC().Format(time.RFC3339))},
+	)
+
+	dlqMsg := &sarama.ProducerMessage{
+		Topic:   q.topic,
+		Value:   sarama.ByteEncoder(message.Value),
+		Headers: headers,
+	}
+	if message.Key != nil {
+		dlqMsg.Key = sarama.ByteEncoder(message.Key)
+	}
+
+	partition, offset, err := q.producer.SendMessage(dlqMsg)
+	if err != nil {
+		return fmt.Errorf("q.producer.SendMessage: %w", err)
+	}
+
+	log.Printf("Сообщение с Offset = %d перенесено в DLQ. Тема: %s, Раздел: %d, Смещение: %d, Причина: %v",
+		message.Offset, q.topic, partition, offset, reason)
+
+	return nil
+}
+
+// Close закрывает продюсера dead-letter топика
+func (q *DeadLetterQueue) Close() error {
+	if err := q.producer.Close(); err != nil {
+		return fmt.Errorf("q.producer.Close: %w", err)
+	}
+	return nil
+}
+
+// headerValue возвращает значение заголовка сообщения или пустую строку
+func headerValue(headers []*sarama.RecordHeader, key string) string {
+	for _, header := range headers {
+		if header != nil && string(header.Key) == key {
+			return string(header.Value)
+		}
+	}
+	return ""
+}
+
+// stripDLQHeaders возвращает исходные заголовки сообщения без служебных заголовков DLQ
+func stripDLQHeaders(headers []*sarama.RecordHeader) []sarama.RecordHeader {
+	var original []sarama.RecordHeader
+	for _, header := range headers {
+		if header != nil && !strings.HasPrefix(string(header.Key), dlqHeaderPrefix) {
+			original = append(original, *header)
+		}
+	}
+	return original
+}
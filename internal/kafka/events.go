@@ -0,0 +1,188 @@
+package kafka
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	eventsv1 "homework1/internal/api/events/v1"
+	"homework1/internal/model"
+)
+
+// EventSchemaVersion - текущая версия схемы конверта события
+const EventSchemaVersion = 1
+
+// legacyEventTypes сопоставляет операции старого формата OrderMessage с типами событий
+var legacyEventTypes = map[string]eventsv1.EventType{
+	"create":        eventsv1.EventType_EVENT_TYPE_ORDER_CREATED,
+	"update":        eventsv1.EventType_EVENT_TYPE_ORDER_UPDATED,
+	"delete":        eventsv1.EventType_EVENT_TYPE_ORDER_DELETED,
+	"return":        eventsv1.EventType_EVENT_TYPE_RETURN_CREATED,
+	"create_return": eventsv1.EventType_EVENT_TYPE_RETURN_CREATED,
+	"update_return": eventsv1.EventType_EVENT_TYPE_RETURN_UPDATED,
+	"delete_return": eventsv1.EventType_EVENT_TYPE_RETURN_DELETED,
+}
+
+// legacyErrorOperations - операции старого формата, которые публиковались только как сообщения об ошибке
+var legacyErrorOperations = map[string]bool{
+	"check_expired_orders":   true,
+	"process_expired_orders": true,
+}
+
+// legacyErrorPrefix - префикс описания, с которым старый SendKafkaErrorMessage публиковал ошибки.
+// Ошибки операций с возвратами имели те же операции, что и успешные события, и отличались только описанием.
+const legacyErrorPrefix = "Ошибка: "
+
+// NewOrderEvent создает событие о заказе со снимком его состояния
+func NewOrderEvent(eventType eventsv1.EventType, order model.Order, description string) *eventsv1.OrderEvent {
+	event := newEvent(eventType, order.OrderID, description)
+	event.Payload = &eventsv1.OrderEvent_Order{Order: &eventsv1.OrderSnapshot{
+		OrderId:        int32(order.OrderID),
+		UserId:         int32(order.UserID),
+		PackagingId:    int32(order.PackagingID),
+		StatusId:       int32(order.StatusID),
+		AcceptanceDate: timestampOrNil(order.AcceptanceDate),
+		ExpirationDate: timestampOrNil(order.ExpirationDate),
+		IssueDate:      timestampOrNil(order.IssueDate),
+		Weight:         order.Weight,
+		BaseCost:       order.BaseCost,
+		PackagingCost:  order.PackagingCost,
+		TotalCost:      order.TotalCost,
+		WithFilm:       order.WithFilm,
+	}}
+	return event
+}
+
+// NewReturnEvent создает событие о возврате со снимком его состояния
+func NewReturnEvent(eventType eventsv1.EventType, ret model.Return, description string) *eventsv1.OrderEvent {
+	event := newEvent(eventType, ret.OrderID, description)
+	event.Payload = &eventsv1.OrderEvent_OrderReturn{OrderReturn: &eventsv1.ReturnSnapshot{
+		ReturnId:      int32(ret.ReturnID),
+		OrderId:       int32(ret.OrderID),
+		UserId:        int32(ret.UserID),
+		ReasonId:      int32(ret.ReasonID),
+		StatusId:      int32(ret.StatusID),
+		PackagingId:   int32(ret.PackagingID),
+		ReturnDate:    timestampOrNil(ret.ReturnDate),
+		BaseCost:      ret.BaseCost,
+		PackagingCost: ret.PackagingCost,
+		TotalCost:     ret.TotalCost,
+	}}
+	return event
+}
+
+// NewErrorEvent создает событие об ошибке при выполнении операции
+func NewErrorEvent(operation string, orderID int, description string) *eventsv1.OrderEvent {
+	event := newEvent(eventsv1.EventType_EVENT_TYPE_ERROR, orderID, fmt.Sprintf("Ошибка: %s", description))
+	event.Payload = &eventsv1.OrderEvent_Error{Error: &eventsv1.ErrorDetails{
+		Operation: operation,
+		Message:   description,
+	}}
+	return event
+}
+
+// newEvent заполняет общие поля конверта события
+func newEvent(eventType eventsv1.EventType, orderID int, description string) *eventsv1.OrderEvent {
+	return &eventsv1.OrderEvent{
+		EventId:       newEventID(),
+		EventType:     eventType,
+		SchemaVersion: EventSchemaVersion,
+		AggregateId:   int64(orderID),
+		OccurredAt:    timestamppb.Now(),
+		Description:   description,
+	}
+}
+
+// MarshalEvent сериализует событие в JSON
+func MarshalEvent(event *eventsv1.OrderEvent) ([]byte, error) {
+	data, err := protojson.Marshal(event)
+	if err != nil {
+		return nil, fmt.Errorf("protojson.Marshal: %w", err)
+	}
+	return data, nil
+}
+
+// DecodeEvent разбирает сообщение топика в конверт события.
+// Сообщения без версии схемы считаются сообщениями старого формата OrderMessage и приводятся к конверту.
+func DecodeEvent(value []byte) (*eventsv1.OrderEvent, error) {
+	var header struct {
+		SchemaVersion int `json:"schemaVersion"`
+	}
+	if err := json.Unmarshal(value, &header); err != nil {
+		return nil, fmt.Errorf("json.Unmarshal: %w", err)
+	}
+
+	if header.SchemaVersion == 0 {
+		return decodeLegacyEvent(value)
+	}
+	if header.SchemaVersion > EventSchemaVersion {
+		return nil, fmt.Errorf("неподдерживаемая версия схемы события: %d", header.SchemaVersion)
+	}
+
+	var event eventsv1.OrderEvent
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(value, &event); err != nil {
+		return nil, fmt.Errorf("protojson.Unmarshal: %w", err)
+	}
+	return &event, nil
+}
+
+// decodeLegacyEvent приводит сообщение старого формата OrderMessage к конверту события.
+// ID события вычисляется из содержимого сообщения, поэтому повторное чтение дает тот же ID.
+func decodeLegacyEvent(value []byte) (*eventsv1.OrderEvent, error) {
+	var message OrderMessage
+	if err := json.Unmarshal(value, &message); err != nil {
+		return nil, fmt.Errorf("json.Unmarshal: %w", err)
+	}
+
+	operation := strings.ToLower(strings.ReplaceAll(strings.TrimSpace(message.Operation), " ", "_"))
+	isError := legacyErrorOperations[operation] || strings.HasPrefix(message.Description, legacyErrorPrefix)
+	eventType, ok := legacyEventTypes[operation]
+	if isError {
+		eventType = eventsv1.EventType_EVENT_TYPE_ERROR
+	} else if !ok {
+		return nil, fmt.Errorf("неизвестная операция: %s для заказа ID = %d", message.Operation, message.OrderID)
+	}
+
+	sum := sha256.Sum256(value)
+	event := &eventsv1.OrderEvent{
+		EventId:       "legacy-" + hex.EncodeToString(sum[:16]),
+		EventType:     eventType,
+		SchemaVersion: 0,
+		AggregateId:   int64(message.OrderID),
+		OccurredAt:    timestampOrNil(message.TimeStamp),
+		Description:   message.Description,
+	}
+	if isError {
+		event.Payload = &eventsv1.OrderEvent_Error{Error: &eventsv1.ErrorDetails{
+			Operation: operation,
+			Message:   strings.TrimPrefix(message.Description, legacyErrorPrefix),
+		}}
+	}
+	return event, nil
+}
+
+// timestampOrNil преобразует время в Timestamp, нулевое время - в nil
+func timestampOrNil(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+// newEventID генерирует случайный идентификатор события в формате UUID v4
+func newEventID() string {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		panic(fmt.Sprintf("crypto/rand: %v", err))
+	}
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
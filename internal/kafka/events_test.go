@@ -0,0 +1,77 @@
+package kafka
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	eventsv1 "homework1/internal/api/events/v1"
+	"homework1/internal/model"
+)
+
+// TestDecodeEvent проверяет разбор события текущей версии схемы
+func TestDecodeEvent(t *testing.T) {
+	t.Parallel()
+	ret := model.Return{ReturnID: 3, OrderID: 7, UserID: 2, ReturnDate: time.Now()}
+	event := NewReturnEvent(eventsv1.EventType_EVENT_TYPE_RETURN_CREATED, ret, "Возврат создан для заказа 7")
+
+	data, err := MarshalEvent(event)
+	assert.NoError(t, err)
+
+	decoded, err := DecodeEvent(data)
+	assert.NoError(t, err)
+	assert.Equal(t, event.GetEventId(), decoded.GetEventId())
+	assert.Equal(t, eventsv1.EventType_EVENT_TYPE_RETURN_CREATED, decoded.GetEventType())
+	assert.Equal(t, int32(EventSchemaVersion), decoded.GetSchemaVersion())
+	assert.Equal(t, int64(7), decoded.GetAggregateId())
+	assert.Equal(t, int32(3), decoded.GetOrderReturn().GetReturnId())
+}
+
+// TestDecodeLegacyEvent проверяет разбор сообщений старого формата OrderMessage.
+// Пары операции и описания повторяют сообщения, которые публиковала версия до конверта событий.
+func TestDecodeLegacyEvent(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		operation   string
+		description string
+		expected    eventsv1.EventType
+	}{
+		{"create", "Order 5 created", eventsv1.EventType_EVENT_TYPE_ORDER_CREATED},
+		{"update", "Order 5 updated", eventsv1.EventType_EVENT_TYPE_ORDER_UPDATED},
+		{"delete", "Order 5 deleted", eventsv1.EventType_EVENT_TYPE_ORDER_DELETED},
+		{"return", "Order 5 returned", eventsv1.EventType_EVENT_TYPE_RETURN_CREATED},
+		{"Create Return", "Возврат создан для заказа 5", eventsv1.EventType_EVENT_TYPE_RETURN_CREATED},
+		{"Update Return", "Возврат обновлен для заказа 5", eventsv1.EventType_EVENT_TYPE_RETURN_UPDATED},
+		{"Delete Return", "Возврат с ID 5 удален", eventsv1.EventType_EVENT_TYPE_RETURN_DELETED},
+		{"create_return", "Ошибка: ошибка создания возврата: conflict", eventsv1.EventType_EVENT_TYPE_ERROR},
+		{"update_return", "Ошибка: ошибка обновления возврата с ID 5: conflict", eventsv1.EventType_EVENT_TYPE_ERROR},
+		{"delete_return", "Ошибка: возврат с ID 5 не найден", eventsv1.EventType_EVENT_TYPE_ERROR},
+		{"check_expired_orders", "Ошибка: ошибка получения статуса 'Создан': timeout", eventsv1.EventType_EVENT_TYPE_ERROR},
+		{"process_expired_orders", "Ошибка: ошибка при обработке заказа с ID 5: timeout", eventsv1.EventType_EVENT_TYPE_ERROR},
+	}
+
+	for _, tc := range cases {
+		name := tc.operation + ": " + tc.description
+		data, err := json.Marshal(OrderMessage{TimeStamp: time.Now(), Operation: tc.operation, OrderID: 5, Description: tc.description})
+		assert.NoError(t, err)
+
+		event, err := DecodeEvent(data)
+		assert.NoError(t, err, name)
+		assert.Equal(t, tc.expected, event.GetEventType(), name)
+		assert.Equal(t, int64(5), event.GetAggregateId(), name)
+		if tc.expected == eventsv1.EventType_EVENT_TYPE_ERROR {
+			assert.NotContains(t, event.GetError().GetMessage(), legacyErrorPrefix, name)
+		}
+
+		again, err := DecodeEvent(data)
+		assert.NoError(t, err, name)
+		assert.Equal(t, event.GetEventId(), again.GetEventId(), "ID старого сообщения должен быть стабильным")
+	}
+
+	_, err := DecodeEvent([]byte(`{"Operation": "unknown", "OrderID": 1}`))
+	assert.Error(t, err, "неизвестная операция должна приводить к ошибке")
+
+	_, err = DecodeEvent([]byte(`{"schemaVersion": 99}`))
+	assert.Error(t, err, "неподдерживаемая версия схемы должна приводить к ошибке")
+}
@@ -1,11 +1,14 @@
 package kafka
 
 import (
+	"context"
 	"os"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	eventsv1 "homework1/internal/api/events/v1"
+	"homework1/internal/model"
 )
 
 // TestMain инициализирует тестовую среду перед запуском тестов
@@ -17,28 +20,27 @@ func TestMain(m *testing.M) {
 func TestNewProducer(t *testing.T) {
 	t.Parallel()
 	brokers := []string{"localhost:9092"}
-	producer, err := NewProducer(brokers, "test-topic")
+	producer, err := NewProducer(brokers, "test-topic", nil)
 	assert.NoError(t, err)
 	assert.NotNil(t, producer)
 	defer producer.Close()
 }
 
-// TestSendOrderMessage проверяет отправку сообщения в Kafka
-func TestSendOrderMessage(t *testing.T) {
+// TestSendEvent проверяет отправку события в Kafka
+func TestSendEvent(t *testing.T) {
 	t.Parallel()
 	brokers := []string{"localhost:9092"}
-	producer, err := NewProducer(brokers, "test-topic")
+	producer, err := NewProducer(brokers, "test-topic", nil)
 	assert.NoError(t, err)
 	defer producer.Close()
 
-	orderMessage := OrderMessage{
-		TimeStamp:   time.Now(),
-		Operation:   "create",
-		OrderID:     1,
-		Description: "Test order",
+	order := model.Order{
+		OrderID:        1,
+		UserID:         1,
+		AcceptanceDate: time.Now(),
 	}
 
-	err = producer.SendOrderMessage(orderMessage)
+	err = producer.SendEvent(context.Background(), NewOrderEvent(eventsv1.EventType_EVENT_TYPE_ORDER_CREATED, order, "Test order"))
 	assert.NoError(t, err)
 
 }
@@ -47,7 +49,7 @@ func TestSendOrderMessage(t *testing.T) {
 func TestClose(t *testing.T) {
 	t.Parallel()
 	brokers := []string{"localhost:9092"}
-	producer, err := NewProducer(brokers, "test-topic")
+	producer, err := NewProducer(brokers, "test-topic", nil)
 	assert.NoError(t, err)
 
 	err = producer.Close()
@@ -0,0 +1,105 @@
+package kafka
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/IBM/sarama"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+
+	eventsv1 "homework1/internal/api/events/v1"
+)
+
+// Заголовки, которые добавляются к каждому событию
+const (
+	HeaderEventType      = "event-type"
+	HeaderSchemaVersion  = "schema-version"
+	HeaderIdempotencyKey = "idempotency-key"
+)
+
+// Поддерживаемые ключи партиционирования событий
+const (
+	PartitionKeyOrderID = "order_id"
+	PartitionKeyUserID  = "user_id"
+)
+
+// KeyFunc вычисляет ключ сообщения Kafka для события.
+// События с одинаковым ключом попадают в один раздел топика и читаются в порядке публикации.
+type KeyFunc func(event *eventsv1.OrderEvent) string
+
+// KeyByOrderID использует в качестве ключа ID заказа, к которому относится событие
+func KeyByOrderID(event *eventsv1.OrderEvent) string {
+	return strconv.FormatInt(event.GetAggregateId(), 10)
+}
+
+// KeyByUserID использует в качестве ключа ID пользователя из снимка заказа или возврата.
+// Все события заказа относятся к одному пользователю (сервис заказов запрещает смену владельца),
+// поэтому порядок по заказу также сохраняется.
+// События без снимка (ошибки, сообщения старого формата) ключуются по ID заказа.
+func KeyByUserID(event *eventsv1.OrderEvent) string {
+	var userID int32
+	switch {
+	case event.GetOrder() != nil:
+		userID = event.GetOrder().GetUserId()
+	case event.GetOrderReturn() != nil:
+		userID = event.GetOrderReturn().GetUserId()
+	}
+	if userID == 0 {
+		return KeyByOrderID(event)
+	}
+	return "user-" + strconv.Itoa(int(userID))
+}
+
+// ParseKeyFunc возвращает функцию ключа по названию из конфигурации.
+// Пустое название означает ключ по ID заказа.
+func ParseKeyFunc(name string) (KeyFunc, error) {
+	switch name {
+	case "", PartitionKeyOrderID:
+		return KeyByOrderID, nil
+	case PartitionKeyUserID:
+		return KeyByUserID, nil
+	default:
+		return nil, fmt.Errorf("неизвестный ключ партиционирования: %s", name)
+	}
+}
+
+// EventHeaders формирует заголовки события: тип, версию схемы, ключ идемпотентности
+// и контекст трассировки из ctx в формате текущего пропагатора OpenTelemetry
+func EventHeaders(ctx context.Context, event *eventsv1.OrderEvent) map[string]string {
+	headers := propagation.MapCarrier{
+		HeaderEventType:      event.GetEventType().String(),
+		HeaderSchemaVersion:  strconv.Itoa(int(event.GetSchemaVersion())),
+		HeaderIdempotencyKey: event.GetEventId(),
+	}
+	otel.GetTextMapPropagator().Inject(ctx, headers)
+	return headers
+}
+
+// ExtractTraceContext восстанавливает контекст трассировки из заголовков сообщения
+func ExtractTraceContext(ctx context.Context, headers []*sarama.RecordHeader) context.Context {
+	carrier := propagation.MapCarrier{}
+	for _, header := range headers {
+		if header != nil {
+			carrier[string(header.Key)] = string(header.Value)
+		}
+	}
+	return otel.GetTextMapPropagator().Extract(ctx, carrier)
+}
+
+// recordHeaders преобразует заголовки в заголовки сообщения Kafka в стабильном порядке
+func recordHeaders(headers map[string]string) []sarama.RecordHeader {
+	keys := make([]string, 0, len(headers))
+	for key := range headers {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	records := make([]sarama.RecordHeader, 0, len(keys))
+	for _, key := range keys {
+		records = append(records, sarama.RecordHeader{Key: []byte(key), Value: []byte(headers[key])})
+	}
+	return records
+}
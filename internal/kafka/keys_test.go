@@ -0,0 +1,67 @@
+package kafka
+
+import (
+	"context"
+	"testing"
+
+	"github.com/IBM/sarama"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+
+	eventsv1 "homework1/internal/api/events/v1"
+	"homework1/internal/model"
+)
+
+// TestParseKeyFunc проверяет выбор ключа партиционирования по названию из конфигурации
+func TestParseKeyFunc(t *testing.T) {
+	order := NewOrderEvent(eventsv1.EventType_EVENT_TYPE_ORDER_CREATED, model.Order{OrderID: 10, UserID: 3}, "")
+	ret := NewReturnEvent(eventsv1.EventType_EVENT_TYPE_RETURN_CREATED, model.Return{OrderID: 10, UserID: 3}, "")
+	failure := NewErrorEvent("create", 10, "ошибка")
+
+	byOrder, err := ParseKeyFunc("")
+	require.NoError(t, err)
+	assert.Equal(t, "10", byOrder(order))
+	assert.Equal(t, "10", byOrder(ret))
+
+	byUser, err := ParseKeyFunc(PartitionKeyUserID)
+	require.NoError(t, err)
+	assert.Equal(t, "user-3", byUser(order))
+	assert.Equal(t, byUser(order), byUser(ret), "события заказа и возврата одного пользователя должны иметь один ключ")
+	assert.Equal(t, "10", byUser(failure), "события без снимка ключуются по ID заказа")
+
+	_, err = ParseKeyFunc("packaging_id")
+	assert.Error(t, err)
+}
+
+// TestNewOutboxEventHeaders проверяет ключ и заголовки записи outbox, включая контекст трассировки
+func TestNewOutboxEventHeaders(t *testing.T) {
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	spanContext := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{0x01},
+		SpanID:     trace.SpanID{0x02},
+		TraceFlags: trace.FlagsSampled,
+	})
+	ctx := trace.ContextWithSpanContext(context.Background(), spanContext)
+
+	event := NewOrderEvent(eventsv1.EventType_EVENT_TYPE_ORDER_UPDATED, model.Order{OrderID: 7, UserID: 1}, "")
+	outboxEvent, err := NewOutboxEvent(ctx, "pvz.events-log", KeyByOrderID, event)
+	require.NoError(t, err)
+
+	assert.Equal(t, "7", outboxEvent.EventKey)
+	assert.Equal(t, "EVENT_TYPE_ORDER_UPDATED", outboxEvent.Headers[HeaderEventType])
+	assert.Equal(t, event.GetEventId(), outboxEvent.Headers[HeaderIdempotencyKey])
+	assert.Equal(t, "1", outboxEvent.Headers[HeaderSchemaVersion])
+
+	// Контекст трассировки восстанавливается из заголовков сообщения Kafka
+	var headers []*sarama.RecordHeader
+	for _, header := range recordHeaders(outboxEvent.Headers) {
+		headers = append(headers, &sarama.RecordHeader{Key: header.Key, Value: header.Value})
+	}
+	extracted := trace.SpanContextFromContext(ExtractTraceContext(context.Background(), headers))
+	assert.Equal(t, spanContext.TraceID(), extracted.TraceID())
+	assert.True(t, extracted.IsRemote())
+}
@@ -0,0 +1,79 @@
+package kafka
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v4/pgxpool"
+
+	"homework1/internal/dao"
+)
+
+// ProcessedEventStore хранит ID обработанных событий, чтобы побочные эффекты
+// повторно доставленного сообщения не выполнялись дважды.
+// Событие занимается до обработки, поэтому сообщение, повторно доставленное во время обработки
+// (например, после ребалансировки), не обрабатывается параллельно.
+type ProcessedEventStore interface {
+	// Claim атомарно занимает событие и возвращает false, если оно уже обработано или обрабатывается
+	Claim(ctx context.Context, eventID, eventType string) (bool, error)
+	// Release снимает отметку с события, обработка которого не удалась, чтобы его можно было обработать повторно
+	Release(ctx context.Context, eventID string) error
+}
+
+// PostgresProcessedEventStore хранит обработанные события в таблице processed_events
+type PostgresProcessedEventStore struct {
+	pool *pgxpool.Pool
+}
+
+// NewPostgresProcessedEventStore создает хранилище обработанных событий в PostgreSQL
+func NewPostgresProcessedEventStore(dbPool *pgxpool.Pool) *PostgresProcessedEventStore {
+	return &PostgresProcessedEventStore{pool: dbPool}
+}
+
+// Claim занимает событие для обработки
+func (s *PostgresProcessedEventStore) Claim(ctx context.Context, eventID, eventType string) (bool, error) {
+	return dao.ClaimEvent(ctx, eventID, eventType, s.pool)
+}
+
+// Release снимает отметку с события
+func (s *PostgresProcessedEventStore) Release(ctx context.Context, eventID string) error {
+	return dao.ReleaseEvent(ctx, eventID, s.pool)
+}
+
+// Cleanup удаляет отметки старше retention. Повторы сообщений возможны только в пределах
+// срока хранения топика, поэтому более старые отметки не нужны.
+func (s *PostgresProcessedEventStore) Cleanup(ctx context.Context, retention time.Duration) (int64, error) {
+	return dao.DeleteProcessedEventsBefore(ctx, time.Now().Add(-retention), s.pool)
+}
+
+// MemoryProcessedEventStore хранит обработанные события в памяти процесса.
+// Подходит для тестов и запуска без базы данных; отметки теряются при перезапуске.
+type MemoryProcessedEventStore struct {
+	mu        sync.Mutex
+	processed map[string]string
+}
+
+// NewMemoryProcessedEventStore создает хранилище обработанных событий в памяти
+func NewMemoryProcessedEventStore() *MemoryProcessedEventStore {
+	return &MemoryProcessedEventStore{processed: make(map[string]string)}
+}
+
+// Claim занимает событие для обработки
+func (s *MemoryProcessedEventStore) Claim(_ context.Context, eventID, eventType string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.processed[eventID]; ok {
+		return false, nil
+	}
+	s.processed[eventID] = eventType
+	return true, nil
+}
+
+// Release снимает отметку с события
+func (s *MemoryProcessedEventStore) Release(_ context.Context, eventID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.processed, eventID)
+	return nil
+}
@@ -1,15 +1,18 @@
 package kafka
 
 import (
-	"encoding/json"
+	"context"
 	"fmt"
+	"homework1/internal/model"
 	"log"
 	"time"
 
 	"github.com/IBM/sarama"
+	eventsv1 "homework1/internal/api/events/v1"
 )
 
-// OrderMessage представляет структуру сообщения о заказе
+// OrderMessage представляет структуру сообщения о заказе старого формата.
+// Новые сообщения публикуются в виде конверта eventsv1.OrderEvent; структура нужна для чтения старых сообщений.
 type OrderMessage struct {
 	TimeStamp   time.Time
 	Operation   string
@@ -17,21 +20,21 @@ type OrderMessage struct {
 	Description string
 }
 
-// Producer представляет Kafka продюсера
+// Producer представляет Kafka продюсера.
+// Сообщения ключуются функцией keyFunc, поэтому события одного заказа попадают в один раздел топика.
 type Producer struct {
 	producer sarama.SyncProducer
 	topic    string
+	keyFunc  KeyFunc
 }
 
-// NewProducer создает нового продюсера Kafka
-func NewProducer(brokers []string, topic string) (*Producer, error) {
-	config := sarama.NewConfig()
-	config.Producer.RequiredAcks = sarama.WaitForAll
-	config.Producer.Idempotent = true
-	config.Producer.Return.Successes = true
-	config.Net.MaxOpenRequests = 1
+// NewProducer создает нового продюсера Kafka. Если keyFunc равен nil, события ключуются по ID заказа.
+func NewProducer(brokers []string, topic string, keyFunc KeyFunc) (*Producer, error) {
+	if keyFunc == nil {
+		keyFunc = KeyByOrderID
+	}
 
-	syncProducer, err := sarama.NewSyncProducer(brokers, config)
+	syncProducer, err := sarama.NewSyncProducer(brokers, newProducerConfig())
 	if err != nil {
 		return nil, fmt.Errorf("sarama.NewSyncProducer: %w", err)
 	}
@@ -39,51 +42,71 @@ func NewProducer(brokers []string, topic string) (*Producer, error) {
 	producer := &Producer{
 		producer: syncProducer,
 		topic:    topic,
+		keyFunc:  keyFunc,
 	}
 
 	return producer, nil
 }
 
-// SendOrderMessage отправляет сообщение о заказе в Kafka
-func (p Producer) SendOrderMessage(message OrderMessage) error {
-	// Сериализация сообщения
-	msg, err := json.Marshal(message)
-	if err != nil {
-		return fmt.Errorf("json.Marshal: %w", err)
-	}
-
-	kafkaMsg := &sarama.ProducerMessage{
-		Topic: p.topic,
-		Value: sarama.ByteEncoder(msg),
-	}
+// newProducerConfig возвращает конфигурацию идемпотентного синхронного продюсера
+func newProducerConfig() *sarama.Config {
+	config := sarama.NewConfig()
+	config.Producer.RequiredAcks = sarama.WaitForAll
+	config.Producer.Idempotent = true
+	config.Producer.Return.Successes = true
+	config.Producer.Partitioner = sarama.NewHashPartitioner
+	config.Net.MaxOpenRequests = 1
+	return config
+}
 
-	partition, offset, err := p.producer.SendMessage(kafkaMsg)
+// SendEvent отправляет событие в Kafka напрямую, минуя outbox
+func (p Producer) SendEvent(ctx context.Context, event *eventsv1.OrderEvent) error {
+	outboxEvent, err := p.NewOutboxEvent(ctx, event)
 	if err != nil {
-		return fmt.Errorf("p.producer.SendMessage: %w", err)
+		return err
 	}
 
-	log.Printf("Сообщение успешно отправлено в Kafka. Тема: %s, Раздел: %d, Смещение: %d, Сообщение: %+v\n", p.topic, partition, offset, message)
+	return p.SendOutboxEvent(outboxEvent)
+}
 
-	return nil
+// Topic возвращает топик, в который пишет продюсер
+func (p Producer) Topic() string {
+	return p.topic
 }
 
-// SendKafkaErrorMessage отправляет сообщение об ошибке в Kafka
-func (p Producer) SendKafkaErrorMessage(operation string, orderID int, description string) error {
-	errorMessage := OrderMessage{
-		TimeStamp:   time.Now(),
-		Operation:   operation,
-		OrderID:     orderID,
-		Description: fmt.Sprintf("Ошибка: %s", description),
-	}
+// NewOutboxEvent сериализует событие в запись outbox для топика продюсера
+// с ключом партиционирования и заголовками, включая контекст трассировки из ctx
+func (p Producer) NewOutboxEvent(ctx context.Context, event *eventsv1.OrderEvent) (model.OutboxEvent, error) {
+	return NewOutboxEvent(ctx, p.topic, p.keyFunc, event)
+}
 
-	msg, err := json.Marshal(errorMessage)
+// NewOutboxEvent сериализует событие в запись outbox для указанного топика и функции ключа
+func NewOutboxEvent(ctx context.Context, topic string, keyFunc KeyFunc, event *eventsv1.OrderEvent) (model.OutboxEvent, error) {
+	payload, err := MarshalEvent(event)
 	if err != nil {
-		return fmt.Errorf("json.Marshal: %w", err)
+		return model.OutboxEvent{}, err
 	}
 
+	return model.OutboxEvent{
+		Topic:       topic,
+		EventKey:    keyFunc(event),
+		AggregateID: int(event.GetAggregateId()),
+		EventType:   event.GetEventType().String(),
+		Payload:     payload,
+		Headers:     EventHeaders(ctx, event),
+	}, nil
+}
+
+// SendOutboxEvent публикует событие outbox в Kafka без повторной сериализации.
+// Ключ сообщения определяет раздел топика, заголовки передаются без изменений.
+func (p Producer) SendOutboxEvent(event model.OutboxEvent) error {
 	kafkaMsg := &sarama.ProducerMessage{
-		Topic: p.topic,
-		Value: sarama.ByteEncoder(msg),
+		Topic:   event.Topic,
+		Value:   sarama.ByteEncoder(event.Payload),
+		Headers: recordHeaders(event.Headers),
+	}
+	if event.EventKey != "" {
+		kafkaMsg.Key = sarama.StringEncoder(event.EventKey)
 	}
 
 	partition, offset, err := p.producer.SendMessage(kafkaMsg)
@@ -91,11 +114,17 @@ func (p Producer) SendKafkaErrorMessage(operation string, orderID int, descripti
 		return fmt.Errorf("p.producer.SendMessage: %w", err)
 	}
 
-	log.Printf("Сообщение об ошибке успешно отправлено в Kafka. Тема: %s, Раздел: %d, Смещение: %d, Сообщение: %+v\n", p.topic, partition, offset, errorMessage)
+	log.Printf("Событие outbox %d отправлено в Kafka. Тема: %s, Ключ: %s, Раздел: %d, Смещение: %d, Тип: %s\n",
+		event.EventID, event.Topic, event.EventKey, partition, offset, event.EventType)
 
 	return nil
 }
 
+// SendKafkaErrorMessage отправляет событие об ошибке в Kafka
+func (p Producer) SendKafkaErrorMessage(operation string, orderID int, description string) error {
+	return p.SendEvent(context.Background(), NewErrorEvent(operation, orderID, description))
+}
+
 // Close закрывает продюсера
 func (p Producer) Close() error {
 	err := p.producer.Close()
@@ -0,0 +1,147 @@
+package kafka
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync/atomic"
+	"time"
+
+	"github.com/IBM/sarama"
+)
+
+// redriveIdleTimeout - время ожидания новых сообщений, после которого раздел DLQ считается вычитанным
+const redriveIdleTimeout = 5 * time.Second
+
+// redriveHandler переотправляет сообщения из DLQ в исходный топик, пока все разделы не будут вычитаны
+type redriveHandler struct {
+	producer      sarama.SyncProducer
+	fallbackTopic string
+	redriven      atomic.Int64
+	remaining     atomic.Int32
+	cancel        context.CancelFunc
+}
+
+// Setup вызывается при запуске сессии и запоминает количество назначенных разделов
+func (h *redriveHandler) Setup(sess sarama.ConsumerGroupSession) error {
+	claims := 0
+	for _, partitions := range sess.Claims() {
+		claims += len(partitions)
+	}
+	h.remaining.Store(int32(claims))
+	if claims == 0 {
+		h.cancel()
+	}
+	return nil
+}
+
+// Cleanup вызывается при завершении сессии
+func (h *redriveHandler) Cleanup(sess sarama.ConsumerGroupSession) error {
+	sess.Commit()
+	return nil
+}
+
+// ConsumeClaim переотправляет сообщения раздела до его конца.
+// Вычитанный раздел не завершает обработчик, иначе sarama завершит сессию для всех разделов:
+// обработчик ждет, пока будут вычитаны остальные разделы.
+func (h *redriveHandler) ConsumeClaim(sess sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
+	if err := h.redrivePartition(sess, claim); err != nil {
+		return err
+	}
+
+	if h.remaining.Add(-1) == 0 {
+		h.cancel()
+	}
+	<-sess.Context().Done()
+	return nil
+}
+
+// redrivePartition переотправляет сообщения раздела, пока не достигнута его верхняя граница
+func (h *redriveHandler) redrivePartition(sess sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
+	highWaterMark := claim.HighWaterMarkOffset()
+	if highWaterMark == 0 || claim.InitialOffset() >= highWaterMark {
+		return nil
+	}
+
+	for {
+		select {
+		case message, ok := <-claim.Messages():
+			if !ok {
+				return nil
+			}
+			if err := h.republish(message); err != nil {
+				return err
+			}
+			sess.MarkMessage(message, "")
+			if message.Offset+1 >= highWaterMark {
+				return nil
+			}
+		case <-time.After(redriveIdleTimeout):
+			return nil
+		case <-sess.Context().Done():
+			return nil
+		}
+	}
+}
+
+// republish отправляет сообщение DLQ в исходный топик с исходными ключом и заголовками
+func (h *redriveHandler) republish(message *sarama.ConsumerMessage) error {
+	topic := headerValue(message.Headers, HeaderDLQOriginalTopic)
+	if topic == "" {
+		topic = h.fallbackTopic
+	}
+
+	headers := stripDLQHeaders(message.Headers)
+	msg := &sarama.ProducerMessage{
+		Topic:   topic,
+		Value:   sarama.ByteEncoder(message.Value),
+		Headers: headers,
+	}
+	if message.Key != nil {
+		msg.Key = sarama.ByteEncoder(message.Key)
+	}
+
+	if _, _, err := h.producer.SendMessage(msg); err != nil {
+		return fmt.Errorf("ошибка переотправки сообщения DLQ с Offset = %d в топик %s: %w", message.Offset, topic, err)
+	}
+
+	h.redriven.Add(1)
+	log.Printf("Сообщение DLQ с Offset = %d переотправлено в топик %s (причина ошибки: %s)",
+		message.Offset, topic, headerValue(message.Headers, HeaderDLQReason))
+	return nil
+}
+
+// Redrive переотправляет все накопившиеся сообщения dead-letter топика в исходные топики.
+// Сообщения без заголовка исходного топика отправляются в fallbackTopic.
+// Прогресс фиксируется в consumer группе groupID, поэтому повторный запуск не переотправляет сообщения еще раз.
+// Возвращает количество переотправленных сообщений.
+func Redrive(ctx context.Context, brokers []string, groupID, dlqTopic, fallbackTopic string) (int64, error) {
+	consumerGroup, err := NewConsumerGroup(brokers, groupID)
+	if err != nil {
+		return 0, err
+	}
+	defer consumerGroup.Close()
+
+	producer, err := sarama.NewSyncProducer(brokers, newProducerConfig())
+	if err != nil {
+		return 0, fmt.Errorf("sarama.NewSyncProducer: %w", err)
+	}
+	defer producer.Close()
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	handler := &redriveHandler{
+		producer:      producer,
+		fallbackTopic: fallbackTopic,
+		cancel:        cancel,
+	}
+
+	for ctx.Err() == nil {
+		if err := consumerGroup.Consume(ctx, []string{dlqTopic}, handler); err != nil {
+			return handler.redriven.Load(), fmt.Errorf("ошибка чтения DLQ: %w", err)
+		}
+	}
+
+	return handler.redriven.Load(), nil
+}
@@ -0,0 +1,38 @@
+package kafka
+
+import (
+	"context"
+	"time"
+)
+
+// RetryPolicy задает политику повторной обработки сообщений консьюмером
+type RetryPolicy struct {
+	MaxRetries     int           // Количество повторных попыток после первой неудачи
+	InitialBackoff time.Duration // Задержка перед первой повторной попыткой
+	MaxBackoff     time.Duration // Максимальная задержка между попытками
+}
+
+// Backoff возвращает экспоненциальную задержку перед повторной попыткой с номером retry (начиная с 1)
+func (p RetryPolicy) Backoff(retry int) time.Duration {
+	delay := p.InitialBackoff
+	for i := 1; i < retry; i++ {
+		delay *= 2
+		if p.MaxBackoff > 0 && delay >= p.MaxBackoff {
+			return p.MaxBackoff
+		}
+	}
+	return delay
+}
+
+// sleep ожидает задержку или отмену контекста; возвращает false, если контекст отменен
+func sleep(ctx context.Context, delay time.Duration) bool {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
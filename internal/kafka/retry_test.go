@@ -0,0 +1,20 @@
+package kafka
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestRetryPolicyBackoff проверяет экспоненциальный рост задержки и ее ограничение сверху
+func TestRetryPolicyBackoff(t *testing.T) {
+	t.Parallel()
+	policy := RetryPolicy{MaxRetries: 5, InitialBackoff: 100 * time.Millisecond, MaxBackoff: time.Second}
+
+	assert.Equal(t, 100*time.Millisecond, policy.Backoff(1))
+	assert.Equal(t, 200*time.Millisecond, policy.Backoff(2))
+	assert.Equal(t, 800*time.Millisecond, policy.Backoff(4))
+	assert.Equal(t, time.Second, policy.Backoff(5))
+	assert.Equal(t, time.Second, policy.Backoff(50))
+}
@@ -0,0 +1,59 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"homework1/internal/cache"
+)
+
+// cacheCollector вычисляет метрики кэшей из снимка счетчиков при каждом сборе.
+// Набор кэшей заранее не известен, поэтому метрики создаются для каждого кэша из снимка с меткой cache.
+type cacheCollector struct {
+	stats        func() []cache.Stats
+	hits         *prometheus.Desc
+	misses       *prometheus.Desc
+	errors       *prometheus.Desc
+	loadErrors   *prometheus.Desc
+	loadDuration *prometheus.Desc
+	evictions    *prometheus.Desc
+}
+
+// RegisterCacheMetrics регистрирует метрики именованных кэшей с меткой cache, вычисляемые из снимка при каждом сборе
+func RegisterCacheMetrics(stats func() []cache.Stats) {
+	labels := []string{"cache"}
+	prometheus.MustRegister(&cacheCollector{
+		stats:        stats,
+		hits:         prometheus.NewDesc("cache_hits_total", "Total number of cache lookups served from the cache", labels, nil),
+		misses:       prometheus.NewDesc("cache_misses_total", "Total number of cache lookups that loaded the value from the source", labels, nil),
+		errors:       prometheus.NewDesc("cache_errors_total", "Total number of cache read and write errors", labels, nil),
+		loadErrors:   prometheus.NewDesc("cache_load_errors_total", "Total number of failed loads from the source", labels, nil),
+		loadDuration: prometheus.NewDesc("cache_load_duration_seconds", "Duration of loads from the source on cache misses", labels, nil),
+		evictions:    prometheus.NewDesc("cache_evictions_total", "Total number of in-process cache entries evicted by the size limit", labels, nil),
+	})
+}
+
+// Describe передает описания метрик кэшей
+func (c *cacheCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.hits
+	ch <- c.misses
+	ch <- c.errors
+	ch <- c.loadErrors
+	ch <- c.loadDuration
+	ch <- c.evictions
+}
+
+// Collect передает значения метрик каждого кэша из снимка
+func (c *cacheCollector) Collect(ch chan<- prometheus.Metric) {
+	for _, s := range c.stats() {
+		ch <- prometheus.MustNewConstMetric(c.hits, prometheus.CounterValue, float64(s.Hits), s.Name)
+		ch <- prometheus.MustNewConstMetric(c.misses, prometheus.CounterValue, float64(s.Misses), s.Name)
+		ch <- prometheus.MustNewConstMetric(c.errors, prometheus.CounterValue, float64(s.Errors), s.Name)
+		ch <- prometheus.MustNewConstMetric(c.loadErrors, prometheus.CounterValue, float64(s.LoadErrors), s.Name)
+		ch <- prometheus.MustNewConstMetric(c.evictions, prometheus.CounterValue, float64(s.Evictions), s.Name)
+
+		buckets := make(map[float64]uint64, len(cache.LoadBuckets))
+		for i, bound := range cache.LoadBuckets {
+			buckets[bound] = s.LoadBuckets[i]
+		}
+		ch <- prometheus.MustNewConstHistogram(c.loadDuration, s.Loads, s.LoadSeconds, buckets, s.Name)
+	}
+}
@@ -0,0 +1,26 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"homework1/internal/model"
+)
+
+// expiredOrdersCounter считает просроченные заказы по результату обработки
+var expiredOrdersCounter = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "expired_orders_total",
+		Help: "Total number of expired orders handled by the expiry sweep",
+	},
+	[]string{"result"},
+)
+
+func init() {
+	prometheus.MustRegister(expiredOrdersCounter)
+}
+
+// RecordExpiredOrders учитывает итог прохода обработки просроченных заказов
+func RecordExpiredOrders(summary model.ExpiredOrdersSummary) {
+	expiredOrdersCounter.WithLabelValues("processed").Add(float64(summary.Processed))
+	expiredOrdersCounter.WithLabelValues("skipped").Add(float64(summary.Skipped))
+	expiredOrdersCounter.WithLabelValues("failed").Add(float64(summary.Failed))
+}
@@ -0,0 +1,30 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"homework1/internal/dao"
+)
+
+// RegisterTxRetryMetrics регистрирует счетчики повторов транзакций, вычисляемые из снимка при каждом сборе
+func RegisterTxRetryMetrics(stats func() dao.TxRetryStats) {
+	retries := func(reason string, value func(dao.TxRetryStats) uint64) prometheus.Collector {
+		return prometheus.NewCounterFunc(prometheus.CounterOpts{
+			Name:        "db_tx_retries_total",
+			Help:        "Total number of database transactions retried after a conflict",
+			ConstLabels: prometheus.Labels{"reason": reason},
+		}, func() float64 {
+			return float64(value(stats()))
+		})
+	}
+
+	prometheus.MustRegister(
+		retries("serialization_failure", func(s dao.TxRetryStats) uint64 { return s.SerializationRetries }),
+		retries("deadlock_detected", func(s dao.TxRetryStats) uint64 { return s.DeadlockRetries }),
+		prometheus.NewCounterFunc(prometheus.CounterOpts{
+			Name: "db_tx_retries_exhausted_total",
+			Help: "Total number of database transactions that failed with a conflict after all attempts",
+		}, func() float64 {
+			return float64(stats().Exhausted)
+		}),
+	)
+}
@@ -0,0 +1,41 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"homework1/internal/pool"
+)
+
+// RegisterWorkerPoolMetrics регистрирует метрики пула воркеров, вычисляемые из снимка состояния при каждом сборе
+func RegisterWorkerPoolMetrics(stats func() pool.Stats) {
+	gauge := func(name, help string, value func(pool.Stats) float64) prometheus.Collector {
+		return prometheus.NewGaugeFunc(prometheus.GaugeOpts{Name: name, Help: help}, func() float64 {
+			return value(stats())
+		})
+	}
+	counter := func(name, help string, value func(pool.Stats) float64) prometheus.Collector {
+		return prometheus.NewCounterFunc(prometheus.CounterOpts{Name: name, Help: help}, func() float64 {
+			return value(stats())
+		})
+	}
+
+	prometheus.MustRegister(
+		gauge("worker_pool_workers", "Configured number of workers",
+			func(s pool.Stats) float64 { return float64(s.Workers) }),
+		gauge("worker_pool_active_workers", "Number of workers executing a task",
+			func(s pool.Stats) float64 { return float64(s.ActiveWorkers) }),
+		gauge("worker_pool_queue_depth", "Number of tasks waiting in the queue",
+			func(s pool.Stats) float64 { return float64(s.QueueDepth) }),
+		gauge("worker_pool_queue_capacity", "Capacity of the task queue",
+			func(s pool.Stats) float64 { return float64(s.QueueCapacity) }),
+		gauge("worker_pool_avg_wait_seconds", "Average time a task waits in the queue",
+			func(s pool.Stats) float64 { return s.AvgWaitTime.Seconds() }),
+		counter("worker_pool_completed_tasks_total", "Total number of successfully completed tasks",
+			func(s pool.Stats) float64 { return float64(s.CompletedTasks) }),
+		counter("worker_pool_failed_tasks_total", "Total number of tasks that returned an error",
+			func(s pool.Stats) float64 { return float64(s.FailedTasks) }),
+		counter("worker_pool_panicked_tasks_total", "Total number of tasks that panicked",
+			func(s pool.Stats) float64 { return float64(s.PanickedTasks) }),
+		counter("worker_pool_rejected_tasks_total", "Total number of tasks rejected because the queue was full",
+			func(s pool.Stats) float64 { return float64(s.RejectedTasks) }),
+	)
+}
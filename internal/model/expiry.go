@@ -0,0 +1,41 @@
+package model
+
+import (
+	"fmt"
+	"time"
+)
+
+// ExpiredOrdersSummary - итог одного прохода обработки просроченных заказов
+type ExpiredOrdersSummary struct {
+	Processed int // Заказы, для которых создан возврат
+	Skipped   int // Заказы, возврат которых уже был создан ранее: завершена только смена статуса
+	Failed    int // Заказы, обработка которых завершилась ошибкой
+}
+
+// Add добавляет к итогу результаты другого прохода или пачки
+func (s *ExpiredOrdersSummary) Add(other ExpiredOrdersSummary) {
+	s.Processed += other.Processed
+	s.Skipped += other.Skipped
+	s.Failed += other.Failed
+}
+
+// String возвращает итог в виде строки для журнала и состояния задания
+func (s ExpiredOrdersSummary) String() string {
+	return fmt.Sprintf("обработано: %d, пропущено: %d, с ошибкой: %d", s.Processed, s.Skipped, s.Failed)
+}
+
+// ExpiredOrdersBatch задает пачку просроченных заказов для оформления возврата
+type ExpiredOrdersBatch struct {
+	StatusID int       // Статус заказов, подлежащих возврату
+	Before   time.Time // Заказы со сроком хранения, истекшим до этого момента
+	Limit    int       // Максимальный размер пачки
+	Exclude  []int     // Заказы, которые не нужно выбирать, например завершившиеся ошибкой в этом проходе
+}
+
+// ExpiredOrdersBatchResult - результат обработки пачки просроченных заказов
+type ExpiredOrdersBatchResult struct {
+	Selected int                  // Заказы, заблокированные для обработки
+	Summary  ExpiredOrdersSummary // Итог обработки пачки
+	Returned []Order              // Заказы, переведенные в статус возврата
+	Failed   map[int]error        // Ошибки обработки заказов по ID
+}
@@ -0,0 +1,68 @@
+package model
+
+import "time"
+
+// OrderFilter задает условия отбора заказов для постраничного списка. Нулевые значения полей не ограничивают выборку.
+// Диапазоны дат полуоткрытые: From включительно, Before не включительно.
+type OrderFilter struct {
+	UserID         int       `json:"user_id,omitempty"`
+	StatusID       int       `json:"status_id,omitempty"`
+	PackagingID    int       `json:"packaging_id,omitempty"`
+	AcceptedFrom   time.Time `json:"accepted_from,omitempty"`
+	AcceptedBefore time.Time `json:"accepted_before,omitempty"`
+	ExpiresFrom    time.Time `json:"expires_from,omitempty"`
+	ExpiresBefore  time.Time `json:"expires_before,omitempty"`
+	Issued         *bool     `json:"issued,omitempty"` // true - только выданные, false - только невыданные, nil - все
+}
+
+// Matches проверяет, подходит ли заказ под условия фильтра
+func (f OrderFilter) Matches(order Order) bool {
+	return matchID(f.UserID, order.UserID) &&
+		matchID(f.StatusID, order.StatusID) &&
+		matchID(f.PackagingID, order.PackagingID) &&
+		matchTime(f.AcceptedFrom, f.AcceptedBefore, order.AcceptanceDate) &&
+		matchTime(f.ExpiresFrom, f.ExpiresBefore, order.ExpirationDate) &&
+		(f.Issued == nil || *f.Issued == !order.IssueDate.IsZero())
+}
+
+// ReturnFilter задает условия отбора возвратов для постраничного списка. Нулевые значения полей не ограничивают выборку.
+// Диапазон дат полуоткрытый: From включительно, Before не включительно.
+type ReturnFilter struct {
+	UserID         int       `json:"user_id,omitempty"`
+	StatusID       int       `json:"status_id,omitempty"`
+	PackagingID    int       `json:"packaging_id,omitempty"`
+	ReasonID       int       `json:"reason_id,omitempty"`
+	ReturnedFrom   time.Time `json:"returned_from,omitempty"`
+	ReturnedBefore time.Time `json:"returned_before,omitempty"`
+}
+
+// Matches проверяет, подходит ли возврат под условия фильтра
+func (f ReturnFilter) Matches(ret Return) bool {
+	return matchID(f.UserID, ret.UserID) &&
+		matchID(f.StatusID, ret.StatusID) &&
+		matchID(f.PackagingID, ret.PackagingID) &&
+		matchID(f.ReasonID, ret.ReasonID) &&
+		matchTime(f.ReturnedFrom, f.ReturnedBefore, ret.ReturnDate)
+}
+
+// OrderPage - страница списка заказов
+type OrderPage struct {
+	Orders        []Order
+	NextPageToken string // Курсор следующей страницы; пустой на последней странице
+}
+
+// ReturnPage - страница списка возвратов
+type ReturnPage struct {
+	Returns       []Return
+	NextPageToken string // Курсор следующей страницы; пустой на последней странице
+}
+
+// matchID проверяет идентификатор; нулевой ожидаемый идентификатор подходит под любой
+func matchID(want, got int) bool {
+	return want == 0 || want == got
+}
+
+// matchTime проверяет попадание времени в полуоткрытый диапазон [from, before); нулевые границы не ограничивают его
+func matchTime(from, before, t time.Time) bool {
+	return (from.IsZero() || !t.Before(from)) && (before.IsZero() || t.Before(before))
+}
@@ -0,0 +1,22 @@
+package model
+
+import "time"
+
+// Статусы попытки доставки уведомления
+const (
+	DeliveryStatusSent   = "sent"
+	DeliveryStatusFailed = "failed"
+)
+
+// NotificationDelivery представляет запись журнала попыток доставки уведомления
+type NotificationDelivery struct {
+	DeliveryID  int64
+	EventID     string
+	EventType   string
+	Channel     string
+	Recipient   string
+	Status      string // DeliveryStatusSent или DeliveryStatusFailed
+	Error       string
+	Duration    time.Duration
+	AttemptedAt time.Time
+}
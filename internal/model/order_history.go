@@ -0,0 +1,16 @@
+package model
+
+import "time"
+
+// OrderStatusChange представляет запись истории смены статуса заказа
+type OrderStatusChange struct {
+	ChangeID      int       `json:"change_id"`       // Уникальный идентификатор записи
+	OrderID       int       `json:"order_id"`        // Идентификатор заказа
+	OldStatusID   int       `json:"old_status_id"`   // Предыдущий статус (0, если заказ только создан)
+	OldStatusName string    `json:"old_status_name"` // Название предыдущего статуса
+	NewStatusID   int       `json:"new_status_id"`   // Новый статус (0, если заказ удален)
+	NewStatusName string    `json:"new_status_name"` // Название нового статуса
+	Actor         string    `json:"actor"`           // Инициатор изменения
+	Reason        string    `json:"reason"`          // Причина изменения
+	ChangedAt     time.Time `json:"changed_at"`      // Время изменения
+}
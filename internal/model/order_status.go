@@ -0,0 +1,64 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+)
+
+// OrderState представляет состояние заказа в жизненном цикле ПВЗ.
+// Значение совпадает с кодом статуса в таблице statuses.
+type OrderState string
+
+const (
+	OrderStateAccepted        OrderState = "accepted"          // Заказ принят на ПВЗ
+	OrderStateIssued          OrderState = "issued"            // Заказ выдан клиенту
+	OrderStateReturned        OrderState = "returned"          // Заказ возвращен (клиентом или по истечении срока хранения)
+	OrderStateHandedToCourier OrderState = "handed_to_courier" // Возврат передан курьеру
+)
+
+var (
+	// ErrInvalidStatusTransition возвращается при попытке недопустимого перехода статуса заказа
+	ErrInvalidStatusTransition = errors.New("недопустимый переход статуса заказа")
+	// ErrUnknownOrderStatus возвращается, если статус не участвует в жизненном цикле заказа
+	ErrUnknownOrderStatus = errors.New("статус не входит в жизненный цикл заказа")
+)
+
+// orderTransitions описывает допустимые переходы между состояниями заказа
+var orderTransitions = map[OrderState][]OrderState{
+	OrderStateAccepted: {OrderStateIssued, OrderStateReturned},
+	OrderStateIssued:   {OrderStateReturned},
+	OrderStateReturned: {OrderStateHandedToCourier},
+}
+
+// IsValid проверяет, что состояние входит в жизненный цикл заказа
+func (s OrderState) IsValid() bool {
+	switch s {
+	case OrderStateAccepted, OrderStateIssued, OrderStateReturned, OrderStateHandedToCourier:
+		return true
+	}
+	return false
+}
+
+// CanTransitionTo проверяет, допустим ли переход в состояние next
+func (s OrderState) CanTransitionTo(next OrderState) bool {
+	for _, allowed := range orderTransitions[s] {
+		if allowed == next {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateOrderTransition возвращает ошибку, если переход from -> to не допускается жизненным циклом
+func ValidateOrderTransition(from, to OrderState) error {
+	if !from.IsValid() {
+		return fmt.Errorf("%w: %q", ErrUnknownOrderStatus, from)
+	}
+	if !to.IsValid() {
+		return fmt.Errorf("%w: %q", ErrUnknownOrderStatus, to)
+	}
+	if !from.CanTransitionTo(to) {
+		return fmt.Errorf("%w: %s -> %s", ErrInvalidStatusTransition, from, to)
+	}
+	return nil
+}
@@ -0,0 +1,41 @@
+package model_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"homework1/internal/model"
+)
+
+// TestValidateOrderTransition проверяет допустимые и недопустимые переходы статусов заказа
+func TestValidateOrderTransition(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		from    model.OrderState
+		to      model.OrderState
+		wantErr error
+	}{
+		{"принят -> выдан", model.OrderStateAccepted, model.OrderStateIssued, nil},
+		{"принят -> возврат по сроку хранения", model.OrderStateAccepted, model.OrderStateReturned, nil},
+		{"выдан -> возврат", model.OrderStateIssued, model.OrderStateReturned, nil},
+		{"возврат -> передан курьеру", model.OrderStateReturned, model.OrderStateHandedToCourier, nil},
+		{"повторная выдача", model.OrderStateIssued, model.OrderStateIssued, model.ErrInvalidStatusTransition},
+		{"выдан -> принят", model.OrderStateIssued, model.OrderStateAccepted, model.ErrInvalidStatusTransition},
+		{"принят -> передан курьеру", model.OrderStateAccepted, model.OrderStateHandedToCourier, model.ErrInvalidStatusTransition},
+		{"передан курьеру -> выдан", model.OrderStateHandedToCourier, model.OrderStateIssued, model.ErrInvalidStatusTransition},
+		{"неизвестный статус", model.OrderState("lost"), model.OrderStateIssued, model.ErrUnknownOrderStatus},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := model.ValidateOrderTransition(tt.from, tt.to)
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
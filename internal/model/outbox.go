@@ -0,0 +1,16 @@
+package model
+
+import "time"
+
+// OutboxEvent представляет событие, сохраненное в outbox для последующей публикации в Kafka
+type OutboxEvent struct {
+	EventID     int64
+	Topic       string
+	EventKey    string
+	AggregateID int // ID сущности, к которой относится событие
+	EventType   string
+	Payload     []byte
+	Headers     map[string]string // Заголовки сообщения Kafka: тип события, ключ идемпотентности, контекст трассировки
+	Attempts    int               // Количество неудачных попыток публикации
+	CreatedAt   time.Time
+}
@@ -0,0 +1,33 @@
+package notification
+
+import (
+	"context"
+	"strings"
+
+	eventsv1 "homework1/internal/api/events/v1"
+)
+
+// Notification представляет готовое к отправке уведомление клиенту
+type Notification struct {
+	EventID   string
+	EventType string // Короткое имя типа события, например ORDER_CREATED
+	OrderID   int64
+	UserID    int32
+	Subject   string
+	Body      string
+}
+
+// Channel отправляет уведомления клиентам через конкретный транспорт
+type Channel interface {
+	// Name возвращает имя канала, используемое в правилах маршрутизации
+	Name() string
+	// Recipient возвращает адрес получателя уведомления в канале для журнала доставки
+	Recipient(n Notification) string
+	// Send доставляет уведомление
+	Send(ctx context.Context, n Notification) error
+}
+
+// EventTypeName возвращает короткое имя типа события без префикса EVENT_TYPE_
+func EventTypeName(eventType eventsv1.EventType) string {
+	return strings.TrimPrefix(eventType.String(), "EVENT_TYPE_")
+}
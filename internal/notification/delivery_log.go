@@ -0,0 +1,30 @@
+package notification
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v4/pgxpool"
+
+	"homework1/internal/dao"
+	"homework1/internal/model"
+)
+
+// PostgresDeliveryLog хранит журнал доставки уведомлений в таблице notification_deliveries
+type PostgresDeliveryLog struct {
+	pool *pgxpool.Pool
+}
+
+// NewPostgresDeliveryLog создает журнал доставки в PostgreSQL
+func NewPostgresDeliveryLog(dbPool *pgxpool.Pool) *PostgresDeliveryLog {
+	return &PostgresDeliveryLog{pool: dbPool}
+}
+
+// Record сохраняет попытку доставки
+func (l *PostgresDeliveryLog) Record(ctx context.Context, delivery model.NotificationDelivery) error {
+	return dao.CreateNotificationDelivery(ctx, delivery, l.pool)
+}
+
+// Delivered проверяет, было ли уведомление о событии уже доставлено в канал
+func (l *PostgresDeliveryLog) Delivered(ctx context.Context, eventID, channel string) (bool, error) {
+	return dao.IsNotificationDelivered(ctx, eventID, channel, l.pool)
+}
@@ -0,0 +1,143 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	eventsv1 "homework1/internal/api/events/v1"
+	"homework1/internal/model"
+)
+
+// DeliveryLog хранит журнал попыток доставки уведомлений
+type DeliveryLog interface {
+	// Record сохраняет попытку доставки
+	Record(ctx context.Context, delivery model.NotificationDelivery) error
+	// Delivered проверяет, было ли уведомление о событии уже доставлено в канал
+	Delivered(ctx context.Context, eventID, channel string) (bool, error)
+}
+
+// Dispatcher отправляет уведомления о событиях в каналы согласно правилам маршрутизации
+// и записывает каждую попытку доставки в журнал.
+type Dispatcher struct {
+	routes     Routes
+	templates  *Templates
+	deliveries DeliveryLog
+	channels   map[string]Channel
+}
+
+// NewDispatcher создает диспетчер уведомлений.
+// Правила, ссылающиеся на неизвестные каналы, приводят к ошибке.
+func NewDispatcher(routes Routes, templates *Templates, deliveries DeliveryLog, channels ...Channel) (*Dispatcher, error) {
+	byName := make(map[string]Channel, len(channels))
+	for _, channel := range channels {
+		byName[channel.Name()] = channel
+	}
+
+	for eventType, names := range routes {
+		for _, name := range names {
+			if _, ok := byName[name]; !ok {
+				return nil, fmt.Errorf("правило для события %s ссылается на неизвестный канал %s", eventType, name)
+			}
+		}
+		if _, ok := templates.byEventType[eventType]; !ok {
+			return nil, fmt.Errorf("нет шаблона уведомления для события %s", eventType)
+		}
+	}
+
+	return &Dispatcher{
+		routes:     routes,
+		templates:  templates,
+		deliveries: deliveries,
+		channels:   byName,
+	}, nil
+}
+
+// Process отправляет уведомление о событии во все каналы, назначенные его типу.
+// Каналы, в которые уведомление уже было доставлено, пропускаются, поэтому повторная обработка
+// события после частичной ошибки не дублирует уведомления.
+func (d *Dispatcher) Process(ctx context.Context, event *eventsv1.OrderEvent) error {
+	eventType := EventTypeName(event.GetEventType())
+	names := d.routes[eventType]
+	if len(names) == 0 {
+		return nil
+	}
+
+	data := TemplateData{
+		EventID:     event.GetEventId(),
+		EventType:   eventType,
+		OrderID:     event.GetAggregateId(),
+		Description: event.GetDescription(),
+		OccurredAt:  event.GetOccurredAt().AsTime(),
+		Order:       event.GetOrder(),
+		Return:      event.GetOrderReturn(),
+	}
+	if data.Order != nil {
+		data.UserID = data.Order.GetUserId()
+	} else if data.Return != nil {
+		data.UserID = data.Return.GetUserId()
+	}
+
+	subject, body, err := d.templates.Render(eventType, data)
+	if err != nil {
+		return err
+	}
+
+	n := Notification{
+		EventID:   data.EventID,
+		EventType: eventType,
+		OrderID:   data.OrderID,
+		UserID:    data.UserID,
+		Subject:   subject,
+		Body:      body,
+	}
+
+	var errs []error
+	for _, name := range names {
+		if err := d.deliver(ctx, d.channels[name], n); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// deliver отправляет уведомление в канал и записывает попытку в журнал
+func (d *Dispatcher) deliver(ctx context.Context, channel Channel, n Notification) error {
+	delivered, err := d.deliveries.Delivered(ctx, n.EventID, channel.Name())
+	if err != nil {
+		return fmt.Errorf("ошибка проверки журнала доставки: %w", err)
+	}
+	if delivered {
+		log.Printf("Уведомление о событии %s уже доставлено в канал %s", n.EventID, channel.Name())
+		return nil
+	}
+
+	start := time.Now()
+	sendErr := channel.Send(ctx, n)
+
+	delivery := model.NotificationDelivery{
+		EventID:     n.EventID,
+		EventType:   n.EventType,
+		Channel:     channel.Name(),
+		Recipient:   channel.Recipient(n),
+		Status:      model.DeliveryStatusSent,
+		Duration:    time.Since(start),
+		AttemptedAt: start,
+	}
+	if sendErr != nil {
+		delivery.Status = model.DeliveryStatusFailed
+		delivery.Error = sendErr.Error()
+	}
+
+	if err := d.deliveries.Record(ctx, delivery); err != nil {
+		log.Printf("Ошибка записи попытки доставки события %s в канал %s: %v", n.EventID, channel.Name(), err)
+	}
+
+	if sendErr != nil {
+		return fmt.Errorf("ошибка доставки в канал %s: %w", channel.Name(), sendErr)
+	}
+	log.Printf("Уведомление о событии %s доставлено в канал %s (%s)", n.EventID, channel.Name(), delivery.Recipient)
+	return nil
+}
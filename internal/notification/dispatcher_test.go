@@ -0,0 +1,116 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	eventsv1 "homework1/internal/api/events/v1"
+	"homework1/internal/kafka"
+	"homework1/internal/model"
+)
+
+// fakeChannel запоминает отправленные уведомления и может возвращать ошибку
+type fakeChannel struct {
+	name string
+	sent []Notification
+	err  error
+}
+
+func (c *fakeChannel) Name() string                    { return c.name }
+func (c *fakeChannel) Recipient(n Notification) string { return c.name + ":" + n.EventID }
+func (c *fakeChannel) Send(_ context.Context, n Notification) error {
+	if c.err != nil {
+		return c.err
+	}
+	c.sent = append(c.sent, n)
+	return nil
+}
+
+// memoryDeliveryLog хранит журнал доставки в памяти
+type memoryDeliveryLog struct {
+	deliveries []model.NotificationDelivery
+}
+
+func (l *memoryDeliveryLog) Record(_ context.Context, delivery model.NotificationDelivery) error {
+	l.deliveries = append(l.deliveries, delivery)
+	return nil
+}
+
+func (l *memoryDeliveryLog) Delivered(_ context.Context, eventID, channel string) (bool, error) {
+	for _, d := range l.deliveries {
+		if d.EventID == eventID && d.Channel == channel && d.Status == model.DeliveryStatusSent {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// Тест разбора правил маршрутизации
+func TestParseRoutes(t *testing.T) {
+	routes, err := ParseRoutes(" order_created = email, webhook ; RETURN_CREATED=email;")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"email", "webhook"}, routes["ORDER_CREATED"])
+	assert.Equal(t, []string{"email"}, routes["RETURN_CREATED"])
+
+	_, err = ParseRoutes("ORDER_CREATED")
+	assert.Error(t, err, "правило без каналов должно приводить к ошибке")
+}
+
+// Тест формирования уведомлений из встроенных шаблонов
+func TestRenderDefaultTemplates(t *testing.T) {
+	templates, err := LoadTemplates("")
+	require.NoError(t, err)
+
+	order := model.Order{OrderID: 42, UserID: 7, ExpirationDate: time.Date(2024, 10, 20, 12, 0, 0, 0, time.Local)}
+	event := kafka.NewOrderEvent(eventsv1.EventType_EVENT_TYPE_ORDER_STORAGE_EXPIRING, order, "")
+
+	subject, body, err := templates.Render("ORDER_STORAGE_EXPIRING", TemplateData{OrderID: 42, Order: event.GetOrder()})
+	require.NoError(t, err)
+	assert.Contains(t, subject, "42")
+	assert.Contains(t, body, "20.10.2024 12:00")
+
+	// Событие старого формата без снимка заказа тоже должно формироваться
+	_, _, err = templates.Render("ORDER_CREATED", TemplateData{OrderID: 42})
+	assert.NoError(t, err)
+}
+
+// Тест маршрутизации события по каналам и записи попыток доставки
+func TestDispatcherProcess(t *testing.T) {
+	templates, err := LoadTemplates("")
+	require.NoError(t, err)
+
+	email := &fakeChannel{name: "email"}
+	webhook := &fakeChannel{name: "webhook", err: errors.New("сервис недоступен")}
+	deliveries := &memoryDeliveryLog{}
+
+	dispatcher, err := NewDispatcher(Routes{"ORDER_CREATED": {"email", "webhook"}}, templates, deliveries, email, webhook)
+	require.NoError(t, err)
+
+	event := kafka.NewOrderEvent(eventsv1.EventType_EVENT_TYPE_ORDER_CREATED, model.Order{OrderID: 5, UserID: 3}, "")
+
+	err = dispatcher.Process(context.Background(), event)
+	assert.Error(t, err, "ошибка канала должна возвращаться для повторной обработки")
+	require.Len(t, email.sent, 1)
+	assert.Equal(t, int32(3), email.sent[0].UserID)
+	require.Len(t, deliveries.deliveries, 2, "должна записываться каждая попытка доставки")
+	assert.Equal(t, model.DeliveryStatusFailed, deliveries.deliveries[1].Status)
+
+	// Повторная обработка не отправляет уведомление в канал, куда оно уже доставлено
+	webhook.err = nil
+	err = dispatcher.Process(context.Background(), event)
+	assert.NoError(t, err)
+	assert.Len(t, email.sent, 1)
+	assert.Len(t, webhook.sent, 1)
+
+	// События без правил маршрутизации игнорируются
+	deleted := kafka.NewOrderEvent(eventsv1.EventType_EVENT_TYPE_ORDER_DELETED, model.Order{OrderID: 5}, "")
+	assert.NoError(t, dispatcher.Process(context.Background(), deleted))
+
+	_, err = NewDispatcher(Routes{"ORDER_CREATED": {"sms"}}, templates, deliveries, email)
+	assert.Error(t, err, "правило с неизвестным каналом должно приводить к ошибке")
+}
@@ -0,0 +1,33 @@
+package notification
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Routes сопоставляет короткие имена типов событий с именами каналов доставки
+type Routes map[string][]string
+
+// ParseRoutes разбирает правила маршрутизации вида "ORDER_CREATED=email,webhook;RETURN_CREATED=email"
+func ParseRoutes(spec string) (Routes, error) {
+	routes := make(Routes)
+	for _, rule := range strings.Split(spec, ";") {
+		rule = strings.TrimSpace(rule)
+		if rule == "" {
+			continue
+		}
+
+		eventType, channels, ok := strings.Cut(rule, "=")
+		eventType = strings.ToUpper(strings.TrimSpace(eventType))
+		if !ok || eventType == "" {
+			return nil, fmt.Errorf("некорректное правило маршрутизации: %q", rule)
+		}
+
+		for _, channel := range strings.Split(channels, ",") {
+			if channel = strings.TrimSpace(channel); channel != "" {
+				routes[eventType] = append(routes[eventType], channel)
+			}
+		}
+	}
+	return routes, nil
+}
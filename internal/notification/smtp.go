@@ -0,0 +1,104 @@
+package notification
+
+import (
+	"context"
+	"fmt"
+	"mime"
+	"net"
+	"net/smtp"
+	"strings"
+	"time"
+)
+
+// SMTPChannel доставляет уведомления письмом через SMTP-сервер.
+// Для локальной разработки можно указать заглушку вроде MailHog (localhost:1025).
+type SMTPChannel struct {
+	addr            string
+	from            string
+	recipientFormat string
+	auth            smtp.Auth
+}
+
+// NewSMTPChannel создает email-канал; recipientFormat содержит %d для ID пользователя
+func NewSMTPChannel(addr, from, recipientFormat string, auth smtp.Auth) *SMTPChannel {
+	return &SMTPChannel{
+		addr:            addr,
+		from:            from,
+		recipientFormat: recipientFormat,
+		auth:            auth,
+	}
+}
+
+// Name возвращает имя канала
+func (c *SMTPChannel) Name() string {
+	return "email"
+}
+
+// Recipient возвращает адрес электронной почты клиента
+func (c *SMTPChannel) Recipient(n Notification) string {
+	return fmt.Sprintf(c.recipientFormat, n.UserID)
+}
+
+// Send отправляет письмо с текстом уведомления
+func (c *SMTPChannel) Send(ctx context.Context, n Notification) error {
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", c.addr)
+	if err != nil {
+		return fmt.Errorf("ошибка подключения к SMTP %s: %w", c.addr, err)
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+
+	host, _, _ := net.SplitHostPort(c.addr)
+	client, err := smtp.NewClient(conn, host)
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("smtp.NewClient: %w", err)
+	}
+	defer client.Close()
+
+	if c.auth != nil {
+		if err := client.Auth(c.auth); err != nil {
+			return fmt.Errorf("ошибка аутентификации SMTP: %w", err)
+		}
+	}
+
+	to := c.Recipient(n)
+	if err := client.Mail(c.from); err != nil {
+		return fmt.Errorf("SMTP MAIL FROM: %w", err)
+	}
+	if err := client.Rcpt(to); err != nil {
+		return fmt.Errorf("SMTP RCPT TO %s: %w", to, err)
+	}
+
+	writer, err := client.Data()
+	if err != nil {
+		return fmt.Errorf("SMTP DATA: %w", err)
+	}
+	if _, err := writer.Write(c.buildMessage(to, n)); err != nil {
+		return fmt.Errorf("ошибка записи письма: %w", err)
+	}
+	if err := writer.Close(); err != nil {
+		return fmt.Errorf("ошибка завершения письма: %w", err)
+	}
+
+	return client.Quit()
+}
+
+// buildMessage формирует письмо в формате RFC 5322 с текстом в UTF-8
+func (c *SMTPChannel) buildMessage(to string, n Notification) []byte {
+	var msg strings.Builder
+	msg.WriteString("From: " + c.from + "\r\n")
+	msg.WriteString("To: " + to + "\r\n")
+	msg.WriteString("Subject: " + mime.QEncoding.Encode("utf-8", n.Subject) + "\r\n")
+	msg.WriteString("Date: " + time.Now().Format(time.RFC1123Z) + "\r\n")
+	msg.WriteString("Message-ID: <" + n.EventID + "@pvz.notifier>\r\n")
+	msg.WriteString("MIME-Version: 1.0\r\n")
+	msg.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	msg.WriteString("Content-Transfer-Encoding: 8bit\r\n")
+	msg.WriteString("\r\n")
+	msg.WriteString(strings.ReplaceAll(n.Body, "\n", "\r\n"))
+	msg.WriteString("\r\n")
+	return []byte(msg.String())
+}
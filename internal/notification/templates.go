@@ -0,0 +1,100 @@
+package notification
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	eventsv1 "homework1/internal/api/events/v1"
+)
+
+//go:embed templates/*.tmpl
+var defaultTemplates embed.FS
+
+// TemplateData содержит данные события, доступные в шаблонах уведомлений
+type TemplateData struct {
+	EventID     string
+	EventType   string
+	OrderID     int64
+	UserID      int32
+	Description string
+	OccurredAt  time.Time
+	Order       *eventsv1.OrderSnapshot
+	Return      *eventsv1.ReturnSnapshot
+}
+
+// Templates хранит шаблоны уведомлений по коротким именам типов событий.
+// Файл шаблона <EVENT_TYPE>.tmpl должен определять шаблоны "subject" и "body".
+type Templates struct {
+	byEventType map[string]*template.Template
+}
+
+// templateFuncs - функции, доступные в шаблонах
+var templateFuncs = template.FuncMap{
+	"date": func(ts *timestamppb.Timestamp) string {
+		if ts == nil {
+			return ""
+		}
+		return ts.AsTime().Local().Format("02.01.2006 15:04")
+	},
+}
+
+// LoadTemplates загружает шаблоны из каталога dir; при пустом dir используются встроенные шаблоны
+func LoadTemplates(dir string) (*Templates, error) {
+	var fsys fs.FS
+	if dir == "" {
+		sub, err := fs.Sub(defaultTemplates, "templates")
+		if err != nil {
+			return nil, err
+		}
+		fsys = sub
+	} else {
+		fsys = os.DirFS(dir)
+	}
+
+	files, err := fs.Glob(fsys, "*.tmpl")
+	if err != nil {
+		return nil, fmt.Errorf("ошибка поиска шаблонов уведомлений: %w", err)
+	}
+
+	templates := &Templates{byEventType: make(map[string]*template.Template, len(files))}
+	for _, file := range files {
+		eventType := strings.ToUpper(strings.TrimSuffix(filepath.Base(file), ".tmpl"))
+		tmpl, err := template.New(file).Funcs(templateFuncs).ParseFS(fsys, file)
+		if err != nil {
+			return nil, fmt.Errorf("ошибка разбора шаблона %s: %w", file, err)
+		}
+		if tmpl.Lookup("subject") == nil || tmpl.Lookup("body") == nil {
+			return nil, fmt.Errorf("шаблон %s должен определять subject и body", file)
+		}
+		templates.byEventType[eventType] = tmpl
+	}
+
+	return templates, nil
+}
+
+// Render формирует тему и текст уведомления для типа события
+func (t *Templates) Render(eventType string, data TemplateData) (string, string, error) {
+	tmpl, ok := t.byEventType[eventType]
+	if !ok {
+		return "", "", fmt.Errorf("нет шаблона уведомления для события %s", eventType)
+	}
+
+	var subject, body bytes.Buffer
+	if err := tmpl.ExecuteTemplate(&subject, "subject", data); err != nil {
+		return "", "", fmt.Errorf("ошибка формирования темы уведомления %s: %w", eventType, err)
+	}
+	if err := tmpl.ExecuteTemplate(&body, "body", data); err != nil {
+		return "", "", fmt.Errorf("ошибка формирования текста уведомления %s: %w", eventType, err)
+	}
+
+	return strings.TrimSpace(subject.String()), strings.TrimSpace(body.String()), nil
+}
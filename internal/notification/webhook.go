@@ -0,0 +1,79 @@
+package notification
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// WebhookChannel доставляет уведомления HTTP POST запросом с JSON телом
+type WebhookChannel struct {
+	url    string
+	client *http.Client
+}
+
+// webhookPayload - тело запроса webhook-канала
+type webhookPayload struct {
+	EventID   string `json:"event_id"`
+	EventType string `json:"event_type"`
+	OrderID   int64  `json:"order_id"`
+	UserID    int32  `json:"user_id"`
+	Subject   string `json:"subject"`
+	Body      string `json:"body"`
+}
+
+// NewWebhookChannel создает webhook-канал с таймаутом запроса
+func NewWebhookChannel(url string, timeout time.Duration) *WebhookChannel {
+	return &WebhookChannel{
+		url:    url,
+		client: &http.Client{Timeout: timeout},
+	}
+}
+
+// Name возвращает имя канала
+func (c *WebhookChannel) Name() string {
+	return "webhook"
+}
+
+// Recipient возвращает URL получателя
+func (c *WebhookChannel) Recipient(_ Notification) string {
+	return c.url
+}
+
+// Send отправляет уведомление; ответ с кодом вне диапазона 2xx считается ошибкой доставки
+func (c *WebhookChannel) Send(ctx context.Context, n Notification) error {
+	body, err := json.Marshal(webhookPayload{
+		EventID:   n.EventID,
+		EventType: n.EventType,
+		OrderID:   n.OrderID,
+		UserID:    n.UserID,
+		Subject:   n.Subject,
+		Body:      n.Body,
+	})
+	if err != nil {
+		return fmt.Errorf("json.Marshal: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("http.NewRequest: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Idempotency-Key", n.EventID)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("ошибка запроса к webhook %s: %w", c.url, err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook %s вернул статус %d", c.url, resp.StatusCode)
+	}
+	return nil
+}
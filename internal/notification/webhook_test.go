@@ -0,0 +1,44 @@
+package notification
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Тест отправки уведомления webhook-каналом
+func TestWebhookChannelSend(t *testing.T) {
+	var received webhookPayload
+	var idempotencyKey string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		idempotencyKey = r.Header.Get("Idempotency-Key")
+		_ = json.NewDecoder(r.Body).Decode(&received)
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	channel := NewWebhookChannel(server.URL, time.Second)
+	err := channel.Send(context.Background(), Notification{EventID: "e1", EventType: "ORDER_CREATED", OrderID: 9, Subject: "s", Body: "b"})
+	require.NoError(t, err)
+	assert.Equal(t, "e1", idempotencyKey)
+	assert.Equal(t, int64(9), received.OrderID)
+	assert.Equal(t, "ORDER_CREATED", received.EventType)
+}
+
+// Тест обработки ответа с ошибкой webhook-каналом
+func TestWebhookChannelSendError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	channel := NewWebhookChannel(server.URL, time.Second)
+	err := channel.Send(context.Background(), Notification{EventID: "e2"})
+	assert.Error(t, err)
+}
@@ -0,0 +1,75 @@
+package outbox
+
+import (
+	"context"
+	"github.com/jackc/pgx/v4/pgxpool"
+	"homework1/internal/dao"
+	"homework1/internal/publisher"
+	"log"
+	"time"
+)
+
+// RelayConfig задает параметры реле outbox
+type RelayConfig struct {
+	PollInterval time.Duration // Интервал опроса таблицы outbox
+	BatchSize    int           // Максимальное количество событий за один проход
+	BaseBackoff  time.Duration // Начальная задержка перед повторной публикацией
+	MaxBackoff   time.Duration // Максимальная задержка перед повторной публикацией
+}
+
+// Relay публикует события из таблицы outbox через публикатор событий и помечает их отправленными.
+// Событие помечается отправленным только после подтверждения публикатора, поэтому доставка at-least-once:
+// после перезапуска неотправленные события будут опубликованы повторно.
+type Relay struct {
+	pool     *pgxpool.Pool
+	producer publisher.EventPublisher
+	config   RelayConfig
+}
+
+// NewRelay создает новое реле outbox
+func NewRelay(dbPool *pgxpool.Pool, producer publisher.EventPublisher, config RelayConfig) *Relay {
+	return &Relay{
+		pool:     dbPool,
+		producer: producer,
+		config:   config,
+	}
+}
+
+// Run опрашивает outbox до отмены контекста
+func (r *Relay) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.config.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		// Пока пачки заполняются целиком, публикуем без ожидания следующего тика
+		for {
+			sent, err := dao.RelayOutboxEvents(ctx, r.pool, r.config.BatchSize, r.producer.SendOutboxEvent, r.backoff)
+			if err != nil {
+				log.Printf("Ошибка публикации событий outbox: %v", err)
+				break
+			}
+			if sent < r.config.BatchSize {
+				break
+			}
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			log.Println("Завершение реле outbox.")
+			return
+		}
+	}
+}
+
+// backoff возвращает экспоненциальную задержку перед очередной попыткой публикации
+func (r *Relay) backoff(attempts int) time.Duration {
+	delay := r.config.BaseBackoff
+	for i := 1; i < attempts; i++ {
+		delay *= 2
+		if delay >= r.config.MaxBackoff {
+			return r.config.MaxBackoff
+		}
+	}
+	return delay
+}
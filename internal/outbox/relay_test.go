@@ -0,0 +1,21 @@
+package outbox
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+// Тест экспоненциальной задержки реле outbox
+func TestRelayBackoff(t *testing.T) {
+	relay := NewRelay(nil, nil, RelayConfig{
+		BaseBackoff: time.Second,
+		MaxBackoff:  10 * time.Second,
+	})
+
+	assert.Equal(t, time.Second, relay.backoff(1), "первая повторная попытка должна ждать базовую задержку")
+	assert.Equal(t, 2*time.Second, relay.backoff(2), "задержка должна удваиваться")
+	assert.Equal(t, 8*time.Second, relay.backoff(4), "задержка должна удваиваться")
+	assert.Equal(t, 10*time.Second, relay.backoff(5), "задержка не должна превышать максимальную")
+	assert.Equal(t, 10*time.Second, relay.backoff(100), "задержка не должна превышать максимальную")
+}
@@ -0,0 +1,139 @@
+package pool
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+)
+
+// ErrTaskPanicked возвращается задачей, завершившейся паникой
+var ErrTaskPanicked = errors.New("паника при выполнении задачи")
+
+// Priority определяет приоритет задачи в очереди пула.
+// Воркер берет задачу с меньшим приоритетом, только если задач с большим приоритетом нет.
+type Priority int
+
+const (
+	PriorityLow    Priority = iota // Фоновые задачи, например обработка просроченных заказов
+	PriorityNormal                 // Задачи обработки запросов
+	PriorityHigh                   // Срочные задачи
+
+	priorityCount = int(PriorityHigh) + 1
+)
+
+// taskOptions содержит параметры постановки задачи
+type taskOptions struct {
+	priority Priority
+}
+
+// TaskOption задает параметр постановки задачи
+type TaskOption func(*taskOptions)
+
+// WithPriority задает приоритет задачи
+func WithPriority(priority Priority) TaskOption {
+	return func(o *taskOptions) {
+		if priority >= PriorityLow && priority <= PriorityHigh {
+			o.priority = priority
+		}
+	}
+}
+
+// Future представляет результат задачи, поставленной в пул через Submit
+type Future[T any] struct {
+	done  chan struct{}
+	value T
+	err   error
+}
+
+// newFuture создает незавершенный результат
+func newFuture[T any]() *Future[T] {
+	return &Future[T]{done: make(chan struct{})}
+}
+
+// resolve сохраняет результат задачи. Вызывается ровно один раз.
+func (f *Future[T]) resolve(value T, err error) {
+	f.value = value
+	f.err = err
+	close(f.done)
+}
+
+// Done возвращает канал, закрывающийся после завершения задачи
+func (f *Future[T]) Done() <-chan struct{} {
+	return f.done
+}
+
+// Get дожидается результата задачи или отмены ctx
+func (f *Future[T]) Get(ctx context.Context) (T, error) {
+	select {
+	case <-f.done:
+		return f.value, f.err
+	case <-ctx.Done():
+		var zero T
+		return zero, ctx.Err()
+	}
+}
+
+// workerKey - ключ контекста, которым задача помечается как выполняемая воркером пула
+type workerKey struct{}
+
+// insideWorker сообщает, выполняется ли вызов из задачи пула wp
+func insideWorker(ctx context.Context, wp *WorkerPool) bool {
+	owner, _ := ctx.Value(workerKey{}).(*WorkerPool)
+	return owner == wp
+}
+
+// Submit ставит задачу в пул и возвращает ее будущий результат.
+// Если ctx отменяется, пока задача ждет места в очереди или своей очереди на выполнение,
+// задача не выполняется и завершается ошибкой контекста. Паника в задаче возвращается как ErrTaskPanicked.
+// Если задачу не удалось поставить в заполненную очередь, результат завершается ошибкой ErrQueueFull.
+// Задача получает контекст, помеченный как контекст воркера, поэтому вложенные вызовы Submit с ним не блокируют пул.
+func Submit[T any](ctx context.Context, wp *WorkerPool, fn func(ctx context.Context) (T, error), opts ...TaskOption) *Future[T] {
+	options := taskOptions{priority: PriorityNormal}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	future := newFuture[T]()
+	run := func() error {
+		if err := ctx.Err(); err != nil {
+			var zero T
+			future.resolve(zero, err)
+			return err
+		}
+
+		var value T
+		err := callSafely(func() error {
+			var err error
+			value, err = fn(context.WithValue(ctx, workerKey{}, wp))
+			return err
+		})
+		future.resolve(value, err)
+		return err
+	}
+
+	if err := wp.enqueue(ctx, run, options.priority); err != nil {
+		var zero T
+		future.resolve(zero, err)
+	}
+	return future
+}
+
+// callSafely выполняет функцию, превращая панику в ошибку ErrTaskPanicked
+func callSafely(fn func() error) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			log.Printf("Паника при выполнении задачи в пуле воркеров: %v", r)
+			err = fmt.Errorf("%w: %v", ErrTaskPanicked, r)
+		}
+	}()
+	return fn()
+}
+
+// Run ставит в пул задачу без результата и дожидается ее завершения или отмены ctx
+func Run(ctx context.Context, wp *WorkerPool, fn func(ctx context.Context) error, opts ...TaskOption) error {
+	_, err := Submit(ctx, wp, func(ctx context.Context) (struct{}, error) {
+		return struct{}{}, fn(ctx)
+	}, opts...).Get(ctx)
+	return err
+}
@@ -0,0 +1,98 @@
+package pool
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSubmitReturnsResult проверяет получение типизированного результата и ошибки задачи
+func TestSubmitReturnsResult(t *testing.T) {
+	wp := NewWorkerPool(2)
+	defer wp.Close()
+
+	value, err := Submit(context.Background(), wp, func(context.Context) (int, error) {
+		return 42, nil
+	}).Get(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 42, value)
+
+	expected := errors.New("ошибка задачи")
+	err = Run(context.Background(), wp, func(context.Context) error { return expected })
+	assert.ErrorIs(t, err, expected)
+}
+
+// TestSubmitRecoversPanic проверяет, что паника возвращается ошибкой и не останавливает воркер
+func TestSubmitRecoversPanic(t *testing.T) {
+	wp := NewWorkerPool(1)
+	defer wp.Close()
+
+	_, err := Submit(context.Background(), wp, func(context.Context) (string, error) {
+		panic("паника в задаче")
+	}).Get(context.Background())
+	assert.ErrorIs(t, err, ErrTaskPanicked)
+
+	value, err := Submit(context.Background(), wp, func(context.Context) (string, error) {
+		return "ok", nil
+	}).Get(context.Background())
+	require.NoError(t, err, "воркер должен продолжить работу после паники")
+	assert.Equal(t, "ok", value)
+	assert.Equal(t, uint64(1), wp.Stats().PanickedTasks)
+}
+
+// TestSubmitSkipsCancelledTask проверяет, что задача с истекшим контекстом не выполняется из очереди
+func TestSubmitSkipsCancelledTask(t *testing.T) {
+	wp := NewWorkerPool(0)
+	defer wp.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	executed := false
+	future := Submit(ctx, wp, func(context.Context) (bool, error) {
+		executed = true
+		return true, nil
+	})
+
+	_, err := future.Get(ctx)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	wp.SetWorkerCount(1)
+	<-future.Done()
+	wp.Wait()
+	assert.False(t, executed, "задача с истекшим сроком не должна выполняться")
+	assert.Equal(t, uint64(1), wp.Stats().FailedTasks)
+}
+
+// TestSubmitPriority проверяет, что задачи с большим приоритетом выполняются первыми
+func TestSubmitPriority(t *testing.T) {
+	wp := NewWorkerPool(0)
+	defer wp.Close()
+
+	var mu sync.Mutex
+	var order []string
+	record := func(name string) func(context.Context) error {
+		return func(context.Context) error {
+			mu.Lock()
+			defer mu.Unlock()
+			order = append(order, name)
+			return nil
+		}
+	}
+
+	ctx := context.Background()
+	low := Submit(ctx, wp, func(ctx context.Context) (struct{}, error) { return struct{}{}, record("low")(ctx) }, WithPriority(PriorityLow))
+	normal := Submit(ctx, wp, func(ctx context.Context) (struct{}, error) { return struct{}{}, record("normal")(ctx) })
+	high := Submit(ctx, wp, func(ctx context.Context) (struct{}, error) { return struct{}{}, record("high")(ctx) }, WithPriority(PriorityHigh))
+
+	wp.SetWorkerCount(1)
+	for _, done := range []<-chan struct{}{low.Done(), normal.Done(), high.Done()} {
+		<-done
+	}
+	assert.Equal(t, []string{"high", "normal", "low"}, order)
+}
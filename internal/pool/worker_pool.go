@@ -1,84 +1,335 @@
 package pool
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
 	"sync"
+	"sync/atomic"
+	"time"
 )
 
+// DefaultQueueSize - размер очереди задач по умолчанию
+const DefaultQueueSize = 100
+
+// ErrQueueFull возвращается, когда задачу не удалось поставить в заполненную очередь
+var ErrQueueFull = errors.New("очередь задач пула воркеров заполнена")
+
+// QueuePolicy определяет поведение пула при заполненной очереди
+type QueuePolicy int
+
+const (
+	// PolicyBlock ждет освобождения места в очереди не дольше BlockTimeout (0 - без ограничения)
+	PolicyBlock QueuePolicy = iota
+	// PolicyReject сразу отклоняет задачу с ошибкой ErrQueueFull
+	PolicyReject
+	// PolicyCallerRuns выполняет задачу в вызывающей горутине
+	PolicyCallerRuns
+)
+
+// ParseQueuePolicy возвращает политику по названию из конфигурации: block, reject или caller_runs
+func ParseQueuePolicy(name string) (QueuePolicy, error) {
+	switch name {
+	case "", "block":
+		return PolicyBlock, nil
+	case "reject":
+		return PolicyReject, nil
+	case "caller_runs":
+		return PolicyCallerRuns, nil
+	default:
+		return 0, fmt.Errorf("неизвестная политика очереди пула воркеров: %s", name)
+	}
+}
+
+// Config содержит параметры пула воркеров
+type Config struct {
+	Workers      int           // Начальное количество воркеров
+	QueueSize    int           // Размер очереди каждого приоритета
+	Policy       QueuePolicy   // Поведение при заполненной очереди
+	BlockTimeout time.Duration // Максимальное ожидание места в очереди для PolicyBlock
+}
+
+// task представляет задачу в очереди вместе со временем постановки
+type task struct {
+	run        func() error
+	enqueuedAt time.Time
+}
+
+// Stats представляет снимок состояния пула воркеров
+type Stats struct {
+	Workers        int           // Заданное количество воркеров
+	ActiveWorkers  int           // Воркеры, выполняющие задачу в данный момент
+	QueueDepth     int           // Задачи, ожидающие в очередях всех приоритетов
+	QueueCapacity  int           // Суммарный размер очередей всех приоритетов
+	CompletedTasks uint64        // Задачи, завершившиеся без ошибки
+	FailedTasks    uint64        // Задачи, вернувшие ошибку
+	PanickedTasks  uint64        // Задачи, завершившиеся паникой
+	RejectedTasks  uint64        // Задачи, отклоненные из-за заполненной очереди
+	AvgWaitTime    time.Duration // Среднее время ожидания задачи в очереди
+}
+
 // WorkerPool представляет пул воркеров для выполнения задач.
+// Количество воркеров меняется постепенно: при уменьшении останавливаются только лишние воркеры,
+// причем каждый дожидается завершения текущей задачи, при увеличении добавляются недостающие.
+// Для каждого приоритета ведется своя очередь; задачи с большим приоритетом выполняются первыми.
 type WorkerPool struct {
-	taskQueue  chan func() // Очередь задач
-	workerPool int         // Текущее количество воркеров
-	wg         sync.WaitGroup
-	mu         sync.Mutex    // Для защиты данных
-	done       chan struct{} // Канал для завершения воркеров
+	queues  [priorityCount]chan task // Очереди задач по приоритетам
+	policy  QueuePolicy
+	timeout time.Duration
+	stops   []chan struct{} // Каналы остановки запущенных воркеров, по одному на воркер
+	mu      sync.Mutex      // Защищает stops и closed
+	closed  bool
+
+	tasks   sync.WaitGroup // Задачи, поставленные в очередь и еще не завершенные
+	workers sync.WaitGroup // Запущенные воркеры
+
+	active    atomic.Int64
+	completed atomic.Uint64
+	failed    atomic.Uint64
+	panicked  atomic.Uint64
+	rejected  atomic.Uint64
+	started   atomic.Uint64
+	waitTotal atomic.Int64 // Суммарное время ожидания задач в очереди в наносекундах
 }
 
-// NewWorkerPool создает новый пул воркеров с заданным количеством воркеров.
+// NewWorkerPool создает новый пул воркеров с заданным количеством воркеров, очередью размера по умолчанию
+// и ожиданием места в очереди без ограничения.
 func NewWorkerPool(workerCount int) *WorkerPool {
+	return NewWorkerPoolWithConfig(Config{Workers: workerCount, QueueSize: DefaultQueueSize})
+}
+
+// NewWorkerPoolWithConfig создает новый пул воркеров с заданными параметрами
+func NewWorkerPoolWithConfig(cfg Config) *WorkerPool {
 	wp := &WorkerPool{
-		taskQueue:  make(chan func(), 100),
-		workerPool: workerCount,
-		done:       make(chan struct{}),
+		policy:  cfg.Policy,
+		timeout: cfg.BlockTimeout,
+	}
+	for i := range wp.queues {
+		wp.queues[i] = make(chan task, cfg.QueueSize)
 	}
 
-	wp.StartWorkers(workerCount)
+	wp.SetWorkerCount(cfg.Workers)
 	return wp
 }
 
-// StartWorkers запускает указанное количество воркеров.
-func (wp *WorkerPool) StartWorkers(workerCount int) {
-	for i := 0; i < workerCount; i++ {
-		go wp.worker()
-	}
-}
-
 // SetWorkerCount обновляет количество воркеров.
+// Выполняющиеся задачи не прерываются: остановленный воркер завершается после текущей задачи.
 func (wp *WorkerPool) SetWorkerCount(newWorkerCount int) {
+	if newWorkerCount < 0 {
+		newWorkerCount = 0
+	}
+
 	wp.mu.Lock()
 	defer wp.mu.Unlock()
 
-	// Останавливаем существующих воркеров.
-	close(wp.done)
-
-	// Создаем новый канал для завершения воркеров.
-	wp.done = make(chan struct{})
+	if wp.closed {
+		return
+	}
 
-	// Запускаем новых воркеров.
-	wp.StartWorkers(newWorkerCount)
-	wp.workerPool = newWorkerCount
+	// Останавливаем лишних воркеров.
+	for len(wp.stops) > newWorkerCount {
+		last := len(wp.stops) - 1
+		close(wp.stops[last])
+		wp.stops = wp.stops[:last]
+	}
 
+	// Запускаем недостающих воркеров.
+	for len(wp.stops) < newWorkerCount {
+		stop := make(chan struct{})
+		wp.stops = append(wp.stops, stop)
+		wp.workers.Add(1)
+		go wp.worker(stop)
+	}
 }
 
-// worker запускает задачи из очереди.
-func (wp *WorkerPool) worker() {
+// worker запускает задачи из очереди до закрытия stop.
+func (wp *WorkerPool) worker(stop <-chan struct{}) {
+	defer wp.workers.Done()
+
 	for {
+		// Остановка проверяется отдельно, чтобы остановленный воркер не брал новые задачи.
 		select {
-		case task := <-wp.taskQueue:
-			// Выполняем задачу.
-			task()
-			wp.wg.Done() // Уменьшаем счетчик после выполнения задачи
-		case <-wp.done:
+		case <-stop:
+			return
+		default:
+		}
+
+		if t, ok := wp.next(); ok {
+			wp.execute(t)
+			continue
+		}
+
+		select {
+		case t := <-wp.queues[PriorityHigh]:
+			wp.execute(t)
+		case t := <-wp.queues[PriorityNormal]:
+			wp.execute(t)
+		case t := <-wp.queues[PriorityLow]:
+			wp.execute(t)
+		case <-stop:
 			// Завершение работы воркера.
 			return
 		}
 	}
 }
 
-// SubmitTask добавляет задачу в очередь
-func (wp *WorkerPool) SubmitTask(task func()) {
-	wp.wg.Add(1)         // Увеличиваем счетчик перед добавлением задачи
-	wp.taskQueue <- task // Добавляем задачу в очередь
+// next возвращает ожидающую задачу с наибольшим приоритетом без блокировки
+func (wp *WorkerPool) next() (task, bool) {
+	for priority := PriorityHigh; priority >= PriorityLow; priority-- {
+		select {
+		case t := <-wp.queues[priority]:
+			return t, true
+		default:
+		}
+	}
+	return task{}, false
+}
+
+// execute выполняет задачу и обновляет статистику. Паника в задаче не останавливает воркер.
+func (wp *WorkerPool) execute(t task) {
+	wp.started.Add(1)
+	wp.waitTotal.Add(int64(time.Since(t.enqueuedAt)))
+	wp.active.Add(1)
+	defer wp.active.Add(-1)
+	defer wp.tasks.Done() // Уменьшаем счетчик после выполнения задачи
+
+	err := callSafely(t.run)
+	switch {
+	case err == nil:
+		wp.completed.Add(1)
+	case errors.Is(err, ErrTaskPanicked):
+		wp.panicked.Add(1)
+	default:
+		wp.failed.Add(1)
+	}
+}
+
+// SubmitTask добавляет задачу в очередь.
+// Возвращает ErrQueueFull, если очередь заполнена и политика пула не позволяет дождаться места.
+func (wp *WorkerPool) SubmitTask(fn func()) error {
+	return wp.SubmitFunc(func() error {
+		fn()
+		return nil
+	})
+}
+
+// SubmitFunc добавляет в очередь задачу, ошибка которой учитывается в статистике пула
+func (wp *WorkerPool) SubmitFunc(fn func() error) error {
+	return wp.enqueue(context.Background(), fn, PriorityNormal)
+}
+
+// enqueue добавляет задачу в очередь приоритета; при заполненной очереди действует по политике пула.
+// Ожидание места в очереди прерывается отменой ctx.
+// Задачи, поставленные из задачи этого же пула, и задачи после закрытия пула выполняются в вызывающей горутине:
+// ожидание вложенной задачи из воркера могло бы занять все воркеры и заблокировать пул.
+func (wp *WorkerPool) enqueue(ctx context.Context, fn func() error, priority Priority) error {
+	if insideWorker(ctx, wp) {
+		return wp.runInCaller(fn)
+	}
+
+	wp.mu.Lock()
+	if wp.closed {
+		wp.mu.Unlock()
+		log.Println("Пул воркеров закрыт, задача выполняется вне пула")
+		_ = callSafely(fn)
+		return nil
+	}
+	wp.tasks.Add(1) // Увеличиваем счетчик перед добавлением задачи
+	wp.mu.Unlock()
+
+	t := task{run: fn, enqueuedAt: time.Now()}
+	select {
+	case wp.queues[priority] <- t: // Добавляем задачу в очередь
+		return nil
+	default:
+	}
+
+	switch wp.policy {
+	case PolicyReject:
+		wp.tasks.Done()
+		wp.rejected.Add(1)
+		return ErrQueueFull
+	case PolicyCallerRuns:
+		wp.tasks.Done()
+		return wp.runInCaller(fn)
+	}
+
+	var timeout <-chan time.Time
+	if wp.timeout > 0 {
+		timer := time.NewTimer(wp.timeout)
+		defer timer.Stop()
+		timeout = timer.C
+	}
+
+	select {
+	case wp.queues[priority] <- t:
+		return nil
+	case <-ctx.Done():
+		wp.tasks.Done()
+		return ctx.Err()
+	case <-timeout:
+		wp.tasks.Done()
+		wp.rejected.Add(1)
+		return ErrQueueFull
+	}
+}
+
+// runInCaller выполняет задачу в вызывающей горутине с учетом в статистике пула
+func (wp *WorkerPool) runInCaller(fn func() error) error {
+	wp.tasks.Add(1)
+	wp.execute(task{run: fn, enqueuedAt: time.Now()})
+	return nil
 }
 
-// Wait завершает выполнение всех задач.
+// Stats возвращает снимок состояния пула
+func (wp *WorkerPool) Stats() Stats {
+	wp.mu.Lock()
+	workers := len(wp.stops)
+	wp.mu.Unlock()
+
+	stats := Stats{
+		Workers:        workers,
+		ActiveWorkers:  int(wp.active.Load()),
+		CompletedTasks: wp.completed.Load(),
+		FailedTasks:    wp.failed.Load(),
+		PanickedTasks:  wp.panicked.Load(),
+		RejectedTasks:  wp.rejected.Load(),
+	}
+	for _, queue := range wp.queues {
+		stats.QueueDepth += len(queue)
+		stats.QueueCapacity += cap(queue)
+	}
+	if started := wp.started.Load(); started > 0 {
+		stats.AvgWaitTime = time.Duration(wp.waitTotal.Load() / int64(started))
+	}
+	return stats
+}
+
+// Wait дожидается выполнения всех поставленных задач.
 func (wp *WorkerPool) Wait() {
-	wp.wg.Wait()
+	wp.tasks.Wait()
 }
 
-// Close завершает работу пула воркеров.
+// Close дожидается выполнения поставленных задач и завершает работу пула воркеров.
+// Повторный вызов ничего не делает.
 func (wp *WorkerPool) Close() {
 	wp.mu.Lock()
-	defer wp.mu.Unlock()
-	close(wp.done)
+	if wp.closed {
+		wp.mu.Unlock()
+		return
+	}
+	wp.closed = true
+	wp.mu.Unlock()
+
 	wp.Wait()
+
+	wp.mu.Lock()
+	for _, stop := range wp.stops {
+		close(stop)
+	}
+	wp.stops = nil
+	wp.mu.Unlock()
+
+	wp.workers.Wait()
 }
@@ -0,0 +1,189 @@
+package pool
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestWorkerPoolStats проверяет учет завершенных, неудачных и завершившихся паникой задач
+func TestWorkerPoolStats(t *testing.T) {
+	wp := NewWorkerPoolWithConfig(Config{Workers: 2, QueueSize: 10})
+	defer wp.Close()
+
+	wp.SubmitTask(func() {})
+	wp.SubmitFunc(func() error { return errors.New("ошибка задачи") })
+	wp.SubmitTask(func() { panic("паника в задаче") })
+	wp.Wait()
+
+	stats := wp.Stats()
+	assert.Equal(t, 2, stats.Workers)
+	assert.Equal(t, 30, stats.QueueCapacity)
+	assert.Equal(t, uint64(1), stats.CompletedTasks)
+	assert.Equal(t, uint64(1), stats.FailedTasks)
+	assert.Equal(t, uint64(1), stats.PanickedTasks)
+	assert.Equal(t, 0, stats.ActiveWorkers)
+}
+
+// TestSetWorkerCountKeepsInFlightTasks проверяет, что уменьшение пула не прерывает выполняющиеся задачи
+func TestSetWorkerCountKeepsInFlightTasks(t *testing.T) {
+	wp := NewWorkerPool(3)
+	defer wp.Close()
+
+	release := make(chan struct{})
+	var started sync.WaitGroup
+	started.Add(3)
+	for i := 0; i < 3; i++ {
+		wp.SubmitTask(func() {
+			started.Done()
+			<-release
+		})
+	}
+	started.Wait()
+
+	wp.SetWorkerCount(1)
+	assert.Equal(t, 1, wp.Stats().Workers)
+	assert.Equal(t, 3, wp.Stats().ActiveWorkers, "остановленные воркеры должны дождаться завершения текущей задачи")
+
+	close(release)
+	wp.Wait()
+	assert.Equal(t, uint64(3), wp.Stats().CompletedTasks)
+
+	// После увеличения пула задачи выполняются параллельно новыми воркерами
+	wp.SetWorkerCount(4)
+	release = make(chan struct{})
+	started.Add(4)
+	for i := 0; i < 4; i++ {
+		wp.SubmitTask(func() {
+			started.Done()
+			<-release
+		})
+	}
+	waitTimeout(t, &started)
+	close(release)
+	wp.Wait()
+}
+
+// TestCloseIsIdempotent проверяет, что пул можно закрыть повторно, а задачи после закрытия не теряются
+func TestCloseIsIdempotent(t *testing.T) {
+	wp := NewWorkerPool(1)
+	wp.Close()
+	wp.Close()
+
+	done := false
+	wp.SubmitTask(func() { done = true })
+	assert.True(t, done)
+}
+
+// blockedPool возвращает пул из одного воркера с заполненной очередью обычного приоритета.
+// Занятый воркер освобождается закрытием release.
+func blockedPool(t *testing.T, cfg Config) (*WorkerPool, chan struct{}) {
+	t.Helper()
+	cfg.Workers, cfg.QueueSize = 1, 1
+	wp := NewWorkerPoolWithConfig(cfg)
+
+	release := make(chan struct{})
+	started := make(chan struct{})
+	assert.NoError(t, wp.SubmitTask(func() {
+		close(started)
+		<-release
+	}))
+	<-started
+	assert.NoError(t, wp.SubmitTask(func() { <-release }))
+	return wp, release
+}
+
+// TestPolicyReject проверяет отклонение задачи при заполненной очереди
+func TestPolicyReject(t *testing.T) {
+	wp, release := blockedPool(t, Config{Policy: PolicyReject})
+	defer wp.Close()
+
+	err := wp.SubmitTask(func() {})
+	assert.ErrorIs(t, err, ErrQueueFull)
+	assert.Equal(t, uint64(1), wp.Stats().RejectedTasks)
+
+	close(release)
+}
+
+// TestPolicyCallerRuns проверяет выполнение задачи в вызывающей горутине при заполненной очереди
+func TestPolicyCallerRuns(t *testing.T) {
+	wp, release := blockedPool(t, Config{Policy: PolicyCallerRuns})
+	defer wp.Close()
+
+	done := false
+	assert.NoError(t, wp.SubmitTask(func() { done = true }))
+	assert.True(t, done)
+	assert.Equal(t, uint64(0), wp.Stats().RejectedTasks)
+
+	close(release)
+}
+
+// TestPolicyBlockTimeout проверяет отклонение задачи после истечения времени ожидания места в очереди
+func TestPolicyBlockTimeout(t *testing.T) {
+	wp, release := blockedPool(t, Config{Policy: PolicyBlock, BlockTimeout: 20 * time.Millisecond})
+	defer wp.Close()
+
+	start := time.Now()
+	err := wp.SubmitTask(func() {})
+	assert.ErrorIs(t, err, ErrQueueFull)
+	assert.GreaterOrEqual(t, time.Since(start), 20*time.Millisecond)
+	assert.Equal(t, uint64(1), wp.Stats().RejectedTasks)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = Submit(ctx, wp, func(context.Context) (int, error) { return 1, nil }).Get(context.Background())
+	assert.ErrorIs(t, err, context.Canceled)
+
+	close(release)
+}
+
+// TestNestedSubmitDoesNotDeadlock проверяет, что задача может дождаться вложенной задачи в пуле из одного воркера
+func TestNestedSubmitDoesNotDeadlock(t *testing.T) {
+	wp := NewWorkerPoolWithConfig(Config{Workers: 1, QueueSize: 1})
+	defer wp.Close()
+
+	result := make(chan int, 1)
+	go func() {
+		value, err := Submit(context.Background(), wp, func(ctx context.Context) (int, error) {
+			return Submit(ctx, wp, func(context.Context) (int, error) { return 42, nil }).Get(ctx)
+		}).Get(context.Background())
+		assert.NoError(t, err)
+		result <- value
+	}()
+
+	select {
+	case value := <-result:
+		assert.Equal(t, 42, value)
+	case <-time.After(time.Second):
+		t.Fatal("вложенная задача заблокировала пул")
+	}
+}
+
+// TestParseQueuePolicy проверяет разбор названия политики из конфигурации
+func TestParseQueuePolicy(t *testing.T) {
+	policy, err := ParseQueuePolicy("caller_runs")
+	assert.NoError(t, err)
+	assert.Equal(t, PolicyCallerRuns, policy)
+
+	_, err = ParseQueuePolicy("drop")
+	assert.Error(t, err)
+}
+
+// waitTimeout ждет группу не дольше секунды
+func waitTimeout(t *testing.T, wg *sync.WaitGroup) {
+	t.Helper()
+	ch := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(ch)
+	}()
+	select {
+	case <-ch:
+	case <-time.After(time.Second):
+		t.Fatal("задачи не запустились параллельно")
+	}
+}
@@ -0,0 +1,119 @@
+package publisher
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"sync"
+	"time"
+
+	eventsv1 "homework1/internal/api/events/v1"
+	"homework1/internal/kafka"
+	"homework1/internal/model"
+)
+
+// FilePublisher дописывает опубликованные события в JSONL-файл, по одному событию на строку.
+// Предназначен для локальной разработки без Kafka: события можно просматривать, например, через tail -f и jq.
+type FilePublisher struct {
+	mu      sync.Mutex
+	file    *os.File
+	writer  *bufio.Writer
+	topic   string
+	keyFunc kafka.KeyFunc
+}
+
+// fileRecord - строка JSONL-файла с событием
+type fileRecord struct {
+	EventID     int64             `json:"event_id,omitempty"`
+	Topic       string            `json:"topic"`
+	Key         string            `json:"key,omitempty"`
+	EventType   string            `json:"event_type"`
+	AggregateID int               `json:"aggregate_id"`
+	Headers     map[string]string `json:"headers,omitempty"`
+	Event       json.RawMessage   `json:"event"`
+	PublishedAt time.Time         `json:"published_at"`
+}
+
+// NewFilePublisher открывает файл path для дозаписи, создавая его при необходимости.
+// Если keyFunc равен nil, события ключуются по ID заказа.
+func NewFilePublisher(path, topic string, keyFunc kafka.KeyFunc) (*FilePublisher, error) {
+	if path == "" {
+		return nil, fmt.Errorf("не задан путь к файлу событий")
+	}
+
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("os.OpenFile: %w", err)
+	}
+
+	return &FilePublisher{
+		file:    file,
+		writer:  bufio.NewWriter(file),
+		topic:   topic,
+		keyFunc: keyFuncOrDefault(keyFunc),
+	}, nil
+}
+
+// NewOutboxEvent сериализует событие в запись outbox для топика публикатора
+func (p *FilePublisher) NewOutboxEvent(ctx context.Context, event *eventsv1.OrderEvent) (model.OutboxEvent, error) {
+	return kafka.NewOutboxEvent(ctx, p.topic, p.keyFunc, event)
+}
+
+// SendOutboxEvent дописывает событие outbox в файл. Строка сбрасывается на диск до возврата,
+// поэтому реле outbox помечает событие отправленным только после записи.
+func (p *FilePublisher) SendOutboxEvent(event model.OutboxEvent) error {
+	line, err := json.Marshal(fileRecord{
+		EventID:     event.EventID,
+		Topic:       event.Topic,
+		Key:         event.EventKey,
+		EventType:   event.EventType,
+		AggregateID: event.AggregateID,
+		Headers:     event.Headers,
+		Event:       json.RawMessage(event.Payload),
+		PublishedAt: time.Now().UTC(),
+	})
+	if err != nil {
+		return fmt.Errorf("json.Marshal: %w", err)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if _, err := p.writer.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("ошибка записи события в файл: %w", err)
+	}
+	if err := p.writer.Flush(); err != nil {
+		return fmt.Errorf("ошибка записи события в файл: %w", err)
+	}
+
+	log.Printf("Событие outbox %d записано в файл %s. Тип: %s, Ключ: %s", event.EventID, p.file.Name(), event.EventType, event.EventKey)
+	return nil
+}
+
+// SendEvent дописывает событие в файл, минуя outbox
+func (p *FilePublisher) SendEvent(ctx context.Context, event *eventsv1.OrderEvent) error {
+	outboxEvent, err := p.NewOutboxEvent(ctx, event)
+	if err != nil {
+		return err
+	}
+
+	return p.SendOutboxEvent(outboxEvent)
+}
+
+// Close сбрасывает буфер и закрывает файл
+func (p *FilePublisher) Close() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if err := p.writer.Flush(); err != nil {
+		p.file.Close()
+		return fmt.Errorf("ошибка записи события в файл: %w", err)
+	}
+	if err := p.file.Close(); err != nil {
+		return fmt.Errorf("p.file.Close: %w", err)
+	}
+	return nil
+}
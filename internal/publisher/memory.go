@@ -0,0 +1,106 @@
+package publisher
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	eventsv1 "homework1/internal/api/events/v1"
+	"homework1/internal/kafka"
+	"homework1/internal/model"
+)
+
+// MemoryPublisher сохраняет опубликованные события в памяти процесса.
+// Предназначен для тестов: опубликованные события можно проверить через Events и OutboxEvents.
+type MemoryPublisher struct {
+	mu      sync.Mutex
+	topic   string
+	keyFunc kafka.KeyFunc
+	events  []model.OutboxEvent
+	err     error
+}
+
+// NewMemoryPublisher создает публикатор в памяти. Если keyFunc равен nil, события ключуются по ID заказа.
+func NewMemoryPublisher(topic string, keyFunc kafka.KeyFunc) *MemoryPublisher {
+	return &MemoryPublisher{
+		topic:   topic,
+		keyFunc: keyFuncOrDefault(keyFunc),
+	}
+}
+
+// NewOutboxEvent сериализует событие в запись outbox для топика публикатора
+func (p *MemoryPublisher) NewOutboxEvent(ctx context.Context, event *eventsv1.OrderEvent) (model.OutboxEvent, error) {
+	return kafka.NewOutboxEvent(ctx, p.topic, p.keyFunc, event)
+}
+
+// SendOutboxEvent сохраняет событие outbox. Если задана ошибка через SetError, событие не сохраняется.
+func (p *MemoryPublisher) SendOutboxEvent(event model.OutboxEvent) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.err != nil {
+		return p.err
+	}
+	if event.CreatedAt.IsZero() {
+		event.CreatedAt = time.Now()
+	}
+	p.events = append(p.events, event)
+	return nil
+}
+
+// SendEvent сохраняет событие, минуя outbox
+func (p *MemoryPublisher) SendEvent(ctx context.Context, event *eventsv1.OrderEvent) error {
+	outboxEvent, err := p.NewOutboxEvent(ctx, event)
+	if err != nil {
+		return err
+	}
+
+	return p.SendOutboxEvent(outboxEvent)
+}
+
+// SetError задает ошибку, которую будут возвращать последующие публикации; nil восстанавливает публикацию
+func (p *MemoryPublisher) SetError(err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.err = err
+}
+
+// OutboxEvents возвращает опубликованные события outbox в порядке публикации
+func (p *MemoryPublisher) OutboxEvents() []model.OutboxEvent {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	events := make([]model.OutboxEvent, len(p.events))
+	copy(events, p.events)
+	return events
+}
+
+// Events возвращает опубликованные события, разобранные в конверты, в порядке публикации
+func (p *MemoryPublisher) Events() ([]*eventsv1.OrderEvent, error) {
+	outboxEvents := p.OutboxEvents()
+
+	events := make([]*eventsv1.OrderEvent, 0, len(outboxEvents))
+	for _, outboxEvent := range outboxEvents {
+		event, err := kafka.DecodeEvent(outboxEvent.Payload)
+		if err != nil {
+			return nil, fmt.Errorf("ошибка разбора события outbox %d: %w", outboxEvent.EventID, err)
+		}
+		events = append(events, event)
+	}
+	return events, nil
+}
+
+// Reset удаляет сохраненные события
+func (p *MemoryPublisher) Reset() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.events = nil
+}
+
+// Close ничего не освобождает: события остаются доступными после закрытия
+func (p *MemoryPublisher) Close() error {
+	return nil
+}
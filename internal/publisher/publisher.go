@@ -0,0 +1,84 @@
+package publisher
+
+import (
+	"context"
+	"fmt"
+
+	eventsv1 "homework1/internal/api/events/v1"
+	"homework1/internal/kafka"
+	"homework1/internal/model"
+)
+
+// EventPublisher публикует события заказов и возвратов.
+// Сервисы сериализуют события в записи outbox через NewOutboxEvent, реле outbox публикует их через SendOutboxEvent.
+// Реализации: *kafka.Producer, MemoryPublisher для тестов и FilePublisher для локальной разработки.
+type EventPublisher interface {
+	// NewOutboxEvent сериализует событие в запись outbox с ключом партиционирования и заголовками
+	NewOutboxEvent(ctx context.Context, event *eventsv1.OrderEvent) (model.OutboxEvent, error)
+	// SendOutboxEvent публикует сериализованное событие outbox
+	SendOutboxEvent(event model.OutboxEvent) error
+	// SendEvent публикует событие напрямую, минуя outbox
+	SendEvent(ctx context.Context, event *eventsv1.OrderEvent) error
+	// Close освобождает ресурсы публикатора
+	Close() error
+}
+
+var _ EventPublisher = (*kafka.Producer)(nil)
+
+// Backend - реализация публикатора событий
+type Backend string
+
+const (
+	BackendKafka  Backend = "kafka"  // Публикация в Kafka
+	BackendFile   Backend = "file"   // Запись событий в JSONL-файл
+	BackendMemory Backend = "memory" // Хранение событий в памяти процесса
+)
+
+// ParseBackend разбирает название реализации публикатора из конфигурации
+func ParseBackend(name string) (Backend, error) {
+	switch name {
+	case "", string(BackendKafka):
+		return BackendKafka, nil
+	case string(BackendFile):
+		return BackendFile, nil
+	case string(BackendMemory):
+		return BackendMemory, nil
+	default:
+		return "", fmt.Errorf("неизвестная реализация публикатора событий: %s", name)
+	}
+}
+
+// Config содержит параметры публикатора событий
+type Config struct {
+	Backend  Backend       // Реализация публикатора
+	Brokers  []string      // Адреса брокеров Kafka для BackendKafka
+	Topic    string        // Топик событий
+	KeyFunc  kafka.KeyFunc // Функция ключа партиционирования
+	FilePath string        // Путь к JSONL-файлу для BackendFile
+}
+
+// New создает публикатор событий выбранной реализации
+func New(config Config) (EventPublisher, error) {
+	switch config.Backend {
+	case BackendKafka:
+		producer, err := kafka.NewProducer(config.Brokers, config.Topic, config.KeyFunc)
+		if err != nil {
+			return nil, err
+		}
+		return producer, nil
+	case BackendFile:
+		return NewFilePublisher(config.FilePath, config.Topic, config.KeyFunc)
+	case BackendMemory:
+		return NewMemoryPublisher(config.Topic, config.KeyFunc), nil
+	default:
+		return nil, fmt.Errorf("неизвестная реализация публикатора событий: %s", config.Backend)
+	}
+}
+
+// keyFuncOrDefault возвращает функцию ключа, по умолчанию ключуя события по ID заказа, как и продюсер Kafka
+func keyFuncOrDefault(keyFunc kafka.KeyFunc) kafka.KeyFunc {
+	if keyFunc == nil {
+		return kafka.KeyByOrderID
+	}
+	return keyFunc
+}
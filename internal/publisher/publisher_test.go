@@ -0,0 +1,110 @@
+package publisher
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	eventsv1 "homework1/internal/api/events/v1"
+	"homework1/internal/kafka"
+	"homework1/internal/model"
+)
+
+// testOrderEvent возвращает событие о создании заказа
+func testOrderEvent(orderID int) *eventsv1.OrderEvent {
+	return kafka.NewOrderEvent(eventsv1.EventType_EVENT_TYPE_ORDER_CREATED, model.Order{OrderID: orderID, UserID: 7}, "Order created")
+}
+
+func TestParseBackend(t *testing.T) {
+	for name, want := range map[string]Backend{"": BackendKafka, "kafka": BackendKafka, "file": BackendFile, "memory": BackendMemory} {
+		backend, err := ParseBackend(name)
+		require.NoError(t, err)
+		assert.Equal(t, want, backend)
+	}
+
+	_, err := ParseBackend("rabbitmq")
+	assert.Error(t, err)
+}
+
+func TestMemoryPublisher(t *testing.T) {
+	ctx := context.Background()
+	publisher, err := New(Config{Backend: BackendMemory, Topic: "test-topic"})
+	require.NoError(t, err)
+	memory := publisher.(*MemoryPublisher)
+
+	outboxEvent, err := publisher.NewOutboxEvent(ctx, testOrderEvent(1))
+	require.NoError(t, err)
+	assert.Equal(t, "test-topic", outboxEvent.Topic)
+	assert.Equal(t, "1", outboxEvent.EventKey, "по умолчанию события ключуются по ID заказа")
+
+	require.NoError(t, publisher.SendOutboxEvent(outboxEvent))
+	require.NoError(t, publisher.SendEvent(ctx, kafka.NewErrorEvent("create", 2, "ошибка")))
+
+	events, err := memory.Events()
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	assert.Equal(t, eventsv1.EventType_EVENT_TYPE_ORDER_CREATED, events[0].GetEventType())
+	assert.Equal(t, eventsv1.EventType_EVENT_TYPE_ERROR, events[1].GetEventType())
+
+	errUnavailable := errors.New("публикатор недоступен")
+	memory.SetError(errUnavailable)
+	assert.ErrorIs(t, publisher.SendEvent(ctx, testOrderEvent(3)), errUnavailable)
+	assert.Len(t, memory.OutboxEvents(), 2, "событие, которое не удалось опубликовать, не сохраняется")
+
+	memory.Reset()
+	assert.Empty(t, memory.OutboxEvents())
+}
+
+func TestFilePublisher(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "events.jsonl")
+
+	publisher, err := NewFilePublisher(path, "test-topic", kafka.KeyByUserID)
+	require.NoError(t, err)
+	outboxEvent, err := publisher.NewOutboxEvent(ctx, testOrderEvent(1))
+	require.NoError(t, err)
+	outboxEvent.EventID = 42
+	require.NoError(t, publisher.SendOutboxEvent(outboxEvent))
+	require.NoError(t, publisher.Close())
+
+	// Повторно открытый файл дописывается, а не перезаписывается
+	publisher, err = NewFilePublisher(path, "test-topic", nil)
+	require.NoError(t, err)
+	require.NoError(t, publisher.SendEvent(ctx, testOrderEvent(2)))
+	require.NoError(t, publisher.Close())
+
+	file, err := os.Open(path)
+	require.NoError(t, err)
+	defer file.Close()
+
+	var records []fileRecord
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var record fileRecord
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &record))
+		records = append(records, record)
+	}
+	require.NoError(t, scanner.Err())
+	require.Len(t, records, 2)
+
+	assert.Equal(t, int64(42), records[0].EventID)
+	assert.Equal(t, "user-7", records[0].Key, "ключ партиционирования вычисляется функцией публикатора")
+	assert.Equal(t, "EVENT_TYPE_ORDER_CREATED", records[0].EventType)
+	assert.WithinDuration(t, time.Now(), records[0].PublishedAt, time.Minute)
+
+	event, err := kafka.DecodeEvent(records[1].Event)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), event.GetAggregateId())
+}
+
+func TestFilePublisherRequiresPath(t *testing.T) {
+	_, err := New(Config{Backend: BackendFile})
+	assert.Error(t, err)
+}
@@ -0,0 +1,285 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"sort"
+	"sync"
+	"time"
+
+	"homework1/internal/model"
+)
+
+// MemoryStore - потокобезопасное хранилище в памяти с той же семантикой, что и хранилище в Postgres:
+// ссылочной целостностью, откатом единицы работы при ошибке и хуками в транзакции основной операции.
+// Единицы работы выполняются последовательно: на время RunInTx хранилище блокируется для остальных вызовов.
+// Хранилище предназначено для тестов сервисного слоя и gRPC-сервера без Postgres.
+type MemoryStore struct {
+	mu   sync.Mutex
+	data *memoryData
+	seq  map[string]int // Последовательности идентификаторов; как и в Postgres, не откатываются вместе с транзакцией
+}
+
+// memoryData - содержимое хранилища, которое копируется для отката единицы работы
+type memoryData struct {
+	orders    map[int]memoryOrder
+	returns   map[int]model.Return
+	users     map[int]model.User
+	packaging map[int]model.PackagingOption
+	statuses  map[int]memoryStatus
+	reasons   map[int]model.ReturnReason
+	history   []model.OrderStatusChange
+	outbox    []model.OutboxEvent
+}
+
+// memoryOrder - заказ вместе с отметкой об уведомлении об истечении срока хранения
+type memoryOrder struct {
+	order          model.Order
+	expiryNotified bool
+}
+
+// memoryStatus - статус вместе с кодом состояния жизненного цикла
+type memoryStatus struct {
+	status model.Status
+	code   string
+}
+
+// memoryTxKey - ключ контекста единицы работы хранилища в памяти
+type memoryTxKey struct{}
+
+// NewMemoryStore создает хранилище в памяти со справочниками статусов, причин возврата, упаковки
+// и пользователями, как в sql/init.sql
+func NewMemoryStore() *MemoryStore {
+	m := &MemoryStore{
+		data: &memoryData{
+			orders:    make(map[int]memoryOrder),
+			returns:   make(map[int]model.Return),
+			users:     make(map[int]model.User),
+			packaging: make(map[int]model.PackagingOption),
+			statuses:  make(map[int]memoryStatus),
+			reasons:   make(map[int]model.ReturnReason),
+		},
+		seq: make(map[string]int),
+	}
+
+	for _, status := range []memoryStatus{
+		{model.Status{StatusName: "Создан"}, string(model.OrderStateAccepted)},
+		{model.Status{StatusName: "Выдан"}, string(model.OrderStateIssued)},
+		{model.Status{StatusName: "Возврат"}, string(model.OrderStateReturned)},
+		{model.Status{StatusName: "Передан курьеру"}, string(model.OrderStateHandedToCourier)},
+	} {
+		status.status.StatusID = m.nextID("statuses")
+		m.data.statuses[status.status.StatusID] = status
+	}
+	for _, reason := range []string{"Истек срок хранения", "Вернул покупатель"} {
+		id := m.nextID("return_reasons")
+		m.data.reasons[id] = model.ReturnReason{ReasonID: id, Reason: reason}
+	}
+	for _, packaging := range []model.PackagingOption{
+		{Type: "Коробка", Cost: 20.0, MaxWeight: 30.0},
+		{Type: "Пленка", Cost: 1.0, MaxWeight: 0.0},
+		{Type: "Пакет", Cost: 5.0, MaxWeight: 10.0},
+	} {
+		packaging.PackagingID = m.nextID("packaging")
+		m.data.packaging[packaging.PackagingID] = packaging
+	}
+	for _, username := range []string{"Иван", "Мария", "Алексей"} {
+		id := m.nextID("users")
+		m.data.users[id] = model.User{UserID: id, Username: username, CreatedAt: time.Now().UTC()}
+	}
+	return m
+}
+
+// Store возвращает репозитории хранилища
+func (m *MemoryStore) Store() Store {
+	return Store{
+		Orders:        memOrders{m: m},
+		Returns:       memReturns{m: m},
+		Users:         memUsers{m: m},
+		Packaging:     memPackaging{m: m},
+		Statuses:      memStatuses{m: m},
+		ReturnReasons: memReturnReasons{m: m},
+		Tx:            m,
+	}
+}
+
+// AddStatus добавляет статус с кодом состояния жизненного цикла, который нельзя задать через StatusRepository
+func (m *MemoryStore) AddStatus(statusName string, state model.OrderState) int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	id := m.nextID("statuses")
+	m.data.statuses[id] = memoryStatus{status: model.Status{StatusID: id, StatusName: statusName}, code: string(state)}
+	return id
+}
+
+// OutboxEvents возвращает события, сохраненные в outbox, в порядке сохранения
+func (m *MemoryStore) OutboxEvents() []model.OutboxEvent {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	events := make([]model.OutboxEvent, len(m.data.outbox))
+	copy(events, m.data.outbox)
+	return events
+}
+
+// RunInTx выполняет fn как единицу работы. Если fn возвращает ошибку или паникует, все ее изменения откатываются.
+// Вложенная единица работы ведет себя как точка сохранения: ее ошибка откатывает только ее изменения.
+// Уровень изоляции не учитывается: единицы работы выполняются последовательно, что не слабее любого уровня.
+func (m *MemoryStore) RunInTx(ctx context.Context, _ IsolationLevel, fn func(ctx context.Context) error) (err error) {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if !m.inTx(ctx) {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		ctx = context.WithValue(ctx, memoryTxKey{}, m)
+	}
+
+	snapshot := m.data.clone()
+	defer func() {
+		if r := recover(); r != nil {
+			*m.data = *snapshot
+			panic(r)
+		}
+		if err != nil {
+			*m.data = *snapshot
+		}
+	}()
+	return fn(ctx)
+}
+
+// inTx проверяет, выполняется ли вызов внутри единицы работы этого хранилища, которая уже держит блокировку
+func (m *MemoryStore) inTx(ctx context.Context) bool {
+	owner, ok := ctx.Value(memoryTxKey{}).(*MemoryStore)
+	return ok && owner == m
+}
+
+// nextID возвращает следующий идентификатор последовательности. Вызывается под m.mu.
+func (m *MemoryStore) nextID(sequence string) int {
+	m.seq[sequence]++
+	return m.seq[sequence]
+}
+
+// memRead выполняет чтение под блокировкой хранилища
+func memRead[T any](ctx context.Context, m *MemoryStore, fn func(d *memoryData) (T, error)) (T, error) {
+	if err := ctx.Err(); err != nil {
+		var zero T
+		return zero, err
+	}
+	if !m.inTx(ctx) {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+	}
+	return fn(m.data)
+}
+
+// memWrite выполняет изменение атомарно вместе с хуками: при ошибке все изменения откатываются
+func memWrite[T any](ctx context.Context, m *MemoryStore, fn func(ctx context.Context, d *memoryData) (T, error)) (T, error) {
+	var result T
+	err := m.RunInTx(ctx, Serializable, func(ctx context.Context) error {
+		var err error
+		result, err = fn(ctx, m.data)
+		return err
+	})
+	return result, err
+}
+
+// runHooks сохраняет записи хуков для сущности entityID. Вызывается под m.mu.
+func (m *MemoryStore) runHooks(ctx context.Context, d *memoryData, entityID int, hooks []Hook) error {
+	for _, hook := range hooks {
+		for _, change := range hook.history {
+			if change.OrderID == 0 {
+				change.OrderID = entityID
+			}
+			if err := d.requireStatusOrNone(change.OldStatusID); err != nil {
+				return fmt.Errorf("ошибка записи истории статусов заказа: %w", err)
+			}
+			if err := d.requireStatusOrNone(change.NewStatusID); err != nil {
+				return fmt.Errorf("ошибка записи истории статусов заказа: %w", err)
+			}
+			change.ChangeID = m.nextID("order_status_history")
+			change.OldStatusName, change.NewStatusName = "", ""
+			d.history = append(d.history, change)
+		}
+
+		if hook.event != nil {
+			event, err := hook.event(ctx, entityID)
+			if err != nil {
+				return fmt.Errorf("ошибка формирования события outbox: %w", err)
+			}
+			event.EventID = int64(m.nextID("outbox_events"))
+			event.CreatedAt = time.Now()
+			if event.Headers == nil {
+				event.Headers = map[string]string{}
+			}
+			d.outbox = append(d.outbox, event)
+		}
+	}
+	return nil
+}
+
+// clone возвращает копию содержимого хранилища
+func (d *memoryData) clone() *memoryData {
+	return &memoryData{
+		orders:    maps.Clone(d.orders),
+		returns:   maps.Clone(d.returns),
+		users:     maps.Clone(d.users),
+		packaging: maps.Clone(d.packaging),
+		statuses:  maps.Clone(d.statuses),
+		reasons:   maps.Clone(d.reasons),
+		history:   append([]model.OrderStatusChange(nil), d.history...),
+		outbox:    append([]model.OutboxEvent(nil), d.outbox...),
+	}
+}
+
+// requireRef возвращает ErrConstraint, если запись, на которую ссылается внешний ключ, не существует
+func requireRef(exists bool, entity string, id int) error {
+	if !exists {
+		return fmt.Errorf("%w: %s с ID %d не существует", ErrConstraint, entity, id)
+	}
+	return nil
+}
+
+// requireNoRefs возвращает ErrConstraint, если на удаляемую запись ссылаются другие записи
+func requireNoRefs(referenced bool, entity string, id int) error {
+	if referenced {
+		return fmt.Errorf("%w: на %s с ID %d ссылаются другие записи", ErrConstraint, entity, id)
+	}
+	return nil
+}
+
+// requireStatusOrNone проверяет ссылку на статус; 0 означает отсутствие статуса
+func (d *memoryData) requireStatusOrNone(statusID int) error {
+	if statusID == 0 {
+		return nil
+	}
+	_, ok := d.statuses[statusID]
+	return requireRef(ok, "статус", statusID)
+}
+
+// sortedValues возвращает значения в порядке возрастания ключей; для пустого набора возвращается nil
+func sortedValues[T any](values map[int]T, keep func(T) bool) []T {
+	keys := make([]int, 0, len(values))
+	for key, value := range values {
+		if keep == nil || keep(value) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Ints(keys)
+
+	var result []T
+	for _, key := range keys {
+		result = append(result, values[key])
+	}
+	return result
+}
+
+// firstN возвращает не более n первых элементов
+func firstN[T any](values []T, n int) []T {
+	if len(values) > n {
+		return values[:n]
+	}
+	return values
+}
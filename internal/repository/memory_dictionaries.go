@@ -0,0 +1,318 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"homework1/internal/model"
+)
+
+// memUsers - репозиторий пользователей в памяти
+type memUsers struct {
+	m *MemoryStore
+}
+
+func (r memUsers) Create(ctx context.Context, user model.User) (int, error) {
+	return memWrite(ctx, r.m, func(_ context.Context, d *memoryData) (int, error) {
+		user.UserID = r.m.nextID("users")
+		d.users[user.UserID] = user
+		return user.UserID, nil
+	})
+}
+
+func (r memUsers) GetByID(ctx context.Context, userID int) (*model.User, error) {
+	return memRead(ctx, r.m, func(d *memoryData) (*model.User, error) {
+		user, ok := d.users[userID]
+		if !ok {
+			return nil, fmt.Errorf("ошибка получения пользователя с ID %d: %w", userID, ErrNotFound)
+		}
+		return &user, nil
+	})
+}
+
+func (r memUsers) GetNameByID(ctx context.Context, userID int) (string, error) {
+	user, err := r.GetByID(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+	return user.Username, nil
+}
+
+func (r memUsers) GetAll(ctx context.Context) ([]model.User, error) {
+	return memRead(ctx, r.m, func(d *memoryData) ([]model.User, error) {
+		return sortedValues(d.users, nil), nil
+	})
+}
+
+func (r memUsers) Update(ctx context.Context, user model.User) error {
+	_, err := memWrite(ctx, r.m, func(_ context.Context, d *memoryData) (struct{}, error) {
+		if _, ok := d.users[user.UserID]; ok {
+			d.users[user.UserID] = user
+		}
+		return struct{}{}, nil
+	})
+	return err
+}
+
+func (r memUsers) Delete(ctx context.Context, userID int) error {
+	_, err := memWrite(ctx, r.m, func(_ context.Context, d *memoryData) (struct{}, error) {
+		referenced := len(sortedValues(d.orders, func(o memoryOrder) bool { return o.order.UserID == userID })) > 0 ||
+			len(sortedValues(d.returns, func(ret model.Return) bool { return ret.UserID == userID })) > 0
+		if err := requireNoRefs(referenced, "пользователя", userID); err != nil {
+			return struct{}{}, fmt.Errorf("ошибка удаления пользователя с ID %d: %w", userID, err)
+		}
+		delete(d.users, userID)
+		return struct{}{}, nil
+	})
+	return err
+}
+
+func (r memUsers) Exists(ctx context.Context, userID int) (bool, error) {
+	return memRead(ctx, r.m, func(d *memoryData) (bool, error) {
+		_, ok := d.users[userID]
+		return ok, nil
+	})
+}
+
+// memPackaging - репозиторий вариантов упаковки в памяти
+type memPackaging struct {
+	m *MemoryStore
+}
+
+func (r memPackaging) Create(ctx context.Context, packaging model.PackagingOption) (int, error) {
+	return memWrite(ctx, r.m, func(_ context.Context, d *memoryData) (int, error) {
+		packaging.PackagingID = r.m.nextID("packaging")
+		d.packaging[packaging.PackagingID] = packaging
+		return packaging.PackagingID, nil
+	})
+}
+
+func (r memPackaging) GetByID(ctx context.Context, packagingID int) (*model.PackagingOption, error) {
+	return memRead(ctx, r.m, func(d *memoryData) (*model.PackagingOption, error) {
+		packaging, ok := d.packaging[packagingID]
+		if !ok {
+			return nil, fmt.Errorf("ошибка получения упаковки с ID %d: %w", packagingID, ErrNotFound)
+		}
+		return &packaging, nil
+	})
+}
+
+func (r memPackaging) GetByName(ctx context.Context, packagingType string) (*model.PackagingOption, error) {
+	return memRead(ctx, r.m, func(d *memoryData) (*model.PackagingOption, error) {
+		matches := sortedValues(d.packaging, func(p model.PackagingOption) bool { return p.Type == packagingType })
+		if len(matches) == 0 {
+			return nil, fmt.Errorf("ошибка получения упаковки с типом %s: %w", packagingType, ErrNotFound)
+		}
+		return &matches[0], nil
+	})
+}
+
+func (r memPackaging) GetAll(ctx context.Context) ([]model.PackagingOption, error) {
+	return memRead(ctx, r.m, func(d *memoryData) ([]model.PackagingOption, error) {
+		return sortedValues(d.packaging, nil), nil
+	})
+}
+
+func (r memPackaging) Update(ctx context.Context, packaging model.PackagingOption) error {
+	_, err := memWrite(ctx, r.m, func(_ context.Context, d *memoryData) (struct{}, error) {
+		if _, ok := d.packaging[packaging.PackagingID]; ok {
+			d.packaging[packaging.PackagingID] = packaging
+		}
+		return struct{}{}, nil
+	})
+	return err
+}
+
+func (r memPackaging) Delete(ctx context.Context, packagingID int) error {
+	_, err := memWrite(ctx, r.m, func(_ context.Context, d *memoryData) (struct{}, error) {
+		referenced := len(sortedValues(d.orders, func(o memoryOrder) bool { return o.order.PackagingID == packagingID })) > 0 ||
+			len(sortedValues(d.returns, func(ret model.Return) bool { return ret.PackagingID == packagingID })) > 0
+		if err := requireNoRefs(referenced, "упаковку", packagingID); err != nil {
+			return struct{}{}, fmt.Errorf("ошибка удаления упаковки с ID %d: %w", packagingID, err)
+		}
+		delete(d.packaging, packagingID)
+		return struct{}{}, nil
+	})
+	return err
+}
+
+func (r memPackaging) Exists(ctx context.Context, packagingID int) (bool, error) {
+	return memRead(ctx, r.m, func(d *memoryData) (bool, error) {
+		_, ok := d.packaging[packagingID]
+		return ok, nil
+	})
+}
+
+// memStatuses - репозиторий статусов в памяти
+type memStatuses struct {
+	m *MemoryStore
+}
+
+func (r memStatuses) Create(ctx context.Context, status model.Status) (int, error) {
+	return memWrite(ctx, r.m, func(_ context.Context, d *memoryData) (int, error) {
+		status.StatusID = r.m.nextID("statuses")
+		d.statuses[status.StatusID] = memoryStatus{status: status}
+		return status.StatusID, nil
+	})
+}
+
+func (r memStatuses) GetByID(ctx context.Context, statusID int) (*model.Status, error) {
+	return memRead(ctx, r.m, func(d *memoryData) (*model.Status, error) {
+		stored, ok := d.statuses[statusID]
+		if !ok {
+			return nil, fmt.Errorf("ошибка получения статуса с ID %d: %w", statusID, ErrNotFound)
+		}
+		return &stored.status, nil
+	})
+}
+
+func (r memStatuses) GetByName(ctx context.Context, statusName string) (*model.Status, error) {
+	return r.find(ctx, func(s memoryStatus) bool { return s.status.StatusName == statusName },
+		fmt.Sprintf("ошибка получения статуса с именем %s", statusName))
+}
+
+func (r memStatuses) GetNameByID(ctx context.Context, statusID int) (string, error) {
+	status, err := r.GetByID(ctx, statusID)
+	if err != nil {
+		return "", err
+	}
+	return status.StatusName, nil
+}
+
+func (r memStatuses) GetByCode(ctx context.Context, code string) (*model.Status, error) {
+	return r.find(ctx, func(s memoryStatus) bool { return s.code != "" && s.code == code },
+		fmt.Sprintf("ошибка получения статуса с кодом %s", code))
+}
+
+func (r memStatuses) GetCodeByID(ctx context.Context, statusID int) (string, error) {
+	return memRead(ctx, r.m, func(d *memoryData) (string, error) {
+		stored, ok := d.statuses[statusID]
+		if !ok {
+			return "", fmt.Errorf("ошибка получения кода статуса с ID %d: %w", statusID, ErrNotFound)
+		}
+		return stored.code, nil
+	})
+}
+
+// find возвращает статус с наименьшим ID среди подходящих под условие
+func (r memStatuses) find(ctx context.Context, match func(memoryStatus) bool, errPrefix string) (*model.Status, error) {
+	return memRead(ctx, r.m, func(d *memoryData) (*model.Status, error) {
+		matches := sortedValues(d.statuses, match)
+		if len(matches) == 0 {
+			return nil, fmt.Errorf("%s: %w", errPrefix, ErrNotFound)
+		}
+		return &matches[0].status, nil
+	})
+}
+
+func (r memStatuses) GetAll(ctx context.Context) ([]model.Status, error) {
+	return memRead(ctx, r.m, func(d *memoryData) ([]model.Status, error) {
+		var statuses []model.Status
+		for _, stored := range sortedValues(d.statuses, nil) {
+			statuses = append(statuses, stored.status)
+		}
+		return statuses, nil
+	})
+}
+
+func (r memStatuses) Update(ctx context.Context, status model.Status) error {
+	_, err := memWrite(ctx, r.m, func(_ context.Context, d *memoryData) (struct{}, error) {
+		if stored, ok := d.statuses[status.StatusID]; ok {
+			stored.status = status
+			d.statuses[status.StatusID] = stored
+		}
+		return struct{}{}, nil
+	})
+	return err
+}
+
+func (r memStatuses) Delete(ctx context.Context, statusID int) error {
+	_, err := memWrite(ctx, r.m, func(_ context.Context, d *memoryData) (struct{}, error) {
+		referenced := len(sortedValues(d.orders, func(o memoryOrder) bool { return o.order.StatusID == statusID })) > 0 ||
+			len(sortedValues(d.returns, func(ret model.Return) bool { return ret.StatusID == statusID })) > 0
+		for _, change := range d.history {
+			referenced = referenced || change.OldStatusID == statusID || change.NewStatusID == statusID
+		}
+		if err := requireNoRefs(referenced, "статус", statusID); err != nil {
+			return struct{}{}, fmt.Errorf("ошибка удаления статуса с ID %d: %w", statusID, err)
+		}
+		delete(d.statuses, statusID)
+		return struct{}{}, nil
+	})
+	return err
+}
+
+func (r memStatuses) Exists(ctx context.Context, statusID int) (bool, error) {
+	return memRead(ctx, r.m, func(d *memoryData) (bool, error) {
+		_, ok := d.statuses[statusID]
+		return ok, nil
+	})
+}
+
+// memReturnReasons - репозиторий причин возврата в памяти
+type memReturnReasons struct {
+	m *MemoryStore
+}
+
+func (r memReturnReasons) Create(ctx context.Context, reason model.ReturnReason) (int, error) {
+	return memWrite(ctx, r.m, func(_ context.Context, d *memoryData) (int, error) {
+		reason.ReasonID = r.m.nextID("return_reasons")
+		d.reasons[reason.ReasonID] = reason
+		return reason.ReasonID, nil
+	})
+}
+
+func (r memReturnReasons) GetByID(ctx context.Context, reasonID int) (*model.ReturnReason, error) {
+	return memRead(ctx, r.m, func(d *memoryData) (*model.ReturnReason, error) {
+		reason, ok := d.reasons[reasonID]
+		if !ok {
+			return nil, fmt.Errorf("ошибка получения причины возврата с ID %d: %w", reasonID, ErrNotFound)
+		}
+		return &reason, nil
+	})
+}
+
+func (r memReturnReasons) GetByName(ctx context.Context, reasonName string) (*model.ReturnReason, error) {
+	return memRead(ctx, r.m, func(d *memoryData) (*model.ReturnReason, error) {
+		matches := sortedValues(d.reasons, func(reason model.ReturnReason) bool { return reason.Reason == reasonName })
+		if len(matches) == 0 {
+			return nil, fmt.Errorf("ошибка получения причины возврата %s: %w", reasonName, ErrNotFound)
+		}
+		return &matches[0], nil
+	})
+}
+
+func (r memReturnReasons) GetAll(ctx context.Context) ([]model.ReturnReason, error) {
+	return memRead(ctx, r.m, func(d *memoryData) ([]model.ReturnReason, error) {
+		return sortedValues(d.reasons, nil), nil
+	})
+}
+
+func (r memReturnReasons) Update(ctx context.Context, reason model.ReturnReason) error {
+	_, err := memWrite(ctx, r.m, func(_ context.Context, d *memoryData) (struct{}, error) {
+		if _, ok := d.reasons[reason.ReasonID]; ok {
+			d.reasons[reason.ReasonID] = reason
+		}
+		return struct{}{}, nil
+	})
+	return err
+}
+
+func (r memReturnReasons) Delete(ctx context.Context, reasonID int) error {
+	_, err := memWrite(ctx, r.m, func(_ context.Context, d *memoryData) (struct{}, error) {
+		referenced := len(sortedValues(d.returns, func(ret model.Return) bool { return ret.ReasonID == reasonID })) > 0
+		if err := requireNoRefs(referenced, "причину возврата", reasonID); err != nil {
+			return struct{}{}, fmt.Errorf("ошибка удаления причины возврата с ID %d: %w", reasonID, err)
+		}
+		delete(d.reasons, reasonID)
+		return struct{}{}, nil
+	})
+	return err
+}
+
+func (r memReturnReasons) Exists(ctx context.Context, reasonID int) (bool, error) {
+	return memRead(ctx, r.m, func(d *memoryData) (bool, error) {
+		_, ok := d.reasons[reasonID]
+		return ok, nil
+	})
+}
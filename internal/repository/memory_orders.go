@@ -0,0 +1,339 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"homework1/internal/model"
+)
+
+// memOrders - репозиторий заказов в памяти
+type memOrders struct {
+	m *MemoryStore
+}
+
+// checkOrderRefs проверяет внешние ключи заказа
+func (d *memoryData) checkOrderRefs(order model.Order) error {
+	_, ok := d.users[order.UserID]
+	if err := requireRef(ok, "пользователь", order.UserID); err != nil {
+		return err
+	}
+	_, ok = d.packaging[order.PackagingID]
+	if err := requireRef(ok, "упаковка", order.PackagingID); err != nil {
+		return err
+	}
+	_, ok = d.statuses[order.StatusID]
+	return requireRef(ok, "статус", order.StatusID)
+}
+
+func (r memOrders) Create(ctx context.Context, order model.Order, hooks ...Hook) (int, error) {
+	return memWrite(ctx, r.m, func(ctx context.Context, d *memoryData) (int, error) {
+		if err := d.checkOrderRefs(order); err != nil {
+			return 0, fmt.Errorf("ошибка создания заказа: %w", err)
+		}
+		order.OrderID = r.m.nextID("orders")
+		d.orders[order.OrderID] = memoryOrder{order: order}
+		return order.OrderID, r.m.runHooks(ctx, d, order.OrderID, hooks)
+	})
+}
+
+func (r memOrders) GetByID(ctx context.Context, orderID int) (*model.Order, error) {
+	return memRead(ctx, r.m, func(d *memoryData) (*model.Order, error) {
+		stored, ok := d.orders[orderID]
+		if !ok {
+			return nil, fmt.Errorf("ошибка получения заказа с ID %d: %w", orderID, ErrNotFound)
+		}
+		return &stored.order, nil
+	})
+}
+
+func (r memOrders) GetAll(ctx context.Context) ([]model.Order, error) {
+	return memRead(ctx, r.m, func(d *memoryData) ([]model.Order, error) {
+		return ordersOf(sortedValues(d.orders, nil)), nil
+	})
+}
+
+func (r memOrders) GetByUserID(ctx context.Context, userID int) ([]model.Order, error) {
+	return memRead(ctx, r.m, func(d *memoryData) ([]model.Order, error) {
+		return ordersOf(sortedValues(d.orders, func(o memoryOrder) bool { return o.order.UserID == userID })), nil
+	})
+}
+
+func (r memOrders) List(ctx context.Context, filter model.OrderFilter, afterID, limit int) ([]model.Order, error) {
+	return memRead(ctx, r.m, func(d *memoryData) ([]model.Order, error) {
+		return ordersOf(firstN(sortedValues(d.orders, func(o memoryOrder) bool {
+			return o.order.OrderID > afterID && filter.Matches(o.order)
+		}), limit)), nil
+	})
+}
+
+func (r memOrders) Update(ctx context.Context, order model.Order, hooks ...Hook) error {
+	_, err := memWrite(ctx, r.m, func(ctx context.Context, d *memoryData) (struct{}, error) {
+		if stored, ok := d.orders[order.OrderID]; ok {
+			if err := d.checkOrderRefs(order); err != nil {
+				return struct{}{}, fmt.Errorf("ошибка обновления заказа с ID %d: %w", order.OrderID, err)
+			}
+			stored.order = order
+			d.orders[order.OrderID] = stored
+		}
+		return struct{}{}, r.m.runHooks(ctx, d, order.OrderID, hooks)
+	})
+	return err
+}
+
+func (r memOrders) Delete(ctx context.Context, orderID int, hooks ...Hook) error {
+	_, err := memWrite(ctx, r.m, func(ctx context.Context, d *memoryData) (struct{}, error) {
+		referenced := len(sortedValues(d.returns, func(ret model.Return) bool { return ret.OrderID == orderID })) > 0
+		if err := requireNoRefs(referenced, "заказ", orderID); err != nil {
+			return struct{}{}, fmt.Errorf("ошибка удаления заказа с ID %d: %w", orderID, err)
+		}
+		delete(d.orders, orderID)
+		return struct{}{}, r.m.runHooks(ctx, d, orderID, hooks)
+	})
+	return err
+}
+
+func (r memOrders) GetExpiringBefore(ctx context.Context, deadline time.Time, statusID int) ([]model.Order, error) {
+	return memRead(ctx, r.m, func(d *memoryData) ([]model.Order, error) {
+		now := time.Now()
+		orders := ordersOf(sortedValues(d.orders, func(o memoryOrder) bool {
+			return o.order.StatusID == statusID && !o.expiryNotified &&
+				!o.order.ExpirationDate.Before(now) && o.order.ExpirationDate.Before(deadline)
+		}))
+		sort.SliceStable(orders, func(i, j int) bool { return orders[i].ExpirationDate.Before(orders[j].ExpirationDate) })
+		return orders, nil
+	})
+}
+
+func (r memOrders) MarkExpiryNotified(ctx context.Context, orderID int, hooks ...Hook) (bool, error) {
+	return memWrite(ctx, r.m, func(ctx context.Context, d *memoryData) (bool, error) {
+		stored, ok := d.orders[orderID]
+		if !ok || stored.expiryNotified {
+			return false, nil
+		}
+		stored.expiryNotified = true
+		d.orders[orderID] = stored
+		if err := r.m.runHooks(ctx, d, orderID, hooks); err != nil {
+			return false, err
+		}
+		return true, nil
+	})
+}
+
+func (r memOrders) ReturnExpired(ctx context.Context, batch model.ExpiredOrdersBatch,
+	newReturn func(order model.Order) model.Return, hooks func(order model.Order, ret model.Return) []Hook) (model.ExpiredOrdersBatchResult, error) {
+	result := model.ExpiredOrdersBatchResult{Failed: make(map[int]error)}
+	err := r.m.RunInTx(ctx, Serializable, func(ctx context.Context) error {
+		excluded := make(map[int]bool, len(batch.Exclude))
+		for _, orderID := range batch.Exclude {
+			excluded[orderID] = true
+		}
+
+		orders := ordersOf(sortedValues(r.m.data.orders, func(o memoryOrder) bool {
+			return o.order.StatusID == batch.StatusID && o.order.ExpirationDate.Before(batch.Before) && !excluded[o.order.OrderID]
+		}))
+		sort.SliceStable(orders, func(i, j int) bool { return orders[i].ExpirationDate.Before(orders[j].ExpirationDate) })
+		if len(orders) > batch.Limit {
+			orders = orders[:batch.Limit]
+		}
+		result.Selected = len(orders)
+
+		for _, order := range orders {
+			var created bool
+			ret := newReturn(order)
+			// Каждый заказ обрабатывается в своей точке сохранения
+			err := r.m.RunInTx(ctx, Serializable, func(ctx context.Context) error {
+				var err error
+				created, err = r.m.returnExpiredOrder(ctx, order, &ret, hooks)
+				return err
+			})
+			if err != nil {
+				result.Failed[order.OrderID] = err
+				result.Summary.Failed++
+				continue
+			}
+
+			if created {
+				result.Summary.Processed++
+			} else {
+				result.Summary.Skipped++
+			}
+			order.StatusID = ret.StatusID
+			result.Returned = append(result.Returned, order)
+		}
+		return nil
+	})
+	if err != nil {
+		return model.ExpiredOrdersBatchResult{}, err
+	}
+	return result, nil
+}
+
+// returnExpiredOrder оформляет возврат одного просроченного заказа.
+// Возвращает false, если возврат уже существовал и создана только смена статуса.
+func (m *MemoryStore) returnExpiredOrder(ctx context.Context, order model.Order, ret *model.Return,
+	hooks func(order model.Order, ret model.Return) []Hook) (bool, error) {
+	d := m.data
+
+	existing := sortedValues(d.returns, func(r model.Return) bool { return r.OrderID == order.OrderID })
+	created := len(existing) == 0
+	if created {
+		if err := d.checkReturnRefs(*ret); err != nil {
+			return false, fmt.Errorf("ошибка вставки возврата для заказа с ID %d: %w", order.OrderID, err)
+		}
+		ret.ReturnID = m.nextID("returns")
+		d.returns[ret.ReturnID] = *ret
+	} else {
+		ret.ReturnID = existing[0].ReturnID
+	}
+
+	stored := d.orders[order.OrderID]
+	stored.order.StatusID = ret.StatusID
+	if err := d.checkOrderRefs(stored.order); err != nil {
+		return false, fmt.Errorf("ошибка обновления статуса заказа с ID %d: %w", order.OrderID, err)
+	}
+	d.orders[order.OrderID] = stored
+
+	return created, m.runHooks(ctx, d, order.OrderID, hooks(order, *ret))
+}
+
+func (r memOrders) GetStatusHistory(ctx context.Context, orderID int) ([]model.OrderStatusChange, error) {
+	return memRead(ctx, r.m, func(d *memoryData) ([]model.OrderStatusChange, error) {
+		var history []model.OrderStatusChange
+		for _, change := range d.history {
+			if change.OrderID != orderID {
+				continue
+			}
+			change.OldStatusName = d.statuses[change.OldStatusID].status.StatusName
+			change.NewStatusName = d.statuses[change.NewStatusID].status.StatusName
+			history = append(history, change)
+		}
+		sort.SliceStable(history, func(i, j int) bool {
+			if !history[i].ChangedAt.Equal(history[j].ChangedAt) {
+				return history[i].ChangedAt.Before(history[j].ChangedAt)
+			}
+			return history[i].ChangeID < history[j].ChangeID
+		})
+		return history, nil
+	})
+}
+
+// ordersOf возвращает заказы без служебных отметок
+func ordersOf(stored []memoryOrder) []model.Order {
+	var orders []model.Order
+	for _, o := range stored {
+		orders = append(orders, o.order)
+	}
+	return orders
+}
+
+// memReturns - репозиторий возвратов в памяти
+type memReturns struct {
+	m *MemoryStore
+}
+
+// checkReturnRefs проверяет внешние ключи возврата
+func (d *memoryData) checkReturnRefs(ret model.Return) error {
+	_, ok := d.orders[ret.OrderID]
+	if err := requireRef(ok, "заказ", ret.OrderID); err != nil {
+		return err
+	}
+	_, ok = d.users[ret.UserID]
+	if err := requireRef(ok, "пользователь", ret.UserID); err != nil {
+		return err
+	}
+	_, ok = d.reasons[ret.ReasonID]
+	if err := requireRef(ok, "причина возврата", ret.ReasonID); err != nil {
+		return err
+	}
+	_, ok = d.packaging[ret.PackagingID]
+	if err := requireRef(ok, "упаковка", ret.PackagingID); err != nil {
+		return err
+	}
+	_, ok = d.statuses[ret.StatusID]
+	return requireRef(ok, "статус", ret.StatusID)
+}
+
+func (r memReturns) Create(ctx context.Context, returns ...model.Return) error {
+	_, err := memWrite(ctx, r.m, func(ctx context.Context, d *memoryData) (struct{}, error) {
+		for _, ret := range returns {
+			if err := d.checkReturnRefs(ret); err != nil {
+				return struct{}{}, fmt.Errorf("ошибка записи возврата: %w", err)
+			}
+			ret.ReturnID = r.m.nextID("returns")
+			d.returns[ret.ReturnID] = ret
+		}
+		return struct{}{}, nil
+	})
+	return err
+}
+
+func (r memReturns) GetByID(ctx context.Context, returnID int) (*model.Return, error) {
+	return memRead(ctx, r.m, func(d *memoryData) (*model.Return, error) {
+		ret, ok := d.returns[returnID]
+		if !ok {
+			return nil, fmt.Errorf("возврат с ID %d не найден: %w", returnID, ErrNotFound)
+		}
+		return &ret, nil
+	})
+}
+
+func (r memReturns) GetByOrderID(ctx context.Context, orderID int) (*model.Return, error) {
+	return memRead(ctx, r.m, func(d *memoryData) (*model.Return, error) {
+		returns := sortedValues(d.returns, func(ret model.Return) bool { return ret.OrderID == orderID })
+		if len(returns) == 0 {
+			return nil, fmt.Errorf("возврат с order_id %d не найден: %w", orderID, ErrNotFound)
+		}
+		return &returns[0], nil
+	})
+}
+
+func (r memReturns) GetAll(ctx context.Context) ([]model.Return, error) {
+	return memRead(ctx, r.m, func(d *memoryData) ([]model.Return, error) {
+		return sortedValues(d.returns, nil), nil
+	})
+}
+
+func (r memReturns) GetByUserID(ctx context.Context, userID int) ([]model.Return, error) {
+	return memRead(ctx, r.m, func(d *memoryData) ([]model.Return, error) {
+		return sortedValues(d.returns, func(ret model.Return) bool { return ret.UserID == userID }), nil
+	})
+}
+
+func (r memReturns) List(ctx context.Context, filter model.ReturnFilter, afterID, limit int) ([]model.Return, error) {
+	return memRead(ctx, r.m, func(d *memoryData) ([]model.Return, error) {
+		return firstN(sortedValues(d.returns, func(ret model.Return) bool {
+			return ret.ReturnID > afterID && filter.Matches(ret)
+		}), limit), nil
+	})
+}
+
+func (r memReturns) Update(ctx context.Context, ret model.Return, hooks ...Hook) error {
+	_, err := memWrite(ctx, r.m, func(ctx context.Context, d *memoryData) (struct{}, error) {
+		if _, ok := d.returns[ret.ReturnID]; ok {
+			if err := d.checkReturnRefs(ret); err != nil {
+				return struct{}{}, fmt.Errorf("ошибка обновления возврата с ID %d: %w", ret.ReturnID, err)
+			}
+			d.returns[ret.ReturnID] = ret
+		}
+		return struct{}{}, r.m.runHooks(ctx, d, ret.ReturnID, hooks)
+	})
+	return err
+}
+
+func (r memReturns) Delete(ctx context.Context, returnID int, hooks ...Hook) error {
+	_, err := memWrite(ctx, r.m, func(ctx context.Context, d *memoryData) (struct{}, error) {
+		delete(d.returns, returnID)
+		return struct{}{}, r.m.runHooks(ctx, d, returnID, hooks)
+	})
+	return err
+}
+
+func (r memReturns) Exists(ctx context.Context, returnID int) (bool, error) {
+	return memRead(ctx, r.m, func(d *memoryData) (bool, error) {
+		_, ok := d.returns[returnID]
+		return ok, nil
+	})
+}
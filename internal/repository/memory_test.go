@@ -0,0 +1,309 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"homework1/internal/model"
+	"homework1/internal/repository"
+)
+
+// testOrder возвращает заказ, ссылающийся на справочники хранилища в памяти
+func testOrder(expiration time.Time) model.Order {
+	return model.Order{
+		UserID:         1,
+		AcceptanceDate: time.Now(),
+		ExpirationDate: expiration,
+		Weight:         1.0,
+		BaseCost:       10.0,
+		PackagingCost:  1.0,
+		TotalCost:      11.0,
+		PackagingID:    1,
+		StatusID:       1,
+	}
+}
+
+// testEvent возвращает хук, сохраняющий событие outbox указанного типа
+func testEvent(eventType string) repository.Hook {
+	return repository.WithOutboxEvent(func(_ context.Context, entityID int) (model.OutboxEvent, error) {
+		return model.OutboxEvent{Topic: "test", EventType: eventType, AggregateID: entityID}, nil
+	})
+}
+
+func TestMemoryOrdersCRUD(t *testing.T) {
+	ctx := context.Background()
+	store := repository.NewMemoryStore().Store()
+
+	orderID, err := store.Orders.Create(ctx, testOrder(time.Now().Add(time.Hour)))
+	require.NoError(t, err)
+
+	order, err := store.Orders.GetByID(ctx, orderID)
+	require.NoError(t, err)
+	assert.Equal(t, orderID, order.OrderID)
+
+	order.Weight = 2.5
+	require.NoError(t, store.Orders.Update(ctx, *order))
+	order, err = store.Orders.GetByID(ctx, orderID)
+	require.NoError(t, err)
+	assert.Equal(t, 2.5, order.Weight)
+
+	orders, err := store.Orders.GetByUserID(ctx, 1)
+	require.NoError(t, err)
+	assert.Len(t, orders, 1)
+
+	require.NoError(t, store.Orders.Delete(ctx, orderID))
+	_, err = store.Orders.GetByID(ctx, orderID)
+	assert.ErrorIs(t, err, repository.ErrNotFound)
+
+	orders, err = store.Orders.GetAll(ctx)
+	require.NoError(t, err)
+	assert.Nil(t, orders, "пустая выборка, как и в Postgres, возвращается как nil")
+}
+
+func TestMemoryOrdersList(t *testing.T) {
+	ctx := context.Background()
+	store := repository.NewMemoryStore().Store()
+
+	var ids []int
+	for i := 0; i < 5; i++ {
+		order := testOrder(time.Now().Add(time.Duration(i+1) * 24 * time.Hour))
+		order.UserID = i%2 + 1
+		if i >= 3 {
+			order.IssueDate = time.Now()
+		}
+		orderID, err := store.Orders.Create(ctx, order)
+		require.NoError(t, err)
+		ids = append(ids, orderID)
+	}
+
+	orderIDs := func(orders []model.Order) []int {
+		var result []int
+		for _, order := range orders {
+			result = append(result, order.OrderID)
+		}
+		return result
+	}
+
+	orders, err := store.Orders.List(ctx, model.OrderFilter{}, 0, 2)
+	require.NoError(t, err)
+	assert.Equal(t, ids[:2], orderIDs(orders))
+
+	orders, err = store.Orders.List(ctx, model.OrderFilter{}, ids[1], 10)
+	require.NoError(t, err)
+	assert.Equal(t, ids[2:], orderIDs(orders), "курсор пропускает уже прочитанные заказы")
+
+	orders, err = store.Orders.List(ctx, model.OrderFilter{UserID: 1}, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []int{ids[0], ids[2], ids[4]}, orderIDs(orders))
+
+	notIssued := false
+	orders, err = store.Orders.List(ctx, model.OrderFilter{Issued: &notIssued}, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, ids[:3], orderIDs(orders))
+
+	orders, err = store.Orders.List(ctx, model.OrderFilter{ExpiresBefore: time.Now().Add(36 * time.Hour)}, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, ids[:1], orderIDs(orders))
+}
+
+func TestMemoryReferentialIntegrity(t *testing.T) {
+	ctx := context.Background()
+	store := repository.NewMemoryStore().Store()
+
+	order := testOrder(time.Now())
+	order.UserID = 100
+	_, err := store.Orders.Create(ctx, order)
+	assert.ErrorIs(t, err, repository.ErrConstraint, "заказ не может ссылаться на несуществующего пользователя")
+
+	orderID, err := store.Orders.Create(ctx, testOrder(time.Now()))
+	require.NoError(t, err)
+	require.NoError(t, store.Returns.Create(ctx, model.Return{OrderID: orderID, UserID: 1, ReasonID: 1, PackagingID: 1, StatusID: 3}))
+
+	assert.ErrorIs(t, store.Orders.Delete(ctx, orderID), repository.ErrConstraint, "заказ с возвратом нельзя удалить")
+	assert.ErrorIs(t, store.Users.Delete(ctx, 1), repository.ErrConstraint, "пользователя с заказами нельзя удалить")
+	assert.NoError(t, store.Users.Delete(ctx, 2))
+}
+
+func TestMemoryHooks(t *testing.T) {
+	ctx := context.Background()
+	memory := repository.NewMemoryStore()
+	store := memory.Store()
+
+	change := model.OrderStatusChange{NewStatusID: 1, Actor: "test", Reason: "приемка", ChangedAt: time.Now()}
+	orderID, err := store.Orders.Create(ctx, testOrder(time.Now()), repository.WithStatusHistory(change), testEvent("ORDER_CREATED"))
+	require.NoError(t, err)
+
+	history, err := store.Orders.GetStatusHistory(ctx, orderID)
+	require.NoError(t, err)
+	require.Len(t, history, 1)
+	assert.Equal(t, orderID, history[0].OrderID, "запись истории получает ID созданного заказа")
+	assert.Equal(t, "Создан", history[0].NewStatusName)
+
+	events := memory.OutboxEvents()
+	require.Len(t, events, 1)
+	assert.Equal(t, orderID, events[0].AggregateID)
+
+	// Ошибка хука откатывает основную операцию
+	failing := repository.WithOutboxEvent(func(context.Context, int) (model.OutboxEvent, error) {
+		return model.OutboxEvent{}, errors.New("ошибка сериализации")
+	})
+	_, err = store.Orders.Create(ctx, testOrder(time.Now()), failing)
+	assert.Error(t, err)
+	orders, err := store.Orders.GetAll(ctx)
+	require.NoError(t, err)
+	assert.Len(t, orders, 1)
+}
+
+func TestMemoryRunInTx(t *testing.T) {
+	ctx := context.Background()
+	memory := repository.NewMemoryStore()
+	store := memory.Store()
+	errAbort := errors.New("отмена единицы работы")
+
+	err := store.Tx.RunInTx(ctx, repository.RepeatableRead, func(ctx context.Context) error {
+		_, err := store.Orders.Create(ctx, testOrder(time.Now()), testEvent("ORDER_CREATED"))
+		require.NoError(t, err)
+		return errAbort
+	})
+	assert.ErrorIs(t, err, errAbort)
+	orders, err := store.Orders.GetAll(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, orders, "изменения единицы работы должны быть откачены")
+	assert.Empty(t, memory.OutboxEvents(), "события outbox должны быть откачены вместе с заказом")
+
+	err = store.Tx.RunInTx(ctx, repository.RepeatableRead, func(ctx context.Context) error {
+		_, err := store.Orders.Create(ctx, testOrder(time.Now()))
+		require.NoError(t, err)
+
+		nested := store.Tx.RunInTx(ctx, repository.RepeatableRead, func(ctx context.Context) error {
+			_, err := store.Orders.Create(ctx, testOrder(time.Now()))
+			require.NoError(t, err)
+			return errAbort
+		})
+		assert.ErrorIs(t, nested, errAbort)
+		return nil
+	})
+	require.NoError(t, err)
+	orders, err = store.Orders.GetAll(ctx)
+	require.NoError(t, err)
+	assert.Len(t, orders, 1, "вложенная единица работы откатывает только свои изменения")
+}
+
+func TestMemoryReturnExpired(t *testing.T) {
+	ctx := context.Background()
+	store := repository.NewMemoryStore().Store()
+	expired := time.Now().Add(-time.Hour)
+
+	var orderIDs []int
+	for i := 0; i < 3; i++ {
+		orderID, err := store.Orders.Create(ctx, testOrder(expired))
+		require.NoError(t, err)
+		orderIDs = append(orderIDs, orderID)
+	}
+	_, err := store.Orders.Create(ctx, testOrder(time.Now().Add(time.Hour)))
+	require.NoError(t, err)
+	require.NoError(t, store.Returns.Create(ctx, model.Return{OrderID: orderIDs[1], UserID: 1, ReasonID: 1, PackagingID: 1, StatusID: 3}))
+
+	failingOrderID := orderIDs[2]
+	result, err := store.Orders.ReturnExpired(ctx, model.ExpiredOrdersBatch{StatusID: 1, Before: time.Now(), Limit: 10},
+		func(order model.Order) model.Return {
+			return model.Return{OrderID: order.OrderID, UserID: order.UserID, ReasonID: 1, PackagingID: order.PackagingID, StatusID: 3}
+		},
+		func(order model.Order, _ model.Return) []repository.Hook {
+			if order.OrderID != failingOrderID {
+				return nil
+			}
+			return []repository.Hook{repository.WithOutboxEvent(func(context.Context, int) (model.OutboxEvent, error) {
+				return model.OutboxEvent{}, errors.New("ошибка формирования события")
+			})}
+		})
+	require.NoError(t, err)
+	assert.Equal(t, 3, result.Selected)
+	assert.Equal(t, model.ExpiredOrdersSummary{Processed: 1, Skipped: 1, Failed: 1}, result.Summary)
+	assert.Contains(t, result.Failed, failingOrderID)
+
+	failed, err := store.Orders.GetByID(ctx, failingOrderID)
+	require.NoError(t, err)
+	assert.Equal(t, 1, failed.StatusID, "ошибка заказа откатывает только его изменения")
+	_, err = store.Returns.GetByOrderID(ctx, failingOrderID)
+	assert.ErrorIs(t, err, repository.ErrNotFound)
+
+	returns, err := store.Returns.GetAll(ctx)
+	require.NoError(t, err)
+	assert.Len(t, returns, 2, "для заказа с существующим возвратом новый возврат не создается")
+}
+
+func TestMemoryMarkExpiryNotified(t *testing.T) {
+	ctx := context.Background()
+	store := repository.NewMemoryStore().Store()
+
+	orderID, err := store.Orders.Create(ctx, testOrder(time.Now().Add(time.Hour)))
+	require.NoError(t, err)
+
+	orders, err := store.Orders.GetExpiringBefore(ctx, time.Now().Add(2*time.Hour), 1)
+	require.NoError(t, err)
+	assert.Len(t, orders, 1)
+
+	marked, err := store.Orders.MarkExpiryNotified(ctx, orderID)
+	require.NoError(t, err)
+	assert.True(t, marked)
+
+	marked, err = store.Orders.MarkExpiryNotified(ctx, orderID)
+	require.NoError(t, err)
+	assert.False(t, marked, "повторная отметка не ставится")
+
+	orders, err = store.Orders.GetExpiringBefore(ctx, time.Now().Add(2*time.Hour), 1)
+	require.NoError(t, err)
+	assert.Empty(t, orders)
+}
+
+func TestMemoryStatusCodes(t *testing.T) {
+	ctx := context.Background()
+	memory := repository.NewMemoryStore()
+	store := memory.Store()
+
+	status, err := store.Statuses.GetByCode(ctx, string(model.OrderStateReturned))
+	require.NoError(t, err)
+	assert.Equal(t, "Возврат", status.StatusName)
+
+	statusID, err := store.Statuses.Create(ctx, model.Status{StatusName: "Без кода"})
+	require.NoError(t, err)
+	code, err := store.Statuses.GetCodeByID(ctx, statusID)
+	require.NoError(t, err)
+	assert.Empty(t, code)
+
+	_, err = store.Statuses.GetCodeByID(ctx, 100)
+	assert.ErrorIs(t, err, repository.ErrNotFound)
+}
+
+func TestMemoryConcurrentAccess(t *testing.T) {
+	ctx := context.Background()
+	store := repository.NewMemoryStore().Store()
+
+	var wg sync.WaitGroup
+	ids := make([]int, 20)
+	for i := range ids {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			err := store.Tx.RunInTx(ctx, repository.RepeatableRead, func(ctx context.Context) error {
+				var err error
+				ids[i], err = store.Orders.Create(ctx, testOrder(time.Now()))
+				return err
+			})
+			assert.NoError(t, err)
+		}(i)
+	}
+	wg.Wait()
+
+	unique := make(map[int]bool)
+	for _, id := range ids {
+		unique[id] = true
+	}
+	assert.Len(t, unique, len(ids), "идентификаторы заказов должны быть уникальными")
+}
@@ -0,0 +1,326 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+	"homework1/internal/dao"
+	"homework1/internal/model"
+)
+
+// Коды SQLSTATE нарушений ограничений целостности
+const (
+	sqlStateForeignKeyViolation = "23503"
+	sqlStateUniqueViolation     = "23505"
+)
+
+// NewPostgresStore создает репозитории, которые хранят данные в Postgres через функции пакета dao.
+// Единица работы выполняется с уровнем изоляции Repeatable Read.
+func NewPostgresStore(pool *pgxpool.Pool) Store {
+	return Store{
+		Orders:        pgOrders{pool: pool},
+		Returns:       pgReturns{pool: pool},
+		Users:         pgUsers{pool: pool},
+		Packaging:     pgPackaging{pool: pool},
+		Statuses:      pgStatuses{pool: pool},
+		ReturnReasons: pgReturnReasons{pool: pool},
+		Tx:            pgTransactor{pool: pool},
+	}
+}
+
+// pgError дополняет ошибку dao общими ошибками репозитория, сохраняя исходную ошибку в цепочке
+func pgError(err error) error {
+	var pgErr *pgconn.PgError
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, pgx.ErrNoRows):
+		return fmt.Errorf("%w: %w", ErrNotFound, err)
+	case errors.As(err, &pgErr) && (pgErr.Code == sqlStateForeignKeyViolation || pgErr.Code == sqlStateUniqueViolation):
+		return fmt.Errorf("%w: %w", ErrConstraint, err)
+	default:
+		return err
+	}
+}
+
+// pgResult дополняет ошибку результата вызова dao общими ошибками репозитория
+func pgResult[T any](value T, err error) (T, error) {
+	return value, pgError(err)
+}
+
+// txHooks преобразует хуки репозитория в хуки транзакции dao
+func txHooks(hooks []Hook) []dao.TxHook {
+	txHooks := make([]dao.TxHook, 0, len(hooks))
+	for _, hook := range hooks {
+		if len(hook.history) > 0 {
+			txHooks = append(txHooks, dao.WithStatusHistory(hook.history...))
+		}
+		if hook.event != nil {
+			txHooks = append(txHooks, dao.WithOutboxEvent(hook.event))
+		}
+	}
+	return txHooks
+}
+
+// pgTransactor выполняет единицу работы через dao.RunInTx
+type pgTransactor struct {
+	pool *pgxpool.Pool
+}
+
+func (t pgTransactor) RunInTx(ctx context.Context, isolation IsolationLevel, fn func(ctx context.Context) error) error {
+	return dao.RunInTx(ctx, t.pool, pgIsoLevel(isolation), fn)
+}
+
+// pgIsoLevel возвращает уровень изоляции pgx для уровня единицы работы
+func pgIsoLevel(isolation IsolationLevel) pgx.TxIsoLevel {
+	switch isolation {
+	case RepeatableRead:
+		return pgx.RepeatableRead
+	case Serializable:
+		return pgx.Serializable
+	default:
+		return pgx.ReadCommitted
+	}
+}
+
+// pgOrders - репозиторий заказов в Postgres
+type pgOrders struct {
+	pool *pgxpool.Pool
+}
+
+func (r pgOrders) Create(ctx context.Context, order model.Order, hooks ...Hook) (int, error) {
+	return pgResult(dao.CreateOrder(ctx, order, r.pool, txHooks(hooks)...))
+}
+
+func (r pgOrders) GetByID(ctx context.Context, orderID int) (*model.Order, error) {
+	return pgResult(dao.GetOrderByID(ctx, orderID, r.pool))
+}
+
+func (r pgOrders) GetAll(ctx context.Context) ([]model.Order, error) {
+	return pgResult(dao.GetAllOrders(ctx, r.pool))
+}
+
+func (r pgOrders) GetByUserID(ctx context.Context, userID int) ([]model.Order, error) {
+	return pgResult(dao.GetOrdersByUserID(ctx, userID, r.pool))
+}
+
+func (r pgOrders) List(ctx context.Context, filter model.OrderFilter, afterID, limit int) ([]model.Order, error) {
+	return pgResult(dao.ListOrders(ctx, filter, afterID, limit, r.pool))
+}
+
+func (r pgOrders) Update(ctx context.Context, order model.Order, hooks ...Hook) error {
+	return pgError(dao.UpdateOrder(ctx, order, r.pool, txHooks(hooks)...))
+}
+
+func (r pgOrders) Delete(ctx context.Context, orderID int, hooks ...Hook) error {
+	return pgError(dao.DeleteOrder(ctx, orderID, r.pool, txHooks(hooks)...))
+}
+
+func (r pgOrders) GetExpiringBefore(ctx context.Context, deadline time.Time, statusID int) ([]model.Order, error) {
+	return pgResult(dao.GetOrdersExpiringBefore(ctx, deadline, statusID, r.pool))
+}
+
+func (r pgOrders) MarkExpiryNotified(ctx context.Context, orderID int, hooks ...Hook) (bool, error) {
+	return pgResult(dao.MarkOrderExpiryNotified(ctx, orderID, r.pool, txHooks(hooks)...))
+}
+
+func (r pgOrders) ReturnExpired(ctx context.Context, batch model.ExpiredOrdersBatch,
+	newReturn func(order model.Order) model.Return, hooks func(order model.Order, ret model.Return) []Hook) (model.ExpiredOrdersBatchResult, error) {
+	return pgResult(dao.ReturnExpiredOrders(ctx, batch, r.pool, newReturn, func(order model.Order, ret model.Return) []dao.TxHook {
+		return txHooks(hooks(order, ret))
+	}))
+}
+
+func (r pgOrders) GetStatusHistory(ctx context.Context, orderID int) ([]model.OrderStatusChange, error) {
+	return pgResult(dao.GetOrderStatusHistory(ctx, orderID, r.pool))
+}
+
+// pgReturns - репозиторий возвратов в Postgres
+type pgReturns struct {
+	pool *pgxpool.Pool
+}
+
+func (r pgReturns) Create(ctx context.Context, returns ...model.Return) error {
+	return pgError(dao.WriteReturns(ctx, returns, r.pool))
+}
+
+func (r pgReturns) GetByID(ctx context.Context, returnID int) (*model.Return, error) {
+	return pgResult(dao.GetReturnByID(ctx, returnID, r.pool))
+}
+
+func (r pgReturns) GetByOrderID(ctx context.Context, orderID int) (*model.Return, error) {
+	return pgResult(dao.FindReturnByOrderID(ctx, orderID, r.pool))
+}
+
+func (r pgReturns) GetAll(ctx context.Context) ([]model.Return, error) {
+	return pgResult(dao.ReadReturns(ctx, r.pool))
+}
+
+func (r pgReturns) GetByUserID(ctx context.Context, userID int) ([]model.Return, error) {
+	return pgResult(dao.FindReturnsByUserID(ctx, userID, r.pool))
+}
+
+func (r pgReturns) List(ctx context.Context, filter model.ReturnFilter, afterID, limit int) ([]model.Return, error) {
+	return pgResult(dao.ListReturns(ctx, filter, afterID, limit, r.pool))
+}
+
+func (r pgReturns) Update(ctx context.Context, ret model.Return, hooks ...Hook) error {
+	return pgError(dao.UpdateReturn(ctx, ret, r.pool, txHooks(hooks)...))
+}
+
+func (r pgReturns) Delete(ctx context.Context, returnID int, hooks ...Hook) error {
+	return pgError(dao.DeleteReturn(ctx, returnID, r.pool, txHooks(hooks)...))
+}
+
+func (r pgReturns) Exists(ctx context.Context, returnID int) (bool, error) {
+	return pgResult(dao.CheckReturnExists(ctx, returnID, r.pool))
+}
+
+// pgUsers - репозиторий пользователей в Postgres
+type pgUsers struct {
+	pool *pgxpool.Pool
+}
+
+func (r pgUsers) Create(ctx context.Context, user model.User) (int, error) {
+	return pgResult(dao.CreateUser(ctx, user, r.pool))
+}
+
+func (r pgUsers) GetByID(ctx context.Context, userID int) (*model.User, error) {
+	return pgResult(dao.GetUserByID(ctx, userID, r.pool))
+}
+
+func (r pgUsers) GetNameByID(ctx context.Context, userID int) (string, error) {
+	return pgResult(dao.GetUserNameByID(ctx, userID, r.pool))
+}
+
+func (r pgUsers) GetAll(ctx context.Context) ([]model.User, error) {
+	return pgResult(dao.GetAllUsers(ctx, r.pool))
+}
+
+func (r pgUsers) Update(ctx context.Context, user model.User) error {
+	return pgError(dao.UpdateUser(ctx, user, r.pool))
+}
+
+func (r pgUsers) Delete(ctx context.Context, userID int) error {
+	return pgError(dao.DeleteUser(ctx, userID, r.pool))
+}
+
+func (r pgUsers) Exists(ctx context.Context, userID int) (bool, error) {
+	return pgResult(dao.CheckUserExists(ctx, userID, r.pool))
+}
+
+// pgPackaging - репозиторий вариантов упаковки в Postgres
+type pgPackaging struct {
+	pool *pgxpool.Pool
+}
+
+func (r pgPackaging) Create(ctx context.Context, packaging model.PackagingOption) (int, error) {
+	return pgResult(dao.CreatePackaging(ctx, packaging, r.pool))
+}
+
+func (r pgPackaging) GetByID(ctx context.Context, packagingID int) (*model.PackagingOption, error) {
+	return pgResult(dao.GetPackagingByID(ctx, packagingID, r.pool))
+}
+
+func (r pgPackaging) GetByName(ctx context.Context, packagingType string) (*model.PackagingOption, error) {
+	return pgResult(dao.GetPackagingByName(ctx, packagingType, r.pool))
+}
+
+func (r pgPackaging) GetAll(ctx context.Context) ([]model.PackagingOption, error) {
+	return pgResult(dao.GetAllPackaging(ctx, r.pool))
+}
+
+func (r pgPackaging) Update(ctx context.Context, packaging model.PackagingOption) error {
+	return pgError(dao.UpdatePackaging(ctx, packaging, r.pool))
+}
+
+func (r pgPackaging) Delete(ctx context.Context, packagingID int) error {
+	return pgError(dao.DeletePackaging(ctx, packagingID, r.pool))
+}
+
+func (r pgPackaging) Exists(ctx context.Context, packagingID int) (bool, error) {
+	return pgResult(dao.CheckPackagingExists(ctx, packagingID, r.pool))
+}
+
+// pgStatuses - репозиторий статусов в Postgres
+type pgStatuses struct {
+	pool *pgxpool.Pool
+}
+
+func (r pgStatuses) Create(ctx context.Context, status model.Status) (int, error) {
+	return pgResult(dao.CreateStatus(ctx, status, r.pool))
+}
+
+func (r pgStatuses) GetByID(ctx context.Context, statusID int) (*model.Status, error) {
+	return pgResult(dao.GetStatusByID(ctx, statusID, r.pool))
+}
+
+func (r pgStatuses) GetByName(ctx context.Context, statusName string) (*model.Status, error) {
+	return pgResult(dao.GetStatusByName(ctx, statusName, r.pool))
+}
+
+func (r pgStatuses) GetNameByID(ctx context.Context, statusID int) (string, error) {
+	return pgResult(dao.GetStatusNameByID(ctx, statusID, r.pool))
+}
+
+func (r pgStatuses) GetByCode(ctx context.Context, code string) (*model.Status, error) {
+	return pgResult(dao.GetStatusByCode(ctx, code, r.pool))
+}
+
+func (r pgStatuses) GetCodeByID(ctx context.Context, statusID int) (string, error) {
+	return pgResult(dao.GetStatusCodeByID(ctx, statusID, r.pool))
+}
+
+func (r pgStatuses) GetAll(ctx context.Context) ([]model.Status, error) {
+	return pgResult(dao.GetAllStatuses(ctx, r.pool))
+}
+
+func (r pgStatuses) Update(ctx context.Context, status model.Status) error {
+	return pgError(dao.UpdateStatus(ctx, status, r.pool))
+}
+
+func (r pgStatuses) Delete(ctx context.Context, statusID int) error {
+	return pgError(dao.DeleteStatus(ctx, statusID, r.pool))
+}
+
+func (r pgStatuses) Exists(ctx context.Context, statusID int) (bool, error) {
+	return pgResult(dao.CheckStatusExists(ctx, statusID, r.pool))
+}
+
+// pgReturnReasons - репозиторий причин возврата в Postgres
+type pgReturnReasons struct {
+	pool *pgxpool.Pool
+}
+
+func (r pgReturnReasons) Create(ctx context.Context, reason model.ReturnReason) (int, error) {
+	return pgResult(dao.CreateReturnReason(ctx, reason, r.pool))
+}
+
+func (r pgReturnReasons) GetByID(ctx context.Context, reasonID int) (*model.ReturnReason, error) {
+	return pgResult(dao.GetReturnReasonByID(ctx, reasonID, r.pool))
+}
+
+func (r pgReturnReasons) GetByName(ctx context.Context, reasonName string) (*model.ReturnReason, error) {
+	return pgResult(dao.GetReturnReasonByName(ctx, reasonName, r.pool))
+}
+
+func (r pgReturnReasons) GetAll(ctx context.Context) ([]model.ReturnReason, error) {
+	return pgResult(dao.GetAllReturnReasons(ctx, r.pool))
+}
+
+func (r pgReturnReasons) Update(ctx context.Context, reason model.ReturnReason) error {
+	return pgError(dao.UpdateReturnReason(ctx, reason, r.pool))
+}
+
+func (r pgReturnReasons) Delete(ctx context.Context, reasonID int) error {
+	return pgError(dao.DeleteReturnReason(ctx, reasonID, r.pool))
+}
+
+func (r pgReturnReasons) Exists(ctx context.Context, reasonID int) (bool, error) {
+	return pgResult(dao.CheckReturnReasonExists(ctx, reasonID, r.pool))
+}
@@ -0,0 +1,152 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"homework1/internal/model"
+)
+
+var (
+	// ErrNotFound возвращается, если запись не найдена
+	ErrNotFound = errors.New("запись не найдена")
+	// ErrConstraint возвращается при нарушении ссылочной целостности или уникальности
+	ErrConstraint = errors.New("нарушено ограничение целостности данных")
+)
+
+// Hook - дополнительная запись, которая сохраняется в транзакции основной операции репозитория.
+// entityID записи - идентификатор изменяемой сущности; для вставок это идентификатор созданной сущности.
+type Hook struct {
+	history []model.OrderStatusChange
+	event   func(ctx context.Context, entityID int) (model.OutboxEvent, error)
+}
+
+// WithStatusHistory сохраняет записи истории статусов заказа в транзакции основной операции.
+// Для записей без OrderID используется идентификатор изменяемого заказа.
+func WithStatusHistory(changes ...model.OrderStatusChange) Hook {
+	return Hook{history: changes}
+}
+
+// WithOutboxEvent сохраняет событие в outbox в транзакции основной операции.
+// build получает контекст операции и ID изменяемой записи.
+func WithOutboxEvent(build func(ctx context.Context, entityID int) (model.OutboxEvent, error)) Hook {
+	return Hook{event: build}
+}
+
+// IsolationLevel - уровень изоляции транзакции единицы работы
+type IsolationLevel int
+
+const (
+	ReadCommitted IsolationLevel = iota
+	RepeatableRead
+	Serializable
+)
+
+// Transactor выполняет несколько вызовов репозиториев как одну единицу работы
+type Transactor interface {
+	// RunInTx выполняет fn в одной транзакции с уровнем изоляции isolation. Вызовы репозиториев с контекстом,
+	// переданным в fn, присоединяются к ней. Если fn возвращает ошибку, все изменения единицы работы откатываются.
+	// При конфликте сериализации единица работы может повторяться, поэтому fn не должна иметь побочных эффектов
+	// вне транзакции.
+	RunInTx(ctx context.Context, isolation IsolationLevel, fn func(ctx context.Context) error) error
+}
+
+// OrderRepository хранит заказы и историю смены их статусов
+type OrderRepository interface {
+	Create(ctx context.Context, order model.Order, hooks ...Hook) (int, error)
+	GetByID(ctx context.Context, orderID int) (*model.Order, error)
+	GetAll(ctx context.Context) ([]model.Order, error)
+	GetByUserID(ctx context.Context, userID int) ([]model.Order, error)
+	// List возвращает не более limit заказов с ID больше afterID, подходящих под фильтр, в порядке возрастания ID
+	List(ctx context.Context, filter model.OrderFilter, afterID, limit int) ([]model.Order, error)
+	Update(ctx context.Context, order model.Order, hooks ...Hook) error
+	Delete(ctx context.Context, orderID int, hooks ...Hook) error
+
+	// GetExpiringBefore возвращает заказы в статусе statusID, срок хранения которых истекает до deadline
+	// и о которых еще не отправлено уведомление
+	GetExpiringBefore(ctx context.Context, deadline time.Time, statusID int) ([]model.Order, error)
+	// MarkExpiryNotified отмечает отправку уведомления об истечении срока хранения.
+	// Хуки выполняются только если отметка поставлена впервые; иначе возвращается false.
+	MarkExpiryNotified(ctx context.Context, orderID int, hooks ...Hook) (bool, error)
+	// ReturnExpired оформляет возвраты пачки просроченных заказов. Ошибка одного заказа откатывает только его изменения.
+	ReturnExpired(ctx context.Context, batch model.ExpiredOrdersBatch,
+		newReturn func(order model.Order) model.Return, hooks func(order model.Order, ret model.Return) []Hook) (model.ExpiredOrdersBatchResult, error)
+
+	// GetStatusHistory возвращает историю смены статусов заказа в хронологическом порядке
+	GetStatusHistory(ctx context.Context, orderID int) ([]model.OrderStatusChange, error)
+}
+
+// ReturnRepository хранит возвраты
+type ReturnRepository interface {
+	Create(ctx context.Context, returns ...model.Return) error
+	GetByID(ctx context.Context, returnID int) (*model.Return, error)
+	GetByOrderID(ctx context.Context, orderID int) (*model.Return, error)
+	GetAll(ctx context.Context) ([]model.Return, error)
+	GetByUserID(ctx context.Context, userID int) ([]model.Return, error)
+	// List возвращает не более limit возвратов с ID больше afterID, подходящих под фильтр, в порядке возрастания ID
+	List(ctx context.Context, filter model.ReturnFilter, afterID, limit int) ([]model.Return, error)
+	Update(ctx context.Context, ret model.Return, hooks ...Hook) error
+	Delete(ctx context.Context, returnID int, hooks ...Hook) error
+	Exists(ctx context.Context, returnID int) (bool, error)
+}
+
+// UserRepository хранит пользователей
+type UserRepository interface {
+	Create(ctx context.Context, user model.User) (int, error)
+	GetByID(ctx context.Context, userID int) (*model.User, error)
+	GetNameByID(ctx context.Context, userID int) (string, error)
+	GetAll(ctx context.Context) ([]model.User, error)
+	Update(ctx context.Context, user model.User) error
+	Delete(ctx context.Context, userID int) error
+	Exists(ctx context.Context, userID int) (bool, error)
+}
+
+// PackagingRepository хранит варианты упаковки
+type PackagingRepository interface {
+	Create(ctx context.Context, packaging model.PackagingOption) (int, error)
+	GetByID(ctx context.Context, packagingID int) (*model.PackagingOption, error)
+	GetByName(ctx context.Context, packagingType string) (*model.PackagingOption, error)
+	GetAll(ctx context.Context) ([]model.PackagingOption, error)
+	Update(ctx context.Context, packaging model.PackagingOption) error
+	Delete(ctx context.Context, packagingID int) error
+	Exists(ctx context.Context, packagingID int) (bool, error)
+}
+
+// StatusRepository хранит справочник статусов и их соответствие состояниям жизненного цикла заказа
+type StatusRepository interface {
+	Create(ctx context.Context, status model.Status) (int, error)
+	GetByID(ctx context.Context, statusID int) (*model.Status, error)
+	GetByName(ctx context.Context, statusName string) (*model.Status, error)
+	GetNameByID(ctx context.Context, statusID int) (string, error)
+	// GetByCode возвращает статус по коду состояния жизненного цикла
+	GetByCode(ctx context.Context, code string) (*model.Status, error)
+	// GetCodeByID возвращает код состояния жизненного цикла; для статусов без кода - пустую строку
+	GetCodeByID(ctx context.Context, statusID int) (string, error)
+	GetAll(ctx context.Context) ([]model.Status, error)
+	Update(ctx context.Context, status model.Status) error
+	Delete(ctx context.Context, statusID int) error
+	Exists(ctx context.Context, statusID int) (bool, error)
+}
+
+// ReturnReasonRepository хранит справочник причин возврата
+type ReturnReasonRepository interface {
+	Create(ctx context.Context, reason model.ReturnReason) (int, error)
+	GetByID(ctx context.Context, reasonID int) (*model.ReturnReason, error)
+	GetByName(ctx context.Context, reasonName string) (*model.ReturnReason, error)
+	GetAll(ctx context.Context) ([]model.ReturnReason, error)
+	Update(ctx context.Context, reason model.ReturnReason) error
+	Delete(ctx context.Context, reasonID int) error
+	Exists(ctx context.Context, reasonID int) (bool, error)
+}
+
+// Store объединяет репозитории одного хранилища и единицу работы над ними
+type Store struct {
+	Orders        OrderRepository
+	Returns       ReturnRepository
+	Users         UserRepository
+	Packaging     PackagingRepository
+	Statuses      StatusRepository
+	ReturnReasons ReturnReasonRepository
+	Tx            Transactor
+}
@@ -0,0 +1,122 @@
+package scheduler
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v4/pgxpool"
+
+	"homework1/internal/dao"
+)
+
+// releaseTimeout ограничивает снятие блокировки лидера при завершении работы
+const releaseTimeout = 5 * time.Second
+
+// Leadership сообщает, может ли реплика выполнять задания по расписанию
+type Leadership interface {
+	// Term возвращает контекст текущего срока лидерства и true, если реплика - лидер.
+	// Контекст отменяется при потере лидерства.
+	Term() (context.Context, bool)
+}
+
+// PostgresElector выбирает лидера среди реплик с помощью сессионной advisory-блокировки PostgreSQL.
+// Лидером становится реплика, захватившая блокировку; остальные периодически пытаются ее захватить.
+// Если лидер падает или теряет соединение с базой, PostgreSQL снимает блокировку вместе с сессией,
+// и лидерство переходит к другой реплике не позже чем через интервал проверки.
+type PostgresElector struct {
+	pool     *pgxpool.Pool
+	name     string
+	key      int64
+	interval time.Duration
+
+	mu     sync.Mutex // Защищает term и cancel
+	term   context.Context
+	cancel context.CancelFunc
+}
+
+// NewPostgresElector создает выбор лидера по блокировке с названием name.
+// interval задает период попыток захвата блокировки и проверки соединения лидера.
+func NewPostgresElector(dbPool *pgxpool.Pool, name string, interval time.Duration) *PostgresElector {
+	return &PostgresElector{
+		pool:     dbPool,
+		name:     name,
+		key:      dao.AdvisoryLockKey(name),
+		interval: interval,
+	}
+}
+
+// Term возвращает контекст текущего срока лидерства
+func (e *PostgresElector) Term() (context.Context, bool) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.term == nil {
+		return nil, false
+	}
+	return e.term, true
+}
+
+// Run участвует в выборе лидера до отмены контекста, после чего снимает блокировку
+func (e *PostgresElector) Run(ctx context.Context) {
+	ticker := time.NewTicker(e.interval)
+	defer ticker.Stop()
+
+	var lock *dao.AdvisoryLock
+	defer func() {
+		if lock != nil {
+			e.resign()
+			releaseCtx, cancel := context.WithTimeout(context.Background(), releaseTimeout)
+			defer cancel()
+			if err := lock.Release(releaseCtx); err != nil {
+				log.Printf("Ошибка снятия блокировки лидера %s: %v", e.name, err)
+			}
+			log.Printf("Реплика сложила полномочия лидера %s", e.name)
+		}
+	}()
+
+	for {
+		if lock == nil {
+			acquired, err := dao.TryAdvisoryLock(ctx, e.key, e.pool)
+			switch {
+			case err != nil:
+				log.Printf("Ошибка захвата блокировки лидера %s: %v", e.name, err)
+			case acquired != nil:
+				lock = acquired
+				e.elect(ctx)
+				log.Printf("Реплика стала лидером %s", e.name)
+			}
+		} else if err := lock.Check(ctx); err != nil && ctx.Err() == nil {
+			log.Printf("Реплика потеряла лидерство %s: %v", e.name, err)
+			e.resign()
+			_ = lock.Release(ctx)
+			lock = nil
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// elect начинает новый срок лидерства
+func (e *PostgresElector) elect(ctx context.Context) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.term, e.cancel = context.WithCancel(ctx)
+}
+
+// resign завершает срок лидерства и отменяет выполняющиеся в нем задания
+func (e *PostgresElector) resign() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.cancel != nil {
+		e.cancel()
+	}
+	e.term, e.cancel = nil, nil
+}
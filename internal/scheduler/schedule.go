@@ -0,0 +1,200 @@
+package scheduler
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Schedule вычисляет время следующего запуска задания
+type Schedule interface {
+	// Next возвращает ближайшее время запуска строго после after
+	Next(after time.Time) time.Time
+}
+
+// intervalSchedule запускает задание через равные интервалы
+type intervalSchedule struct {
+	interval time.Duration
+}
+
+// Every возвращает расписание с запуском через каждые interval
+func Every(interval time.Duration) Schedule {
+	return intervalSchedule{interval: interval}
+}
+
+// Next возвращает время через interval после after
+func (s intervalSchedule) Next(after time.Time) time.Time {
+	return after.Add(s.interval)
+}
+
+// cronSchedule - расписание в формате cron из пяти полей: минута, час, день месяца, месяц, день недели.
+// Каждое поле хранится битовой маской допустимых значений.
+type cronSchedule struct {
+	minute, hour, dom, month, dow uint64
+	anyDom, anyDow                bool // Поле задано как "*": день подходит по другому полю
+}
+
+// cronField описывает допустимый диапазон значений поля cron
+type cronField struct {
+	name     string
+	min, max int
+}
+
+var cronFields = [5]cronField{
+	{"минута", 0, 59},
+	{"час", 0, 23},
+	{"день месяца", 1, 31},
+	{"месяц", 1, 12},
+	{"день недели", 0, 6},
+}
+
+// cronAliases - сокращения для часто используемых расписаний
+var cronAliases = map[string]string{
+	"@hourly":   "0 * * * *",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@weekly":   "0 0 * * 0",
+	"@monthly":  "0 0 1 * *",
+}
+
+// ParseSchedule разбирает расписание задания.
+// Поддерживаются интервалы "@every 10m", сокращения @hourly, @daily, @midnight, @weekly, @monthly
+// и выражения cron из пяти полей со значениями "*", списками "1,15", диапазонами "1-5" и шагом "*/10".
+func ParseSchedule(spec string) (Schedule, error) {
+	spec = strings.TrimSpace(spec)
+	if rest, ok := strings.CutPrefix(spec, "@every "); ok {
+		interval, err := time.ParseDuration(strings.TrimSpace(rest))
+		if err != nil {
+			return nil, fmt.Errorf("некорректный интервал в расписании %q: %w", spec, err)
+		}
+		if interval <= 0 {
+			return nil, fmt.Errorf("интервал в расписании %q должен быть положительным", spec)
+		}
+		return Every(interval), nil
+	}
+	if alias, ok := cronAliases[spec]; ok {
+		spec = alias
+	}
+
+	fields := strings.Fields(spec)
+	if len(fields) != len(cronFields) {
+		return nil, fmt.Errorf("расписание %q должно содержать %d полей cron", spec, len(cronFields))
+	}
+
+	var masks [5]uint64
+	for i, field := range fields {
+		mask, err := parseCronField(field, cronFields[i])
+		if err != nil {
+			return nil, fmt.Errorf("некорректное расписание %q: %w", spec, err)
+		}
+		masks[i] = mask
+	}
+
+	return &cronSchedule{
+		minute: masks[0],
+		hour:   masks[1],
+		dom:    masks[2],
+		month:  masks[3],
+		dow:    masks[4],
+		anyDom: fields[2] == "*",
+		anyDow: fields[4] == "*",
+	}, nil
+}
+
+// parseCronField возвращает битовую маску значений одного поля cron
+func parseCronField(field string, bounds cronField) (uint64, error) {
+	var mask uint64
+	for _, part := range strings.Split(field, ",") {
+		rangePart, stepPart, hasStep := strings.Cut(part, "/")
+
+		step := 1
+		if hasStep {
+			var err error
+			step, err = strconv.Atoi(stepPart)
+			if err != nil || step <= 0 {
+				return 0, fmt.Errorf("некорректный шаг %q в поле \"%s\"", stepPart, bounds.name)
+			}
+		}
+
+		low, high := bounds.min, bounds.max
+		if rangePart != "*" {
+			lowPart, highPart, isRange := strings.Cut(rangePart, "-")
+			var err error
+			if low, err = parseCronValue(lowPart, bounds); err != nil {
+				return 0, err
+			}
+			high = low
+			if isRange {
+				if high, err = parseCronValue(highPart, bounds); err != nil {
+					return 0, err
+				}
+			} else if hasStep {
+				high = bounds.max
+			}
+			if low > high {
+				return 0, fmt.Errorf("пустой диапазон %q в поле \"%s\"", rangePart, bounds.name)
+			}
+		}
+
+		for value := low; value <= high; value += step {
+			mask |= 1 << uint(value)
+		}
+	}
+	return mask, nil
+}
+
+// parseCronValue разбирает одно значение поля cron и проверяет его диапазон
+func parseCronValue(value string, bounds cronField) (int, error) {
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("некорректное значение %q в поле \"%s\"", value, bounds.name)
+	}
+	if n < bounds.min || n > bounds.max {
+		return 0, fmt.Errorf("значение %d в поле \"%s\" вне диапазона %d-%d", n, bounds.name, bounds.min, bounds.max)
+	}
+	return n, nil
+}
+
+// cronSearchLimit ограничивает поиск следующего запуска для расписаний, которые никогда не срабатывают (например, 30 февраля)
+const cronSearchLimit = 5 * 366 * 24 * time.Hour
+
+// Next возвращает ближайшую минуту после after, подходящую под все поля расписания.
+// Если такой минуты нет в ближайшие пять лет, возвращается нулевое время.
+func (s *cronSchedule) Next(after time.Time) time.Time {
+	t := after.Truncate(time.Minute).Add(time.Minute)
+	limit := t.Add(cronSearchLimit)
+
+	for t.Before(limit) {
+		switch {
+		case s.month&(1<<uint(t.Month())) == 0:
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, t.Location())
+		case !s.dayMatches(t):
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, t.Location())
+		case s.hour&(1<<uint(t.Hour())) == 0:
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, t.Location())
+		case s.minute&(1<<uint(t.Minute())) == 0:
+			t = t.Add(time.Minute)
+		default:
+			return t
+		}
+	}
+	return time.Time{}
+}
+
+// dayMatches проверяет день месяца и день недели.
+// Как в cron, если оба поля ограничены, достаточно совпадения любого из них.
+func (s *cronSchedule) dayMatches(t time.Time) bool {
+	domMatch := s.dom&(1<<uint(t.Day())) != 0
+	dowMatch := s.dow&(1<<uint(t.Weekday())) != 0
+	switch {
+	case s.anyDom && s.anyDow:
+		return true
+	case s.anyDom:
+		return dowMatch
+	case s.anyDow:
+		return domMatch
+	default:
+		return domMatch || dowMatch
+	}
+}
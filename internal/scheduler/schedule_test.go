@@ -0,0 +1,61 @@
+package scheduler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestParseScheduleCron проверяет вычисление следующего запуска для выражений cron
+func TestParseScheduleCron(t *testing.T) {
+	// 2024-10-15 - вторник
+	from := time.Date(2024, 10, 15, 10, 17, 30, 0, time.UTC)
+
+	tests := []struct {
+		spec string
+		want time.Time
+	}{
+		{"*/10 * * * *", time.Date(2024, 10, 15, 10, 20, 0, 0, time.UTC)},
+		{"0 3 * * *", time.Date(2024, 10, 16, 3, 0, 0, 0, time.UTC)},
+		{"@hourly", time.Date(2024, 10, 15, 11, 0, 0, 0, time.UTC)},
+		{"30 9 * * 1-5", time.Date(2024, 10, 16, 9, 30, 0, 0, time.UTC)},
+		{"0 0 1 * *", time.Date(2024, 11, 1, 0, 0, 0, 0, time.UTC)},
+		{"0 12 1,20 * *", time.Date(2024, 10, 20, 12, 0, 0, 0, time.UTC)},
+		// Ограничены и день месяца, и день недели: достаточно совпадения любого поля
+		{"0 0 1 * 0", time.Date(2024, 10, 20, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.spec, func(t *testing.T) {
+			schedule, err := ParseSchedule(tt.spec)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, schedule.Next(from))
+		})
+	}
+}
+
+// TestParseScheduleEvery проверяет интервальное расписание
+func TestParseScheduleEvery(t *testing.T) {
+	schedule, err := ParseSchedule("@every 10m")
+	require.NoError(t, err)
+
+	from := time.Date(2024, 10, 15, 10, 17, 30, 0, time.UTC)
+	assert.Equal(t, from.Add(10*time.Minute), schedule.Next(from))
+}
+
+// TestParseScheduleInvalid проверяет отклонение некорректных расписаний
+func TestParseScheduleInvalid(t *testing.T) {
+	for _, spec := range []string{"", "* * * *", "60 * * * *", "*/0 * * * *", "5-1 * * * *", "@every -1m", "@every soon"} {
+		_, err := ParseSchedule(spec)
+		assert.Error(t, err, spec)
+	}
+}
+
+// TestCronScheduleNeverFires проверяет расписание без подходящих дат
+func TestCronScheduleNeverFires(t *testing.T) {
+	schedule, err := ParseSchedule("0 0 30 2 *")
+	require.NoError(t, err)
+	assert.True(t, schedule.Next(time.Now()).IsZero())
+}
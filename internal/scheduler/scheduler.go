@@ -0,0 +1,294 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+var (
+	// ErrJobNotFound возвращается для незарегистрированного задания
+	ErrJobNotFound = errors.New("задание не найдено")
+	// ErrJobRunning возвращается при ручном запуске задания, которое уже выполняется
+	ErrJobRunning = errors.New("задание уже выполняется")
+	// ErrNotStarted возвращается при ручном запуске задания до запуска планировщика
+	ErrNotStarted = errors.New("планировщик не запущен")
+	// ErrNotLeader возвращается при ручном запуске задания на реплике, которая не является лидером
+	ErrNotLeader = errors.New("реплика не является лидером, задания выполняются на другой реплике")
+)
+
+// JobFunc - функция, выполняющая задание
+type JobFunc func(ctx context.Context) error
+
+// Outcome - результат последнего запуска задания
+type Outcome string
+
+const (
+	OutcomeNone    Outcome = ""        // Задание еще не запускалось
+	OutcomeSuccess Outcome = "success" // Задание завершилось без ошибки
+	OutcomeFailure Outcome = "failure" // Задание вернуло ошибку или завершилось паникой
+)
+
+// JobStatus представляет состояние задания
+type JobStatus struct {
+	Name         string        // Название задания
+	Spec         string        // Расписание в том виде, в котором оно задано
+	Running      bool          // Задание выполняется в данный момент
+	LastRunAt    time.Time     // Время начала последнего запуска
+	LastDuration time.Duration // Длительность последнего завершенного запуска
+	LastOutcome  Outcome       // Результат последнего завершенного запуска
+	LastError    string        // Ошибка последнего завершенного запуска
+	LastSummary  string        // Итог последнего завершенного запуска, переданный заданием через RecordSummary
+	NextRunAt    time.Time     // Время следующего запуска по расписанию
+	Runs         uint64        // Количество завершенных запусков
+	Failures     uint64        // Количество запусков, завершившихся ошибкой
+}
+
+// job хранит задание вместе с его состоянием
+type job struct {
+	run      JobFunc
+	schedule Schedule
+	status   JobStatus
+}
+
+// Scheduler запускает зарегистрированные задания по расписанию.
+// Все расписания обслуживает одна горутина, которая ждет ближайшего запуска;
+// каждый запуск задания выполняется в отдельной горутине.
+// Задание не запускается повторно, пока не завершится предыдущий запуск: пропущенный запуск переносится на следующий по расписанию.
+// При нескольких репликах задания выполняются только на лидере, а при потере лидерства их контекст отменяется.
+type Scheduler struct {
+	mu   sync.Mutex // Защищает jobs и ctx
+	jobs map[string]*job
+	ctx  context.Context // Контекст запущенного планировщика, нужен для ручных запусков
+
+	leadership Leadership     // Выбор лидера среди реплик; nil - реплика всегда выполняет задания
+	wake       chan struct{}  // Сигнал пересчитать ближайший запуск
+	runs       sync.WaitGroup // Выполняющиеся запуски заданий
+	now        func() time.Time
+}
+
+// NewScheduler создает новый планировщик без заданий.
+// Если leadership равен nil, задания выполняются без согласования с другими репликами.
+func NewScheduler(leadership Leadership) *Scheduler {
+	return &Scheduler{
+		jobs:       make(map[string]*job),
+		leadership: leadership,
+		wake:       make(chan struct{}, 1),
+		now:        time.Now,
+	}
+}
+
+// Register добавляет задание с расписанием spec в формате ParseSchedule.
+// Возвращает ошибку, если расписание некорректно или задание с таким названием уже зарегистрировано.
+func (s *Scheduler) Register(name, spec string, run JobFunc) error {
+	schedule, err := ParseSchedule(spec)
+	if err != nil {
+		return fmt.Errorf("ошибка регистрации задания %s: %w", name, err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.jobs[name]; ok {
+		return fmt.Errorf("задание %s уже зарегистрировано", name)
+	}
+	s.jobs[name] = &job{
+		run:      run,
+		schedule: schedule,
+		status: JobStatus{
+			Name:      name,
+			Spec:      spec,
+			NextRunAt: schedule.Next(s.now()),
+		},
+	}
+
+	s.notify()
+	return nil
+}
+
+// Run запускает задания по расписанию до отмены контекста и дожидается завершения выполняющихся запусков
+func (s *Scheduler) Run(ctx context.Context) {
+	s.mu.Lock()
+	s.ctx = ctx
+	s.mu.Unlock()
+
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for {
+		next := s.runDue(ctx)
+
+		if !timer.Stop() {
+			select {
+			case <-timer.C:
+			default:
+			}
+		}
+		if !next.IsZero() {
+			timer.Reset(next.Sub(s.now()))
+		}
+
+		select {
+		case <-timer.C:
+		case <-s.wake:
+		case <-ctx.Done():
+			log.Println("Завершение планировщика заданий, ожидание выполняющихся заданий.")
+			s.runs.Wait()
+			return
+		}
+	}
+}
+
+// runDue запускает задания, время которых наступило, и возвращает время ближайшего следующего запуска.
+// На реплике, которая не является лидером, наступившие запуски пропускаются.
+func (s *Scheduler) runDue(ctx context.Context) time.Time {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	runCtx, leader := s.term(ctx)
+	now := s.now()
+	var next time.Time
+	for name, j := range s.jobs {
+		if j.status.NextRunAt.IsZero() {
+			continue
+		}
+		if !j.status.NextRunAt.After(now) {
+			switch {
+			case !leader:
+				// Запуск выполняет реплика-лидер
+			case j.status.Running:
+				log.Printf("Задание %s пропущено: предыдущий запуск еще выполняется", name)
+			default:
+				s.start(runCtx, j)
+			}
+			j.status.NextRunAt = j.schedule.Next(now)
+		}
+		if next.IsZero() || j.status.NextRunAt.Before(next) {
+			next = j.status.NextRunAt
+		}
+	}
+	return next
+}
+
+// Trigger запускает задание вне расписания, не дожидаясь его завершения.
+// Время следующего запуска по расписанию не меняется.
+func (s *Scheduler) Trigger(name string) (JobStatus, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	j, ok := s.jobs[name]
+	if !ok {
+		return JobStatus{}, fmt.Errorf("%w: %s", ErrJobNotFound, name)
+	}
+	if s.ctx == nil {
+		return JobStatus{}, ErrNotStarted
+	}
+	runCtx, leader := s.term(s.ctx)
+	if !leader {
+		return j.status, ErrNotLeader
+	}
+	if j.status.Running {
+		return j.status, fmt.Errorf("%w: %s", ErrJobRunning, name)
+	}
+
+	log.Printf("Ручной запуск задания %s", name)
+	s.start(runCtx, j)
+	return j.status, nil
+}
+
+// IsLeader сообщает, выполняет ли реплика задания
+func (s *Scheduler) IsLeader() bool {
+	_, leader := s.term(context.Background())
+	return leader
+}
+
+// term возвращает контекст для запуска заданий и признак лидерства реплики
+func (s *Scheduler) term(ctx context.Context) (context.Context, bool) {
+	if s.leadership == nil {
+		return ctx, true
+	}
+	return s.leadership.Term()
+}
+
+// Jobs возвращает состояние всех заданий, отсортированных по названию
+func (s *Scheduler) Jobs() []JobStatus {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	statuses := make([]JobStatus, 0, len(s.jobs))
+	for _, j := range s.jobs {
+		statuses = append(statuses, j.status)
+	}
+	sort.Slice(statuses, func(i, k int) bool { return statuses[i].Name < statuses[k].Name })
+	return statuses
+}
+
+// summaryKey - ключ контекста запуска, через который задание передает итог
+type summaryKey struct{}
+
+// RecordSummary сохраняет итог текущего запуска задания, например количество обработанных записей.
+// Итог попадает в состояние задания после завершения запуска; вне задания вызов ничего не делает.
+func RecordSummary(ctx context.Context, summary string) {
+	if holder, ok := ctx.Value(summaryKey{}).(*atomic.Value); ok {
+		holder.Store(summary)
+	}
+}
+
+// start запускает задание в отдельной горутине. Вызывается под s.mu.
+func (s *Scheduler) start(ctx context.Context, j *job) {
+	startedAt := s.now()
+	j.status.Running = true
+	j.status.LastRunAt = startedAt
+
+	summary := &atomic.Value{}
+	ctx = context.WithValue(ctx, summaryKey{}, summary)
+
+	s.runs.Add(1)
+	go func() {
+		defer s.runs.Done()
+
+		err := callSafely(ctx, j.run)
+		duration := s.now().Sub(startedAt)
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		j.status.Running = false
+		j.status.LastDuration = duration
+		j.status.LastSummary, _ = summary.Load().(string)
+		j.status.Runs++
+		if err != nil {
+			j.status.LastOutcome = OutcomeFailure
+			j.status.LastError = err.Error()
+			j.status.Failures++
+			log.Printf("Задание %s завершилось с ошибкой за %v: %v", j.status.Name, duration, err)
+			return
+		}
+		j.status.LastOutcome = OutcomeSuccess
+		j.status.LastError = ""
+		log.Printf("Задание %s выполнено за %v", j.status.Name, duration)
+	}()
+}
+
+// notify будит цикл планировщика, чтобы он пересчитал ближайший запуск
+func (s *Scheduler) notify() {
+	select {
+	case s.wake <- struct{}{}:
+	default:
+	}
+}
+
+// callSafely выполняет задание, превращая панику в ошибку
+func callSafely(ctx context.Context, run JobFunc) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("паника в задании: %v", r)
+		}
+	}()
+	return run(ctx)
+}
@@ -0,0 +1,151 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSchedulerRunsJobs проверяет запуск задания по расписанию и учет результатов
+func TestSchedulerRunsJobs(t *testing.T) {
+	s := NewScheduler(nil)
+	runs := make(chan struct{}, 10)
+	require.NoError(t, s.Register("failing", "@every 10ms", func(context.Context) error {
+		runs <- struct{}{}
+		return errors.New("ошибка задания")
+	}))
+	assert.Error(t, s.Register("failing", "@every 10ms", func(context.Context) error { return nil }))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		s.Run(ctx)
+		close(done)
+	}()
+
+	for i := 0; i < 2; i++ {
+		select {
+		case <-runs:
+		case <-time.After(time.Second):
+			t.Fatal("задание не запустилось по расписанию")
+		}
+	}
+	cancel()
+	<-done
+
+	jobs := s.Jobs()
+	require.Len(t, jobs, 1)
+	assert.Equal(t, "failing", jobs[0].Name)
+	assert.Equal(t, OutcomeFailure, jobs[0].LastOutcome)
+	assert.Equal(t, "ошибка задания", jobs[0].LastError)
+	assert.GreaterOrEqual(t, jobs[0].Runs, uint64(2))
+	assert.Equal(t, jobs[0].Runs, jobs[0].Failures)
+	assert.False(t, jobs[0].Running)
+}
+
+// TestSchedulerTrigger проверяет ручной запуск задания и запрет параллельного запуска
+func TestSchedulerTrigger(t *testing.T) {
+	s := NewScheduler(nil)
+	release := make(chan struct{})
+	started := make(chan struct{}, 1)
+	require.NoError(t, s.Register("report", "@daily", func(ctx context.Context) error {
+		started <- struct{}{}
+		<-release
+		RecordSummary(ctx, "строк в отчете: 3")
+		return nil
+	}))
+
+	_, err := s.Trigger("report")
+	assert.ErrorIs(t, err, ErrNotStarted)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go s.Run(ctx)
+	require.Eventually(t, func() bool {
+		_, err := s.Trigger("report")
+		return err == nil
+	}, time.Second, 5*time.Millisecond)
+	<-started
+
+	status, err := s.Trigger("report")
+	assert.ErrorIs(t, err, ErrJobRunning)
+	assert.True(t, status.Running)
+
+	_, err = s.Trigger("missing")
+	assert.ErrorIs(t, err, ErrJobNotFound)
+
+	close(release)
+	require.Eventually(t, func() bool {
+		return s.Jobs()[0].LastOutcome == OutcomeSuccess
+	}, time.Second, 5*time.Millisecond)
+	assert.Equal(t, uint64(1), s.Jobs()[0].Runs)
+	assert.Equal(t, "строк в отчете: 3", s.Jobs()[0].LastSummary)
+}
+
+// fakeLeadership - управляемое из теста лидерство
+type fakeLeadership struct {
+	mu     sync.Mutex
+	term   context.Context
+	cancel context.CancelFunc
+}
+
+func (l *fakeLeadership) Term() (context.Context, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.term, l.term != nil
+}
+
+func (l *fakeLeadership) elect() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.term, l.cancel = context.WithCancel(context.Background())
+}
+
+func (l *fakeLeadership) resign() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.cancel()
+	l.term, l.cancel = nil, nil
+}
+
+// TestSchedulerRunsOnlyOnLeader проверяет, что задания выполняются только на лидере
+// и отменяются при потере лидерства
+func TestSchedulerRunsOnlyOnLeader(t *testing.T) {
+	leadership := &fakeLeadership{}
+	s := NewScheduler(leadership)
+	started := make(chan struct{}, 10)
+	require.NoError(t, s.Register("sweep", "@every 10ms", func(ctx context.Context) error {
+		started <- struct{}{}
+		<-ctx.Done()
+		return ctx.Err()
+	}))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go s.Run(ctx)
+
+	time.Sleep(50 * time.Millisecond)
+	assert.Empty(t, started, "реплика без лидерства не должна выполнять задания")
+	assert.False(t, s.IsLeader())
+	_, err := s.Trigger("sweep")
+	assert.ErrorIs(t, err, ErrNotLeader)
+
+	leadership.elect()
+	select {
+	case <-started:
+	case <-time.After(time.Second):
+		t.Fatal("лидер не запустил задание")
+	}
+	assert.True(t, s.IsLeader())
+
+	leadership.resign()
+	require.Eventually(t, func() bool {
+		return s.Jobs()[0].LastOutcome == OutcomeFailure
+	}, time.Second, 5*time.Millisecond, "задание должно быть отменено при потере лидерства")
+	assert.Equal(t, context.Canceled.Error(), s.Jobs()[0].LastError)
+}
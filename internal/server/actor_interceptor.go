@@ -0,0 +1,27 @@
+package server
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"homework1/internal/service"
+)
+
+// actorMetadataKey - ключ метаданных gRPC с инициатором запроса.
+// Через HTTP Gateway передается заголовком Grpc-Metadata-X-Actor.
+const actorMetadataKey = "x-actor"
+
+// defaultActor - инициатор изменений для запросов без метаданных x-actor
+const defaultActor = "api"
+
+// ActorInterceptor сохраняет инициатора запроса в контексте для записи в историю статусов заказа
+func ActorInterceptor(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	actor := defaultActor
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(actorMetadataKey); len(values) > 0 && values[0] != "" {
+			actor = values[0]
+		}
+	}
+	return handler(service.WithActor(ctx, actor), req)
+}
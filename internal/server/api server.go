@@ -3,6 +3,7 @@ package server
 import (
 	"homework1/internal/api/v1"
 	"homework1/internal/pool"
+	"homework1/internal/scheduler"
 	"homework1/internal/service"
 )
 
@@ -16,6 +17,8 @@ type APIServiceServer struct {
 	returnReasonService              *service.ReturnReasonService
 	statusService                    *service.StatusService
 	workerPool                       *pool.WorkerPool
+	scheduler                        *scheduler.Scheduler
+	cacheAdmin                       *service.CacheAdminService
 }
 
 // NewAPIServiceServer создает новый APIServiceServer
@@ -27,6 +30,8 @@ func NewAPIServiceServer(
 	returnReasonService *service.ReturnReasonService,
 	statusService *service.StatusService,
 	workerPool *pool.WorkerPool,
+	jobScheduler *scheduler.Scheduler,
+	cacheAdmin *service.CacheAdminService,
 ) *APIServiceServer {
 	return &APIServiceServer{
 		userService:         userService,
@@ -36,5 +41,7 @@ func NewAPIServiceServer(
 		returnReasonService: returnReasonService,
 		statusService:       statusService,
 		workerPool:          workerPool,
+		scheduler:           jobScheduler,
+		cacheAdmin:          cacheAdmin,
 	}
 }
@@ -17,7 +17,7 @@ func (s *APIServiceServer) CheckReturnReasonExists(ctx context.Context, req *v1.
 	// Проверка существования причины возврата через service
 	exists, err := s.returnReasonService.CheckReturnReasonExists(ctx, int(req.ReasonId))
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "ошибка проверки существования причины возврата: %v", err)
+		return nil, status.Errorf(errorCode(err, codes.Internal), "ошибка проверки существования причины возврата: %v", err)
 	}
 
 	// Возвращаем успешный ответ
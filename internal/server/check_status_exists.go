@@ -20,7 +20,7 @@ func (s *APIServiceServer) CheckStatusExists(ctx context.Context, req *v1.CheckS
 	statusName, err := controller.GetStatusNameByID(ctx, s.statusService, int(req.StatusId))
 	if err != nil {
 		// Возвращаем код Internal для ошибок при проверке статуса
-		return nil, status.Errorf(codes.Internal, "ошибка при проверке существования статуса: %v", err)
+		return nil, status.Errorf(errorCode(err, codes.Internal), "ошибка при проверке существования статуса: %v", err)
 	}
 
 	// Проверяем, существует ли статус
@@ -19,7 +19,7 @@ func (s *APIServiceServer) CheckUserExists(ctx context.Context, req *v1.CheckUse
 	exists, err := s.userService.CheckUserExists(ctx, int(req.UserId))
 	if err != nil {
 		// Возвращаем код ошибки Internal, если возникли проблемы при проверке пользователя
-		return nil, status.Errorf(codes.Internal, "ошибка проверки существования пользователя: %v", err)
+		return nil, status.Errorf(errorCode(err, codes.Internal), "ошибка проверки существования пользователя: %v", err)
 	}
 
 	// Если пользователь не существует, можно вернуть NotFound
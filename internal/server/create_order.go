@@ -32,7 +32,7 @@ func (s *APIServiceServer) CreateOrder(ctx context.Context, req *v1.CreateOrderR
 		withFilmStr)
 	if err != nil {
 		// Используем код ошибки Internal для ошибок во время создания заказа
-		return nil, status.Errorf(codes.Internal, "ошибка создания заказа: %v", err)
+		return nil, status.Errorf(errorCode(err, codes.Internal), "ошибка создания заказа: %v", err)
 	}
 
 	// Возвращаем успешный ответ
@@ -22,7 +22,7 @@ func (s *APIServiceServer) CreatePackaging(ctx context.Context, req *v1.CreatePa
 		fmt.Sprintf("%f", req.Cost), fmt.Sprintf("%f", req.MaxWeight))
 	if err != nil {
 		// Возвращаем ошибку Internal в случае ошибки во время создания упаковки
-		return nil, status.Errorf(codes.Internal, "ошибка создания упаковки: %v", err)
+		return nil, status.Errorf(errorCode(err, codes.Internal), "ошибка создания упаковки: %v", err)
 	}
 
 	// Возвращаем успешный ответ с ID созданной упаковки
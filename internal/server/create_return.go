@@ -20,8 +20,8 @@ func (s *APIServiceServer) CreateReturn(ctx context.Context, req *v1.CreateRetur
 	// Создание возврата через контроллер
 	err := controller.CreateReturn(ctx, s.returnService, fmt.Sprint(req.OrderId))
 	if err != nil {
-		// Возвращаем код ошибки Internal для внутренних ошибок
-		return nil, status.Errorf(codes.Internal, "ошибка создания возврата: %v", err)
+		// Недопустимая смена статуса заказа возвращается как FailedPrecondition, остальные ошибки - как Internal
+		return nil, status.Errorf(errorCode(err, codes.Internal), "ошибка создания возврата: %v", err)
 	}
 
 	// Возвращаем успешный ответ
@@ -20,7 +20,7 @@ func (s *APIServiceServer) CreateReturnReason(ctx context.Context, req *v1.Creat
 	reasonID, err := controller.CreateReturnReason(ctx, s.returnReasonService, req.Reason)
 	if err != nil {
 		// Возвращаем код ошибки Internal, если возникли внутренние проблемы при создании причины
-		return nil, status.Errorf(codes.Internal, "ошибка создания причины возврата: %v", err)
+		return nil, status.Errorf(errorCode(err, codes.Internal), "ошибка создания причины возврата: %v", err)
 	}
 
 	// Возвращаем успешный ответ
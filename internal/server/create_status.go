@@ -20,7 +20,7 @@ func (s *APIServiceServer) CreateStatus(ctx context.Context, req *v1.CreateStatu
 	statusID, err := controller.CreateStatus(ctx, s.statusService, req.StatusName)
 	if err != nil {
 		// Возвращаем код ошибки Internal, если возникли внутренние проблемы при создании статуса
-		return nil, status.Errorf(codes.Internal, "ошибка создания статуса: %v", err)
+		return nil, status.Errorf(errorCode(err, codes.Internal), "ошибка создания статуса: %v", err)
 	}
 
 	// Возвращаем успешный ответ
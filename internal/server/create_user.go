@@ -20,7 +20,7 @@ func (s *APIServiceServer) CreateUser(ctx context.Context, req *v1.CreateUserReq
 	userID, err := controller.CreateUser(ctx, s.userService, req.Username)
 	if err != nil {
 		// Возвращаем ошибку с кодом Internal, если произошла внутренняя ошибка при создании пользователя
-		return nil, status.Errorf(codes.Internal, "ошибка создания пользователя: %v", err)
+		return nil, status.Errorf(errorCode(err, codes.Internal), "ошибка создания пользователя: %v", err)
 	}
 
 	// Возвращаем успешный ответ
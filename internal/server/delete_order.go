@@ -20,8 +20,8 @@ func (s *APIServiceServer) DeleteOrder(ctx context.Context, req *v1.DeleteOrderR
 
 	// Удаление заказа через контроллер
 	if err := controller.DeleteOrder(ctx, s.orderService, fmt.Sprintf("%d", req.OrderId)); err != nil {
-		// Возвращаем код ошибки Internal, если возникли проблемы при удалении заказа
-		return nil, status.Errorf(codes.Internal, "ошибка удаления заказа: %v", err)
+		// Перегрузка пула возвращается как ResourceExhausted, остальные ошибки - как Internal
+		return nil, status.Errorf(errorCode(err, codes.Internal), "ошибка удаления заказа: %v", err)
 	}
 
 	// Возвращаем успешный ответ
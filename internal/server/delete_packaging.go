@@ -19,7 +19,7 @@ func (s *APIServiceServer) DeletePackaging(ctx context.Context, req *v1.DeletePa
 
 	// Удаление упаковки через контроллер
 	if err := controller.DeletePackaging(ctx, s.packagingService, fmt.Sprintf("%d", req.PackagingId)); err != nil {
-		return nil, status.Errorf(codes.Internal, "ошибка удаления упаковки: %v", err)
+		return nil, status.Errorf(errorCode(err, codes.Internal), "ошибка удаления упаковки: %v", err)
 	}
 
 	// Возвращаем пустой ответ
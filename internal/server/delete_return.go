@@ -19,7 +19,7 @@ func (s *APIServiceServer) DeleteReturn(ctx context.Context, req *v1.DeleteRetur
 
 	// Удаление возврата через контроллер
 	if err := controller.DeleteReturn(ctx, s.returnService, fmt.Sprintf("%d", req.ReturnId)); err != nil {
-		return nil, status.Errorf(codes.Internal, "ошибка удаления возврата: %v", err)
+		return nil, status.Errorf(errorCode(err, codes.Internal), "ошибка удаления возврата: %v", err)
 	}
 
 	// Возвращаем пустой ответ
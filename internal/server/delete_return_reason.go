@@ -19,7 +19,7 @@ func (s *APIServiceServer) DeleteReturnReason(ctx context.Context, req *v1.Delet
 
 	// Удаление причины возврата через контроллер
 	if err := controller.DeleteReturnReason(ctx, s.returnReasonService, fmt.Sprintf("%d", req.ReasonId)); err != nil {
-		return nil, status.Errorf(codes.Internal, "ошибка удаления причины возврата: %v", err)
+		return nil, status.Errorf(errorCode(err, codes.Internal), "ошибка удаления причины возврата: %v", err)
 	}
 
 	// Возвращаем пустой ответ
@@ -19,7 +19,7 @@ func (s *APIServiceServer) DeleteStatus(ctx context.Context, req *v1.DeleteStatu
 
 	// Удаление статуса через контроллер
 	if err := controller.DeleteStatus(ctx, s.statusService, fmt.Sprintf("%d", req.StatusId)); err != nil {
-		return nil, status.Errorf(codes.Internal, "ошибка удаления статуса: %v", err)
+		return nil, status.Errorf(errorCode(err, codes.Internal), "ошибка удаления статуса: %v", err)
 	}
 
 	// Возвращаем пустой ответ
@@ -21,7 +21,7 @@ func (s *APIServiceServer) DeleteUser(ctx context.Context, req *v1.DeleteUserReq
 	err := controller.DeleteUser(ctx, s.userService, int(req.UserId))
 	if err != nil {
 		// Возвращаем код ошибки Internal, если возникли проблемы при удалении пользователя
-		return nil, status.Errorf(codes.Internal, "ошибка удаления пользователя: %v", err)
+		return nil, status.Errorf(errorCode(err, codes.Internal), "ошибка удаления пользователя: %v", err)
 	}
 
 	// Возвращаем успешный ответ
@@ -0,0 +1,41 @@
+package server
+
+import (
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"homework1/internal/cache"
+	"homework1/internal/dao"
+	"homework1/internal/model"
+	"homework1/internal/pool"
+	"homework1/internal/scheduler"
+	"homework1/internal/service"
+)
+
+// errorCode возвращает код gRPC для ошибки сервисного слоя.
+// Нарушения жизненного цикла заказа, смена владельца заказа и недопустимый ручной запуск задания
+// возвращаются как FailedPrecondition, перегрузка пула воркеров - как ResourceExhausted, конфликт транзакций после всех повторов - как Aborted,
+// неизвестный кэш и отсутствующий ключ кэша - как NotFound, недействительный курсор страницы - как InvalidArgument,
+// остальные ошибки - с кодом fallback.
+func errorCode(err error, fallback codes.Code) codes.Code {
+	switch {
+	case errors.Is(err, model.ErrInvalidStatusTransition), errors.Is(err, service.ErrOrderOwnerChange):
+		return codes.FailedPrecondition
+	case errors.Is(err, model.ErrUnknownOrderStatus), errors.Is(err, service.ErrInvalidPageToken):
+		return codes.InvalidArgument
+	case dao.IsTxConflict(err):
+		return codes.Aborted
+	case errors.Is(err, pool.ErrQueueFull):
+		return codes.ResourceExhausted
+	case errors.Is(err, scheduler.ErrJobNotFound):
+		return codes.NotFound
+	case errors.Is(err, scheduler.ErrJobRunning), errors.Is(err, scheduler.ErrNotLeader):
+		return codes.FailedPrecondition
+	case errors.Is(err, scheduler.ErrNotStarted):
+		return codes.Unavailable
+	case errors.Is(err, service.ErrUnknownCache), errors.Is(err, cache.ErrNotFound):
+		return codes.NotFound
+	default:
+		return fallback
+	}
+}
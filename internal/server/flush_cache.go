@@ -0,0 +1,25 @@
+package server
+
+import (
+	"context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	v1 "homework1/internal/api/v1"
+)
+
+// FlushCache удаляет все записи кэша
+func (s *APIServiceServer) FlushCache(ctx context.Context, req *v1.FlushCacheRequest) (*v1.FlushCacheResponse, error) {
+	// Валидация запроса
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "ошибка валидации: %v", err)
+	}
+
+	keys, err := s.cacheAdmin.Flush(ctx, req.Cache)
+	if err != nil {
+		return nil, status.Errorf(errorCode(err, codes.Internal), "ошибка сброса кэша: %v", err)
+	}
+
+	return &v1.FlushCacheResponse{
+		DeletedKeys: int32(len(keys)),
+	}, nil
+}
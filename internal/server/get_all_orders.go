@@ -15,7 +15,7 @@ func (s *APIServiceServer) GetAllOrders(ctx context.Context, req *emptypb.Empty)
 	orders, err := controller.GetOrders(ctx, s.orderService)
 	if err != nil {
 		// Возвращаем код ошибки Internal, если возникли проблемы при получении заказов
-		return nil, status.Errorf(codes.Internal, "ошибка получения всех заказов: %v", err)
+		return nil, status.Errorf(errorCode(err, codes.Internal), "ошибка получения всех заказов: %v", err)
 	}
 
 	// Формирование ответа для gRPC
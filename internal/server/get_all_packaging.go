@@ -15,7 +15,7 @@ func (s *APIServiceServer) GetAllPackaging(ctx context.Context, req *emptypb.Emp
 	packagingOptions, err := controller.GetAllPackaging(ctx, s.packagingService)
 	if err != nil {
 		// Возвращаем код ошибки Internal, если возникли проблемы при получении данных
-		return nil, status.Errorf(codes.Internal, "ошибка получения всех упаковок: %v", err)
+		return nil, status.Errorf(errorCode(err, codes.Internal), "ошибка получения всех упаковок: %v", err)
 	}
 
 	// Формирование списка упаковок для ответа
@@ -15,7 +15,7 @@ func (s *APIServiceServer) GetAllReturnReasons(ctx context.Context, req *emptypb
 	reasons, err := controller.GetAllReturnReasons(ctx, s.returnReasonService)
 	if err != nil {
 		// Возвращаем код ошибки Internal, если возникли проблемы при получении данных
-		return nil, status.Errorf(codes.Internal, "ошибка получения всех причин возврата: %v", err)
+		return nil, status.Errorf(errorCode(err, codes.Internal), "ошибка получения всех причин возврата: %v", err)
 	}
 
 	// Формирование ответа для gRPC
@@ -15,7 +15,7 @@ func (s *APIServiceServer) GetAllStatuses(ctx context.Context, req *emptypb.Empt
 	statuses, err := controller.GetAllStatuses(ctx, s.statusService)
 	if err != nil {
 		// Возвращаем код ошибки Internal, если возникла внутренняя ошибка при получении данных
-		return nil, status.Errorf(codes.Internal, "ошибка получения всех статусов: %v", err)
+		return nil, status.Errorf(errorCode(err, codes.Internal), "ошибка получения всех статусов: %v", err)
 	}
 
 	// Формирование ответа для gRPC
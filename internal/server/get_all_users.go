@@ -16,7 +16,7 @@ func (s *APIServiceServer) GetAllUsers(ctx context.Context, req *emptypb.Empty)
 	users, err := controller.GetAllUsers(ctx, s.userService)
 	if err != nil {
 		// Возвращаем код ошибки Internal, если возникли проблемы при получении пользователей
-		return nil, status.Errorf(codes.Internal, "ошибка получения всех пользователей: %v", err)
+		return nil, status.Errorf(errorCode(err, codes.Internal), "ошибка получения всех пользователей: %v", err)
 	}
 
 	// Формирование ответа для gRPC
@@ -0,0 +1,34 @@
+package server
+
+import (
+	"context"
+	"google.golang.org/protobuf/types/known/emptypb"
+	v1 "homework1/internal/api/v1"
+	"homework1/internal/cache"
+)
+
+// GetCacheStats возвращает счетчики именованных кэшей с момента запуска
+func (s *APIServiceServer) GetCacheStats(ctx context.Context, _ *emptypb.Empty) (*v1.GetCacheStatsResponse, error) {
+	var caches []*v1.CacheStats
+	for _, stats := range cache.GetStats() {
+		var avgLoadTimeMs float64
+		if stats.Loads > 0 {
+			avgLoadTimeMs = stats.LoadSeconds * 1000 / float64(stats.Loads)
+		}
+
+		caches = append(caches, &v1.CacheStats{
+			Name:          stats.Name,
+			Hits:          stats.Hits,
+			Misses:        stats.Misses,
+			Errors:        stats.Errors,
+			Loads:         stats.Loads,
+			LoadErrors:    stats.LoadErrors,
+			AvgLoadTimeMs: avgLoadTimeMs,
+			Evictions:     stats.Evictions,
+		})
+	}
+
+	return &v1.GetCacheStatsResponse{
+		Caches: caches,
+	}, nil
+}
@@ -21,7 +21,7 @@ func (s *APIServiceServer) GetOrder(ctx context.Context, req *v1.GetOrderRequest
 	order, err := controller.GetOrderByID(ctx, s.orderService, fmt.Sprintf("%d", req.OrderId))
 	if err != nil {
 		// Возвращаем код ошибки Internal, если возникла внутренняя ошибка при получении заказа
-		return nil, status.Errorf(codes.Internal, "ошибка при получении заказа: %v", err)
+		return nil, status.Errorf(errorCode(err, codes.Internal), "ошибка при получении заказа: %v", err)
 	}
 
 	// Возвращаем успешный ответ с данными заказа
@@ -0,0 +1,48 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	v1 "homework1/internal/api/v1"
+	"homework1/internal/controller"
+	"time"
+)
+
+// GetOrderHistory возвращает историю смены статусов заказа
+func (s *APIServiceServer) GetOrderHistory(ctx context.Context, req *v1.GetOrderHistoryRequest) (*v1.GetOrderHistoryResponse, error) {
+	// Валидация запроса
+	if err := req.Validate(); err != nil {
+		// Возвращаем код ошибки InvalidArgument, если данные запроса некорректны
+		return nil, status.Errorf(codes.InvalidArgument, "ошибка валидации: %v", err)
+	}
+
+	// Получение истории через контроллер
+	history, err := controller.GetOrderHistory(ctx, s.orderService, fmt.Sprintf("%d", req.OrderId))
+	if err != nil {
+		// Возвращаем код ошибки Internal, если возникла проблема при получении истории
+		return nil, status.Errorf(errorCode(err, codes.Internal), "ошибка получения истории заказа: %v", err)
+	}
+
+	// Формирование ответа для gRPC
+	var grpcChanges []*v1.OrderStatusChange
+	for _, change := range history {
+		grpcChanges = append(grpcChanges, &v1.OrderStatusChange{
+			ChangeId:      int32(change.ChangeID),
+			OrderId:       int32(change.OrderID),
+			OldStatusId:   int32(change.OldStatusID),
+			OldStatusName: change.OldStatusName,
+			NewStatusId:   int32(change.NewStatusID),
+			NewStatusName: change.NewStatusName,
+			Actor:         change.Actor,
+			Reason:        change.Reason,
+			ChangedAt:     change.ChangedAt.Format(time.RFC3339),
+		})
+	}
+
+	// Возвращаем успешный ответ
+	return &v1.GetOrderHistoryResponse{
+		Changes: grpcChanges,
+	}, nil
+}
@@ -20,7 +20,7 @@ func (s *APIServiceServer) GetOrdersByUserID(ctx context.Context, req *v1.GetOrd
 	orders, err := controller.GetOrdersByUserID(ctx, s.orderService, int(req.UserId))
 	if err != nil {
 		// Возвращаем код ошибки Internal, если возникла проблема при получении заказов
-		return nil, status.Errorf(codes.Internal, "ошибка получения заказов для пользователя с ID %d: %v", req.UserId, err)
+		return nil, status.Errorf(errorCode(err, codes.Internal), "ошибка получения заказов для пользователя с ID %d: %v", req.UserId, err)
 	}
 
 	// Формирование списка заказов для ответа
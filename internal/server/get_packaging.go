@@ -20,14 +20,14 @@ func (s *APIServiceServer) GetPackaging(ctx context.Context, req *v1.GetPackagin
 	packagingType, err := controller.GetPackagingTypeByID(ctx, s.packagingService, int(req.PackagingId))
 	if err != nil {
 		// Возвращаем код ошибки Internal, если возникла ошибка при получении типа упаковки
-		return nil, status.Errorf(codes.Internal, "ошибка получения типа упаковки: %v", err)
+		return nil, status.Errorf(errorCode(err, codes.Internal), "ошибка получения типа упаковки: %v", err)
 	}
 
 	// Получение информации об упаковке через сервис
 	packaging, err := s.packagingService.GetPackagingByID(ctx, int(req.PackagingId))
 	if err != nil {
 		// Возвращаем код ошибки Internal, если возникла ошибка при получении упаковки
-		return nil, status.Errorf(codes.Internal, "ошибка получения упаковки: %v", err)
+		return nil, status.Errorf(errorCode(err, codes.Internal), "ошибка получения упаковки: %v", err)
 	}
 
 	// Возвращаем успешный ответ
@@ -21,7 +21,7 @@ func (s *APIServiceServer) GetReturnByOrderID(ctx context.Context, req *v1.GetRe
 	ret, err := controller.GetReturnByOrderID(ctx, s.returnService, fmt.Sprint(req.OrderId))
 	if err != nil {
 		// Возвращаем код ошибки Internal, если возникла проблема при получении возврата
-		return nil, status.Errorf(codes.Internal, "ошибка получения возврата: %v", err)
+		return nil, status.Errorf(errorCode(err, codes.Internal), "ошибка получения возврата: %v", err)
 	}
 
 	// Возвращаем успешный ответ с данными возврата
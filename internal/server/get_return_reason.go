@@ -20,7 +20,7 @@ func (s *APIServiceServer) GetReturnReason(ctx context.Context, req *v1.GetRetur
 	reason, err := controller.GetReturnReasonByID(ctx, s.returnReasonService, int(req.ReasonId))
 	if err != nil {
 		// Возвращаем код ошибки Internal, если возникла проблема при получении причины возврата
-		return nil, status.Errorf(codes.Internal, "ошибка получения причины возврата: %v", err)
+		return nil, status.Errorf(errorCode(err, codes.Internal), "ошибка получения причины возврата: %v", err)
 	}
 
 	// Возвращаем успешный ответ с данными причины возврата
@@ -15,7 +15,7 @@ func (s *APIServiceServer) GetReturns(ctx context.Context, req *emptypb.Empty) (
 	returns, err := controller.GetReturns(ctx, s.returnService)
 	if err != nil {
 		// Возвращаем код ошибки Internal, если возникла проблема при получении возвратов
-		return nil, status.Errorf(codes.Internal, "ошибка получения всех возвратов: %v", err)
+		return nil, status.Errorf(errorCode(err, codes.Internal), "ошибка получения всех возвратов: %v", err)
 	}
 
 	// Формирование списка возвратов для ответа
@@ -21,7 +21,7 @@ func (s *APIServiceServer) GetReturnsByUserID(ctx context.Context, req *v1.GetRe
 	returns, err := controller.GetReturnsByUserID(ctx, s.returnService, fmt.Sprint(req.UserId))
 	if err != nil {
 		// Возвращаем код ошибки Internal, если возникла проблема при получении возвратов
-		return nil, status.Errorf(codes.Internal, "ошибка получения возвратов для пользователя: %v", err)
+		return nil, status.Errorf(errorCode(err, codes.Internal), "ошибка получения возвратов для пользователя: %v", err)
 	}
 
 	// Формирование списка возвратов для ответа
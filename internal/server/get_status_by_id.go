@@ -20,7 +20,7 @@ func (s *APIServiceServer) GetStatusByID(ctx context.Context, req *v1.GetStatusB
 	stat, err := controller.GetStatusByID(ctx, s.statusService, int(req.StatusId))
 	if err != nil {
 		// Использование status.Errorf из правильного пакета для формирования gRPC ошибки
-		return nil, status.Errorf(codes.Internal, "ошибка получения статуса: %v", err)
+		return nil, status.Errorf(errorCode(err, codes.Internal), "ошибка получения статуса: %v", err)
 	}
 
 	// Возвращаем успешный ответ с данными статуса
@@ -0,0 +1,24 @@
+package server
+
+import (
+	"context"
+	"google.golang.org/protobuf/types/known/emptypb"
+	v1 "homework1/internal/api/v1"
+)
+
+// GetWorkerPoolStats возвращает снимок состояния пула воркеров
+func (s *APIServiceServer) GetWorkerPoolStats(ctx context.Context, _ *emptypb.Empty) (*v1.GetWorkerPoolStatsResponse, error) {
+	stats := s.workerPool.Stats()
+
+	return &v1.GetWorkerPoolStatsResponse{
+		Workers:        int32(stats.Workers),
+		ActiveWorkers:  int32(stats.ActiveWorkers),
+		QueueDepth:     int32(stats.QueueDepth),
+		QueueCapacity:  int32(stats.QueueCapacity),
+		CompletedTasks: stats.CompletedTasks,
+		FailedTasks:    stats.FailedTasks,
+		PanickedTasks:  stats.PanickedTasks,
+		RejectedTasks:  stats.RejectedTasks,
+		AvgWaitTimeMs:  stats.AvgWaitTime.Milliseconds(),
+	}, nil
+}
@@ -0,0 +1,35 @@
+package server
+
+import (
+	"context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	v1 "homework1/internal/api/v1"
+	"time"
+)
+
+// InspectCacheKey возвращает запись кэша по ключу
+func (s *APIServiceServer) InspectCacheKey(ctx context.Context, req *v1.InspectCacheKeyRequest) (*v1.InspectCacheKeyResponse, error) {
+	// Валидация запроса
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "ошибка валидации: %v", err)
+	}
+
+	entry, err := s.cacheAdmin.Inspect(ctx, req.Cache, req.Key)
+	if err != nil {
+		return nil, status.Errorf(errorCode(err, codes.Internal), "ошибка чтения кэша: %v", err)
+	}
+
+	var expiresAt string
+	if !entry.ExpiresAt.IsZero() {
+		expiresAt = entry.ExpiresAt.Format(time.RFC3339)
+	}
+
+	return &v1.InspectCacheKeyResponse{
+		Key:            entry.Key,
+		Value:          string(entry.Value),
+		NotFound:       entry.NotFound,
+		ExpiresAt:      expiresAt,
+		LoadDurationMs: entry.LoadDuration.Milliseconds(),
+	}, nil
+}
@@ -0,0 +1,28 @@
+package server
+
+import (
+	"context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	v1 "homework1/internal/api/v1"
+)
+
+// InvalidateCache удаляет записи кэша по ключам и тегам
+func (s *APIServiceServer) InvalidateCache(ctx context.Context, req *v1.InvalidateCacheRequest) (*v1.InvalidateCacheResponse, error) {
+	// Валидация запроса
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "ошибка валидации: %v", err)
+	}
+	if len(req.Keys) == 0 && len(req.Tags) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "ошибка валидации: нужно указать ключи или теги")
+	}
+
+	keys, err := s.cacheAdmin.Invalidate(ctx, req.Cache, req.Keys, req.Tags)
+	if err != nil {
+		return nil, status.Errorf(errorCode(err, codes.Internal), "ошибка сброса кэша: %v", err)
+	}
+
+	return &v1.InvalidateCacheResponse{
+		Keys: keys,
+	}, nil
+}
@@ -17,9 +17,9 @@ func (s *APIServiceServer) IssueOrder(ctx context.Context, req *v1.IssueOrderReq
 		return nil, status.Errorf(codes.InvalidArgument, "ошибка валидации: %v", err)
 	}
 
-	// Выдача заказа через контроллер, теперь с передачей statusService
-	if err := controller.IssueOrder(ctx, s.orderService, s.statusService, fmt.Sprintf("%d", req.OrderId)); err != nil {
-		return nil, status.Errorf(codes.Internal, "ошибка выдачи заказа: %v", err)
+	// Выдача заказа через контроллер
+	if err := controller.IssueOrder(ctx, s.orderService, fmt.Sprintf("%d", req.OrderId)); err != nil {
+		return nil, status.Errorf(errorCode(err, codes.Internal), "ошибка выдачи заказа: %v", err)
 	}
 
 	// Возвращаем пустой ответ
@@ -0,0 +1,47 @@
+package server
+
+import (
+	"context"
+	"google.golang.org/protobuf/types/known/emptypb"
+	v1 "homework1/internal/api/v1"
+	"homework1/internal/scheduler"
+	"time"
+)
+
+// ListJobs возвращает состояние заданий планировщика и признак того, что реплика выполняет задания как лидер
+func (s *APIServiceServer) ListJobs(ctx context.Context, _ *emptypb.Empty) (*v1.ListJobsResponse, error) {
+	var jobs []*v1.Job
+	for _, job := range s.scheduler.Jobs() {
+		jobs = append(jobs, jobToProto(job))
+	}
+
+	return &v1.ListJobsResponse{
+		Jobs:   jobs,
+		Leader: s.scheduler.IsLeader(),
+	}, nil
+}
+
+// jobToProto преобразует состояние задания в сообщение gRPC
+func jobToProto(job scheduler.JobStatus) *v1.Job {
+	return &v1.Job{
+		Name:           job.Name,
+		Schedule:       job.Spec,
+		Running:        job.Running,
+		LastRunAt:      formatJobTime(job.LastRunAt),
+		LastDurationMs: job.LastDuration.Milliseconds(),
+		LastOutcome:    string(job.LastOutcome),
+		LastError:      job.LastError,
+		LastSummary:    job.LastSummary,
+		NextRunAt:      formatJobTime(job.NextRunAt),
+		Runs:           job.Runs,
+		Failures:       job.Failures,
+	}
+}
+
+// formatJobTime форматирует время в RFC3339, для нулевого времени возвращает пустую строку
+func formatJobTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
@@ -0,0 +1,92 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	v1 "homework1/internal/api/v1"
+	"homework1/internal/controller"
+	"homework1/internal/model"
+)
+
+// ListOrders возвращает страницу заказов, подходящих под фильтр, и курсор следующей страницы
+func (s *APIServiceServer) ListOrders(ctx context.Context, req *v1.ListOrdersRequest) (*v1.ListOrdersResponse, error) {
+	// Валидация запроса
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "ошибка валидации: %v", err)
+	}
+
+	filter := model.OrderFilter{
+		UserID:      int(req.UserId),
+		StatusID:    int(req.StatusId),
+		PackagingID: int(req.PackagingId),
+	}
+	var err error
+	if filter.AcceptedFrom, filter.AcceptedBefore, err = parseDateRange(req.AcceptedFrom, req.AcceptedTo); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "ошибка фильтра по дате приема: %v", err)
+	}
+	if filter.ExpiresFrom, filter.ExpiresBefore, err = parseDateRange(req.ExpiresFrom, req.ExpiresTo); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "ошибка фильтра по сроку хранения: %v", err)
+	}
+	switch req.Issued {
+	case v1.IssueFilter_ISSUE_FILTER_ISSUED:
+		issued := true
+		filter.Issued = &issued
+	case v1.IssueFilter_ISSUE_FILTER_NOT_ISSUED:
+		issued := false
+		filter.Issued = &issued
+	}
+
+	page, err := controller.ListOrders(ctx, s.orderService, filter, int(req.PageSize), req.PageToken)
+	if err != nil {
+		return nil, status.Errorf(errorCode(err, codes.Internal), "ошибка получения страницы заказов: %v", err)
+	}
+
+	// Формирование списка заказов для ответа
+	grpcOrders := make([]*v1.GetOrderResponse, 0, len(page.Orders))
+	for _, order := range page.Orders {
+		grpcOrders = append(grpcOrders, &v1.GetOrderResponse{
+			OrderId:        int32(order.OrderID),
+			UserId:         int32(order.UserID),
+			PackagingId:    int32(order.PackagingID),
+			StatusId:       int32(order.StatusID),
+			AcceptanceDate: order.AcceptanceDate.Format("2006-01-02"),
+			ExpirationDate: order.ExpirationDate.Format("2006-01-02"),
+			Weight:         order.Weight,
+			BaseCost:       order.BaseCost,
+			PackagingCost:  order.PackagingCost,
+			TotalCost:      order.TotalCost,
+			WithFilm:       order.WithFilm,
+			IssueDate:      order.IssueDate.Format("2006-01-02"),
+		})
+	}
+
+	return &v1.ListOrdersResponse{
+		Orders:        grpcOrders,
+		NextPageToken: page.NextPageToken,
+	}, nil
+}
+
+// parseDateRange парсит границы диапазона дат в формате YYYY-MM-DD, обе включительно,
+// и возвращает полуоткрытый диапазон [from, before). Пустая граница не ограничивает диапазон.
+func parseDateRange(fromStr, toStr string) (from, before time.Time, err error) {
+	if fromStr != "" {
+		if from, err = time.Parse("2006-01-02", fromStr); err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("неверный формат даты %q. Используйте YYYY-MM-DD", fromStr)
+		}
+	}
+	if toStr != "" {
+		to, err := time.Parse("2006-01-02", toStr)
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("неверный формат даты %q. Используйте YYYY-MM-DD", toStr)
+		}
+		before = to.AddDate(0, 0, 1)
+	}
+	if !from.IsZero() && !before.IsZero() && !from.Before(before) {
+		return time.Time{}, time.Time{}, fmt.Errorf("начало диапазона %s позже его конца %s", fromStr, toStr)
+	}
+	return from, before, nil
+}
@@ -0,0 +1,57 @@
+package server
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	v1 "homework1/internal/api/v1"
+	"homework1/internal/controller"
+	"homework1/internal/model"
+)
+
+// ListReturns возвращает страницу возвратов, подходящих под фильтр, и курсор следующей страницы
+func (s *APIServiceServer) ListReturns(ctx context.Context, req *v1.ListReturnsRequest) (*v1.ListReturnsResponse, error) {
+	// Валидация запроса
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "ошибка валидации запроса: %v", err)
+	}
+
+	filter := model.ReturnFilter{
+		UserID:      int(req.UserId),
+		StatusID:    int(req.StatusId),
+		PackagingID: int(req.PackagingId),
+		ReasonID:    int(req.ReasonId),
+	}
+	var err error
+	if filter.ReturnedFrom, filter.ReturnedBefore, err = parseDateRange(req.ReturnedFrom, req.ReturnedTo); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "ошибка фильтра по дате возврата: %v", err)
+	}
+
+	page, err := controller.ListReturns(ctx, s.returnService, filter, int(req.PageSize), req.PageToken)
+	if err != nil {
+		return nil, status.Errorf(errorCode(err, codes.Internal), "ошибка получения страницы возвратов: %v", err)
+	}
+
+	// Формирование списка возвратов для ответа
+	returnResponses := make([]*v1.ReturnResponse, 0, len(page.Returns))
+	for _, ret := range page.Returns {
+		returnResponses = append(returnResponses, &v1.ReturnResponse{
+			ReturnId:      int32(ret.ReturnID),
+			OrderId:       int32(ret.OrderID),
+			UserId:        int32(ret.UserID),
+			ReasonId:      int32(ret.ReasonID),
+			BaseCost:      float32(ret.BaseCost),
+			PackagingCost: float32(ret.PackagingCost),
+			TotalCost:     float32(ret.TotalCost),
+			PackagingId:   int32(ret.PackagingID),
+			StatusId:      int32(ret.StatusID),
+			ReturnDate:    ret.ReturnDate.Format("2006-01-02"),
+		})
+	}
+
+	return &v1.ListReturnsResponse{
+		Returns:       returnResponses,
+		NextPageToken: page.NextPageToken,
+	}, nil
+}
@@ -0,0 +1,42 @@
+package server
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// retryAfterMetadataKey - ключ метаданных ответа с рекомендуемой задержкой повтора в секундах.
+// Через HTTP Gateway передается заголовком Grpc-Metadata-Retry-After.
+const retryAfterMetadataKey = "retry-after"
+
+// OverloadInterceptor добавляет к ошибкам ResourceExhausted подсказку о повторе запроса:
+// деталь RetryInfo и метаданные retry-after с задержкой retryAfter
+func OverloadInterceptor(retryAfter time.Duration) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		resp, err := handler(ctx, req)
+		st, ok := status.FromError(err)
+		if !ok || st.Code() != codes.ResourceExhausted || len(st.Details()) > 0 {
+			return resp, err
+		}
+
+		seconds := int(retryAfter.Round(time.Second) / time.Second)
+		if seconds < 1 {
+			seconds = 1
+		}
+		_ = grpc.SetHeader(ctx, metadata.Pairs(retryAfterMetadataKey, strconv.Itoa(seconds)))
+
+		withRetry, detailsErr := st.WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(retryAfter)})
+		if detailsErr != nil {
+			return resp, err
+		}
+		return resp, withRetry.Err()
+	}
+}
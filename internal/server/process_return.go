@@ -17,8 +17,8 @@ func (s *APIServiceServer) ProcessReturn(ctx context.Context, req *v1.ProcessRet
 	}
 
 	// Логика обработки возврата
-	if err := controller.ProcessReturn(ctx, s.returnService, s.statusService, int(req.OrderId)); err != nil {
-		return nil, status.Errorf(codes.Internal, "ошибка обработки возврата: %v", err)
+	if err := controller.ProcessReturn(ctx, s.returnService, int(req.OrderId)); err != nil {
+		return nil, status.Errorf(errorCode(err, codes.Internal), "ошибка обработки возврата: %v", err)
 	}
 
 	// Возвращаем пустой ответ
@@ -17,7 +17,7 @@ func (s *APIServiceServer) SeedOrders(ctx context.Context, req *v1.SeedOrdersReq
 
 	// Логика создания фейковых заказов
 	if err := s.orderService.SeedOrders(ctx, int(req.Count)); err != nil {
-		return nil, status.Errorf(codes.Internal, "ошибка создания фейковых заказов: %v", err)
+		return nil, status.Errorf(errorCode(err, codes.Internal), "ошибка создания фейковых заказов: %v", err)
 	}
 
 	// Возвращаем пустой ответ
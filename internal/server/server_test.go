@@ -0,0 +1,140 @@
+package server_test
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	v1 "homework1/internal/api/v1"
+	"homework1/internal/cache"
+	"homework1/internal/model"
+	"homework1/internal/pool"
+	"homework1/internal/publisher"
+	"homework1/internal/repository"
+	"homework1/internal/server"
+	"homework1/internal/service"
+)
+
+// newTestCache создает кэш сервиса в памяти процесса
+func newTestCache[V any]() *cache.LoadingCache[string, V] {
+	return cache.NewLoadingCache[string, V](cache.NewLRUCache[string, V](cache.LRUConfig{DefaultTTL: time.Minute}),
+		cache.LoadOptions{TTL: time.Minute, NotFound: repository.ErrNotFound})
+}
+
+// newTestClient запускает gRPC сервер поверх хранилища и публикатора событий в памяти и возвращает клиента к нему
+func newTestClient(t *testing.T) v1.APIServiceClient {
+	return newTestClientWithPool(t, pool.NewWorkerPool(2))
+}
+
+// newTestClientWithPool запускает тестовый gRPC сервер с указанным пулом воркеров
+func newTestClientWithPool(t *testing.T, wp *pool.WorkerPool) v1.APIServiceClient {
+	store := repository.NewMemoryStore().Store()
+	producer := publisher.NewMemoryPublisher("test-topic", nil)
+	t.Cleanup(wp.Close)
+
+	statusService := service.NewStatusService(store, wp, newTestCache[model.Status]())
+	packagingService := service.NewPackagingService(store, wp, newTestCache[model.PackagingOption]())
+	reasonService := service.NewReturnReasonService(store, wp, newTestCache[model.ReturnReason]())
+	api := server.NewAPIServiceServer(
+		service.NewUserService(store, wp, newTestCache[model.User]()),
+		service.NewOrderService(store, wp, producer, newTestCache[model.Order]()),
+		packagingService,
+		service.NewReturnService(store, wp, producer, newTestCache[model.Return]()),
+		reasonService,
+		statusService,
+		wp,
+		nil,
+		service.NewCacheAdminService(nil, statusService, packagingService, reasonService),
+	)
+
+	lis := bufconn.Listen(1 << 20)
+	grpcServer := grpc.NewServer(grpc.ChainUnaryInterceptor(server.ActorInterceptor))
+	v1.RegisterAPIServiceServer(grpcServer, api)
+	go func() { _ = grpcServer.Serve(lis) }()
+	t.Cleanup(grpcServer.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return v1.NewAPIServiceClient(conn)
+}
+
+// TestOrderLifecycleOverGRPC проверяет создание, выдачу и постраничный список заказов через gRPC без Postgres и Kafka
+func TestOrderLifecycleOverGRPC(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t)
+
+	expiration := time.Now().AddDate(0, 0, 7).Format("2006-01-02")
+	var orderIDs []int32
+	for i := 0; i < 3; i++ {
+		created, err := client.CreateOrder(ctx, &v1.CreateOrderRequest{
+			UserId: 1, PackagingId: 1, ExpirationDate: expiration, Weight: 1, BaseCost: 100,
+		})
+		require.NoError(t, err)
+		orderIDs = append(orderIDs, created.OrderId)
+	}
+
+	_, err := client.IssueOrder(ctx, &v1.IssueOrderRequest{OrderId: orderIDs[0]})
+	require.NoError(t, err)
+
+	page, err := client.ListOrders(ctx, &v1.ListOrdersRequest{UserId: 1, PageSize: 1, Issued: v1.IssueFilter_ISSUE_FILTER_NOT_ISSUED})
+	require.NoError(t, err)
+	require.Len(t, page.Orders, 1)
+	assert.Equal(t, orderIDs[1], page.Orders[0].OrderId)
+
+	page, err = client.ListOrders(ctx, &v1.ListOrdersRequest{UserId: 1, PageSize: 1, Issued: v1.IssueFilter_ISSUE_FILTER_NOT_ISSUED, PageToken: page.NextPageToken})
+	require.NoError(t, err)
+	require.Len(t, page.Orders, 1)
+	assert.Equal(t, orderIDs[2], page.Orders[0].OrderId)
+	assert.Empty(t, page.NextPageToken)
+
+	all, err := client.GetOrdersByUserID(ctx, &v1.GetOrdersByUserIDRequest{UserId: 1})
+	require.NoError(t, err)
+	assert.Len(t, all.Orders, 3)
+
+	_, err = client.ListOrders(ctx, &v1.ListOrdersRequest{PageToken: "не-курсор"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = client.ListOrders(ctx, &v1.ListOrdersRequest{AcceptedFrom: "2024-02-30"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+// TestDeleteOrderQueueFull проверяет, что удаление, отклоненное заполненным пулом, возвращает ResourceExhausted
+func TestDeleteOrderQueueFull(t *testing.T) {
+	ctx := context.Background()
+	wp := pool.NewWorkerPoolWithConfig(pool.Config{Workers: 1, QueueSize: 1, Policy: pool.PolicyReject})
+	client := newTestClientWithPool(t, wp)
+
+	created, err := client.CreateOrder(ctx, &v1.CreateOrderRequest{
+		UserId: 1, PackagingId: 1, ExpirationDate: time.Now().AddDate(0, 0, 7).Format("2006-01-02"), Weight: 1, BaseCost: 100,
+	})
+	require.NoError(t, err)
+
+	// Один воркер занят, очередь заполнена
+	release := make(chan struct{})
+	started := make(chan struct{})
+	require.NoError(t, wp.SubmitTask(func() {
+		close(started)
+		<-release
+	}))
+	<-started
+	require.NoError(t, wp.SubmitTask(func() { <-release }))
+
+	_, err = client.DeleteOrder(ctx, &v1.DeleteOrderRequest{OrderId: created.GetOrderId()})
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	close(release)
+	wp.Wait()
+	_, err = client.DeleteOrder(ctx, &v1.DeleteOrderRequest{OrderId: created.GetOrderId()})
+	assert.NoError(t, err, "удаление после освобождения пула выполняется")
+}
@@ -0,0 +1,25 @@
+package server
+
+import (
+	"context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	v1 "homework1/internal/api/v1"
+)
+
+// TriggerJob запускает задание планировщика вне расписания
+func (s *APIServiceServer) TriggerJob(ctx context.Context, req *v1.TriggerJobRequest) (*v1.TriggerJobResponse, error) {
+	// Валидация запроса
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "ошибка валидации: %v", err)
+	}
+
+	job, err := s.scheduler.Trigger(req.Name)
+	if err != nil {
+		return nil, status.Errorf(errorCode(err, codes.Internal), "ошибка запуска задания: %v", err)
+	}
+
+	return &v1.TriggerJobResponse{
+		Job: jobToProto(job),
+	}, nil
+}
@@ -50,8 +50,8 @@ func (s *APIServiceServer) UpdateOrder(ctx context.Context, req *v1.UpdateOrderR
 
 	// Обновление заказа через контроллер
 	if err := controller.UpdateOrder(ctx, s.orderService, order); err != nil {
-		// Возвращаем код ошибки Internal, если возникла проблема при обновлении заказа
-		return nil, status.Errorf(codes.Internal, "ошибка обновления заказа: %v", err)
+		// Недопустимая смена статуса возвращается как FailedPrecondition, остальные ошибки - как Internal
+		return nil, status.Errorf(errorCode(err, codes.Internal), "ошибка обновления заказа: %v", err)
 	}
 
 	// Возвращаем успешный ответ
@@ -25,7 +25,10 @@ func (s *APIServiceServer) UpdatePackaging(ctx context.Context, req *v1.UpdatePa
 	}
 
 	// Обновление упаковки через сервис
-	s.packagingService.UpdatePackaging(ctx, packaging)
+	if err := s.packagingService.UpdatePackaging(ctx, packaging); err != nil {
+		// Возвращаем код ошибки Internal, если возникла проблема при обновлении упаковки
+		return nil, status.Errorf(errorCode(err, codes.Internal), "ошибка обновления упаковки: %v", err)
+	}
 
 	// Возвращаем успешный ответ
 	return &v1.UpdatePackagingResponse{
@@ -21,7 +21,7 @@ func (s *APIServiceServer) UpdateReturnReason(ctx context.Context, req *v1.Updat
 	err := controller.UpdateReturnReason(ctx, s.returnReasonService, fmt.Sprintf("%d", req.ReasonId), req.Reason)
 	if err != nil {
 		// Возвращаем код ошибки Internal, если возникла проблема при обновлении причины возврата
-		return nil, status.Errorf(codes.Internal, "ошибка обновления причины возврата: %v", err)
+		return nil, status.Errorf(errorCode(err, codes.Internal), "ошибка обновления причины возврата: %v", err)
 	}
 
 	// Возвращаем успешный ответ
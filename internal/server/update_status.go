@@ -21,7 +21,7 @@ func (s *APIServiceServer) UpdateStatus(ctx context.Context, req *v1.UpdateStatu
 	err := controller.UpdateStatus(ctx, s.statusService, fmt.Sprintf("%d", req.StatusId), req.StatusName)
 	if err != nil {
 		// Возвращаем код ошибки Internal, если возникла проблема при обновлении статуса
-		return nil, status.Errorf(codes.Internal, "ошибка обновления статуса: %v", err)
+		return nil, status.Errorf(errorCode(err, codes.Internal), "ошибка обновления статуса: %v", err)
 	}
 
 	// Возвращаем успешный ответ
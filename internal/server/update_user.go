@@ -20,7 +20,7 @@ func (s *APIServiceServer) UpdateUser(ctx context.Context, req *v1.UpdateUserReq
 	err := controller.UpdateUser(ctx, s.userService, int(req.UserId), req.Username)
 	if err != nil {
 		// Возвращаем код ошибки Internal, если возникла проблема при обновлении данных пользователя
-		return nil, status.Errorf(codes.Internal, "ошибка обновления пользователя: %v", err)
+		return nil, status.Errorf(errorCode(err, codes.Internal), "ошибка обновления пользователя: %v", err)
 	}
 
 	// Возвращаем успешный ответ
@@ -0,0 +1,17 @@
+package server
+
+import (
+	"context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// WarmCache загружает в кэш справочники статусов, упаковки и причин возврата
+func (s *APIServiceServer) WarmCache(ctx context.Context, _ *emptypb.Empty) (*emptypb.Empty, error) {
+	if err := s.cacheAdmin.WarmCaches(ctx); err != nil {
+		return nil, status.Errorf(errorCode(err, codes.Internal), "ошибка прогрева кэша: %v", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
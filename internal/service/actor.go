@@ -0,0 +1,38 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"homework1/internal/model"
+)
+
+// ActorSystem - инициатор изменений, выполняемых фоновыми задачами сервиса
+const ActorSystem = "system"
+
+type actorKey struct{}
+
+// WithActor возвращает контекст с инициатором изменений, который попадает в историю статусов заказа
+func WithActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+// ActorFromContext возвращает инициатора изменений из контекста или ActorSystem, если он не задан
+func ActorFromContext(ctx context.Context) string {
+	if actor, ok := ctx.Value(actorKey{}).(string); ok && actor != "" {
+		return actor
+	}
+	return ActorSystem
+}
+
+// newStatusChange создает запись истории смены статуса заказа от имени инициатора из контекста
+func newStatusChange(ctx context.Context, orderID, oldStatusID, newStatusID int, reason string) model.OrderStatusChange {
+	return model.OrderStatusChange{
+		OrderID:     orderID,
+		OldStatusID: oldStatusID,
+		NewStatusID: newStatusID,
+		Actor:       ActorFromContext(ctx),
+		Reason:      reason,
+		ChangedAt:   time.Now(),
+	}
+}
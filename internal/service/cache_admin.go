@@ -0,0 +1,135 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+
+	"homework1/internal/cache"
+)
+
+// ErrUnknownCache возвращается административными операциями для кэша, которого нет у сервисов
+var ErrUnknownCache = errors.New("кэш не найден")
+
+// AdminCache - именованный кэш сервиса с административными операциями, например *cache.LoadingCache[string, model.Order]
+type AdminCache interface {
+	Name() string
+	Inspect(ctx context.Context, key string) (cache.Entry, error)
+	Delete(ctx context.Context, key string) error
+	InvalidateTags(ctx context.Context, tags ...string) ([]string, error)
+	Flush(ctx context.Context) ([]string, error)
+}
+
+// CacheWarmer - сервис, загружающий в кэш часто читаемые данные
+type CacheWarmer interface {
+	WarmCache(ctx context.Context) error
+}
+
+// CacheAdminService выполняет административные операции с кэшами сервисов.
+// Сброс записей, как и при изменении данных, рассылается другим репликам.
+type CacheAdminService struct {
+	caches  map[string]AdminCache
+	warmers []CacheWarmer
+}
+
+// NewCacheAdminService создает сервис администрирования кэшей caches; warmers прогреваются через WarmCaches
+func NewCacheAdminService(caches []AdminCache, warmers ...CacheWarmer) *CacheAdminService {
+	byName := make(map[string]AdminCache, len(caches))
+	for _, c := range caches {
+		byName[c.Name()] = c
+	}
+	return &CacheAdminService{caches: byName, warmers: warmers}
+}
+
+// CacheNames возвращает имена кэшей, отсортированные по алфавиту
+func (s *CacheAdminService) CacheNames() []string {
+	names := make([]string, 0, len(s.caches))
+	for name := range s.caches {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Inspect возвращает запись кэша name по ключу
+func (s *CacheAdminService) Inspect(ctx context.Context, name, key string) (cache.Entry, error) {
+	c, err := s.cache(name)
+	if err != nil {
+		return cache.Entry{}, err
+	}
+
+	entry, err := c.Inspect(ctx, key)
+	if err != nil {
+		return cache.Entry{}, fmt.Errorf("ошибка чтения ключа %s кэша %s: %w", key, name, err)
+	}
+	return entry, nil
+}
+
+// Invalidate удаляет из кэша name ключи keys и записи тегов tags и возвращает удаленные ключи
+func (s *CacheAdminService) Invalidate(ctx context.Context, name string, keys, tags []string) ([]string, error) {
+	c, err := s.cache(name)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, key := range keys {
+		if err := c.Delete(ctx, key); err != nil {
+			return nil, fmt.Errorf("ошибка удаления ключа %s кэша %s: %w", key, name, err)
+		}
+	}
+
+	deleted := append([]string(nil), keys...)
+	if len(tags) > 0 {
+		tagKeys, err := c.InvalidateTags(ctx, tags...)
+		deleted = append(deleted, tagKeys...)
+		if err != nil {
+			return deleted, fmt.Errorf("ошибка инвалидации тегов %v кэша %s: %w", tags, name, err)
+		}
+	}
+
+	log.Printf("Кэш %s сброшен вручную: ключи %v, теги %v", name, keys, tags)
+	return deleted, nil
+}
+
+// Flush удаляет все записи кэша name и возвращает их ключи
+func (s *CacheAdminService) Flush(ctx context.Context, name string) ([]string, error) {
+	c, err := s.cache(name)
+	if err != nil {
+		return nil, err
+	}
+
+	keys, err := c.Flush(ctx)
+	if err != nil {
+		return keys, fmt.Errorf("ошибка сброса кэша %s: %w", name, err)
+	}
+
+	log.Printf("Кэш %s сброшен полностью вручную, удалено ключей: %d", name, len(keys))
+	return keys, nil
+}
+
+// WarmCaches загружает в кэши справочники. Ошибка одного справочника не прерывает прогрев остальных.
+func (s *CacheAdminService) WarmCaches(ctx context.Context) error {
+	var errs []error
+	for _, warmer := range s.warmers {
+		if err := warmer.WarmCache(ctx); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return fmt.Errorf("ошибка прогрева кэша: %w", err)
+	}
+	log.Println("Кэш справочников прогрет")
+	return nil
+}
+
+// cache возвращает кэш по имени
+func (s *CacheAdminService) cache(name string) (AdminCache, error) {
+	c, ok := s.caches[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownCache, name)
+	}
+	return c, nil
+}
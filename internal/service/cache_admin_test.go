@@ -0,0 +1,62 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"homework1/internal/cache"
+	"homework1/internal/model"
+	"homework1/internal/pool"
+	"homework1/internal/repository"
+	"homework1/internal/service"
+)
+
+// newNamedTestCache создает именованный кэш сервиса поверх общего хранилища в памяти процесса
+func newNamedTestCache[V any](name string, store *cache.LRUStore) *cache.LoadingCache[string, V] {
+	return cache.NewLoadingCache(cache.New[string, V](nil, cache.Options{Name: name, Backend: cache.BackendMemory, Local: store}),
+		cache.LoadOptions{Name: name, TTL: time.Minute, NotFound: repository.ErrNotFound})
+}
+
+// TestCacheAdminService проверяет прогрев справочников и ручной сброс кэша на хранилище в памяти
+func TestCacheAdminService(t *testing.T) {
+	ctx := context.Background()
+	store := repository.NewMemoryStore().Store()
+	localStore := cache.NewLRUStore(cache.LRUConfig{})
+	wp := pool.NewWorkerPool(2)
+	defer wp.Close()
+
+	statusCache := newNamedTestCache[model.Status]("admin_test_statuses", localStore)
+	packagingCache := newNamedTestCache[model.PackagingOption]("admin_test_packaging", localStore)
+	reasonCache := newNamedTestCache[model.ReturnReason]("admin_test_return_reasons", localStore)
+	admin := service.NewCacheAdminService(
+		[]service.AdminCache{statusCache, packagingCache, reasonCache},
+		service.NewStatusService(store, wp, statusCache),
+		service.NewPackagingService(store, wp, packagingCache),
+		service.NewReturnReasonService(store, wp, reasonCache),
+	)
+	assert.Equal(t, []string{"admin_test_packaging", "admin_test_return_reasons", "admin_test_statuses"}, admin.CacheNames())
+
+	require.NoError(t, admin.WarmCaches(ctx))
+	// 4 статуса по ID, имени и ID для имени, 3 упаковки и 2 причины возврата со списками
+	assert.Equal(t, 4*3+3+1+2+1, localStore.Len())
+
+	entry, err := admin.Inspect(ctx, "admin_test_packaging", "packaging_1")
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"packaging_id":1,"type":"Коробка","cost":20,"max_weight":30}`, string(entry.Value))
+
+	keys, err := admin.Invalidate(ctx, "admin_test_statuses", []string{"status_1"}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"status_1"}, keys)
+	_, err = admin.Inspect(ctx, "admin_test_statuses", "status_1")
+	assert.ErrorIs(t, err, cache.ErrNotFound)
+
+	keys, err = admin.Flush(ctx, "admin_test_packaging")
+	require.NoError(t, err)
+	assert.Len(t, keys, 4)
+
+	_, err = admin.Flush(ctx, "orders")
+	assert.ErrorIs(t, err, service.ErrUnknownCache)
+}
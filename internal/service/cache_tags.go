@@ -0,0 +1,44 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+)
+
+// Теги кэша. Запись регистрируется в тегах данных, от которых она зависит,
+// и изменение данных сбрасывает все такие записи одной инвалидацией тега.
+const (
+	allOrdersTag     = "orders:all"     // Список всех заказов
+	orderListsTag    = "orders:lists"   // Все списки заказов, в том числе списки заказов пользователей
+	statusesTag      = "statuses"       // Справочник статусов
+	packagingTag     = "packaging"      // Справочник упаковки
+	returnReasonsTag = "return_reasons" // Справочник причин возврата
+)
+
+// orderTag возвращает тег записей заказа: самого заказа и его возврата
+func orderTag(orderID int) string {
+	return fmt.Sprintf("order:%d", orderID)
+}
+
+// userTag возвращает тег записей пользователя: самого пользователя и списка его заказов
+func userTag(userID int) string {
+	return fmt.Sprintf("user:%d", userID)
+}
+
+// orderChangeTags возвращает теги записей, которые устаревают при изменении заказа пользователя
+func orderChangeTags(orderID, userID int) []string {
+	return []string{orderTag(orderID), userTag(userID), allOrdersTag}
+}
+
+// tagInvalidator - кэш со сбросом записей по тегам
+type tagInvalidator interface {
+	InvalidateTags(ctx context.Context, tags ...string) ([]string, error)
+}
+
+// invalidateTags сбрасывает записи кэша по тегам. Ошибка только логируется: запись истечет по TTL.
+func invalidateTags(ctx context.Context, cache tagInvalidator, tags ...string) {
+	if _, err := cache.InvalidateTags(ctx, tags...); err != nil {
+		log.Printf("Ошибка инвалидации кэша по тегам %v: %v", tags, err)
+	}
+}
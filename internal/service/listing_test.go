@@ -0,0 +1,82 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"homework1/internal/cache"
+	"homework1/internal/model"
+	"homework1/internal/pool"
+	"homework1/internal/publisher"
+	"homework1/internal/repository"
+	"homework1/internal/service"
+)
+
+// TestListOrdersPagination проверяет обход списка заказов по курсорам и сброс кэша страниц при изменении заказа
+func TestListOrdersPagination(t *testing.T) {
+	ctx := context.Background()
+	store := repository.NewMemoryStore().Store()
+	wp := pool.NewWorkerPool(2)
+	defer wp.Close()
+
+	orderCache := newNamedTestCache[model.Order]("listing_test_orders", cache.NewLRUStore(cache.LRUConfig{}))
+	orderService := service.NewOrderService(store, wp, publisher.NewMemoryPublisher("test-topic", nil), orderCache)
+
+	for i := 0; i < 5; i++ {
+		_, err := store.Orders.Create(ctx, model.Order{
+			UserID:         i%2 + 1,
+			AcceptanceDate: time.Now(),
+			ExpirationDate: time.Now().Add(24 * time.Hour),
+			PackagingID:    1,
+			StatusID:       1,
+		})
+		require.NoError(t, err)
+	}
+
+	filter := model.OrderFilter{UserID: 1}
+	first, err := orderService.ListOrders(ctx, filter, 2, "")
+	require.NoError(t, err)
+	require.Len(t, first.Orders, 2)
+	require.NotEmpty(t, first.NextPageToken)
+
+	second, err := orderService.ListOrders(ctx, filter, 2, first.NextPageToken)
+	require.NoError(t, err)
+	require.Len(t, second.Orders, 1)
+	assert.Empty(t, second.NextPageToken, "последняя страница без курсора")
+	assert.Greater(t, second.Orders[0].OrderID, first.Orders[1].OrderID)
+
+	_, err = orderService.ListOrders(ctx, model.OrderFilter{UserID: 2}, 2, first.NextPageToken)
+	assert.ErrorIs(t, err, service.ErrInvalidPageToken, "курсор привязан к фильтру")
+	_, err = orderService.ListOrders(ctx, filter, 2, "не-курсор")
+	assert.ErrorIs(t, err, service.ErrInvalidPageToken)
+
+	// Новый заказ сбрасывает закэшированные страницы
+	orders, err := orderService.GetOrdersByUserID(ctx, 1)
+	require.NoError(t, err)
+	require.Len(t, orders, 3)
+	_, err = orderService.CreateOrder(ctx, 1, 1, 1, time.Now().Add(24*time.Hour), 1, 10, 1, 11, false)
+	require.NoError(t, err)
+	orders, err = orderService.GetOrdersByUserID(ctx, 1)
+	require.NoError(t, err)
+	assert.Len(t, orders, 4)
+}
+
+// TestSeedOrdersNotIssued проверяет, что сгенерированные заказы попадают в список невыданных
+func TestSeedOrdersNotIssued(t *testing.T) {
+	ctx := context.Background()
+	s := newTestServices(t)
+
+	require.NoError(t, s.orders.SeedOrders(ctx, 5))
+
+	notIssued := false
+	page, err := s.orders.ListOrders(ctx, model.OrderFilter{Issued: &notIssued}, 10, "")
+	require.NoError(t, err)
+	assert.Len(t, page.Orders, 5)
+	for _, order := range page.Orders {
+		assert.Equal(t, 1, order.StatusID, "заказ создается в состоянии \"принят\"")
+		assert.True(t, order.IssueDate.IsZero())
+	}
+}
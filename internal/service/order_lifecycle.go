@@ -0,0 +1,80 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"homework1/internal/model"
+	"homework1/internal/repository"
+)
+
+// orderLifecycle сопоставляет статусы из справочника statuses с состояниями жизненного цикла заказа
+// и проверяет допустимость переходов между ними
+type orderLifecycle struct {
+	statuses repository.StatusRepository
+}
+
+// stateOf возвращает состояние жизненного цикла для статуса с указанным ID
+func (l orderLifecycle) stateOf(ctx context.Context, statusID int) (model.OrderState, error) {
+	code, err := l.statuses.GetCodeByID(ctx, statusID)
+	if errors.Is(err, repository.ErrNotFound) {
+		return "", fmt.Errorf("%w: статус с ID %d не найден", model.ErrUnknownOrderStatus, statusID)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	state := model.OrderState(code)
+	if !state.IsValid() {
+		return "", fmt.Errorf("%w: статус с ID %d", model.ErrUnknownOrderStatus, statusID)
+	}
+	return state, nil
+}
+
+// statusID возвращает ID статуса, соответствующего состоянию жизненного цикла
+func (l orderLifecycle) statusID(ctx context.Context, state model.OrderState) (int, error) {
+	status, err := l.statuses.GetByCode(ctx, string(state))
+	if err != nil {
+		return 0, fmt.Errorf("ошибка получения статуса для состояния %s: %w", state, err)
+	}
+	return status.StatusID, nil
+}
+
+// transition проверяет переход из текущего статуса в состояние to и возвращает ID целевого статуса
+func (l orderLifecycle) transition(ctx context.Context, currentStatusID int, to model.OrderState) (int, error) {
+	from, err := l.stateOf(ctx, currentStatusID)
+	if err != nil {
+		return 0, err
+	}
+	if err := model.ValidateOrderTransition(from, to); err != nil {
+		return 0, err
+	}
+	return l.statusID(ctx, to)
+}
+
+// checkStatusChange проверяет смену статуса по ID. Запись без смены статуса переходом не считается.
+func (l orderLifecycle) checkStatusChange(ctx context.Context, currentStatusID, newStatusID int) error {
+	if currentStatusID == newStatusID {
+		return nil
+	}
+
+	to, err := l.stateOf(ctx, newStatusID)
+	if err != nil {
+		return err
+	}
+	_, err = l.transition(ctx, currentStatusID, to)
+	return err
+}
+
+// requireState проверяет, что статус соответствует ожидаемому состоянию жизненного цикла
+func (l orderLifecycle) requireState(ctx context.Context, statusID int, expected model.OrderState) error {
+	state, err := l.stateOf(ctx, statusID)
+	if err != nil {
+		return err
+	}
+	if state != expected {
+		return fmt.Errorf("%w: ожидается состояние %s, получено %s", model.ErrInvalidStatusTransition, expected, state)
+	}
+	return nil
+}
@@ -2,86 +2,75 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/brianvoe/gofakeit/v6"
 	"homework1/internal/tracing"
 	"log"
-	"sync"
 	"time"
 
-	"github.com/jackc/pgx/v4/pgxpool"
 	"go.opentelemetry.io/otel/trace"
+	eventsv1 "homework1/internal/api/events/v1"
 	"homework1/internal/cache"
-	"homework1/internal/dao"
 	"homework1/internal/kafka"
 	"homework1/internal/model"
 	"homework1/internal/pool"
+	"homework1/internal/publisher"
+	"homework1/internal/repository"
 )
 
+// ErrOrderOwnerChange возвращается при попытке передать заказ другому пользователю.
+// События заказа могут ключеваться по ID пользователя, поэтому смена владельца нарушила бы их порядок.
+var ErrOrderOwnerChange = errors.New("заказ нельзя передать другому пользователю")
+
 // OrderService представляет сервис для работы с заказами
 type OrderService struct {
-	pool     *pgxpool.Pool
-	wp       *pool.WorkerPool
-	producer *kafka.Producer
-	cache    *cache.RedisCache[string, model.Order]
-	tracer   trace.Tracer
+	orders    repository.OrderRepository
+	reasons   repository.ReturnReasonRepository
+	tx        repository.Transactor
+	wp        *pool.WorkerPool
+	producer  publisher.EventPublisher
+	cache     *cache.LoadingCache[string, model.Order]
+	tracer    trace.Tracer
+	lifecycle orderLifecycle
 }
 
 // NewOrderService создает новый сервис для работы с заказами
-func NewOrderService(dbPool *pgxpool.Pool, workerPool *pool.WorkerPool, producer *kafka.Producer, cache *cache.RedisCache[string, model.Order]) *OrderService {
+func NewOrderService(store repository.Store, workerPool *pool.WorkerPool, producer publisher.EventPublisher, cache *cache.LoadingCache[string, model.Order]) *OrderService {
 	return &OrderService{
-		pool:     dbPool,
-		wp:       workerPool,
-		producer: producer,
-		cache:    cache,
-		tracer:   tracing.GetTracer(),
+		orders:    store.Orders,
+		reasons:   store.ReturnReasons,
+		tx:        store.Tx,
+		wp:        workerPool,
+		producer:  producer,
+		cache:     cache,
+		tracer:    tracing.GetTracer(),
+		lifecycle: orderLifecycle{statuses: store.Statuses},
 	}
 }
 
-// CreateOrder создает новый заказ и отправляет событие в Kafka
+// CreateOrder создает новый заказ и сохраняет событие о создании в outbox
 func (s *OrderService) CreateOrder(ctx context.Context, userID, packagingID, statusID int, expirationDate time.Time, weight, baseCost, packagingCost, totalCost float64, withFilm bool) (int, error) {
 	ctx, span := s.tracer.Start(ctx, "CreateOrder")
 	defer span.End()
 
-	resultChan := make(chan int, 1)
-	errChan := make(chan error, 1)
-
-	var wg sync.WaitGroup
-	wg.Add(1)
-	s.wp.SubmitTask(func() {
-		defer wg.Done()
+	return pool.Submit(ctx, s.wp, func(ctx context.Context) (int, error) {
+		if err := s.lifecycle.requireState(ctx, statusID, model.OrderStateAccepted); err != nil {
+			return 0, fmt.Errorf("ошибка создания заказа: %w", err)
+		}
 
 		newOrder := s.buildOrder(userID, packagingID, statusID, expirationDate, weight, baseCost, packagingCost, totalCost, withFilm)
 
 		orderID, err := s.saveOrder(ctx, newOrder)
 		if err != nil {
-			errChan <- err
-			return
-		}
-
-		if err := s.cache.Delete(ctx, "all_orders"); err != nil {
-			log.Printf("Ошибка инвалидации кэша всех заказов: %v", err)
+			return 0, err
 		}
 
-		orderCacheKey := fmt.Sprintf("order_%d", orderID)
-		if err := s.cache.Delete(ctx, orderCacheKey); err != nil {
-			log.Printf("Ошибка инвалидации кэша для заказа %d: %v", orderID, err)
-		}
-
-		userOrdersCacheKey := fmt.Sprintf("user_orders_%d", userID)
-		if err := s.cache.Delete(ctx, userOrdersCacheKey); err != nil {
-			log.Printf("Ошибка инвалидации кэша для заказов пользователя %d: %v", userID, err)
-		}
+		// Сбрасывается и отметка об отсутствии заказа с новым ID
+		invalidateTags(ctx, s.cache, orderChangeTags(orderID, userID)...)
 
-		if err := s.notifyOrderCreation(orderID); err != nil {
-			errChan <- fmt.Errorf("Ошибка отправки сообщения в Kafka: %v", err)
-		} else {
-			resultChan <- orderID
-		}
-	})
-
-	wg.Wait()
-	return s.getResultOrError(resultChan, errChan)
+		return orderID, nil
+	}).Get(ctx)
 }
 
 // buildOrder создает новый объект заказа
@@ -102,7 +91,9 @@ func (s *OrderService) buildOrder(userID, packagingID, statusID int, expirationD
 
 // saveOrder сохраняет заказ в базе данных
 func (s *OrderService) saveOrder(ctx context.Context, order model.Order) (int, error) {
-	orderID, err := dao.CreateOrder(ctx, order, s.pool)
+	change := newStatusChange(ctx, 0, 0, order.StatusID, "приемка заказа на ПВЗ")
+	created := orderEvent(s.producer, eventsv1.EventType_EVENT_TYPE_ORDER_CREATED, order, "Order %d created")
+	orderID, err := s.orders.Create(ctx, order, repository.WithStatusHistory(change), created)
 	if err != nil {
 		log.Printf("Ошибка создания заказа: %v", err)
 		return 0, err
@@ -111,24 +102,9 @@ func (s *OrderService) saveOrder(ctx context.Context, order model.Order) (int, e
 	return orderID, nil
 }
 
-// notifyOrderCreation отправляет уведомление в Kafka о создании заказа
-func (s *OrderService) notifyOrderCreation(orderID int) error {
-	return s.sendKafkaMessage("create", orderID, fmt.Sprintf("Order %d created", orderID))
-}
-
-// getResultOrError возвращает результат или ошибку
-func (s *OrderService) getResultOrError(resultChan chan int, errChan chan error) (int, error) {
-	select {
-	case orderID := <-resultChan:
-		return orderID, nil
-	case err := <-errChan:
-		return 0, err
-	}
-}
-
-// updateOrderFields обновляет поля существующего заказа на основе нового
+// updateOrderFields обновляет поля существующего заказа на основе нового.
+// Статус и дата выдачи относятся к жизненному циклу заказа и меняются только его операциями, владелец не меняется.
 func updateOrderFields(existingOrder, updatedOrder *model.Order) {
-	existingOrder.UserID = updatedOrder.UserID
 	existingOrder.AcceptanceDate = updatedOrder.AcceptanceDate
 	existingOrder.ExpirationDate = updatedOrder.ExpirationDate
 	existingOrder.Weight = updatedOrder.Weight
@@ -136,233 +112,219 @@ func updateOrderFields(existingOrder, updatedOrder *model.Order) {
 	existingOrder.PackagingCost = updatedOrder.PackagingCost
 	existingOrder.TotalCost = updatedOrder.TotalCost
 	existingOrder.PackagingID = updatedOrder.PackagingID
-	existingOrder.StatusID = updatedOrder.StatusID
-	existingOrder.IssueDate = updatedOrder.IssueDate
 	existingOrder.WithFilm = updatedOrder.WithFilm
 }
 
-// sendKafkaMessage отправляет сообщение в Kafka
-func (s *OrderService) sendKafkaMessage(operation string, orderID int, description string) error {
-	orderMessage := kafka.OrderMessage{
-		TimeStamp:   time.Now(),
-		Operation:   operation,
-		OrderID:     orderID,
-		Description: description,
-	}
-	return s.producer.SendOrderMessage(orderMessage)
-}
-
 // GetOrderByID возвращает заказ по ID
 func (s *OrderService) GetOrderByID(ctx context.Context, orderID int) (*model.Order, error) {
 	ctx, span := s.tracer.Start(ctx, "GetOrderByID")
 	defer span.End()
 
-	// Проверка кэша
 	cacheKey := fmt.Sprintf("order_%d", orderID)
-	var cachedOrder model.Order
-	if err := s.cache.Get(ctx, cacheKey, &cachedOrder); err == nil {
-		return &cachedOrder, nil
-	}
-
-	order, err := dao.GetOrderByID(ctx, orderID, s.pool)
+	order, err := s.cache.GetOrLoad(ctx, cacheKey, func(ctx context.Context) (model.Order, error) {
+		order, err := s.orders.GetByID(ctx, orderID)
+		if err != nil {
+			return model.Order{}, err
+		}
+		return *order, nil
+	}, cache.WithTags(orderTag(orderID)))
 	if err != nil {
 		return nil, fmt.Errorf("ошибка получения заказа с ID %d: %w", orderID, err)
 	}
 
-	if err := s.cache.Set(ctx, cacheKey, *order); err != nil {
-		log.Printf("Ошибка сохранения заказа в кэше: %v", err)
-	}
+	return &order, nil
+}
 
-	return order, nil
+// StatusIDByState возвращает ID статуса, соответствующего состоянию жизненного цикла заказа
+func (s *OrderService) StatusIDByState(ctx context.Context, state model.OrderState) (int, error) {
+	return s.lifecycle.statusID(ctx, state)
 }
 
-// UpdateOrder обновляет заказ и отправляет событие в Kafka
-func (s *OrderService) UpdateOrder(ctx context.Context, order model.Order) {
-	ctx, span := s.tracer.Start(ctx, "UpdateOrder")
+// IssueOrder выдает заказ клиенту, переводя его в состояние "выдан".
+// Повторная выдача уже выданного заказа возвращает model.ErrInvalidStatusTransition.
+func (s *OrderService) IssueOrder(ctx context.Context, orderID int) error {
+	ctx, span := s.tracer.Start(ctx, "IssueOrder")
 	defer span.End()
 
-	var wg sync.WaitGroup
-	wg.Add(1)
-	s.wp.SubmitTask(func() {
-		defer wg.Done()
+	return s.updateOrder(ctx, orderID, eventsv1.EventType_EVENT_TYPE_ORDER_ISSUED, "выдача заказа клиенту",
+		func(ctx context.Context, order *model.Order) error {
+			issuedStatusID, err := s.lifecycle.transition(ctx, order.StatusID, model.OrderStateIssued)
+			if err != nil {
+				return fmt.Errorf("заказ с ID %d не может быть выдан: %w", orderID, err)
+			}
+			order.StatusID = issuedStatusID
+			order.IssueDate = time.Now()
+			return nil
+		})
+}
 
-		existingOrder, err := dao.GetOrderByID(ctx, order.OrderID, s.pool)
-		if err != nil {
-			log.Printf("Ошибка поиска заказа с ID %d: %v", order.OrderID, err)
-			return
-		}
+// UpdateOrder обновляет данные заказа и сохраняет событие об обновлении в outbox.
+// Статус заказа этим методом не меняется: выдача и возврат выполняются через IssueOrder, CreateReturn и ProcessReturn,
+// поэтому смена статуса возвращает model.ErrInvalidStatusTransition. Дата выдачи не обновляется.
+// Смена владельца заказа возвращает ErrOrderOwnerChange.
+func (s *OrderService) UpdateOrder(ctx context.Context, order model.Order) error {
+	ctx, span := s.tracer.Start(ctx, "UpdateOrder")
+	defer span.End()
 
-		updateOrderFields(existingOrder, &order)
+	return s.updateOrder(ctx, order.OrderID, eventsv1.EventType_EVENT_TYPE_ORDER_UPDATED, "обновление заказа",
+		func(_ context.Context, existingOrder *model.Order) error {
+			if existingOrder.StatusID != order.StatusID {
+				return fmt.Errorf("%w: статус заказа с ID %d меняется только выдачей и возвратом", model.ErrInvalidStatusTransition, order.OrderID)
+			}
+			if existingOrder.UserID != order.UserID {
+				return fmt.Errorf("%w: заказ с ID %d принадлежит пользователю %d", ErrOrderOwnerChange, order.OrderID, existingOrder.UserID)
+			}
+			updateOrderFields(existingOrder, &order)
+			return nil
+		})
+}
 
-		if err := dao.UpdateOrder(ctx, *existingOrder, s.pool); err != nil {
-			log.Printf("Ошибка обновления заказа с ID %d: %v", order.OrderID, err)
-			return
-		}
+// updateOrder читает заказ, изменяет его через apply и записывает одной транзакцией через worker pool.
+// apply проверяет допустимость изменения по прочитанному в транзакции заказу, поэтому параллельное изменение
+// не обойдет проверку и не будет перезаписано устаревшим состоянием. Смена статуса записывается в историю
+// с указанной причиной, событие указанного типа сохраняется в outbox.
+func (s *OrderService) updateOrder(ctx context.Context, orderID int, eventType eventsv1.EventType, reason string,
+	apply func(ctx context.Context, order *model.Order) error) error {
+	return pool.Run(ctx, s.wp, func(ctx context.Context) error {
+		var userID int
+		err := s.tx.RunInTx(ctx, repository.RepeatableRead, func(ctx context.Context) error {
+			order, err := s.orders.GetByID(ctx, orderID)
+			if err != nil {
+				return fmt.Errorf("ошибка поиска заказа с ID %d: %w", orderID, err)
+			}
 
-		orderCacheKey := fmt.Sprintf("order_%d", order.OrderID)
-		if err := s.cache.Delete(ctx, orderCacheKey); err != nil {
-			log.Printf("Ошибка инвалидации кэша для заказа %d: %v", order.OrderID, err)
-		}
+			previousStatusID := order.StatusID
+			if err := apply(ctx, order); err != nil {
+				return err
+			}
+			userID = order.UserID
 
-		if err := s.cache.Delete(ctx, "all_orders"); err != nil {
-			log.Printf("Ошибка инвалидации кэша всех заказов: %v", err)
-		}
+			var hooks []repository.Hook
+			if previousStatusID != order.StatusID {
+				change := newStatusChange(ctx, orderID, previousStatusID, order.StatusID, reason)
+				hooks = append(hooks, repository.WithStatusHistory(change))
+			}
+			hooks = append(hooks, orderEvent(s.producer, eventType, *order, "Order %d updated"))
 
-		userOrdersCacheKey := fmt.Sprintf("user_orders_%d", order.UserID)
-		if err := s.cache.Delete(ctx, userOrdersCacheKey); err != nil {
-			log.Printf("Ошибка инвалидации кэша для заказов пользователя %d: %v", order.UserID, err)
+			if err := s.orders.Update(ctx, *order, hooks...); err != nil {
+				return fmt.Errorf("ошибка обновления заказа с ID %d: %w", orderID, err)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
 		}
 
-		if err := s.sendKafkaMessage("update", order.OrderID, fmt.Sprintf("Order %d updated", order.OrderID)); err != nil {
-			log.Printf("Ошибка отправки сообщения в Kafka: %v", err)
-		}
+		invalidateTags(ctx, s.cache, orderChangeTags(orderID, userID)...)
 
-		log.Printf("Заказ с ID %d успешно обновлен", order.OrderID)
+		log.Printf("Заказ с ID %d успешно обновлен", orderID)
+		return nil
 	})
-
-	wg.Wait()
 }
 
-// DeleteOrder удаляет заказ и отправляет событие в Kafka
-func (s *OrderService) DeleteOrder(ctx context.Context, orderID int) {
+// DeleteOrder удаляет заказ и сохраняет событие об удалении в outbox.
+// Возвращает pool.ErrQueueFull, если пул воркеров отклонил задачу.
+func (s *OrderService) DeleteOrder(ctx context.Context, orderID int) error {
 	ctx, span := s.tracer.Start(ctx, "DeleteOrder")
 	defer span.End()
 
-	var wg sync.WaitGroup
-	wg.Add(1)
-	s.wp.SubmitTask(func() {
-		defer wg.Done()
-
-		order, err := dao.GetOrderByID(ctx, orderID, s.pool)
+	return pool.Run(ctx, s.wp, func(ctx context.Context) error {
+		order, err := s.orders.GetByID(ctx, orderID)
 		if err != nil {
-			log.Printf("Заказ с ID %d не найден: %v", orderID, err)
-			return
-		}
-
-		if err := dao.DeleteOrder(ctx, orderID, s.pool); err != nil {
-			log.Printf("Ошибка удаления заказа с ID %d: %v", orderID, err)
-			return
-		}
-
-		orderCacheKey := fmt.Sprintf("order_%d", orderID)
-		if err := s.cache.Delete(ctx, orderCacheKey); err != nil {
-			log.Printf("Ошибка инвалидации кэша для заказа %d: %v", orderID, err)
-		}
-
-		if err := s.cache.Delete(ctx, "all_orders"); err != nil {
-			log.Printf("Ошибка инвалидации кэша всех заказов: %v", err)
+			return fmt.Errorf("заказ с ID %d не найден: %w", orderID, err)
 		}
 
-		userOrdersCacheKey := fmt.Sprintf("user_orders_%d", order.UserID)
-		if err := s.cache.Delete(ctx, userOrdersCacheKey); err != nil {
-			log.Printf("Ошибка инвалидации кэша для заказов пользователя %d: %v", order.UserID, err)
+		change := newStatusChange(ctx, orderID, order.StatusID, 0, "удаление заказа")
+		deleted := orderEvent(s.producer, eventsv1.EventType_EVENT_TYPE_ORDER_DELETED, *order, "Order %d deleted")
+		if err := s.orders.Delete(ctx, orderID, repository.WithStatusHistory(change), deleted); err != nil {
+			return fmt.Errorf("ошибка удаления заказа с ID %d: %w", orderID, err)
 		}
 
-		orderMessage := kafka.OrderMessage{
-			TimeStamp:   time.Now(),
-			Operation:   "delete",
-			OrderID:     orderID,
-			Description: fmt.Sprintf("Order %d deleted", orderID),
-		}
-
-		if err := s.producer.SendOrderMessage(orderMessage); err != nil {
-			log.Printf("Ошибка отправки сообщения в Kafka: %v", err)
-		}
+		invalidateTags(ctx, s.cache, orderChangeTags(orderID, order.UserID)...)
 
 		log.Printf("Заказ с ID %d успешно удален", orderID)
+		return nil
 	})
-
-	wg.Wait()
 }
 
-// CheckExpiredOrders проверяет и обрабатывает просроченные заказы через worker pool
-func (s *OrderService) CheckExpiredOrders(ctx context.Context) error {
+// CheckExpiredOrders оформляет возвраты просроченных заказов в статусе "Создан" пачками не больше batchSize.
+// Каждая пачка обрабатывается одной транзакцией задачей пула с низким приоритетом,
+// чтобы обработка просроченных заказов не задерживала запросы пользователей.
+// Заказ, обработка которого завершилась ошибкой, логируется и не выбирается повторно в этом проходе.
+// При отмене контекста обработанные пачки остаются подтвержденными, а оставшиеся заказы обработает следующий проход.
+func (s *OrderService) CheckExpiredOrders(ctx context.Context, batchSize int) (model.ExpiredOrdersSummary, error) {
 	ctx, span := s.tracer.Start(ctx, "CheckExpiredOrders")
 	defer span.End()
 
-	expiredOrders, err := dao.GetExpiredOrders(ctx, s.pool)
-	if err != nil {
-		errMsg := fmt.Sprintf("ошибка при получении просроченных заказов: %v", err)
-		if kafkaErr := s.producer.SendKafkaErrorMessage("check_expired_orders", 0, errMsg); kafkaErr != nil {
-			log.Printf("Ошибка при отправке сообщения в Kafka: %v", kafkaErr)
-		}
-		return fmt.Errorf("%s", errMsg)
-	}
+	ctx = WithActor(ctx, ActorSystem+":expiry")
+
+	var summary model.ExpiredOrdersSummary
 
 	reasonID, err := s.getReturnReasonID(ctx, "Истек срок хранения")
 	if err != nil {
-		if kafkaErr := s.producer.SendKafkaErrorMessage("check_expired_orders", 0, fmt.Sprintf("ошибка получения причины возврата: %v", err)); kafkaErr != nil {
-			log.Printf("Ошибка при отправке сообщения в Kafka: %v", kafkaErr)
-		}
-		return err
+		s.reportExpiryError(0, fmt.Sprintf("ошибка получения причины возврата: %v", err))
+		return summary, err
 	}
 
-	returnStatusID, err := s.getStatusID(ctx, "Возврат")
+	acceptedStatusID, err := s.lifecycle.statusID(ctx, model.OrderStateAccepted)
 	if err != nil {
-		if kafkaErr := s.producer.SendKafkaErrorMessage("check_expired_orders", 0, fmt.Sprintf("ошибка получения статуса 'Возврат': %v", err)); kafkaErr != nil {
-			log.Printf("Ошибка при отправке сообщения в Kafka: %v", kafkaErr)
-		}
-		return err
+		s.reportExpiryError(0, err.Error())
+		return summary, err
 	}
 
-	createdStatusID, err := s.getStatusID(ctx, "Создан")
+	returnStatusID, err := s.lifecycle.statusID(ctx, model.OrderStateReturned)
 	if err != nil {
-		if kafkaErr := s.producer.SendKafkaErrorMessage("check_expired_orders", 0, fmt.Sprintf("ошибка получения статуса 'Создан': %v", err)); kafkaErr != nil {
-			log.Printf("Ошибка при отправке сообщения в Kafka: %v", kafkaErr)
-		}
-		return err
+		s.reportExpiryError(0, err.Error())
+		return summary, err
 	}
 
-	return s.processExpiredOrders(ctx, expiredOrders, createdStatusID, returnStatusID, reasonID)
-}
-
-// processExpiredOrders обрабатывает просроченные заказы
-func (s *OrderService) processExpiredOrders(ctx context.Context, expiredOrders []model.Order, createdStatusID, returnStatusID, reasonID int) error {
-	for _, order := range expiredOrders {
-		if order.StatusID == createdStatusID {
-			if err := s.handleExpiredOrder(ctx, order, reasonID, returnStatusID); err != nil {
-				if kafkaErr := s.producer.SendKafkaErrorMessage("process_expired_orders", order.OrderID, fmt.Sprintf("ошибка при обработке заказа с ID %d: %v", order.OrderID, err)); kafkaErr != nil {
-					log.Printf("Ошибка при отправке сообщения в Kafka: %v", kafkaErr)
-				}
-				return fmt.Errorf("ошибка при обработке заказа с ID %d: %w", order.OrderID, err)
-			}
-		} else if order.StatusID == returnStatusID {
-			continue
-		}
+	if err := s.lifecycle.checkStatusChange(ctx, acceptedStatusID, returnStatusID); err != nil {
+		return summary, fmt.Errorf("просроченные заказы не могут быть возвращены: %w", err)
 	}
 
-	return nil
-}
-
-func (s *OrderService) handleExpiredOrder(ctx context.Context, order model.Order, reasonID, returnStatusID int) error {
-	var wg sync.WaitGroup
-
-	wg.Add(1)
-	s.wp.SubmitTask(func() {
-		defer wg.Done()
-
-		if err := s.createReturn(ctx, order, reasonID, returnStatusID); err != nil {
-			log.Printf("Ошибка создания возврата: %v", err)
-			return
+	batch := model.ExpiredOrdersBatch{
+		StatusID: acceptedStatusID,
+		Before:   time.Now(),
+		Limit:    batchSize,
+	}
+	for {
+		result, err := pool.Submit(ctx, s.wp, func(ctx context.Context) (model.ExpiredOrdersBatchResult, error) {
+			return s.orders.ReturnExpired(ctx, batch,
+				func(order model.Order) model.Return {
+					return newExpiredOrderReturn(order, reasonID, returnStatusID)
+				},
+				func(order model.Order, ret model.Return) []repository.Hook {
+					return s.expiredOrderHooks(ctx, order, ret)
+				})
+		}, pool.WithPriority(pool.PriorityLow)).Get(ctx)
+		if err != nil {
+			s.reportExpiryError(0, fmt.Sprintf("ошибка обработки пачки просроченных заказов: %v", err))
+			return summary, fmt.Errorf("ошибка обработки пачки просроченных заказов: %w", err)
 		}
 
-		if err := s.updateOrderStatus(ctx, order, returnStatusID); err != nil {
-			log.Printf("Ошибка обновления статуса заказа: %v", err)
-			return
+		summary.Add(result.Summary)
+		for orderID, orderErr := range result.Failed {
+			errMsg := fmt.Sprintf("ошибка при обработке заказа с ID %d: %v", orderID, orderErr)
+			log.Printf("Ошибка обработки просроченного заказа: %s", errMsg)
+			s.reportExpiryError(orderID, errMsg)
+			batch.Exclude = append(batch.Exclude, orderID)
+		}
+		for _, order := range result.Returned {
+			s.invalidateReturnedOrder(ctx, order)
 		}
 
-		if err := s.notifyKafkaReturn(order.OrderID); err != nil {
-			log.Printf("Ошибка отправки сообщения в Kafka: %v", err)
+		if result.Selected < batch.Limit {
+			break
 		}
-	})
+	}
 
-	wg.Wait()
-	return nil
+	log.Printf("Проверка просроченных заказов завершена: %s", summary)
+	return summary, nil
 }
 
-// createReturn создает новый возврат для заказа
-func (s *OrderService) createReturn(ctx context.Context, order model.Order, reasonID, returnStatusID int) error {
-	newReturn := model.Return{
+// newExpiredOrderReturn создает возврат просроченного заказа
+func newExpiredOrderReturn(order model.Order, reasonID, returnStatusID int) model.Return {
+	return model.Return{
 		OrderID:       order.OrderID,
 		UserID:        order.UserID,
 		ReturnDate:    time.Now().UTC(),
@@ -373,72 +335,77 @@ func (s *OrderService) createReturn(ctx context.Context, order model.Order, reas
 		PackagingID:   order.PackagingID,
 		StatusID:      returnStatusID,
 	}
-	err := dao.CreateReturn(ctx, newReturn, s.pool)
-	if err != nil {
-		log.Printf("Ошибка создания возврата: %v", err)
-		return err
-	}
+}
 
-	// Инвалидация кэша для возвратов
-	if err := s.cache.Delete(ctx, fmt.Sprintf("return_%d", newReturn.OrderID)); err != nil {
-		log.Printf("Ошибка инвалидации кэша для возврата заказа %d: %v", newReturn.OrderID, err)
+// expiredOrderHooks возвращает хуки, сохраняющие историю статусов и событие о возврате
+// в транзакции оформления возврата просроченного заказа
+func (s *OrderService) expiredOrderHooks(ctx context.Context, order model.Order, ret model.Return) []repository.Hook {
+	change := newStatusChange(ctx, order.OrderID, order.StatusID, ret.StatusID, "истек срок хранения")
+	description := fmt.Sprintf("Возврат создан для заказа с ID %d", order.OrderID)
+	return []repository.Hook{
+		repository.WithStatusHistory(change),
+		returnEvent(s.producer, eventsv1.EventType_EVENT_TYPE_RETURN_CREATED, ret, description),
 	}
+}
 
-	return nil
+// invalidateReturnedOrder удаляет из кэша заказ, переведенный в статус возврата, и его возврат
+func (s *OrderService) invalidateReturnedOrder(ctx context.Context, order model.Order) {
+	invalidateTags(ctx, s.cache, orderChangeTags(order.OrderID, order.UserID)...)
 }
 
-// updateOrderStatus обновляет статус заказа
-func (s *OrderService) updateOrderStatus(ctx context.Context, order model.Order, returnStatusID int) error {
-	order.StatusID = returnStatusID
-	return dao.UpdateOrder(ctx, order, s.pool)
+// reportExpiryError публикует событие об ошибке обработки просроченных заказов
+func (s *OrderService) reportExpiryError(orderID int, description string) {
+	if kafkaErr := s.producer.SendEvent(context.Background(), kafka.NewErrorEvent("check_expired_orders", orderID, description)); kafkaErr != nil {
+		log.Printf("Ошибка при отправке сообщения в Kafka: %v", kafkaErr)
+	}
 }
 
-// notifyKafkaReturn отправляет сообщение в Kafka о возврате
-func (s *OrderService) notifyKafkaReturn(orderID int) error {
-	orderMessage := kafka.OrderMessage{
-		TimeStamp:   time.Now(),
-		Operation:   "return",
-		OrderID:     orderID,
-		Description: fmt.Sprintf("Возврат создан для заказа с ID %d", orderID),
+// NotifyExpiringOrders сохраняет в outbox события о заказах, срок хранения которых истекает в течение notice.
+// Для каждого заказа событие публикуется один раз.
+func (s *OrderService) NotifyExpiringOrders(ctx context.Context, notice time.Duration) error {
+	ctx, span := s.tracer.Start(ctx, "NotifyExpiringOrders")
+	defer span.End()
+
+	acceptedStatusID, err := s.lifecycle.statusID(ctx, model.OrderStateAccepted)
+	if err != nil {
+		return err
+	}
+
+	orders, err := s.orders.GetExpiringBefore(ctx, time.Now().Add(notice), acceptedStatusID)
+	if err != nil {
+		return err
+	}
+
+	for _, order := range orders {
+		expiring := orderEvent(s.producer, eventsv1.EventType_EVENT_TYPE_ORDER_STORAGE_EXPIRING, order, "Срок хранения заказа %d скоро истекает")
+		if _, err := s.orders.MarkExpiryNotified(ctx, order.OrderID, expiring); err != nil {
+			return fmt.Errorf("ошибка уведомления об истечении срока хранения заказа с ID %d: %w", order.OrderID, err)
+		}
 	}
-	return s.producer.SendOrderMessage(orderMessage)
+
+	if len(orders) > 0 {
+		log.Printf("Сохранено уведомлений об истечении срока хранения: %d", len(orders))
+	}
+	return nil
 }
 
 // getReturnReasonID получает ID причины возврата по её имени
 func (s *OrderService) getReturnReasonID(ctx context.Context, reasonName string) (int, error) {
-	reason, err := dao.GetReturnReasonByName(ctx, reasonName, s.pool)
+	reason, err := s.reasons.GetByName(ctx, reasonName)
 	if err != nil {
 		return 0, fmt.Errorf("ошибка получения причины возврата: %w", err)
 	}
 	return reason.ReasonID, nil
 }
 
-// getStatusID получает ID статуса по его имени
-func (s *OrderService) getStatusID(ctx context.Context, statusName string) (int, error) {
-	status, err := dao.GetStatusByName(ctx, statusName, s.pool)
-	if err != nil {
-		return 0, fmt.Errorf("ошибка получения статуса: %w", err)
-	}
-	return status.StatusID, nil
-}
-
-// GetAllOrders получает все заказы из базы данных.
+// GetAllOrders получает все заказы, последовательно читая страницы списка.
+// Оставлен для совместимости со старыми клиентами; новые клиенты используют ListOrders.
 func (s *OrderService) GetAllOrders(ctx context.Context) ([]model.Order, error) {
-	// Проверка кэша
-	var cachedOrders []model.Order
-	if err := s.cache.GetSlice(ctx, "all_orders", &cachedOrders); err == nil {
-		return cachedOrders, nil
-	}
-
-	orders, err := dao.GetAllOrders(ctx, s.pool)
+	orders, err := s.listAllOrders(ctx, model.OrderFilter{})
 	if err != nil {
 		return nil, fmt.Errorf("ошибка получения всех заказов: %w", err)
 	}
 
-	if err := s.cache.SetSlice(ctx, "all_orders", orders); err != nil {
-		log.Printf("Ошибка сохранения всех заказов в кэше: %v", err)
-	}
-
 	return orders, nil
 }
 
@@ -447,6 +414,12 @@ func (s *OrderService) SeedOrders(ctx context.Context, num int) error {
 	ctx, span := s.tracer.Start(ctx, "SeedOrders")
 	defer span.End()
 
+	// Заказы создаются так же, как при приемке: в состоянии "принят" и без даты выдачи
+	acceptedStatusID, err := s.lifecycle.statusID(ctx, model.OrderStateAccepted)
+	if err != nil {
+		return err
+	}
+
 	for i := 0; i < num; i++ {
 		order := model.Order{
 			UserID:         gofakeit.Number(1, 3),
@@ -457,56 +430,92 @@ func (s *OrderService) SeedOrders(ctx context.Context, num int) error {
 			PackagingCost:  gofakeit.Float64Range(1, 20),
 			TotalCost:      gofakeit.Float64Range(15, 600),
 			PackagingID:    gofakeit.Number(1, 3),
-			StatusID:       gofakeit.Number(1, 4),
-			IssueDate:      gofakeit.Date(),
+			StatusID:       acceptedStatusID,
 			WithFilm:       gofakeit.Bool(),
 		}
 
-		orderID, err := dao.CreateOrder(ctx, order, s.pool)
+		change := newStatusChange(ctx, 0, 0, order.StatusID, "генерация тестовых заказов")
+		orderID, err := s.orders.Create(ctx, order, repository.WithStatusHistory(change))
 		if err != nil {
 			return fmt.Errorf("ошибка при создании заказа: %w", err)
 		}
 
-		cacheKey := fmt.Sprintf("order_%d", orderID)
-		if err := s.cache.Delete(ctx, cacheKey); err != nil {
-			log.Printf("Ошибка инвалидации кэша для заказа %d: %v", orderID, err)
-		}
+		invalidateTags(ctx, s.cache, orderTag(orderID))
 	}
 
-	if err := s.cache.Delete(ctx, "all_orders"); err != nil {
-		log.Printf("Ошибка инвалидации кэша всех заказов после массовой генерации: %v", err)
-	}
+	// Заказы созданы для разных пользователей, поэтому сбрасываются все списки заказов
+	invalidateTags(ctx, s.cache, orderListsTag)
 
-	userKeys, err := s.cache.Keys(ctx, "user_orders_*")
+	return nil
+}
+
+// GetOrdersByUserID получает заказы по идентификатору пользователя, последовательно читая страницы списка.
+// Оставлен для совместимости со старыми клиентами; новые клиенты используют ListOrders.
+func (s *OrderService) GetOrdersByUserID(ctx context.Context, userID int) ([]model.Order, error) {
+	orders, err := s.listAllOrders(ctx, model.OrderFilter{UserID: userID})
 	if err != nil {
-		log.Printf("Ошибка получения ключей кэша для заказов пользователей: %v", err)
-	} else {
-		for _, key := range userKeys {
-			if err := s.cache.Delete(ctx, key); err != nil {
-				log.Printf("Ошибка инвалидации кэша для ключа %s: %v", key, err)
-			}
-		}
+		return nil, fmt.Errorf("ошибка получения заказов для пользователя с ID %d: %w", userID, err)
 	}
 
-	return nil
+	return orders, nil
 }
 
-// GetOrdersByUserID получает заказы по идентификатору пользователя.
-func (s *OrderService) GetOrdersByUserID(ctx context.Context, userID int) ([]model.Order, error) {
-	cacheKey := fmt.Sprintf("user_orders_%d", userID)
-	var cachedOrders []model.Order
-	if err := s.cache.GetSlice(ctx, cacheKey, &cachedOrders); err == nil {
-		return cachedOrders, nil
+// ListOrders возвращает страницу заказов, подходящих под фильтр, в порядке возрастания ID.
+// pageSize 0 означает размер по умолчанию; pageToken - курсор из предыдущей страницы, пустой для первой.
+// Каждая страница кэшируется отдельно и сбрасывается при изменении любого заказа.
+func (s *OrderService) ListOrders(ctx context.Context, filter model.OrderFilter, pageSize int, pageToken string) (model.OrderPage, error) {
+	ctx, span := s.tracer.Start(ctx, "ListOrders")
+	defer span.End()
+
+	fingerprint := filterFingerprint(filter)
+	after, err := decodePageToken(pageToken, fingerprint)
+	if err != nil {
+		return model.OrderPage{}, err
 	}
+	pageSize = normalizePageSize(pageSize)
 
-	orders, err := dao.GetOrdersByUserID(ctx, userID, s.pool)
+	// Читается на одну запись больше, чтобы узнать, есть ли следующая страница
+	cacheKey := fmt.Sprintf("orders_page_%s_%d_%d", fingerprint, after, pageSize)
+	orders, err := s.cache.GetOrLoadSlice(ctx, cacheKey, func(ctx context.Context) ([]model.Order, error) {
+		return s.orders.List(ctx, filter, after, pageSize+1)
+	}, cache.WithTags(allOrdersTag, orderListsTag))
 	if err != nil {
-		return nil, fmt.Errorf("ошибка получения заказов для пользователя с ID %d: %w", userID, err)
+		return model.OrderPage{}, fmt.Errorf("ошибка получения страницы заказов: %w", err)
 	}
 
-	if err := s.cache.SetSlice(ctx, cacheKey, orders); err != nil {
-		log.Printf("Ошибка сохранения заказов пользователя в кэше: %v", err)
+	page := model.OrderPage{Orders: orders}
+	if len(orders) > pageSize {
+		page.Orders = orders[:pageSize]
+		page.NextPageToken = encodePageToken(page.Orders[pageSize-1].OrderID, fingerprint)
 	}
+	return page, nil
+}
 
-	return orders, nil
+// listAllOrders читает все страницы списка заказов, подходящих под фильтр
+func (s *OrderService) listAllOrders(ctx context.Context, filter model.OrderFilter) ([]model.Order, error) {
+	var orders []model.Order
+	token := ""
+	for {
+		page, err := s.ListOrders(ctx, filter, maxPageSize, token)
+		if err != nil {
+			return nil, err
+		}
+		orders = append(orders, page.Orders...)
+		if page.NextPageToken == "" {
+			return orders, nil
+		}
+		token = page.NextPageToken
+	}
+}
+
+// GetOrderHistory возвращает историю смены статусов заказа в хронологическом порядке
+func (s *OrderService) GetOrderHistory(ctx context.Context, orderID int) ([]model.OrderStatusChange, error) {
+	ctx, span := s.tracer.Start(ctx, "GetOrderHistory")
+	defer span.End()
+
+	history, err := s.orders.GetStatusHistory(ctx, orderID)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка получения истории статусов заказа с ID %d: %w", orderID, err)
+	}
+	return history, nil
 }
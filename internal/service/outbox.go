@@ -0,0 +1,30 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	eventsv1 "homework1/internal/api/events/v1"
+	"homework1/internal/kafka"
+	"homework1/internal/model"
+	"homework1/internal/publisher"
+	"homework1/internal/repository"
+)
+
+// orderEvent возвращает хук, сохраняющий событие о заказе в outbox в транзакции основной операции.
+// Если у заказа нет ID, используется ID изменяемой записи; descriptionFormat содержит один %d для ID заказа.
+func orderEvent(producer publisher.EventPublisher, eventType eventsv1.EventType, order model.Order, descriptionFormat string) repository.Hook {
+	return repository.WithOutboxEvent(func(ctx context.Context, entityID int) (model.OutboxEvent, error) {
+		if order.OrderID == 0 {
+			order.OrderID = entityID
+		}
+		event := kafka.NewOrderEvent(eventType, order, fmt.Sprintf(descriptionFormat, order.OrderID))
+		return producer.NewOutboxEvent(ctx, event)
+	})
+}
+
+// returnEvent возвращает хук, сохраняющий событие о возврате в outbox в транзакции основной операции
+func returnEvent(producer publisher.EventPublisher, eventType eventsv1.EventType, ret model.Return, description string) repository.Hook {
+	return repository.WithOutboxEvent(func(ctx context.Context, _ int) (model.OutboxEvent, error) {
+		return producer.NewOutboxEvent(ctx, kafka.NewReturnEvent(eventType, ret, description))
+	})
+}
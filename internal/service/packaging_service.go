@@ -3,11 +3,10 @@ package service
 import (
 	"context"
 	"fmt"
-	"github.com/jackc/pgx/v4/pgxpool"
 	"homework1/internal/cache"
-	"homework1/internal/dao"
 	"homework1/internal/model"
 	"homework1/internal/pool"
+	"homework1/internal/repository"
 	"log"
 	"time"
 
@@ -17,19 +16,19 @@ import (
 
 // PackagingService представляет сервис для работы с упаковками
 type PackagingService struct {
-	pool   *pgxpool.Pool
-	wp     *pool.WorkerPool
-	cache  *cache.RedisCache[string, model.PackagingOption]
-	tracer trace.Tracer
+	packaging repository.PackagingRepository
+	wp        *pool.WorkerPool
+	cache     *cache.LoadingCache[string, model.PackagingOption]
+	tracer    trace.Tracer
 }
 
 // NewPackagingService создает новый сервис для работы с упаковками
-func NewPackagingService(dbPool *pgxpool.Pool, workerPool *pool.WorkerPool, cache *cache.RedisCache[string, model.PackagingOption]) *PackagingService {
+func NewPackagingService(store repository.Store, workerPool *pool.WorkerPool, cache *cache.LoadingCache[string, model.PackagingOption]) *PackagingService {
 	return &PackagingService{
-		pool:   dbPool,
-		wp:     workerPool,
-		cache:  cache,
-		tracer: tracing.GetTracer(),
+		packaging: store.Packaging,
+		wp:        workerPool,
+		cache:     cache,
+		tracer:    tracing.GetTracer(),
 	}
 }
 
@@ -38,37 +37,22 @@ func (s *PackagingService) CreatePackaging(ctx context.Context, packagingType st
 	ctx, span := s.tracer.Start(ctx, "CreatePackaging")
 	defer span.End()
 
-	var packagingID int
-	errCh := make(chan error, 1)
-
-	s.wp.SubmitTask(func() {
+	return pool.Submit(ctx, s.wp, func(ctx context.Context) (int, error) {
 		newPackaging := model.PackagingOption{
 			Type:      packagingType,
 			Cost:      cost,
 			MaxWeight: maxWeight,
 		}
 
-		var err error
-		packagingID, err = dao.CreatePackaging(ctx, newPackaging, s.pool)
+		packagingID, err := s.packaging.Create(ctx, newPackaging)
 		if err != nil {
-			errCh <- fmt.Errorf("ошибка создания упаковки: %w", err)
-			return
+			return 0, fmt.Errorf("ошибка создания упаковки: %w", err)
 		}
 
-		cacheKey := fmt.Sprintf("packaging_%d", packagingID)
-		if err := s.cache.Delete(ctx, cacheKey); err != nil {
-			log.Printf("Ошибка удаления кэша упаковки с ключом %s: %v", cacheKey, err)
-		}
+		invalidateTags(ctx, s.cache, packagingTag)
 
-		if err := s.cache.Delete(ctx, "all_packaging"); err != nil {
-			log.Printf("Ошибка удаления кэша всех упаковок: %v", err)
-		}
-
-		errCh <- nil
-	})
-
-	err := <-errCh
-	return packagingID, err
+		return packagingID, nil
+	}).Get(ctx)
 }
 
 // GetPackagingByID возвращает упаковку по её ID с использованием кэша
@@ -78,26 +62,18 @@ func (s *PackagingService) GetPackagingByID(ctx context.Context, packagingID int
 
 	cacheKey := fmt.Sprintf("packaging_%d", packagingID)
 
-	var cachedPackaging model.PackagingOption
-	err := s.cache.Get(ctx, cacheKey, &cachedPackaging)
-	if err == nil {
-		log.Printf("Упаковка с ID %d получена из кэша", packagingID)
-		return &cachedPackaging, nil
-	} else {
-		log.Printf("Упаковка с ID %d не найдена в кэше, получаем из базы данных", packagingID)
-	}
-
-	packaging, err := dao.GetPackagingByID(ctx, packagingID, s.pool)
+	packaging, err := s.cache.GetOrLoad(ctx, cacheKey, func(ctx context.Context) (model.PackagingOption, error) {
+		packaging, err := s.packaging.GetByID(ctx, packagingID)
+		if err != nil {
+			return model.PackagingOption{}, err
+		}
+		return *packaging, nil
+	}, cache.WithTTL(10*time.Minute), cache.WithTags(packagingTag))
 	if err != nil {
 		return nil, fmt.Errorf("ошибка получения упаковки с ID %d: %w", packagingID, err)
 	}
 
-	err = s.cache.Set(ctx, cacheKey, *packaging, 10*time.Minute) // Разыменование указателя
-	if err != nil {
-		log.Printf("Ошибка сохранения упаковки в кэш: %v", err)
-	}
-
-	return packaging, nil
+	return &packaging, nil
 }
 
 // GetAllPackaging возвращает все упаковки с использованием кэша
@@ -107,61 +83,38 @@ func (s *PackagingService) GetAllPackaging(ctx context.Context) ([]model.Packagi
 
 	cacheKey := "all_packaging"
 
-	var cachedPackagingOptions []model.PackagingOption
-	err := s.cache.GetSlice(ctx, cacheKey, &cachedPackagingOptions) // Используем новый метод для получения среза
-	if err == nil {
-		log.Println("Все упаковки получены из кэша")
-		return cachedPackagingOptions, nil
-	} else {
-		log.Println("Упаковки не найдены в кэше, получаем из базы данных")
-	}
-
-	packagingOptions, err := dao.GetAllPackaging(ctx, s.pool)
+	packagingOptions, err := s.cache.GetOrLoadSlice(ctx, cacheKey, s.packaging.GetAll, cache.WithTTL(10*time.Minute), cache.WithTags(packagingTag))
 	if err != nil {
 		return nil, fmt.Errorf("ошибка получения всех упаковок: %w", err)
 	}
 
-	err = s.cache.SetSlice(ctx, cacheKey, packagingOptions, 10*time.Minute) // Используем новый метод для сохранения среза
-	if err != nil {
-		log.Printf("Ошибка сохранения всех упаковок в кэш: %v", err)
-	}
-
 	log.Printf("Получены все упаковки: %+v", packagingOptions)
 	return packagingOptions, nil
 }
 
 // UpdatePackaging обновляет данные упаковки и сбрасывает кэш
-func (s *PackagingService) UpdatePackaging(ctx context.Context, packaging model.PackagingOption) {
+func (s *PackagingService) UpdatePackaging(ctx context.Context, packaging model.PackagingOption) error {
 	ctx, span := s.tracer.Start(ctx, "UpdatePackaging")
 	defer span.End()
 
-	s.wp.SubmitTask(func() {
-		existingPackaging, err := dao.GetPackagingByID(ctx, packaging.PackagingID, s.pool)
+	return pool.Run(ctx, s.wp, func(ctx context.Context) error {
+		existingPackaging, err := s.packaging.GetByID(ctx, packaging.PackagingID)
 		if err != nil {
-			log.Printf("Ошибка поиска упаковки с ID %d: %v", packaging.PackagingID, err)
-			return
+			return fmt.Errorf("ошибка поиска упаковки с ID %d: %w", packaging.PackagingID, err)
 		}
 
 		existingPackaging.Type = packaging.Type
 		existingPackaging.Cost = packaging.Cost
 		existingPackaging.MaxWeight = packaging.MaxWeight
 
-		if err := dao.UpdatePackaging(ctx, *existingPackaging, s.pool); err != nil {
-			log.Printf("Ошибка обновления упаковки с ID %d: %v", packaging.PackagingID, err)
-			return
+		if err := s.packaging.Update(ctx, *existingPackaging); err != nil {
+			return fmt.Errorf("ошибка обновления упаковки с ID %d: %w", packaging.PackagingID, err)
 		}
 
-		cacheKey := fmt.Sprintf("packaging_%d", packaging.PackagingID)
-		if err := s.cache.Delete(ctx, cacheKey); err != nil {
-			log.Printf("Ошибка удаления кэша упаковки с ключом %s: %v", cacheKey, err)
-		}
-
-		// Очищаем общий кэш всех упаковок
-		if err := s.cache.Delete(ctx, "all_packaging"); err != nil {
-			log.Printf("Ошибка удаления кэша всех упаковок: %v", err)
-		}
+		invalidateTags(ctx, s.cache, packagingTag)
 
 		log.Printf("Упаковка с ID %d обновлена", packaging.PackagingID)
+		return nil
 	})
 }
 
@@ -170,37 +123,23 @@ func (s *PackagingService) DeletePackaging(ctx context.Context, packagingID int)
 	ctx, span := s.tracer.Start(ctx, "DeletePackaging")
 	defer span.End()
 
-	errCh := make(chan error, 1)
-
-	s.wp.SubmitTask(func() {
-		exists, err := dao.CheckPackagingExists(ctx, packagingID, s.pool)
+	return pool.Run(ctx, s.wp, func(ctx context.Context) error {
+		exists, err := s.packaging.Exists(ctx, packagingID)
 		if err != nil {
-			errCh <- fmt.Errorf("ошибка проверки существования упаковки с ID %d: %w", packagingID, err)
-			return
+			return fmt.Errorf("ошибка проверки существования упаковки с ID %d: %w", packagingID, err)
 		}
 		if !exists {
-			errCh <- fmt.Errorf("упаковка с ID %d не найдена", packagingID)
-			return
+			return fmt.Errorf("упаковка с ID %d не найдена", packagingID)
 		}
 
-		if err := dao.DeletePackaging(ctx, packagingID, s.pool); err != nil {
-			errCh <- fmt.Errorf("ошибка удаления упаковки с ID %d: %w", packagingID, err)
-			return
+		if err := s.packaging.Delete(ctx, packagingID); err != nil {
+			return fmt.Errorf("ошибка удаления упаковки с ID %d: %w", packagingID, err)
 		}
 
-		cacheKey := fmt.Sprintf("packaging_%d", packagingID)
-		if err := s.cache.Delete(ctx, cacheKey); err != nil {
-			log.Printf("Ошибка удаления кэша упаковки с ключом %s: %v", cacheKey, err)
-		}
+		invalidateTags(ctx, s.cache, packagingTag)
 
-		if err := s.cache.Delete(ctx, "all_packaging"); err != nil {
-			log.Printf("Ошибка удаления кэша всех упаковок: %v", err)
-		}
-
-		errCh <- nil
+		return nil
 	})
-
-	return <-errCh
 }
 
 // GetPackagingIDByName возвращает ID упаковки по её имени через worker pool
@@ -208,7 +147,7 @@ func (s *PackagingService) GetPackagingIDByName(ctx context.Context, packagingTy
 	ctx, span := s.tracer.Start(ctx, "GetPackagingIDByName")
 	defer span.End()
 
-	packaging, err := dao.GetPackagingByName(ctx, packagingType, s.pool)
+	packaging, err := s.packaging.GetByName(ctx, packagingType)
 	if err != nil {
 		return 0, fmt.Errorf("ошибка получения ID упаковки по названию: %w", err)
 	}
@@ -222,7 +161,7 @@ func (s *PackagingService) CheckPackagingExists(ctx context.Context, packagingID
 	ctx, span := s.tracer.Start(ctx, "CheckPackagingExists")
 	defer span.End()
 
-	exists, err := dao.CheckPackagingExists(ctx, packagingID, s.pool)
+	exists, err := s.packaging.Exists(ctx, packagingID)
 	if err != nil {
 		return false, fmt.Errorf("ошибка проверки существования упаковки с ID %d: %w", packagingID, err)
 	}
@@ -230,3 +169,21 @@ func (s *PackagingService) CheckPackagingExists(ctx context.Context, packagingID
 	log.Printf("Упаковка с ID %d существует: %v", packagingID, exists)
 	return exists, nil
 }
+
+// WarmCache загружает в кэш справочник упаковки: он читается при каждом создании заказа
+func (s *PackagingService) WarmCache(ctx context.Context) error {
+	ctx, span := s.tracer.Start(ctx, "WarmPackagingCache")
+	defer span.End()
+
+	packagingOptions, err := s.GetAllPackaging(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, packaging := range packagingOptions {
+		if _, err := s.GetPackagingByID(ctx, packaging.PackagingID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+package service
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+const (
+	defaultPageSize = 50  // Размер страницы, если клиент его не задал
+	maxPageSize     = 500 // Наибольший размер страницы
+)
+
+// ErrInvalidPageToken возвращается для поврежденного курсора или курсора, выданного для другого фильтра
+var ErrInvalidPageToken = errors.New("недействительный курсор страницы")
+
+// pageToken - содержимое курсора страницы: ID последней записи предыдущей страницы и отпечаток фильтра
+type pageToken struct {
+	After  int    `json:"a"`
+	Filter string `json:"f"`
+}
+
+// filterFingerprint возвращает отпечаток фильтра для курсора и ключа кэша страницы
+func filterFingerprint(filter any) string {
+	data, err := json.Marshal(filter)
+	if err != nil {
+		panic(fmt.Sprintf("фильтр не сериализуется в JSON: %v", err))
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:8])
+}
+
+// encodePageToken кодирует курсор страницы, следующей за записью с ID after
+func encodePageToken(after int, fingerprint string) string {
+	data, _ := json.Marshal(pageToken{After: after, Filter: fingerprint})
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// decodePageToken возвращает ID записи, после которой начинается страница. Пустой курсор - первая страница.
+func decodePageToken(token, fingerprint string) (int, error) {
+	if token == "" {
+		return 0, nil
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, ErrInvalidPageToken
+	}
+	var decoded pageToken
+	if err := json.Unmarshal(data, &decoded); err != nil || decoded.After <= 0 {
+		return 0, ErrInvalidPageToken
+	}
+	if decoded.Filter != fingerprint {
+		return 0, fmt.Errorf("%w: курсор выдан для другого фильтра", ErrInvalidPageToken)
+	}
+	return decoded.After, nil
+}
+
+// normalizePageSize возвращает размер страницы с учетом значения по умолчанию и ограничения сверху
+func normalizePageSize(pageSize int) int {
+	switch {
+	case pageSize <= 0:
+		return defaultPageSize
+	case pageSize > maxPageSize:
+		return maxPageSize
+	default:
+		return pageSize
+	}
+}
@@ -3,11 +3,10 @@ package service
 import (
 	"context"
 	"fmt"
-	"github.com/jackc/pgx/v4/pgxpool"
 	"homework1/internal/cache"
-	"homework1/internal/dao"
 	"homework1/internal/model"
 	"homework1/internal/pool"
+	"homework1/internal/repository"
 	"log"
 	"time"
 
@@ -17,19 +16,19 @@ import (
 
 // ReturnReasonService представляет сервис для работы с причинами возврата
 type ReturnReasonService struct {
-	pool   *pgxpool.Pool
-	wp     *pool.WorkerPool
-	cache  *cache.RedisCache[string, model.ReturnReason]
-	tracer trace.Tracer
+	reasons repository.ReturnReasonRepository
+	wp      *pool.WorkerPool
+	cache   *cache.LoadingCache[string, model.ReturnReason]
+	tracer  trace.Tracer
 }
 
 // NewReturnReasonService создает новый сервис для работы с причинами возврата
-func NewReturnReasonService(dbPool *pgxpool.Pool, workerPool *pool.WorkerPool, cache *cache.RedisCache[string, model.ReturnReason]) *ReturnReasonService {
+func NewReturnReasonService(store repository.Store, workerPool *pool.WorkerPool, cache *cache.LoadingCache[string, model.ReturnReason]) *ReturnReasonService {
 	return &ReturnReasonService{
-		pool:   dbPool,
-		wp:     workerPool,
-		cache:  cache,
-		tracer: tracing.GetTracer(),
+		reasons: store.ReturnReasons,
+		wp:      workerPool,
+		cache:   cache,
+		tracer:  tracing.GetTracer(),
 	}
 }
 
@@ -38,35 +37,20 @@ func (s *ReturnReasonService) CreateReturnReason(ctx context.Context, reason str
 	ctx, span := s.tracer.Start(ctx, "CreateReturnReason")
 	defer span.End()
 
-	var reasonID int
-	errCh := make(chan error, 1)
-
-	s.wp.SubmitTask(func() {
+	return pool.Submit(ctx, s.wp, func(ctx context.Context) (int, error) {
 		newReason := model.ReturnReason{
 			Reason: reason,
 		}
 
-		var err error
-		reasonID, err = dao.CreateReturnReason(ctx, newReason, s.pool)
+		reasonID, err := s.reasons.Create(ctx, newReason)
 		if err != nil {
-			errCh <- fmt.Errorf("ошибка создания причины возврата: %w", err)
-			return
-		}
-
-		cacheKey := fmt.Sprintf("return_reason_%d", reasonID)
-		if err := s.cache.Delete(ctx, cacheKey); err != nil {
-			log.Printf("Ошибка удаления кэша причины возврата с ключом %s: %v", cacheKey, err)
-		}
-
-		if err := s.cache.Delete(ctx, "all_return_reasons"); err != nil {
-			log.Printf("Ошибка удаления кэша всех причин возврата: %v", err)
+			return 0, fmt.Errorf("ошибка создания причины возврата: %w", err)
 		}
 
-		errCh <- nil
-	})
+		invalidateTags(ctx, s.cache, returnReasonsTag)
 
-	err := <-errCh
-	return reasonID, err
+		return reasonID, nil
+	}).Get(ctx)
 }
 
 // GetReturnReasonByID возвращает причину возврата по её ID с использованием кэша
@@ -76,26 +60,18 @@ func (s *ReturnReasonService) GetReturnReasonByID(ctx context.Context, reasonID
 
 	cacheKey := fmt.Sprintf("return_reason_%d", reasonID)
 
-	var cachedReason model.ReturnReason
-	err := s.cache.Get(ctx, cacheKey, &cachedReason)
-	if err == nil {
-		log.Printf("Причина возврата с ID %d получена из кэша", reasonID)
-		return &cachedReason, nil
-	} else {
-		log.Printf("Причина возврата с ID %d не найдена в кэше, получаем из базы данных", reasonID)
-	}
-
-	reason, err := dao.GetReturnReasonByID(ctx, reasonID, s.pool)
+	reason, err := s.cache.GetOrLoad(ctx, cacheKey, func(ctx context.Context) (model.ReturnReason, error) {
+		reason, err := s.reasons.GetByID(ctx, reasonID)
+		if err != nil {
+			return model.ReturnReason{}, err
+		}
+		return *reason, nil
+	}, cache.WithTTL(10*time.Minute), cache.WithTags(returnReasonsTag))
 	if err != nil {
 		return nil, fmt.Errorf("ошибка получения причины возврата с ID %d: %w", reasonID, err)
 	}
 
-	err = s.cache.Set(ctx, cacheKey, *reason, 10*time.Minute)
-	if err != nil {
-		log.Printf("Ошибка сохранения причины возврата в кэш: %v", err)
-	}
-
-	return reason, nil
+	return &reason, nil
 }
 
 // GetAllReturnReasons возвращает все причины возвратов с использованием кэша
@@ -105,25 +81,11 @@ func (s *ReturnReasonService) GetAllReturnReasons(ctx context.Context) ([]model.
 
 	cacheKey := "all_return_reasons"
 
-	var cachedReasons []model.ReturnReason
-	err := s.cache.GetSlice(ctx, cacheKey, &cachedReasons)
-	if err == nil {
-		log.Println("Все причины возврата получены из кэша")
-		return cachedReasons, nil
-	} else {
-		log.Println("Причины возврата не найдены в кэше, получаем из базы данных")
-	}
-
-	reasons, err := dao.GetAllReturnReasons(ctx, s.pool)
+	reasons, err := s.cache.GetOrLoadSlice(ctx, cacheKey, s.reasons.GetAll, cache.WithTTL(10*time.Minute), cache.WithTags(returnReasonsTag))
 	if err != nil {
 		return nil, fmt.Errorf("ошибка получения всех причин возвратов: %w", err)
 	}
 
-	err = s.cache.SetSlice(ctx, cacheKey, reasons, 10*time.Minute)
-	if err != nil {
-		log.Printf("Ошибка сохранения всех причин возврата в кэш: %v", err)
-	}
-
 	log.Printf("Получены все причины возвратов: %+v", reasons)
 	return reasons, nil
 }
@@ -133,17 +95,13 @@ func (s *ReturnReasonService) UpdateReturnReason(ctx context.Context, reasonID i
 	ctx, span := s.tracer.Start(ctx, "UpdateReturnReason")
 	defer span.End()
 
-	errCh := make(chan error, 1)
-
-	s.wp.SubmitTask(func() {
-		exists, err := dao.CheckReturnReasonExists(ctx, reasonID, s.pool)
+	return pool.Run(ctx, s.wp, func(ctx context.Context) error {
+		exists, err := s.reasons.Exists(ctx, reasonID)
 		if err != nil {
-			errCh <- fmt.Errorf("ошибка проверки существования причины возврата с ID %d: %w", reasonID, err)
-			return
+			return fmt.Errorf("ошибка проверки существования причины возврата с ID %d: %w", reasonID, err)
 		}
 		if !exists {
-			errCh <- fmt.Errorf("причина возврата с ID %d не найдена", reasonID)
-			return
+			return fmt.Errorf("причина возврата с ID %d не найдена", reasonID)
 		}
 
 		updatedReason := model.ReturnReason{
@@ -151,24 +109,14 @@ func (s *ReturnReasonService) UpdateReturnReason(ctx context.Context, reasonID i
 			Reason:   reason,
 		}
 
-		if err := dao.UpdateReturnReason(ctx, updatedReason, s.pool); err != nil {
-			errCh <- fmt.Errorf("ошибка обновления причины возврата с ID %d: %w", reasonID, err)
-			return
+		if err := s.reasons.Update(ctx, updatedReason); err != nil {
+			return fmt.Errorf("ошибка обновления причины возврата с ID %d: %w", reasonID, err)
 		}
 
-		cacheKey := fmt.Sprintf("return_reason_%d", reasonID)
-		if err := s.cache.Delete(ctx, cacheKey); err != nil {
-			log.Printf("Ошибка удаления кэша причины возврата с ключом %s: %v", cacheKey, err)
-		}
-
-		if err := s.cache.Delete(ctx, "all_return_reasons"); err != nil {
-			log.Printf("Ошибка удаления кэша всех причин возврата: %v", err)
-		}
+		invalidateTags(ctx, s.cache, returnReasonsTag)
 
-		errCh <- nil
+		return nil
 	})
-
-	return <-errCh
 }
 
 // DeleteReturnReason удаляет причину возврата и сбрасывает кэш
@@ -176,37 +124,23 @@ func (s *ReturnReasonService) DeleteReturnReason(ctx context.Context, reasonID i
 	ctx, span := s.tracer.Start(ctx, "DeleteReturnReason")
 	defer span.End()
 
-	errCh := make(chan error, 1)
-
-	s.wp.SubmitTask(func() {
-		exists, err := dao.CheckReturnReasonExists(ctx, reasonID, s.pool)
+	return pool.Run(ctx, s.wp, func(ctx context.Context) error {
+		exists, err := s.reasons.Exists(ctx, reasonID)
 		if err != nil {
-			errCh <- fmt.Errorf("ошибка проверки существования причины возврата с ID %d: %w", reasonID, err)
-			return
+			return fmt.Errorf("ошибка проверки существования причины возврата с ID %d: %w", reasonID, err)
 		}
 		if !exists {
-			errCh <- fmt.Errorf("причина возврата с ID %d не найдена", reasonID)
-			return
+			return fmt.Errorf("причина возврата с ID %d не найдена", reasonID)
 		}
 
-		if err := dao.DeleteReturnReason(ctx, reasonID, s.pool); err != nil {
-			errCh <- fmt.Errorf("ошибка удаления причины возврата с ID %d: %w", reasonID, err)
-			return
+		if err := s.reasons.Delete(ctx, reasonID); err != nil {
+			return fmt.Errorf("ошибка удаления причины возврата с ID %d: %w", reasonID, err)
 		}
 
-		cacheKey := fmt.Sprintf("return_reason_%d", reasonID)
-		if err := s.cache.Delete(ctx, cacheKey); err != nil {
-			log.Printf("Ошибка удаления кэша причины возврата с ключом %s: %v", cacheKey, err)
-		}
-
-		if err := s.cache.Delete(ctx, "all_return_reasons"); err != nil {
-			log.Printf("Ошибка удаления кэша всех причин возврата: %v", err)
-		}
+		invalidateTags(ctx, s.cache, returnReasonsTag)
 
-		errCh <- nil
+		return nil
 	})
-
-	return <-errCh
 }
 
 // CheckReturnReasonExists проверяет существование причины возврата через worker pool
@@ -214,7 +148,7 @@ func (s *ReturnReasonService) CheckReturnReasonExists(ctx context.Context, reaso
 	ctx, span := s.tracer.Start(ctx, "CheckReturnReasonExists")
 	defer span.End()
 
-	exists, err := dao.CheckReturnReasonExists(ctx, reasonID, s.pool)
+	exists, err := s.reasons.Exists(ctx, reasonID)
 	if err != nil {
 		return false, fmt.Errorf("ошибка проверки существования причины возврата с ID %d: %w", reasonID, err)
 	}
@@ -222,3 +156,21 @@ func (s *ReturnReasonService) CheckReturnReasonExists(ctx context.Context, reaso
 	log.Printf("Причина возврата с ID %d существует: %v", reasonID, exists)
 	return exists, nil
 }
+
+// WarmCache загружает в кэш справочник причин возврата
+func (s *ReturnReasonService) WarmCache(ctx context.Context) error {
+	ctx, span := s.tracer.Start(ctx, "WarmReturnReasonCache")
+	defer span.End()
+
+	reasons, err := s.GetAllReturnReasons(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, reason := range reasons {
+		if _, err := s.GetReturnReasonByID(ctx, reason.ReasonID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
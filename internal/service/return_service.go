@@ -3,13 +3,13 @@ package service
 import (
 	"context"
 	"fmt"
-	"github.com/jackc/pgx/v4/pgxpool"
+	eventsv1 "homework1/internal/api/events/v1"
 	"homework1/internal/cache"
-	"homework1/internal/dao"
 	"homework1/internal/kafka"
 	"homework1/internal/model"
 	"homework1/internal/pool"
-	"log"
+	"homework1/internal/publisher"
+	"homework1/internal/repository"
 	"time"
 
 	"go.opentelemetry.io/otel/trace"
@@ -18,106 +18,158 @@ import (
 
 // ReturnService представляет сервис для работы с возвратами
 type ReturnService struct {
-	pool     *pgxpool.Pool
-	wp       *pool.WorkerPool
-	producer *kafka.Producer
-	cache    *cache.RedisCache[string, model.Return]
-	tracer   trace.Tracer
+	returns   repository.ReturnRepository
+	orders    repository.OrderRepository
+	reasons   repository.ReturnReasonRepository
+	tx        repository.Transactor
+	wp        *pool.WorkerPool
+	producer  publisher.EventPublisher
+	cache     *cache.LoadingCache[string, model.Return]
+	tracer    trace.Tracer
+	lifecycle orderLifecycle
 }
 
 // NewReturnService создает новый сервис для работы с возвратами
-func NewReturnService(dbPool *pgxpool.Pool, workerPool *pool.WorkerPool, producer *kafka.Producer, cache *cache.RedisCache[string, model.Return]) *ReturnService {
+func NewReturnService(store repository.Store, workerPool *pool.WorkerPool, producer publisher.EventPublisher, cache *cache.LoadingCache[string, model.Return]) *ReturnService {
 	return &ReturnService{
-		pool:     dbPool,
-		wp:       workerPool,
-		producer: producer,
-		cache:    cache,
-		tracer:   tracing.GetTracer(),
+		returns:   store.Returns,
+		orders:    store.Orders,
+		reasons:   store.ReturnReasons,
+		tx:        store.Tx,
+		wp:        workerPool,
+		producer:  producer,
+		cache:     cache,
+		tracer:    tracing.GetTracer(),
+		lifecycle: orderLifecycle{statuses: store.Statuses},
 	}
 }
 
-// CreateReturn создает новый возврат через общий worker pool
+// CreateReturn создает новый возврат через общий worker pool.
+// Возврат и смена статуса заказа сохраняются вместе: при ошибке заказ остается без изменений.
+// Событие об ошибке публикуется один раз после завершения транзакции, поэтому повтор транзакции его не дублирует.
 func (s *ReturnService) CreateReturn(ctx context.Context, orderID int) error {
 	ctx, span := s.tracer.Start(ctx, "CreateReturn")
 	defer span.End()
 
-	errCh := make(chan error, 1)
-
-	s.wp.SubmitTask(func() {
-		order, err := dao.GetOrderByID(ctx, orderID, s.pool)
+	return pool.Run(ctx, s.wp, func(ctx context.Context) error {
+		// Чтение заказа, создание возврата, смена статуса и событие в outbox выполняются одной транзакцией
+		var order *model.Order
+		err := s.tx.RunInTx(ctx, repository.RepeatableRead, func(ctx context.Context) error {
+			var err error
+			order, err = s.orders.GetByID(ctx, orderID)
+			if err != nil {
+				return fmt.Errorf("ошибка получения заказа с ID %d: %w", orderID, err)
+			}
+
+			// Срок возврата отсчитывается от даты выдачи, поэтому сначала проверяется, что заказ выдан
+			if err := s.lifecycle.requireState(ctx, order.StatusID, model.OrderStateIssued); err != nil {
+				return fmt.Errorf("возврат заказа с ID %d невозможен: %w", orderID, err)
+			}
+			if time.Since(order.IssueDate).Hours() > 48 {
+				return fmt.Errorf("возврат заказа с ID %d невозможен, так как прошло более двух дней с момента выдачи", orderID)
+			}
+
+			reason, err := s.reasons.GetByName(ctx, "Вернул покупатель")
+			if err != nil {
+				return fmt.Errorf("ошибка получения причины возврата 'Вернул покупатель': %w", err)
+			}
+			reasonID := reason.ReasonID
+
+			returnedStatusID, err := s.lifecycle.transition(ctx, order.StatusID, model.OrderStateReturned)
+			if err != nil {
+				return fmt.Errorf("возврат заказа с ID %d невозможен: %w", orderID, err)
+			}
+
+			newReturn := model.Return{
+				OrderID:       orderID,
+				UserID:        order.UserID,
+				ReturnDate:    time.Now().UTC(),
+				ReasonID:      reasonID,
+				BaseCost:      order.BaseCost,
+				PackagingCost: order.PackagingCost,
+				PackagingID:   order.PackagingID,
+				TotalCost:     order.TotalCost,
+				StatusID:      returnedStatusID,
+			}
+
+			if err := s.returns.Create(ctx, newReturn); err != nil {
+				return fmt.Errorf("ошибка создания возврата: %w", err)
+			}
+
+			change := newStatusChange(ctx, orderID, order.StatusID, returnedStatusID, "возврат заказа покупателем")
+			order.StatusID = returnedStatusID
+			description := fmt.Sprintf("Возврат создан для заказа %d", orderID)
+			created := returnEvent(s.producer, eventsv1.EventType_EVENT_TYPE_RETURN_CREATED, newReturn, description)
+			if err := s.orders.Update(ctx, *order, repository.WithStatusHistory(change), created); err != nil {
+				return fmt.Errorf("ошибка обновления статуса заказа с ID %d: %w", orderID, err)
+			}
+			return nil
+		})
 		if err != nil {
-			s.handleKafkaError("create_return", orderID, fmt.Sprintf("ошибка получения заказа с ID %d: %v", orderID, err))
-			errCh <- fmt.Errorf("ошибка получения заказа с ID %d: %v", orderID, err)
-			return
+			s.handleKafkaError("create_return", orderID, err.Error())
+			return err
 		}
 
-		if time.Since(order.IssueDate).Hours() > 48 {
-			s.handleKafkaError("create_return", orderID, fmt.Sprintf("возврат заказа с ID %d невозможен, так как прошло более двух дней с момента выдачи", orderID))
-			errCh <- fmt.Errorf("возврат заказа с ID %d невозможен, так как прошло более двух дней с момента выдачи", orderID)
-			return
-		}
+		// Сбрасываются кэш заказа и кэш его возврата
+		s.invalidateOrderCache(ctx, *order)
 
-		reason, err := dao.GetReturnReasonByName(ctx, "Вернул покупатель", s.pool)
-		if err != nil {
-			s.handleKafkaError("create_return", orderID, fmt.Sprintf("ошибка получения причины возврата 'Вернул покупатель': %v", err))
-			errCh <- fmt.Errorf("ошибка получения причины возврата 'Вернул покупатель': %v", err)
-			return
-		}
-		reasonID := reason.ReasonID
+		return nil
+	})
+}
+
+// ProcessReturn передает возврат курьеру, переводя заказ и возврат в состояние "передан курьеру"
+func (s *ReturnService) ProcessReturn(ctx context.Context, orderID int) error {
+	ctx, span := s.tracer.Start(ctx, "ProcessReturn")
+	defer span.End()
 
-		status, err := dao.GetStatusByName(ctx, "Возврат", s.pool)
+	// Возврат и заказ переводятся в новое состояние одной транзакцией
+	var order *model.Order
+	err := s.tx.RunInTx(ctx, repository.RepeatableRead, func(ctx context.Context) error {
+		ret, err := s.returns.GetByOrderID(ctx, orderID)
 		if err != nil {
-			s.handleKafkaError("create_return", orderID, fmt.Sprintf("ошибка получения статуса 'возврат': %v", err))
-			errCh <- fmt.Errorf("ошибка получения статуса 'возврат': %v", err)
-			return
+			return fmt.Errorf("ошибка поиска возврата для заказа с ID %d: %w", orderID, err)
 		}
 
-		newReturn := model.Return{
-			OrderID:       orderID,
-			UserID:        order.UserID,
-			ReturnDate:    time.Now().UTC(),
-			ReasonID:      reasonID,
-			BaseCost:      order.BaseCost,
-			PackagingCost: order.PackagingCost,
-			PackagingID:   order.PackagingID,
-			TotalCost:     order.TotalCost,
-			StatusID:      status.StatusID,
+		order, err = s.orders.GetByID(ctx, orderID)
+		if err != nil {
+			return fmt.Errorf("ошибка получения заказа с ID %d: %w", orderID, err)
 		}
 
-		if err := dao.WriteReturns(ctx, []model.Return{newReturn}, s.pool); err != nil {
-			s.handleKafkaError("create_return", orderID, fmt.Sprintf("ошибка создания возврата: %v", err))
-			errCh <- fmt.Errorf("ошибка создания возврата: %v", err)
-			return
+		courierStatusID, err := s.lifecycle.transition(ctx, order.StatusID, model.OrderStateHandedToCourier)
+		if err != nil {
+			return fmt.Errorf("возврат заказа с ID %d не может быть передан курьеру: %w", orderID, err)
 		}
 
-		// Сбрасываем кэш для данного возврата
-		cacheKey := fmt.Sprintf("return_%d", orderID)
-		if err := s.cache.Delete(ctx, cacheKey); err != nil {
-			log.Printf("Ошибка удаления кэша возврата с ключом %s: %v", cacheKey, err)
+		if err := s.updateReturn(ctx, ret.ReturnID, ret.OrderID, ret.UserID, ret.ReasonID, ret.BaseCost,
+			ret.PackagingCost, ret.TotalCost, ret.PackagingID, courierStatusID); err != nil {
+			return err
 		}
 
-		message := kafka.OrderMessage{
-			TimeStamp:   time.Now(),
-			Operation:   "Create Return",
-			OrderID:     orderID,
-			Description: fmt.Sprintf("Возврат создан для заказа %d", orderID),
+		change := newStatusChange(ctx, orderID, order.StatusID, courierStatusID, "передача возврата курьеру")
+		order.StatusID = courierStatusID
+		if err := s.orders.Update(ctx, *order, repository.WithStatusHistory(change)); err != nil {
+			return fmt.Errorf("ошибка обновления статуса заказа с ID %d: %w", orderID, err)
 		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
 
-		if err := s.producer.SendOrderMessage(message); err != nil {
-			s.handleKafkaError("create_return", orderID, fmt.Sprintf("ошибка отправки сообщения в Kafka: %v", err))
-			errCh <- fmt.Errorf("ошибка отправки сообщения в Kafka: %v", err)
-			return
-		}
+	// Возврат мог быть прочитан в кэш до подтверждения транзакции, поэтому сбрасывается и он
+	s.invalidateOrderCache(ctx, *order)
 
-		errCh <- nil
-	})
+	return nil
+}
 
-	return <-errCh
+// invalidateOrderCache сбрасывает кэш заказа и его возврата после смены статуса заказа
+func (s *ReturnService) invalidateOrderCache(ctx context.Context, order model.Order) {
+	invalidateTags(ctx, s.cache, orderChangeTags(order.OrderID, order.UserID)...)
 }
 
-// handleKafkaError отправляет сообщение об ошибке в Kafka
+// handleKafkaError публикует событие об ошибке
 func (s *ReturnService) handleKafkaError(operation string, orderID int, errMsg string) {
-	if kafkaErr := s.producer.SendKafkaErrorMessage(operation, orderID, errMsg); kafkaErr != nil {
+	if kafkaErr := s.producer.SendEvent(context.Background(), kafka.NewErrorEvent(operation, orderID, errMsg)); kafkaErr != nil {
 		fmt.Printf("Ошибка отправки сообщения в Kafka: %v\n", kafkaErr)
 	}
 }
@@ -127,53 +179,45 @@ func (s *ReturnService) UpdateReturn(ctx context.Context, returnID, orderID, use
 	ctx, span := s.tracer.Start(ctx, "UpdateReturn")
 	defer span.End()
 
-	errCh := make(chan error, 1)
-
-	s.wp.SubmitTask(func() {
-		ret, err := dao.FindReturnByOrderID(ctx, orderID, s.pool)
-		if err != nil {
-			s.handleKafkaError("update_return", orderID, fmt.Sprintf("ошибка поиска возврата с ID %d: %v", returnID, err))
-			errCh <- fmt.Errorf("ошибка поиска возврата с ID %d: %v", returnID, err)
-			return
-		}
-
-		ret.UserID = userID
-		ret.ReasonID = reasonID
-		ret.BaseCost = baseCost
-		ret.PackagingCost = packagingCost
-		ret.TotalCost = totalCost
-		ret.PackagingID = packagingID
-		ret.StatusID = statusID
-		ret.ReturnDate = time.Now().UTC()
-
-		if err := dao.UpdateReturn(ctx, *ret, s.pool); err != nil {
-			s.handleKafkaError("update_return", orderID, fmt.Sprintf("ошибка обновления возврата с ID %d: %v", returnID, err))
-			errCh <- fmt.Errorf("ошибка обновления возврата с ID %d: %v", returnID, err)
-			return
+	return pool.Run(ctx, s.wp, func(ctx context.Context) error {
+		if err := s.updateReturn(ctx, returnID, orderID, userID, reasonID, baseCost, packagingCost, totalCost, packagingID, statusID); err != nil {
+			s.handleKafkaError("update_return", orderID, err.Error())
+			return err
 		}
 
-		cacheKey := fmt.Sprintf("return_%d", orderID)
-		if err := s.cache.Delete(ctx, cacheKey); err != nil {
-			log.Printf("Ошибка удаления кэша возврата с ключом %s: %v", cacheKey, err)
-		}
+		invalidateTags(ctx, s.cache, orderTag(orderID))
 
-		message := kafka.OrderMessage{
-			TimeStamp:   time.Now(),
-			Operation:   "Update Return",
-			OrderID:     orderID,
-			Description: fmt.Sprintf("Возврат обновлен для заказа %d", orderID),
-		}
+		return nil
+	})
+}
 
-		if err := s.producer.SendOrderMessage(message); err != nil {
-			s.handleKafkaError("update_return", orderID, fmt.Sprintf("ошибка отправки сообщения в Kafka: %v", err))
-			errCh <- fmt.Errorf("ошибка отправки сообщения в Kafka: %v", err)
-			return
-		}
+// updateReturn проверяет смену статуса возврата заказа orderID и сохраняет его новые данные вместе с событием в outbox.
+// Выполняется в текущей горутине и не публикует событий напрямую, поэтому может вызываться внутри единицы работы.
+func (s *ReturnService) updateReturn(ctx context.Context, returnID, orderID, userID, reasonID int, baseCost, packagingCost, totalCost float64, packagingID, statusID int) error {
+	ret, err := s.returns.GetByOrderID(ctx, orderID)
+	if err != nil {
+		return fmt.Errorf("ошибка поиска возврата с ID %d: %w", returnID, err)
+	}
 
-		errCh <- nil
-	})
+	if err := s.lifecycle.checkStatusChange(ctx, ret.StatusID, statusID); err != nil {
+		return fmt.Errorf("ошибка смены статуса возврата с ID %d: %w", returnID, err)
+	}
 
-	return <-errCh
+	ret.UserID = userID
+	ret.ReasonID = reasonID
+	ret.BaseCost = baseCost
+	ret.PackagingCost = packagingCost
+	ret.TotalCost = totalCost
+	ret.PackagingID = packagingID
+	ret.StatusID = statusID
+	ret.ReturnDate = time.Now().UTC()
+
+	description := fmt.Sprintf("Возврат обновлен для заказа %d", orderID)
+	updated := returnEvent(s.producer, eventsv1.EventType_EVENT_TYPE_RETURN_UPDATED, *ret, description)
+	if err := s.returns.Update(ctx, *ret, updated); err != nil {
+		return fmt.Errorf("ошибка обновления возврата с ID %d: %w", returnID, err)
+	}
+	return nil
 }
 
 // DeleteReturn удаляет возврат через общий worker pool
@@ -181,57 +225,43 @@ func (s *ReturnService) DeleteReturn(ctx context.Context, returnID int) error {
 	ctx, span := s.tracer.Start(ctx, "DeleteReturn")
 	defer span.End()
 
-	errCh := make(chan error, 1)
-
-	s.wp.SubmitTask(func() {
-		exists, err := dao.CheckReturnExists(ctx, returnID, s.pool)
+	return pool.Run(ctx, s.wp, func(ctx context.Context) error {
+		exists, err := s.returns.Exists(ctx, returnID)
 		if err != nil {
 			s.handleKafkaError("delete_return", returnID, fmt.Sprintf("ошибка проверки существования возврата с ID %d: %v", returnID, err))
-			errCh <- fmt.Errorf("ошибка проверки существования возврата с ID %d: %v", returnID, err)
-			return
+			return fmt.Errorf("ошибка проверки существования возврата с ID %d: %v", returnID, err)
 		}
 		if !exists {
 			s.handleKafkaError("delete_return", returnID, fmt.Sprintf("возврат с ID %d не найден", returnID))
-			errCh <- fmt.Errorf("возврат с ID %d не найден", returnID)
-			return
+			return fmt.Errorf("возврат с ID %d не найден", returnID)
 		}
 
-		if err := dao.DeleteReturn(ctx, returnID, s.pool); err != nil {
-			s.handleKafkaError("delete_return", returnID, fmt.Sprintf("ошибка удаления возврата с ID %d: %v", returnID, err))
-			errCh <- fmt.Errorf("ошибка удаления возврата с ID %d: %v", returnID, err)
-			return
-		}
-
-		cacheKey := fmt.Sprintf("return_%d", returnID)
-		if err := s.cache.Delete(ctx, cacheKey); err != nil {
-			log.Printf("Ошибка удаления кэша возврата с ключом %s: %v", cacheKey, err)
+		ret, err := s.returns.GetByID(ctx, returnID)
+		if err != nil {
+			s.handleKafkaError("delete_return", returnID, fmt.Sprintf("ошибка получения возврата с ID %d: %v", returnID, err))
+			return fmt.Errorf("ошибка получения возврата с ID %d: %w", returnID, err)
 		}
 
-		message := kafka.OrderMessage{
-			TimeStamp:   time.Now(),
-			Operation:   "Delete Return",
-			OrderID:     returnID,
-			Description: fmt.Sprintf("Возврат с ID %d удален", returnID),
+		description := fmt.Sprintf("Возврат с ID %d удален", returnID)
+		deleted := returnEvent(s.producer, eventsv1.EventType_EVENT_TYPE_RETURN_DELETED, *ret, description)
+		if err := s.returns.Delete(ctx, returnID, deleted); err != nil {
+			s.handleKafkaError("delete_return", returnID, fmt.Sprintf("ошибка удаления возврата с ID %d: %v", returnID, err))
+			return fmt.Errorf("ошибка удаления возврата с ID %d: %v", returnID, err)
 		}
 
-		if err := s.producer.SendOrderMessage(message); err != nil {
-			s.handleKafkaError("delete_return", returnID, fmt.Sprintf("ошибка отправки сообщения в Kafka: %v", err))
-			errCh <- fmt.Errorf("ошибка отправки сообщения в Kafka: %v", err)
-			return
-		}
+		invalidateTags(ctx, s.cache, orderTag(ret.OrderID))
 
-		errCh <- nil
+		return nil
 	})
-
-	return <-errCh
 }
 
-// GetReturns возвращает все возвраты через worker pool
+// GetReturns возвращает все возвраты, последовательно читая страницы списка.
+// Оставлен для совместимости со старыми клиентами; новые клиенты используют ListReturns.
 func (s *ReturnService) GetReturns(ctx context.Context) ([]model.Return, error) {
 	ctx, span := s.tracer.Start(ctx, "GetReturns")
 	defer span.End()
 
-	returns, err := dao.ReadReturns(ctx, s.pool)
+	returns, err := s.listAllReturns(ctx, model.ReturnFilter{})
 	if err != nil {
 		return nil, fmt.Errorf("ошибка чтения возвратов: %w", err)
 	}
@@ -244,37 +274,73 @@ func (s *ReturnService) GetReturnByOrderID(ctx context.Context, orderID int) (*m
 	defer span.End()
 
 	cacheKey := fmt.Sprintf("return_%d", orderID)
-
-	var cachedReturn model.Return
-	err := s.cache.Get(ctx, cacheKey, &cachedReturn)
-	if err == nil {
-		log.Printf("Возврат с ID заказа %d получен из кэша", orderID)
-		return &cachedReturn, nil
-	} else {
-		log.Printf("Возврат с ID заказа %d не найден в кэше, получаем из базы данных", orderID)
-	}
-
-	ret, err := dao.FindReturnByOrderID(ctx, orderID, s.pool)
+	ret, err := s.cache.GetOrLoad(ctx, cacheKey, func(ctx context.Context) (model.Return, error) {
+		ret, err := s.returns.GetByOrderID(ctx, orderID)
+		if err != nil {
+			return model.Return{}, err
+		}
+		return *ret, nil
+	}, cache.WithTTL(10*time.Minute), cache.WithTags(orderTag(orderID)))
 	if err != nil {
 		return nil, fmt.Errorf("ошибка поиска возврата для заказа с ID %d: %w", orderID, err)
 	}
 
-	err = s.cache.Set(ctx, cacheKey, *ret, 10*time.Minute)
-	if err != nil {
-		log.Printf("Ошибка сохранения возврата в кэш: %v", err)
-	}
-
-	return ret, nil
+	return &ret, nil
 }
 
-// GetReturnsByUserID возвращает возвраты по ID пользователя через worker pool
+// GetReturnsByUserID возвращает возвраты по ID пользователя, последовательно читая страницы списка.
+// Оставлен для совместимости со старыми клиентами; новые клиенты используют ListReturns.
 func (s *ReturnService) GetReturnsByUserID(ctx context.Context, userID int) ([]model.Return, error) {
 	ctx, span := s.tracer.Start(ctx, "GetReturnsByUserID")
 	defer span.End()
 
-	returns, err := dao.FindReturnsByUserID(ctx, userID, s.pool)
+	returns, err := s.listAllReturns(ctx, model.ReturnFilter{UserID: userID})
 	if err != nil {
 		return nil, fmt.Errorf("ошибка поиска возвратов для пользователя с ID %d: %w", userID, err)
 	}
 	return returns, nil
 }
+
+// ListReturns возвращает страницу возвратов, подходящих под фильтр, в порядке возрастания ID.
+// pageSize 0 означает размер по умолчанию; pageToken - курсор из предыдущей страницы, пустой для первой.
+func (s *ReturnService) ListReturns(ctx context.Context, filter model.ReturnFilter, pageSize int, pageToken string) (model.ReturnPage, error) {
+	ctx, span := s.tracer.Start(ctx, "ListReturns")
+	defer span.End()
+
+	fingerprint := filterFingerprint(filter)
+	after, err := decodePageToken(pageToken, fingerprint)
+	if err != nil {
+		return model.ReturnPage{}, err
+	}
+	pageSize = normalizePageSize(pageSize)
+
+	// Читается на одну запись больше, чтобы узнать, есть ли следующая страница
+	returns, err := s.returns.List(ctx, filter, after, pageSize+1)
+	if err != nil {
+		return model.ReturnPage{}, fmt.Errorf("ошибка получения страницы возвратов: %w", err)
+	}
+
+	page := model.ReturnPage{Returns: returns}
+	if len(returns) > pageSize {
+		page.Returns = returns[:pageSize]
+		page.NextPageToken = encodePageToken(page.Returns[pageSize-1].ReturnID, fingerprint)
+	}
+	return page, nil
+}
+
+// listAllReturns читает все страницы списка возвратов, подходящих под фильтр
+func (s *ReturnService) listAllReturns(ctx context.Context, filter model.ReturnFilter) ([]model.Return, error) {
+	var returns []model.Return
+	token := ""
+	for {
+		page, err := s.ListReturns(ctx, filter, maxPageSize, token)
+		if err != nil {
+			return nil, err
+		}
+		returns = append(returns, page.Returns...)
+		if page.NextPageToken == "" {
+			return returns, nil
+		}
+		token = page.NextPageToken
+	}
+}
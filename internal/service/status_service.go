@@ -2,12 +2,12 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"github.com/jackc/pgx/v4/pgxpool"
 	"homework1/internal/cache"
-	"homework1/internal/dao"
 	"homework1/internal/model"
 	"homework1/internal/pool"
+	"homework1/internal/repository"
 	"log"
 	"time"
 
@@ -17,19 +17,19 @@ import (
 
 // StatusService представляет сервис для работы со статусами
 type StatusService struct {
-	pool   *pgxpool.Pool
-	wp     *pool.WorkerPool
-	cache  *cache.RedisCache[string, model.Status]
-	tracer trace.Tracer
+	statuses repository.StatusRepository
+	wp       *pool.WorkerPool
+	cache    *cache.LoadingCache[string, model.Status]
+	tracer   trace.Tracer
 }
 
 // NewStatusService создает новый сервис для работы со статусами
-func NewStatusService(dbPool *pgxpool.Pool, workerPool *pool.WorkerPool, cache *cache.RedisCache[string, model.Status]) *StatusService {
+func NewStatusService(store repository.Store, workerPool *pool.WorkerPool, cache *cache.LoadingCache[string, model.Status]) *StatusService {
 	return &StatusService{
-		pool:   dbPool,
-		wp:     workerPool,
-		cache:  cache,
-		tracer: tracing.GetTracer(),
+		statuses: store.Statuses,
+		wp:       workerPool,
+		cache:    cache,
+		tracer:   tracing.GetTracer(),
 	}
 }
 
@@ -38,31 +38,21 @@ func (s *StatusService) CreateStatus(ctx context.Context, statusName string) (in
 	ctx, span := s.tracer.Start(ctx, "CreateStatus")
 	defer span.End()
 
-	var statusID int
-	errCh := make(chan error, 1)
-
-	s.wp.SubmitTask(func() {
+	return pool.Submit(ctx, s.wp, func(ctx context.Context) (int, error) {
 		newStatus := model.Status{
 			StatusName: statusName,
 		}
 
-		var err error
-		statusID, err = dao.CreateStatus(ctx, newStatus, s.pool)
+		statusID, err := s.statuses.Create(ctx, newStatus)
 		if err != nil {
-			errCh <- fmt.Errorf("ошибка создания статуса: %w", err)
-			return
-		}
-
-		cacheKey := fmt.Sprintf("status_%d", statusID)
-		if err := s.cache.Delete(ctx, cacheKey); err != nil {
-			log.Printf("Ошибка удаления кэша статуса с ключом %s: %v", cacheKey, err)
+			return 0, fmt.Errorf("ошибка создания статуса: %w", err)
 		}
 
-		errCh <- nil
-	})
+		// Сбрасывается и отметка об отсутствии статуса с таким именем
+		s.invalidateStatus(ctx)
 
-	err := <-errCh
-	return statusID, err
+		return statusID, nil
+	}).Get(ctx)
 }
 
 // GetStatusByID возвращает статус по его ID с использованием кэша
@@ -71,28 +61,18 @@ func (s *StatusService) GetStatusByID(ctx context.Context, statusID int) (*model
 	defer span.End()
 
 	cacheKey := fmt.Sprintf("status_%d", statusID)
-
-	var cachedStatus model.Status
-	err := s.cache.Get(ctx, cacheKey, &cachedStatus)
-	if err == nil {
-		log.Printf("Статус с ID %d получен из кэша", statusID)
-		return &cachedStatus, nil
-	} else {
-		log.Printf("Статус с ID %d не найден в кэше, получаем из базы данных", statusID)
-	}
-
-	status, err := dao.GetStatusByID(ctx, statusID, s.pool)
+	status, err := s.cache.GetOrLoad(ctx, cacheKey, func(ctx context.Context) (model.Status, error) {
+		status, err := s.statuses.GetByID(ctx, statusID)
+		if err != nil {
+			return model.Status{}, err
+		}
+		return *status, nil
+	}, cache.WithTTL(10*time.Minute), cache.WithTags(statusesTag))
 	if err != nil {
 		return nil, fmt.Errorf("ошибка получения статуса с ID %d: %w", statusID, err)
 	}
 
-	// Сохраняем в кэш на 10 минут
-	err = s.cache.Set(ctx, cacheKey, *status, 10*time.Minute)
-	if err != nil {
-		log.Printf("Ошибка сохранения статуса в кэш: %v", err)
-	}
-
-	return status, nil
+	return &status, nil
 }
 
 // GetAllStatuses возвращает все статусы через worker pool
@@ -100,7 +80,7 @@ func (s *StatusService) GetAllStatuses(ctx context.Context) ([]model.Status, err
 	ctx, span := s.tracer.Start(ctx, "GetAllStatuses")
 	defer span.End()
 
-	statuses, err := dao.GetAllStatuses(ctx, s.pool)
+	statuses, err := s.statuses.GetAll(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("ошибка получения всех статусов: %w", err)
 	}
@@ -114,17 +94,9 @@ func (s *StatusService) UpdateStatus(ctx context.Context, statusID int, statusNa
 	ctx, span := s.tracer.Start(ctx, "UpdateStatus")
 	defer span.End()
 
-	errCh := make(chan error, 1)
-
-	s.wp.SubmitTask(func() {
-		exists, err := dao.CheckStatusExists(ctx, statusID, s.pool)
-		if err != nil {
-			errCh <- fmt.Errorf("ошибка проверки существования статуса с ID %d: %w", statusID, err)
-			return
-		}
-		if !exists {
-			errCh <- fmt.Errorf("статус с ID %d не найден", statusID)
-			return
+	return pool.Run(ctx, s.wp, func(ctx context.Context) error {
+		if err := s.requireStatus(ctx, statusID); err != nil {
+			return err
 		}
 
 		updatedStatus := model.Status{
@@ -132,20 +104,13 @@ func (s *StatusService) UpdateStatus(ctx context.Context, statusID int, statusNa
 			StatusName: statusName,
 		}
 
-		if err := dao.UpdateStatus(ctx, updatedStatus, s.pool); err != nil {
-			errCh <- fmt.Errorf("ошибка обновления статуса с ID %d: %w", statusID, err)
-			return
+		if err := s.statuses.Update(ctx, updatedStatus); err != nil {
+			return fmt.Errorf("ошибка обновления статуса с ID %d: %w", statusID, err)
 		}
 
-		cacheKey := fmt.Sprintf("status_%d", statusID)
-		if err := s.cache.Delete(ctx, cacheKey); err != nil {
-			log.Printf("Ошибка удаления кэша статуса с ключом %s: %v", cacheKey, err)
-		}
-
-		errCh <- nil
+		s.invalidateStatus(ctx)
+		return nil
 	})
-
-	return <-errCh
 }
 
 // DeleteStatus удаляет статус и сбрасывает кэш
@@ -153,46 +118,59 @@ func (s *StatusService) DeleteStatus(ctx context.Context, statusID int) error {
 	ctx, span := s.tracer.Start(ctx, "DeleteStatus")
 	defer span.End()
 
-	errCh := make(chan error, 1)
-
-	s.wp.SubmitTask(func() {
-		exists, err := dao.CheckStatusExists(ctx, statusID, s.pool)
-		if err != nil {
-			errCh <- fmt.Errorf("ошибка проверки существования статуса с ID %d: %w", statusID, err)
-			return
-		}
-		if !exists {
-			errCh <- fmt.Errorf("статус с ID %d не найден", statusID)
-			return
-		}
-
-		if err := dao.DeleteStatus(ctx, statusID, s.pool); err != nil {
-			errCh <- fmt.Errorf("ошибка удаления статуса с ID %d: %w", statusID, err)
-			return
+	return pool.Run(ctx, s.wp, func(ctx context.Context) error {
+		if err := s.requireStatus(ctx, statusID); err != nil {
+			return err
 		}
 
-		cacheKey := fmt.Sprintf("status_%d", statusID)
-		if err := s.cache.Delete(ctx, cacheKey); err != nil {
-			log.Printf("Ошибка удаления кэша статуса с ключом %s: %v", cacheKey, err)
+		if err := s.statuses.Delete(ctx, statusID); err != nil {
+			return fmt.Errorf("ошибка удаления статуса с ID %d: %w", statusID, err)
 		}
 
-		errCh <- nil
+		s.invalidateStatus(ctx)
+		return nil
 	})
+}
+
+// requireStatus возвращает ошибку, если статус с ID statusID не найден
+func (s *StatusService) requireStatus(ctx context.Context, statusID int) error {
+	_, err := s.statuses.GetByID(ctx, statusID)
+	if errors.Is(err, repository.ErrNotFound) {
+		return fmt.Errorf("статус с ID %d не найден", statusID)
+	}
+	if err != nil {
+		return fmt.Errorf("ошибка проверки существования статуса с ID %d: %w", statusID, err)
+	}
+	return nil
+}
 
-	return <-errCh
+// invalidateStatus сбрасывает кэш справочника статусов, в том числе записи по именам и отметки об отсутствии статусов
+func (s *StatusService) invalidateStatus(ctx context.Context) {
+	invalidateTags(ctx, s.cache, statusesTag)
 }
 
-// GetStatusByName возвращает статус по его имени через worker pool
+// statusByNameCacheKey возвращает ключ кэша статуса по имени
+func statusByNameCacheKey(statusName string) string {
+	return "status_by_name_" + statusName
+}
+
+// GetStatusByName возвращает ID статуса по его имени с использованием кэша
 func (s *StatusService) GetStatusByName(ctx context.Context, statusName string) (int, error) {
 	ctx, span := s.tracer.Start(ctx, "GetStatusByName")
 	defer span.End()
 
-	status, err := dao.GetStatusByName(ctx, statusName, s.pool)
+	cacheKey := statusByNameCacheKey(statusName)
+	status, err := s.cache.GetOrLoad(ctx, cacheKey, func(ctx context.Context) (model.Status, error) {
+		status, err := s.statuses.GetByName(ctx, statusName)
+		if err != nil {
+			return model.Status{}, err
+		}
+		return *status, nil
+	}, cache.WithTTL(10*time.Minute), cache.WithTags(statusesTag))
 	if err != nil {
 		return 0, fmt.Errorf("ошибка получения статуса с именем %s: %w", statusName, err)
 	}
 
-	log.Printf("Статус с именем %s имеет ID %d", statusName, status.StatusID)
 	return status.StatusID, nil
 }
 
@@ -201,7 +179,7 @@ func (s *StatusService) CheckStatusExists(ctx context.Context, statusID int) (bo
 	ctx, span := s.tracer.Start(ctx, "CheckStatusExists")
 	defer span.End()
 
-	exists, err := dao.CheckStatusExists(ctx, statusID, s.pool)
+	exists, err := s.statuses.Exists(ctx, statusID)
 	if err != nil {
 		return false, fmt.Errorf("ошибка проверки существования статуса с ID %d: %w", statusID, err)
 	}
@@ -216,25 +194,36 @@ func (s *StatusService) GetStatusNameByID(ctx context.Context, statusID int) (st
 	defer span.End()
 
 	cacheKey := fmt.Sprintf("status_name_%d", statusID)
-
-	var cachedStatusName string
-	err := s.cache.Get(ctx, cacheKey, &cachedStatusName)
-	if err == nil {
-		log.Printf("Имя статуса с ID %d получено из кэша", statusID)
-		return cachedStatusName, nil
-	} else {
-		log.Printf("Имя статуса с ID %d не найдено в кэше, получаем из базы данных", statusID)
-	}
-
-	statusName, err := dao.GetStatusNameByID(ctx, statusID, s.pool)
+	statusName, err := s.cache.GetOrLoadString(ctx, cacheKey, func(ctx context.Context) (string, error) {
+		return s.statuses.GetNameByID(ctx, statusID)
+	}, cache.WithTTL(10*time.Minute), cache.WithTags(statusesTag))
 	if err != nil {
 		return "", fmt.Errorf("ошибка получения имени статуса с ID %d: %w", statusID, err)
 	}
 
-	err = s.cache.SetString(ctx, cacheKey, statusName, 10*time.Minute)
+	return statusName, nil
+}
+
+// WarmCache загружает в кэш все статусы: они читаются при каждом изменении заказа
+func (s *StatusService) WarmCache(ctx context.Context) error {
+	ctx, span := s.tracer.Start(ctx, "WarmStatusCache")
+	defer span.End()
+
+	statuses, err := s.statuses.GetAll(ctx)
 	if err != nil {
-		log.Printf("Ошибка сохранения имени статуса в кэш: %v", err)
+		return fmt.Errorf("ошибка получения статусов для прогрева кэша: %w", err)
 	}
 
-	return statusName, nil
+	for _, status := range statuses {
+		if _, err := s.GetStatusByID(ctx, status.StatusID); err != nil {
+			return err
+		}
+		if _, err := s.GetStatusByName(ctx, status.StatusName); err != nil {
+			return err
+		}
+		if _, err := s.GetStatusNameByID(ctx, status.StatusID); err != nil {
+			return err
+		}
+	}
+	return nil
 }
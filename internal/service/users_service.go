@@ -3,11 +3,10 @@ package service
 import (
 	"context"
 	"fmt"
-	"github.com/jackc/pgx/v4/pgxpool"
 	"homework1/internal/cache"
-	"homework1/internal/dao"
 	"homework1/internal/model"
 	"homework1/internal/pool"
+	"homework1/internal/repository"
 	"log"
 	"time"
 
@@ -17,16 +16,16 @@ import (
 
 // UserService представляет сервис для работы с пользователями
 type UserService struct {
-	pool   *pgxpool.Pool
+	users  repository.UserRepository
 	wp     *pool.WorkerPool
-	cache  *cache.RedisCache[string, model.User]
+	cache  *cache.LoadingCache[string, model.User]
 	tracer trace.Tracer
 }
 
 // NewUserService создает новый сервис для работы с пользователями
-func NewUserService(dbPool *pgxpool.Pool, workerPool *pool.WorkerPool, cache *cache.RedisCache[string, model.User]) *UserService {
+func NewUserService(store repository.Store, workerPool *pool.WorkerPool, cache *cache.LoadingCache[string, model.User]) *UserService {
 	return &UserService{
-		pool:   dbPool,
+		users:  store.Users,
 		wp:     workerPool,
 		cache:  cache,
 		tracer: tracing.GetTracer(),
@@ -38,32 +37,21 @@ func (s *UserService) CreateUser(ctx context.Context, username string) (int, err
 	ctx, span := s.tracer.Start(ctx, "CreateUser")
 	defer span.End()
 
-	var userID int
-	errCh := make(chan error, 1)
-
-	s.wp.SubmitTask(func() {
+	return pool.Submit(ctx, s.wp, func(ctx context.Context) (int, error) {
 		newUser := model.User{
 			Username:  username,
 			CreatedAt: time.Now().UTC(),
 		}
 
-		var err error
-		userID, err = dao.CreateUser(ctx, newUser, s.pool)
+		userID, err := s.users.Create(ctx, newUser)
 		if err != nil {
-			errCh <- fmt.Errorf("ошибка создания пользователя: %w", err)
-			return
-		}
-
-		cacheKey := fmt.Sprintf("user_%d", userID)
-		if err := s.cache.Delete(ctx, cacheKey); err != nil {
-			log.Printf("Ошибка удаления кэша пользователя с ключом %s: %v", cacheKey, err)
+			return 0, fmt.Errorf("ошибка создания пользователя: %w", err)
 		}
 
-		errCh <- nil
-	})
+		s.invalidateUser(ctx, userID)
 
-	err := <-errCh
-	return userID, err
+		return userID, nil
+	}).Get(ctx)
 }
 
 // GetUserByID возвращает пользователя по его ID с использованием кэша
@@ -73,26 +61,18 @@ func (s *UserService) GetUserByID(ctx context.Context, userID int) (*model.User,
 
 	cacheKey := fmt.Sprintf("user_%d", userID)
 
-	var cachedUser model.User
-	err := s.cache.Get(ctx, cacheKey, &cachedUser)
-	if err == nil {
-		log.Printf("Пользователь с ID %d получен из кэша", userID)
-		return &cachedUser, nil
-	} else {
-		log.Printf("Пользователь с ID %d не найден в кэше, получаем из базы данных", userID)
-	}
-
-	user, err := dao.GetUserByID(ctx, userID, s.pool)
+	user, err := s.cache.GetOrLoad(ctx, cacheKey, func(ctx context.Context) (model.User, error) {
+		user, err := s.users.GetByID(ctx, userID)
+		if err != nil {
+			return model.User{}, err
+		}
+		return *user, nil
+	}, cache.WithTTL(10*time.Minute), cache.WithTags(userTag(userID)))
 	if err != nil {
 		return nil, fmt.Errorf("ошибка получения пользователя с ID %d: %w", userID, err)
 	}
 
-	err = s.cache.Set(ctx, cacheKey, *user, 10*time.Minute) // Разыменовываем указатель
-	if err != nil {
-		log.Printf("Ошибка сохранения пользователя в кэш: %v", err)
-	}
-
-	return user, nil
+	return &user, nil
 }
 
 // GetAllUsers возвращает всех пользователей через worker pool
@@ -100,7 +80,7 @@ func (s *UserService) GetAllUsers(ctx context.Context) ([]model.User, error) {
 	ctx, span := s.tracer.Start(ctx, "GetAllUsers")
 	defer span.End()
 
-	users, err := dao.GetAllUsers(ctx, s.pool)
+	users, err := s.users.GetAll(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("ошибка получения всех пользователей: %w", err)
 	}
@@ -114,17 +94,13 @@ func (s *UserService) UpdateUser(ctx context.Context, userID int, username strin
 	ctx, span := s.tracer.Start(ctx, "UpdateUser")
 	defer span.End()
 
-	errCh := make(chan error, 1)
-
-	s.wp.SubmitTask(func() {
-		exists, err := dao.CheckUserExists(ctx, userID, s.pool)
+	return pool.Run(ctx, s.wp, func(ctx context.Context) error {
+		exists, err := s.users.Exists(ctx, userID)
 		if err != nil {
-			errCh <- fmt.Errorf("ошибка проверки существования пользователя с ID %d: %w", userID, err)
-			return
+			return fmt.Errorf("ошибка проверки существования пользователя с ID %d: %w", userID, err)
 		}
 		if !exists {
-			errCh <- fmt.Errorf("пользователь с ID %d не найден", userID)
-			return
+			return fmt.Errorf("пользователь с ID %d не найден", userID)
 		}
 
 		updatedUser := model.User{
@@ -133,20 +109,14 @@ func (s *UserService) UpdateUser(ctx context.Context, userID int, username strin
 			CreatedAt: time.Now().UTC(),
 		}
 
-		if err := dao.UpdateUser(ctx, updatedUser, s.pool); err != nil {
-			errCh <- fmt.Errorf("ошибка обновления данных пользователя с ID %d: %w", userID, err)
-			return
+		if err := s.users.Update(ctx, updatedUser); err != nil {
+			return fmt.Errorf("ошибка обновления данных пользователя с ID %d: %w", userID, err)
 		}
 
-		cacheKey := fmt.Sprintf("user_%d", userID)
-		if err := s.cache.Delete(ctx, cacheKey); err != nil {
-			log.Printf("Ошибка удаления кэша пользователя с ключом %s: %v", cacheKey, err)
-		}
+		s.invalidateUser(ctx, userID)
 
-		errCh <- nil
+		return nil
 	})
-
-	return <-errCh
 }
 
 // DeleteUser удаляет пользователя и сбрасывает кэш
@@ -154,33 +124,23 @@ func (s *UserService) DeleteUser(ctx context.Context, userID int) error {
 	ctx, span := s.tracer.Start(ctx, "DeleteUser")
 	defer span.End()
 
-	errCh := make(chan error, 1)
-
-	s.wp.SubmitTask(func() {
-		exists, err := dao.CheckUserExists(ctx, userID, s.pool)
+	return pool.Run(ctx, s.wp, func(ctx context.Context) error {
+		exists, err := s.users.Exists(ctx, userID)
 		if err != nil {
-			errCh <- fmt.Errorf("ошибка проверки существования пользователя с ID %d: %w", userID, err)
-			return
+			return fmt.Errorf("ошибка проверки существования пользователя с ID %d: %w", userID, err)
 		}
 		if !exists {
-			errCh <- fmt.Errorf("пользователь с ID %d не найден", userID)
-			return
+			return fmt.Errorf("пользователь с ID %d не найден", userID)
 		}
 
-		if err := dao.DeleteUser(ctx, userID, s.pool); err != nil {
-			errCh <- fmt.Errorf("ошибка удаления пользователя с ID %d: %w", userID, err)
-			return
+		if err := s.users.Delete(ctx, userID); err != nil {
+			return fmt.Errorf("ошибка удаления пользователя с ID %d: %w", userID, err)
 		}
 
-		cacheKey := fmt.Sprintf("user_%d", userID)
-		if err := s.cache.Delete(ctx, cacheKey); err != nil {
-			log.Printf("Ошибка удаления кэша пользователя с ключом %s: %v", cacheKey, err)
-		}
+		s.invalidateUser(ctx, userID)
 
-		errCh <- nil
+		return nil
 	})
-
-	return <-errCh
 }
 
 // GetUserNameByID возвращает имя пользователя по его ID через worker pool
@@ -190,25 +150,13 @@ func (s *UserService) GetUserNameByID(ctx context.Context, userID int) (string,
 
 	cacheKey := fmt.Sprintf("user_name_%d", userID)
 
-	var cachedUsername string
-	err := s.cache.Get(ctx, cacheKey, &cachedUsername)
-	if err == nil {
-		log.Printf("Имя пользователя с ID %d получено из кэша", userID)
-		return cachedUsername, nil
-	} else {
-		log.Printf("Имя пользователя с ID %d не найдено в кэше, получаем из базы данных", userID)
-	}
-
-	username, err := dao.GetUserNameByID(ctx, userID, s.pool)
+	username, err := s.cache.GetOrLoadString(ctx, cacheKey, func(ctx context.Context) (string, error) {
+		return s.users.GetNameByID(ctx, userID)
+	}, cache.WithTTL(10*time.Minute), cache.WithTags(userTag(userID)))
 	if err != nil {
 		return "", fmt.Errorf("ошибка получения имени пользователя с ID %d: %w", userID, err)
 	}
 
-	err = s.cache.SetString(ctx, cacheKey, username, 10*time.Minute)
-	if err != nil {
-		log.Printf("Ошибка сохранения имени пользователя в кэш: %v", err)
-	}
-
 	return username, nil
 }
 
@@ -217,7 +165,7 @@ func (s *UserService) CheckUserExists(ctx context.Context, userID int) (bool, er
 	ctx, span := s.tracer.Start(ctx, "CheckUserExists")
 	defer span.End()
 
-	exists, err := dao.CheckUserExists(ctx, userID, s.pool)
+	exists, err := s.users.Exists(ctx, userID)
 	if err != nil {
 		return false, fmt.Errorf("ошибка проверки существования пользователя с ID %d: %w", userID, err)
 	}
@@ -225,3 +173,8 @@ func (s *UserService) CheckUserExists(ctx context.Context, userID int) (bool, er
 	log.Printf("Пользователь с ID %d существует: %v", userID, exists)
 	return exists, nil
 }
+
+// invalidateUser сбрасывает кэш пользователя, в том числе отметки об отсутствии пользователя
+func (s *UserService) invalidateUser(ctx context.Context, userID int) {
+	invalidateTags(ctx, s.cache, userTag(userID))
+}
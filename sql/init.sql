@@ -13,7 +13,8 @@ CREATE TABLE IF NOT EXISTS packaging (
 
 CREATE TABLE IF NOT EXISTS statuses (
                                         status_id SERIAL PRIMARY KEY,
-                                        status_name VARCHAR(255) NOT NULL
+                                        status_name VARCHAR(255) NOT NULL,
+    code VARCHAR(64) UNIQUE
     );
 
 CREATE TABLE IF NOT EXISTS return_reasons (
@@ -34,7 +35,8 @@ CREATE TABLE IF NOT EXISTS orders (
     packaging_id INT REFERENCES packaging(packaging_id),
     status_id INT REFERENCES statuses(status_id),
     issue_date TIMESTAMP,
-    with_film BOOLEAN NOT NULL
+    with_film BOOLEAN NOT NULL,
+    expiry_notified_at TIMESTAMP
     );
 
 CREATE TABLE IF NOT EXISTS returns (
@@ -50,13 +52,87 @@ CREATE TABLE IF NOT EXISTS returns (
     status_id INT REFERENCES statuses(status_id)
     );
 
+CREATE INDEX IF NOT EXISTS idx_orders_user_id_order_id ON orders (user_id, order_id);
+CREATE INDEX IF NOT EXISTS idx_orders_status_id_order_id ON orders (status_id, order_id);
+CREATE INDEX IF NOT EXISTS idx_orders_packaging_id_order_id ON orders (packaging_id, order_id);
+CREATE INDEX IF NOT EXISTS idx_orders_acceptance_date ON orders (acceptance_date);
+CREATE INDEX IF NOT EXISTS idx_orders_expiration_date ON orders (expiration_date);
+CREATE INDEX IF NOT EXISTS idx_orders_not_issued ON orders (order_id) WHERE issue_date IS NULL;
+CREATE INDEX IF NOT EXISTS idx_returns_user_id_return_id ON returns (user_id, return_id);
+CREATE INDEX IF NOT EXISTS idx_returns_status_id_return_id ON returns (status_id, return_id);
+CREATE INDEX IF NOT EXISTS idx_returns_return_date ON returns (return_date);
+
+CREATE TABLE IF NOT EXISTS order_status_history (
+                                                    change_id BIGSERIAL PRIMARY KEY,
+                                                    order_id INT NOT NULL,
+                                                    old_status_id INT,
+                                                    new_status_id INT,
+                                                    actor TEXT NOT NULL,
+                                                    reason TEXT NOT NULL DEFAULT '',
+                                                    changed_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_order_status_history_order_id ON order_status_history (order_id, changed_at, change_id);
+
+-- История только дополняется: изменение и удаление записей запрещены
+CREATE OR REPLACE FUNCTION order_status_history_append_only() RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'order_status_history is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS trg_order_status_history_append_only ON order_status_history;
+CREATE TRIGGER trg_order_status_history_append_only
+    BEFORE UPDATE OR DELETE ON order_status_history
+    FOR EACH ROW EXECUTE FUNCTION order_status_history_append_only();
+
+CREATE TABLE IF NOT EXISTS outbox_events (
+                                             event_id BIGSERIAL PRIMARY KEY,
+                                             topic VARCHAR(255) NOT NULL,
+    event_key VARCHAR(255) NOT NULL DEFAULT '',
+    aggregate_id INT NOT NULL,
+    event_type VARCHAR(255) NOT NULL,
+    payload JSONB NOT NULL,
+    headers JSONB NOT NULL DEFAULT '{}',
+    attempts INT NOT NULL DEFAULT 0,
+    last_error TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    next_attempt_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    sent_at TIMESTAMP
+    );
+
+CREATE INDEX IF NOT EXISTS idx_outbox_events_pending ON outbox_events (event_id) WHERE sent_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_outbox_events_pending_key ON outbox_events (event_key, event_id) WHERE sent_at IS NULL;
+
+CREATE TABLE IF NOT EXISTS notification_deliveries (
+                                                       delivery_id BIGSERIAL PRIMARY KEY,
+                                                       event_id VARCHAR(255) NOT NULL,
+    event_type VARCHAR(255) NOT NULL,
+    channel VARCHAR(255) NOT NULL,
+    recipient VARCHAR(255) NOT NULL DEFAULT '',
+    status VARCHAR(32) NOT NULL,
+    error TEXT NOT NULL DEFAULT '',
+    duration_ms BIGINT NOT NULL DEFAULT 0,
+    attempted_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+    );
+
+CREATE INDEX IF NOT EXISTS idx_notification_deliveries_event ON notification_deliveries (event_id, channel);
+
+CREATE TABLE IF NOT EXISTS processed_events (
+                                                event_id VARCHAR(255) PRIMARY KEY,
+    event_type VARCHAR(255) NOT NULL,
+    processed_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+    );
+
+CREATE INDEX IF NOT EXISTS idx_processed_events_processed_at ON processed_events (processed_at);
+
 
 -- Заполнение таблицы статусов
-INSERT INTO statuses (status_name) VALUES
-                                       ('Создан'),
-                                       ('Выдан'),
-                                       ('Возврат'),
-                                       ('Передан курьеру');
+INSERT INTO statuses (status_name, code) VALUES
+                                       ('Создан', 'accepted'),
+                                       ('Выдан', 'issued'),
+                                       ('Возврат', 'returned'),
+                                       ('Передан курьеру', 'handed_to_courier');
 
 -- Заполнение таблицы причин возвратов
 INSERT INTO return_reasons (reason) VALUES